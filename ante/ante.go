@@ -57,9 +57,13 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 	if sigGasConsumer == nil {
 		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
 	}
+	extensionOptionChecker := opts.ExtensionOptionChecker
+	if extensionOptionChecker == nil {
+		extensionOptionChecker = photonante.ExtensionOptionChecker
+	}
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		ante.NewExtensionOptionsDecorator(opts.ExtensionOptionChecker),
+		ante.NewExtensionOptionsDecorator(extensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewSetPubKeyDecorator(opts.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
@@ -69,17 +73,22 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewIncrementSequenceDecorator(opts.AccountKeeper),
 		ante.NewValidateMemoDecorator(opts.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
+		photonante.NewMintOnFeeDecorator(opts.PhotonKeeper), // MintOnFeeDecorator must be called before the fee is validated and deducted
 		photonante.NewValidateFeeDecorator(opts.PhotonKeeper),
-		dynamicfeeante.NewDynamicfeeCheckDecorator(
-			opts.AccountKeeper,
-			opts.BankKeeper,
-			opts.FeegrantKeeper,
-			opts.DynamicfeeKeeper,
-			ante.NewDeductFeeDecorator( // legacy fee deduct decorator used as fallback if dynamicfee is disabled
+		photonante.NewRelayerZeroFeeDecorator( // RelayerZeroFeeDecorator skips the fee checks of zero-fee relayer txs, if allowed by the photon params
+			opts.PhotonKeeper,
+			opts.IBCkeeper.ChannelKeeper,
+			dynamicfeeante.NewDynamicfeeCheckDecorator(
 				opts.AccountKeeper,
 				opts.BankKeeper,
 				opts.FeegrantKeeper,
-				opts.TxFeeChecker,
+				opts.DynamicfeeKeeper,
+				ante.NewDeductFeeDecorator( // legacy fee deduct decorator used as fallback if dynamicfee is disabled
+					opts.AccountKeeper,
+					opts.BankKeeper,
+					opts.FeegrantKeeper,
+					opts.TxFeeChecker,
+				),
 			),
 		),
 		NewGovVoteDecorator(opts.Codec, opts.StakingKeeper),
@@ -85,6 +85,16 @@ func (g GovVoteDecorator) ValidateVoteMsgs(ctx sdk.Context, msgs []sdk.Msg) erro
 			if err != nil {
 				return err
 			}
+		case *govv1.MsgCommitVote:
+			accAddr, err = sdk.AccAddressFromBech32(msg.Voter)
+			if err != nil {
+				return err
+			}
+		case *govv1.MsgRevealVote:
+			accAddr, err = sdk.AccAddressFromBech32(msg.Voter)
+			if err != nil {
+				return err
+			}
 		case *sdkgovv1beta1.MsgVote:
 			accAddr, err = sdk.AccAddressFromBech32(msg.Voter)
 			if err != nil {
@@ -439,6 +439,34 @@ func TestVoteSpamDecoratorGovV1(t *testing.T) {
 		} else {
 			require.Error(t, err, "expected %v to fail", tc.name)
 		}
+
+		// Create commit vote message
+		commitMsg := govv1.NewMsgCommitVote(delegator, 0, make([]byte, 32))
+
+		// Validate commit vote message
+		err = decorator.ValidateVoteMsgs(ctx, []sdk.Msg{commitMsg})
+		if tc.expectPass {
+			require.NoError(t, err, "expected %v to pass", tc.name)
+		} else {
+			require.Error(t, err, "expected %v to fail", tc.name)
+		}
+
+		// Create reveal vote message
+		revealMsg := govv1.NewMsgRevealVote(
+			delegator,
+			0,
+			govv1.NewNonSplitVoteOption(govv1.OptionYes),
+			"new-v1-vote-message-test",
+			make([]byte, govv1.MinVoteSaltLength),
+		)
+
+		// Validate reveal vote message
+		err = decorator.ValidateVoteMsgs(ctx, []sdk.Msg{revealMsg})
+		if tc.expectPass {
+			require.NoError(t, err, "expected %v to pass", tc.name)
+		} else {
+			require.Error(t, err, "expected %v to fail", tc.name)
+		}
 	}
 }
 
@@ -15,6 +15,20 @@ import (
 	govtypes "github.com/atomone-hub/atomone/x/gov/types"
 )
 
+func TestAtomOneApp_New(t *testing.T) {
+	// the keepers and their hooks are wired at construction, e.g. the epochs
+	// hooks can only be set once and after the keepers they reference.
+	require.NotPanics(t, func() {
+		atomone.NewAtomOneApp(
+			log.NewNopLogger(),
+			dbm.NewMemDB(),
+			nil,
+			true,
+			atomone.EmptyAppOptions{},
+		)
+	})
+}
+
 func TestAtomOneApp_BlockedModuleAccountAddrs(t *testing.T) {
 	app := atomone.NewAtomOneApp(
 		log.NewNopLogger(),
@@ -0,0 +1,23 @@
+package keepers
+
+import (
+	"context"
+
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// communityPoolKeeper exposes the distribution fee pool to the photon keeper,
+// which converts the community pool funds into photon, and to the coredaos
+// keeper, which funds the budgets of the Core DAOs from the community pool.
+type communityPoolKeeper struct {
+	k distrkeeper.Keeper
+}
+
+func (d communityPoolKeeper) GetFeePool(ctx context.Context) (distrtypes.FeePool, error) {
+	return d.k.FeePool.Get(ctx)
+}
+
+func (d communityPoolKeeper) SetFeePool(ctx context.Context, feePool distrtypes.FeePool) error {
+	return d.k.FeePool.Set(ctx, feePool)
+}
@@ -68,6 +68,7 @@ import (
 	coredaoskeeper "github.com/atomone-hub/atomone/x/coredaos/keeper"
 	coredaostypes "github.com/atomone-hub/atomone/x/coredaos/types"
 	atomonegovkeeper "github.com/atomone-hub/atomone/x/gov/keeper"
+	atomonegovtypes "github.com/atomone-hub/atomone/x/gov/types"
 	photonkeeper "github.com/atomone-hub/atomone/x/photon/keeper"
 	photontypes "github.com/atomone-hub/atomone/x/photon/types"
 )
@@ -190,15 +191,6 @@ func NewAppKeeper(
 		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
 	)
 
-	appKeepers.PhotonKeeper = photonkeeper.NewKeeper(
-		appCodec,
-		appKeepers.keys[photontypes.StoreKey],
-		authorityStr,
-		appKeepers.BankKeeper,
-		appKeepers.AccountKeeper,
-		appKeepers.StakingKeeper,
-	)
-
 	appKeepers.MintKeeper = mintkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[minttypes.StoreKey]),
@@ -225,7 +217,17 @@ func NewAppKeeper(
 		runtime.NewKVStoreService(appKeepers.keys[epochstypes.StoreKey]),
 		appCodec,
 	)
-	appKeepers.EpochsKeeper.SetHooks(appKeepers.DistrKeeper.Hooks())
+
+	appKeepers.PhotonKeeper = photonkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[photontypes.StoreKey],
+		authorityStr,
+		appKeepers.BankKeeper,
+		appKeepers.AccountKeeper,
+		appKeepers.StakingKeeper,
+		&appKeepers.EpochsKeeper,
+		communityPoolKeeper{appKeepers.DistrKeeper},
+	)
 
 	appKeepers.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec,
@@ -277,7 +279,11 @@ func NewAppKeeper(
 		})
 	appKeepers.GovKeeper.SetLegacyRouter(govRouter)
 
-	appKeepers.GovKeeperWrapper = atomonegovkeeper.NewKeeper(appKeepers.GovKeeper)
+	appKeepers.GovKeeperWrapper = atomonegovkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[atomonegovtypes.AtomOneStoreKey]),
+		appKeepers.GovKeeper,
+	)
 
 	appKeepers.CoreDaosKeeper = coredaoskeeper.NewKeeper(
 		appCodec,
@@ -285,6 +291,23 @@ func NewAppKeeper(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		appKeepers.GovKeeper,
 		appKeepers.StakingKeeper,
+		&appKeepers.EpochsKeeper,
+		appKeepers.BankKeeper,
+		communityPoolKeeper{appKeepers.DistrKeeper},
+	)
+	appKeepers.CoreDaosKeeper.SetQuorumCheckKeeper(appKeepers.GovKeeperWrapper)
+	appKeepers.CoreDaosKeeper.SetCommitRevealKeeper(appKeepers.GovKeeperWrapper)
+	appKeepers.GovKeeperWrapper.SetEndorsementKeeper(appKeepers.CoreDaosKeeper)
+
+	// register the epochs hooks
+	// NOTE: the coredaos keeper funds the Core DAO budgets at the start of the
+	// epochs, so it must be created first.
+	appKeepers.EpochsKeeper.SetHooks(
+		epochstypes.NewMultiEpochHooks(
+			appKeepers.DistrKeeper.Hooks(),
+			appKeepers.PhotonKeeper.EpochHooks(),
+			appKeepers.CoreDaosKeeper.EpochHooks(),
+		),
 	)
 
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -298,8 +321,14 @@ func NewAppKeeper(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	appKeepers.EvidenceKeeper = *evidenceKeeper
 
-	// Register coredaos gov hooks (rejects bundling an oversight-DAO change with other messages).
-	appKeepers.GovKeeper = appKeepers.GovKeeper.SetHooks(appKeepers.CoreDaosKeeper.GovHooks())
+	// Register coredaos gov hooks (rejects bundling an oversight-DAO change with other messages)
+	// and the AtomOne gov hooks (records the proposals enacting the laws and amending the constitution).
+	appKeepers.GovKeeper = appKeepers.GovKeeper.SetHooks(
+		govtypes.NewMultiGovHooks(
+			appKeepers.CoreDaosKeeper.GovHooks(),
+			appKeepers.GovKeeperWrapper.GovHooks(),
+		),
+	)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -50,6 +50,7 @@ import (
 	"github.com/atomone-hub/atomone/x/coredaos"
 	coredaostypes "github.com/atomone-hub/atomone/x/coredaos/types"
 	atomonegov "github.com/atomone-hub/atomone/x/gov"
+	atomonegovtypes "github.com/atomone-hub/atomone/x/gov/types"
 	atomonegovv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	"github.com/atomone-hub/atomone/x/photon"
 	photontypes "github.com/atomone-hub/atomone/x/photon/types"
@@ -76,7 +77,7 @@ type govModuleAtomOneDefaults struct {
 // DefaultGenesis returns default genesis state as raw bytes for the gov module.
 // It sets the same defaults than the atom one x/gov wrapper for genesis
 func (am govModuleAtomOneDefaults) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(atomonegovv1.DefaultGenesisState())
+	return cdc.MustMarshalJSON(atomonegovv1.ConvertAtomOneGenesisStateToSDK(atomonegovv1.DefaultGenesisState()))
 }
 
 func appModules(
@@ -215,6 +216,7 @@ func orderInitBlockers() []string {
 		distrtypes.ModuleName,
 		stakingtypes.ModuleName,
 		govtypes.ModuleName,
+		atomonegovtypes.AtomOneModuleName,
 		photontypes.ModuleName,
 		slashingtypes.ModuleName,
 		minttypes.ModuleName,
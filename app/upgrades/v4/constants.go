@@ -9,6 +9,7 @@ import (
 
 	"github.com/atomone-hub/atomone/app/upgrades"
 	coredaostypes "github.com/atomone-hub/atomone/x/coredaos/types"
+	atomonegovtypes "github.com/atomone-hub/atomone/x/gov/types"
 )
 
 const (
@@ -25,7 +26,10 @@ var Upgrade = upgrades.Upgrade{
 			coredaostypes.StoreKey,
 			icacontrollertypes.StoreKey,
 			epochstypes.StoreKey,
-			// x/gov has been added but it uses the same store key as the x/gov fork from v3
+			// x/gov has been added but it uses the same store key as the x/gov fork from v3,
+			// except for the laws, the constitution history, the constitution amendment patch params
+			// and the commit-reveal voting which are held in their own store
+			atomonegovtypes.AtomOneStoreKey,
 		},
 		Deleted: []string{
 			capabilityStoreKey,
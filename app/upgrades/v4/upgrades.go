@@ -48,6 +48,11 @@ func CreateUpgradeHandler(
 			return vm, err
 		}
 
+		// start the constitution history with the constitution in force
+		if err := keepers.GovKeeperWrapper.InitConstitutionHistory(ctx); err != nil {
+			return vm, fmt.Errorf("failed to init constitution history: %w", err)
+		}
+
 		if err := MigrateStakingParams(ctx, keepers.StakingKeeper); err != nil {
 			return vm, err
 		}
@@ -3,6 +3,7 @@ package e2e
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	coredaostypes "github.com/atomone-hub/atomone/x/coredaos/types"
 	govtypesv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
@@ -23,6 +24,9 @@ func (s *IntegrationTestSuite) testCoreDAOs() {
 	s.Require().NoError(err)
 	params.Params.SteeringDaoAddress = steeringDAOAddress.String()
 	params.Params.OversightDaoAddress = oversightDAOAddress.String()
+	// execute vetoes at the end of the block they are submitted in
+	vetoDelay := time.Duration(0)
+	params.Params.VetoDelay = &vetoDelay
 	s.writeCoreDAOsParamChangeProposal(s.chainA, params.Params)
 	// Gov tests may be run in arbitrary order, each test must increment proposalCounter to have the correct proposal id to submit and query
 	proposalCounter++
@@ -308,6 +308,12 @@ func (s *IntegrationTestSuite) testGovConstitutionAmendment() {
 			10*time.Second,
 			time.Second,
 		)
+
+		history := s.queryConstitutionHistory(chainAAPIEndpoint)
+		s.Require().NotEmpty(history.ConstitutionVersions)
+		latest := history.ConstitutionVersions[len(history.ConstitutionVersions)-1]
+		s.Require().Equal(newConstitution, latest.Constitution)
+		s.Require().Equal(uint64(proposalCounter), latest.AmendingProposalId)
 	})
 }
 
@@ -402,6 +408,12 @@ func (s *IntegrationTestSuite) testGovDynamicQuorum() {
 		s.Require().Equal(quorumsAfter.Quorum, quorums.Quorum, "quorum does not match expected quorum", quorumsAfter.Quorum, quorums.Quorum)
 		s.Require().Equal(quorumsAfter.ConstitutionAmendmentQuorum,
 			quorums.ConstitutionAmendmentQuorum, "constitution amendment quorum does not match expected quorum", quorumsAfter.ConstitutionAmendmentQuorum, quorums.ConstitutionAmendmentQuorum)
+
+		laws := s.queryGovLaws(chainAAPIEndpoint)
+		s.Require().NotEmpty(laws.Laws)
+		law := laws.Laws[len(laws.Laws)-1]
+		s.Require().Equal(uint64(proposalCounter), law.EnactingProposalId)
+		s.Require().Equal("New Law", law.Title)
 	})
 
 	s.Run("dynamic constitution amendment quorum change", func() {
@@ -789,7 +801,9 @@ func (s *IntegrationTestSuite) writeGovLawProposal(c *chain) {
 	 "messages": [
 		{
 		 "@type": "/atomone.gov.v1.MsgProposeLaw",
-		 "authority": "%s"
+		 "authority": "%s",
+		 "title": "New Law",
+		 "text": "This is the text of the law"
 		}
 	 ],
 	 "deposit": "%s",
@@ -236,7 +236,7 @@ func modifyGenesis(cdc codec.Codec, path, moniker, amountStr string, addrAll []s
 		),
 	)
 	govGenState.Constitution = "This is a test constitution"
-	govGenStateBz, err := cdc.MarshalJSON(govGenState)
+	govGenStateBz, err := cdc.MarshalJSON(govv1.ConvertAtomOneGenesisStateToSDK(govGenState))
 	if err != nil {
 		return fmt.Errorf("failed to marshal gov genesis state: %w", err)
 	}
@@ -227,6 +227,15 @@ func (s *IntegrationTestSuite) queryGovQuorums(endpoint string) govtypesv1.Query
 	return res
 }
 
+func (s *IntegrationTestSuite) queryGovLaws(endpoint string) govtypesv1.QueryLawsResponse {
+	body, err := httpGet(fmt.Sprintf("%s/atomone/gov/v1/laws", endpoint))
+	s.Require().NoError(err)
+	var res govtypesv1.QueryLawsResponse
+	err = s.cdc.UnmarshalJSON(body, &res)
+	s.Require().NoError(err)
+	return res
+}
+
 func (s *IntegrationTestSuite) queryGovParams(endpoint string, param string) govtypesv1.QueryParamsResponse {
 	body, err := httpGet(fmt.Sprintf("%s/atomone/gov/v1/params/%s", endpoint, param))
 	s.Require().NoError(err)
@@ -396,6 +405,15 @@ func (s *IntegrationTestSuite) queryConstitution(endpoint string) govtypesv1.Que
 	return res
 }
 
+func (s *IntegrationTestSuite) queryConstitutionHistory(endpoint string) govtypesv1.QueryConstitutionHistoryResponse {
+	var res govtypesv1.QueryConstitutionHistoryResponse
+	body, err := httpGet(fmt.Sprintf("%s/atomone/gov/v1/constitution/versions", endpoint))
+	s.Require().NoError(err)
+	err = s.cdc.UnmarshalJSON(body, &res)
+	s.Require().NoError(err)
+	return res
+}
+
 func (s *IntegrationTestSuite) queryPhotonConversionRate(endpoint string) math.LegacyDec {
 	body, err := httpGet(fmt.Sprintf("%s/atomone/photon/v1/conversion_rate", endpoint))
 	s.Require().NoError(err)
@@ -0,0 +1,18 @@
+package coredaos
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/coredaos/keeper"
+)
+
+// EndBlocker is called at the end of every block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	if err := k.PruneExpiredDaoActions(ctx); err != nil {
+		return err
+	}
+	if err := k.SuspendInactiveDaos(ctx); err != nil {
+		return err
+	}
+	return k.ProcessPendingVetoes(ctx)
+}
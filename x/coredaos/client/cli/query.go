@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -24,6 +25,15 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetQueryParamsCmd(),
+		GetQueryProposalActionsCmd(),
+		GetQueryDaoActionsCmd(),
+		GetQueryPendingVetoesCmd(),
+		GetQueryDaoMembersCmd(),
+		GetQueryPendingDaoActionsCmd(),
+		GetQuerySteeringProposalCountCmd(),
+		GetQueryRecusalsCmd(),
+		GetQueryDaoBudgetsCmd(),
+		GetQueryDaoStatusesCmd(),
 	)
 	return cmd
 }
@@ -49,3 +59,241 @@ func GetQueryParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetQueryProposalActionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal-actions [proposal-id]",
+		Short: "shows the actions taken by the Core DAOs on a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ProposalActions(cmd.Context(), &types.QueryProposalActionsRequest{
+				ProposalId: proposalID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "proposal-actions")
+	return cmd
+}
+
+func GetQueryDaoActionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dao-actions [dao-address]",
+		Short: "shows the actions taken by a Core DAO",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DaoActions(cmd.Context(), &types.QueryDaoActionsRequest{
+				DaoAddress: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "dao-actions")
+	return cmd
+}
+
+func GetQueryPendingVetoesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-vetoes",
+		Short: "shows the vetoes waiting for execution",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingVetoes(cmd.Context(), &types.QueryPendingVetoesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-vetoes")
+	return cmd
+}
+
+func GetQueryDaoMembersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dao-members",
+		Short: "shows the members of the Core DAOs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DaoMembers(cmd.Context(), &types.QueryDaoMembersRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryPendingDaoActionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-dao-actions",
+		Short: "shows the actions waiting for the approval of the members of the Core DAOs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingDaoActions(cmd.Context(), &types.QueryPendingDaoActionsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-dao-actions")
+	return cmd
+}
+
+func GetQuerySteeringProposalCountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "steering-proposal-count",
+		Short: "shows the number of proposals submitted by the Steering DAO in the current epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SteeringProposalCount(cmd.Context(), &types.QuerySteeringProposalCountRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryRecusalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recusals [proposal-id]",
+		Short: "shows the recusals of the Core DAOs from a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Recusals(cmd.Context(), &types.QueryRecusalsRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryDaoBudgetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dao-budgets",
+		Short: "shows the allowance, spent and remaining amounts of the budgets of the Core DAOs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DaoBudgets(cmd.Context(), &types.QueryDaoBudgetsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryDaoStatusesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dao-statuses",
+		Short: "shows the state, the last action and the time to suspension of the Core DAOs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DaoStatuses(cmd.Context(), &types.QueryDaoStatusesRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -9,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/atomone-hub/atomone/x/coredaos/types"
 )
@@ -27,6 +30,12 @@ func GetTxCmd() *cobra.Command {
 		GetTxEndorseProposalCmd(),
 		GetTxExtendVotingPeriodCmd(),
 		GetTxVetoProposalCmd(),
+		GetTxWithdrawVetoCmd(),
+		GetTxSubmitSteeringProposalCmd(),
+		GetTxRecuseFromProposalCmd(),
+		GetTxDaoSpendCmd(),
+		GetTxSubmitDaoActionCmd(),
+		GetTxApproveActionCmd(),
 	)
 	return cmd
 }
@@ -152,3 +161,319 @@ func GetTxVetoProposalCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetTxWithdrawVetoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-veto [proposal-id]",
+		Short: "Broadcast a message to withdraw a pending veto. Only available to the Oversight DAO.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+			msg := types.NewMsgWithdrawVeto(
+				clientCtx.GetFromAddress(),
+				proposalID,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTxRecuseFromProposalCmd returns the command to recuse a Core DAO from a
+// proposal
+func GetTxRecuseFromProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recuse [proposal-id] [reason]",
+		Short: "Broadcast a message to recuse from a proposal. Available to both the Steering DAO and the Oversight DAO.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+			msg := types.NewMsgRecuseFromProposal(
+				clientCtx.GetFromAddress(),
+				proposalID,
+				args[1],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTxDaoSpendCmd returns the command to spend from the budget of a Core DAO
+func GetTxDaoSpendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spend [recipient] [amount] [memo]",
+		Short: "Broadcast a message to spend from the budget of a Core DAO. Available to both the Steering DAO and the Oversight DAO.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDaoSpend(
+				clientCtx.GetFromAddress(),
+				recipient,
+				amount,
+				args[2],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// steeringProposal defines the JSON file read by submit-steering-proposal.
+type steeringProposal struct {
+	// Messages defines the messages of the proposal, in JSON format.
+	Messages []json.RawMessage `json:"messages,omitempty"`
+	Metadata string            `json:"metadata"`
+	Title    string            `json:"title"`
+	Summary  string            `json:"summary"`
+}
+
+// parseSteeringProposal reads and parses the proposal from path.
+func parseSteeringProposal(clientCtx client.Context, path string) (steeringProposal, []sdk.Msg, error) {
+	var proposal steeringProposal
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return proposal, nil, err
+	}
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(proposal.Messages))
+	for i, anyJSON := range proposal.Messages {
+		var msg sdk.Msg
+		if err := clientCtx.Codec.UnmarshalInterfaceJSON(anyJSON, &msg); err != nil {
+			return proposal, nil, err
+		}
+		msgs[i] = msg
+	}
+	return proposal, msgs, nil
+}
+
+// GetTxSubmitSteeringProposalCmd returns the command to submit a proposal on
+// behalf of the Steering DAO
+func GetTxSubmitSteeringProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-steering-proposal [path/to/proposal.json]",
+		Short: "Broadcast a message to submit a proposal without deposit. Only available to the Steering DAO.",
+		Long: `Submit a proposal without deposit along with some messages, metadata, title and summary.
+The proposal enters the voting period immediately. The number of proposals the Steering DAO can
+submit is limited per epoch by the module parameters.
+
+Example:
+$ atomoned tx coredaos submit-steering-proposal path/to/proposal.json
+
+Where proposal.json contains:
+
+{
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgSend",
+      "from_address": "atone1...", // The gov module account address
+      "to_address": "atone1...",
+      "amount":[{"denom": "uatone","amount": "10"}]
+    }
+  ],
+  "metadata": "ipfs://CID",
+  "title": "My proposal",
+  "summary": "A short summary of my proposal"
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, msgs, err := parseSteeringProposal(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			msg, err := types.NewMsgSubmitSteeringProposal(
+				clientCtx.GetFromAddress(),
+				msgs,
+				proposal.Metadata,
+				proposal.Title,
+				proposal.Summary,
+			)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	FlagAnnotation  = "annotation"
+	FlagOverwrite   = "overwrite"
+	FlagBurnDeposit = "burn-deposit"
+	FlagReason      = "reason"
+	FlagRecipient   = "recipient"
+	FlagAmount      = "amount"
+	FlagMemo        = "memo"
+)
+
+var (
+	daosByName = map[string]types.CoreDao{
+		"steering":  types.CoreDaoSteering,
+		"oversight": types.CoreDaoOversight,
+	}
+	daoActionsByName = map[string]types.DaoActionType{
+		"annotate":             types.DaoActionTypeAnnotate,
+		"endorse":              types.DaoActionTypeEndorse,
+		"extend-voting-period": types.DaoActionTypeExtendVotingPeriod,
+		"veto":                 types.DaoActionTypeVeto,
+		"withdraw-veto":        types.DaoActionTypeWithdrawVeto,
+		"recuse":               types.DaoActionTypeRecuse,
+		"spend":                types.DaoActionTypeSpend,
+	}
+)
+
+// GetTxSubmitDaoActionCmd returns the command to submit an action on behalf
+// of a Core DAO
+func GetTxSubmitDaoActionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-dao-action [steering|oversight] [annotate|endorse|extend-voting-period|veto|withdraw-veto|recuse|spend] [proposal-id]",
+		Short: "Broadcast a message to submit an action on behalf of a Core DAO. Only available to the members of the Core DAO.",
+		Long:  "Broadcast a message to submit an action on behalf of a Core DAO. Only available to the members of the Core DAO. Spends do not apply to a proposal, their proposal-id is ignored.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			dao, ok := daosByName[args[0]]
+			if !ok {
+				return fmt.Errorf("dao %s not valid, please input steering or oversight", args[0])
+			}
+			action, ok := daoActionsByName[args[1]]
+			if !ok {
+				return fmt.Errorf("action %s not valid, please input annotate, endorse, extend-voting-period, veto, withdraw-veto, recuse or spend", args[1])
+			}
+			proposalID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[2])
+			}
+			msg := types.NewMsgSubmitDaoAction(
+				clientCtx.GetFromAddress(),
+				dao,
+				action,
+				proposalID,
+			)
+			if msg.Annotation, err = cmd.Flags().GetString(FlagAnnotation); err != nil {
+				return err
+			}
+			if msg.Overwrite, err = cmd.Flags().GetBool(FlagOverwrite); err != nil {
+				return err
+			}
+			if msg.BurnDeposit, err = cmd.Flags().GetBool(FlagBurnDeposit); err != nil {
+				return err
+			}
+			if msg.Reason, err = cmd.Flags().GetString(FlagReason); err != nil {
+				return err
+			}
+			if action == types.DaoActionTypeSpend {
+				msg.ProposalId = 0
+				if msg.Recipient, err = cmd.Flags().GetString(FlagRecipient); err != nil {
+					return err
+				}
+				amount, err := cmd.Flags().GetString(FlagAmount)
+				if err != nil {
+					return err
+				}
+				if msg.Amount, err = sdk.ParseCoinsNormalized(amount); err != nil {
+					return err
+				}
+				if msg.Memo, err = cmd.Flags().GetString(FlagMemo); err != nil {
+					return err
+				}
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagAnnotation, "", "The annotation to add to the proposal, for annotations")
+	cmd.Flags().Bool(FlagOverwrite, false, "Overwrite the existing annotation of the proposal, for annotations")
+	cmd.Flags().Bool(FlagBurnDeposit, false, "Burn the deposits of the proposal, for vetoes")
+	cmd.Flags().String(FlagReason, "", "The reason of the recusal, for recusals")
+	cmd.Flags().String(FlagRecipient, "", "The address receiving the funds, for spends")
+	cmd.Flags().String(FlagAmount, "", "The amount to spend from the budget of the Core DAO, for spends")
+	cmd.Flags().String(FlagMemo, "", "The memo of the spend, for spends")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTxApproveActionCmd returns the command to approve a pending action of a
+// Core DAO
+func GetTxApproveActionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-action [action-id]",
+		Short: "Broadcast a message to approve a pending action of a Core DAO. Only available to the members of the Core DAO.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("action-id %s not a valid uint, please input a valid action-id", args[0])
+			}
+			msg := types.NewMsgApproveAction(
+				clientCtx.GetFromAddress(),
+				id,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
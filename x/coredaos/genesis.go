@@ -3,6 +3,8 @@ package coredaos
 import (
 	"fmt"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/atomone-hub/atomone/x/coredaos/keeper"
@@ -18,10 +20,146 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.Params.Set(ctx, genState.Params); err != nil {
 		panic(fmt.Sprintf("%s module params has not been set", types.ModuleName))
 	}
+
+	var nextActionID uint64
+	for _, action := range genState.Actions {
+		if err := k.SetAction(ctx, action); err != nil {
+			panic(fmt.Sprintf("%s module action %d has not been set: %s", types.ModuleName, action.Id, err))
+		}
+		if action.Id >= nextActionID {
+			nextActionID = action.Id + 1
+		}
+	}
+	if err := k.ActionSequence.Set(ctx, nextActionID); err != nil {
+		panic(fmt.Sprintf("%s module action sequence has not been set", types.ModuleName))
+	}
+
+	for _, veto := range genState.PendingVetoes {
+		if err := k.PendingVetoes.Set(ctx, veto.ProposalId, veto); err != nil {
+			panic(fmt.Sprintf("%s module pending veto of proposal %d has not been set: %s", types.ModuleName, veto.ProposalId, err))
+		}
+		// x/gov genesis puts back all proposals in voting period in the active
+		// proposals queue, vetoed proposals must be taken out of it again.
+		if err := k.SuspendProposal(ctx, veto.ProposalId); err != nil {
+			panic(fmt.Sprintf("%s module proposal %d with pending veto has not been suspended: %s", types.ModuleName, veto.ProposalId, err))
+		}
+	}
+
+	for _, daoMembers := range genState.DaoMembers {
+		if err := k.DaoMembers.Set(ctx, int32(daoMembers.Dao), daoMembers); err != nil {
+			panic(fmt.Sprintf("%s module members of %s have not been set: %s", types.ModuleName, daoMembers.Dao, err))
+		}
+	}
+	var nextDaoActionID uint64
+	for _, action := range genState.PendingDaoActions {
+		if err := k.PendingDaoActions.Set(ctx, action.Id, action); err != nil {
+			panic(fmt.Sprintf("%s module pending dao action %d has not been set: %s", types.ModuleName, action.Id, err))
+		}
+		if action.Id >= nextDaoActionID {
+			nextDaoActionID = action.Id + 1
+		}
+	}
+	if err := k.DaoActionSequence.Set(ctx, nextDaoActionID); err != nil {
+		panic(fmt.Sprintf("%s module dao action sequence has not been set", types.ModuleName))
+	}
+	for _, recusal := range genState.Recusals {
+		if err := k.Recusals.Set(ctx, collections.Join(recusal.ProposalId, int32(recusal.Dao)), recusal); err != nil {
+			panic(fmt.Sprintf("%s module recusal of %s from proposal %d has not been set: %s", types.ModuleName, recusal.Dao, recusal.ProposalId, err))
+		}
+	}
+	for _, budget := range genState.DaoBudgets {
+		if err := k.DaoBudgets.Set(ctx, int32(budget.Dao), budget); err != nil {
+			panic(fmt.Sprintf("%s module budget of %s has not been set: %s", types.ModuleName, budget.Dao, err))
+		}
+	}
+	for _, account := range genState.DaoBudgetAccounts {
+		if err := k.DaoBudgetAccounts.Set(ctx, int32(account.Dao), account); err != nil {
+			panic(fmt.Sprintf("%s module budget account of %s has not been set: %s", types.ModuleName, account.Dao, err))
+		}
+	}
+	for _, liveness := range genState.DaoLiveness {
+		if err := k.DaoLiveness.Set(ctx, int32(liveness.Dao), liveness); err != nil {
+			panic(fmt.Sprintf("%s module liveness of %s has not been set: %s", types.ModuleName, liveness.Dao, err))
+		}
+	}
+	for _, proposalID := range genState.QuorumExtensionExemptions {
+		if err := k.QuorumExtensionExemptions.Set(ctx, proposalID); err != nil {
+			panic(fmt.Sprintf("%s module quorum extension exemption of proposal %d has not been set: %s", types.ModuleName, proposalID, err))
+		}
+		// x/gov genesis may put back the proposals in voting period in the
+		// quorum check queue, exempted proposals must be taken out of it again.
+		if err := k.RemoveFromQuorumCheckQueue(ctx, proposalID); err != nil {
+			panic(fmt.Sprintf("%s module exempted proposal %d has not been removed from the quorum check queue: %s", types.ModuleName, proposalID, err))
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	params := k.GetParams(ctx)
-	return types.NewGenesisState(params)
+	genState := types.NewGenesisState(params)
+	err := k.ProposalActions.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], action types.DaoAction) (bool, error) {
+		genState.Actions = append(genState.Actions, action)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = k.PendingVetoes.Walk(ctx, nil, func(_ uint64, veto types.PendingVeto) (bool, error) {
+		genState.PendingVetoes = append(genState.PendingVetoes, veto)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = k.DaoMembers.Walk(ctx, nil, func(_ int32, daoMembers types.DaoMembers) (bool, error) {
+		genState.DaoMembers = append(genState.DaoMembers, daoMembers)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = k.PendingDaoActions.Walk(ctx, nil, func(_ uint64, action types.PendingDaoAction) (bool, error) {
+		genState.PendingDaoActions = append(genState.PendingDaoActions, action)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = k.Recusals.Walk(ctx, nil, func(_ collections.Pair[uint64, int32], recusal types.Recusal) (bool, error) {
+		genState.Recusals = append(genState.Recusals, recusal)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = k.DaoBudgets.Walk(ctx, nil, func(_ int32, budget types.DaoBudget) (bool, error) {
+		genState.DaoBudgets = append(genState.DaoBudgets, budget)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = k.DaoBudgetAccounts.Walk(ctx, nil, func(_ int32, account types.DaoBudgetAccount) (bool, error) {
+		genState.DaoBudgetAccounts = append(genState.DaoBudgetAccounts, account)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = k.DaoLiveness.Walk(ctx, nil, func(_ int32, liveness types.DaoLiveness) (bool, error) {
+		genState.DaoLiveness = append(genState.DaoLiveness, liveness)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = k.QuorumExtensionExemptions.Walk(ctx, nil, func(proposalID uint64) (bool, error) {
+		genState.QuorumExtensionExemptions = append(genState.QuorumExtensionExemptions, proposalID)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return genState
 }
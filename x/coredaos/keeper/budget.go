@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+	photontypes "github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// GetDaoBudget returns the budget of dao, and false if dao has no budget.
+func (k Keeper) GetDaoBudget(ctx context.Context, dao types.CoreDao) (types.DaoBudget, bool, error) {
+	budget, err := k.DaoBudgets.Get(ctx, int32(dao))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DaoBudget{}, false, nil
+	}
+	if err != nil {
+		return types.DaoBudget{}, false, err
+	}
+	return budget, true, nil
+}
+
+// SetDaoBudget replaces the budget of a Core DAO, removing the budget if its
+// allowance is empty. The funds of the current epoch are not affected, the new
+// budget applies from the start of the next epoch of the budget.
+func (k Keeper) SetDaoBudget(ctx context.Context, budget types.DaoBudget) error {
+	if budget.Allowance.Empty() {
+		return k.DaoBudgets.Remove(ctx, int32(budget.Dao))
+	}
+	return k.DaoBudgets.Set(ctx, int32(budget.Dao), budget)
+}
+
+// validateDaoBudget returns an error if the epoch identifier of budget is not
+// registered in x/epochs, or if its allowance contains other denoms than the
+// bond denom and photon.
+func (k Keeper) validateDaoBudget(ctx context.Context, budget types.DaoBudget) error {
+	if _, err := k.epochsKeeper.GetEpochInfo(ctx, budget.EpochIdentifier); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unknown epoch identifier %s", budget.EpochIdentifier)
+	}
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	for _, coin := range budget.Allowance {
+		if coin.Denom != bondDenom && coin.Denom != photontypes.Denom {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"invalid allowance denom %s, expected %s or %s", coin.Denom, bondDenom, photontypes.Denom,
+			)
+		}
+	}
+	return nil
+}
+
+// GetDaoBudgetAccount returns the funds of the current epoch of the budget of
+// dao, and false if dao has no funds.
+func (k Keeper) GetDaoBudgetAccount(ctx context.Context, dao types.CoreDao) (types.DaoBudgetAccount, bool, error) {
+	account, err := k.DaoBudgetAccounts.Get(ctx, int32(dao))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DaoBudgetAccount{}, false, nil
+	}
+	if err != nil {
+		return types.DaoBudgetAccount{}, false, err
+	}
+	return account, true, nil
+}
+
+// FundDaoBudgets withdraws the allowance of the budgets of epochIdentifier from
+// the community pool, at the start of the epochNumber epoch. The budgets of the
+// disabled and suspended Core DAOs are not funded. A failed funding doesn't
+// affect the state and is only logged, so the budget is funded again at the
+// start of the next epoch.
+func (k Keeper) FundDaoBudgets(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	params := k.GetParams(ctx)
+	var budgets []types.DaoBudget
+	err := k.DaoBudgets.Walk(ctx, nil, func(_ int32, budget types.DaoBudget) (bool, error) {
+		if budget.EpochIdentifier != epochIdentifier || params.DaoAddress(budget.Dao) == "" {
+			return false, nil
+		}
+		liveness, _, err := k.GetDaoLiveness(ctx, budget.Dao)
+		if err != nil {
+			return true, err
+		}
+		if liveness.Suspended {
+			return false, nil
+		}
+		budgets = append(budgets, budget)
+		return false, nil
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to get core DAO budgets", "err", err)
+		return
+	}
+	for _, budget := range budgets {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.fundDaoBudget(cacheCtx, budget, epochNumber); err != nil {
+			k.Logger(ctx).Error("failed to fund core DAO budget", "dao", budget.Dao, "err", err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// fundDaoBudget withdraws the allowance of budget from the community pool for
+// the epochNumber epoch. Only the available funds are withdrawn if the
+// community pool lacks funds.
+func (k Keeper) fundDaoBudget(ctx sdk.Context, budget types.DaoBudget, epochNumber int64) error {
+	// The funds of a previous epoch are left if the epoch identifier of the
+	// budget changed, return them first.
+	if err := k.returnDaoBudget(ctx, budget.Dao); err != nil {
+		return err
+	}
+	feePool, err := k.distrKeeper.GetFeePool(ctx)
+	if err != nil {
+		return err
+	}
+	available, _ := feePool.CommunityPool.TruncateDecimal()
+	funded := budget.Allowance.Min(available)
+	if !funded.IsZero() {
+		newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(funded...))
+		if negative {
+			return distrtypes.ErrBadDistribution
+		}
+		feePool.CommunityPool = newPool
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, types.ModuleName, funded); err != nil {
+			return err
+		}
+		if err := k.distrKeeper.SetFeePool(ctx, feePool); err != nil {
+			return err
+		}
+	}
+	account := types.DaoBudgetAccount{
+		Dao:             budget.Dao,
+		EpochIdentifier: budget.EpochIdentifier,
+		EpochNumber:     epochNumber,
+		Funded:          funded,
+		Spent:           sdk.NewCoins(),
+	}
+	if err := k.DaoBudgetAccounts.Set(ctx, int32(budget.Dao), account); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFundDaoBudget,
+			sdk.NewAttribute(types.AttributeKeyDao, budget.Dao.String()),
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epochNumber)),
+			sdk.NewAttribute(types.AttributeKeyAmount, funded.String()),
+		),
+	})
+	return nil
+}
+
+// ReturnDaoBudgets returns the unspent funds of the budgets of epochIdentifier
+// to the community pool, at the end of the epoch. A failed return doesn't
+// affect the state and is only logged, so the funds are returned at the end of
+// the next epoch.
+func (k Keeper) ReturnDaoBudgets(ctx sdk.Context, epochIdentifier string) {
+	var daos []types.CoreDao
+	err := k.DaoBudgetAccounts.Walk(ctx, nil, func(_ int32, account types.DaoBudgetAccount) (bool, error) {
+		if account.EpochIdentifier == epochIdentifier {
+			daos = append(daos, account.Dao)
+		}
+		return false, nil
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to get core DAO budget accounts", "err", err)
+		return
+	}
+	for _, dao := range daos {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.returnDaoBudget(cacheCtx, dao); err != nil {
+			k.Logger(ctx).Error("failed to return core DAO budget", "dao", dao, "err", err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// returnDaoBudget returns the unspent funds of the budget of dao to the
+// community pool, and removes the budget account of dao.
+func (k Keeper) returnDaoBudget(ctx sdk.Context, dao types.CoreDao) error {
+	account, found, err := k.GetDaoBudgetAccount(ctx, dao)
+	if err != nil || !found {
+		return err
+	}
+	remaining := account.Remaining()
+	if !remaining.IsZero() {
+		feePool, err := k.distrKeeper.GetFeePool(ctx)
+		if err != nil {
+			return err
+		}
+		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(remaining...)...)
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distrtypes.ModuleName, remaining); err != nil {
+			return err
+		}
+		if err := k.distrKeeper.SetFeePool(ctx, feePool); err != nil {
+			return err
+		}
+	}
+	if err := k.DaoBudgetAccounts.Remove(ctx, int32(dao)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReturnDaoBudget,
+			sdk.NewAttribute(types.AttributeKeyDao, dao.String()),
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", account.EpochNumber)),
+			sdk.NewAttribute(types.AttributeKeyAmount, remaining.String()),
+		),
+	})
+	return nil
+}
+
+// spendDaoBudget sends amount from the budget of dao to recipient. It returns
+// an error if amount exceeds the remaining funds of the current epoch.
+func (k Keeper) spendDaoBudget(ctx context.Context, dao types.CoreDao, recipient sdk.AccAddress, amount sdk.Coins) error {
+	account, found, err := k.GetDaoBudgetAccount(ctx, dao)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return types.ErrInsufficientBudget.Wrapf("%s has no budget funds in the current epoch", dao.DisplayName())
+	}
+	remaining := account.Remaining()
+	if !amount.IsAllLTE(remaining) {
+		return types.ErrInsufficientBudget.Wrapf("remaining %s, requested %s", remaining, amount)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return err
+	}
+	account.Spent = account.Spent.Add(amount...)
+	return k.DaoBudgetAccounts.Set(ctx, int32(dao), account)
+}
+
+// DaoBudgetStatuses returns the status of the budgets of the Core DAOs, which
+// includes the Core DAOs whose budget was removed but still hold funds for the
+// current epoch.
+func (k Keeper) DaoBudgetStatuses(ctx context.Context) ([]types.DaoBudgetStatus, error) {
+	var statuses []types.DaoBudgetStatus
+	for _, dao := range []types.CoreDao{types.CoreDaoSteering, types.CoreDaoOversight} {
+		budget, hasBudget, err := k.GetDaoBudget(ctx, dao)
+		if err != nil {
+			return nil, err
+		}
+		account, hasAccount, err := k.GetDaoBudgetAccount(ctx, dao)
+		if err != nil {
+			return nil, err
+		}
+		if !hasBudget && !hasAccount {
+			continue
+		}
+		budget.Dao = dao
+		account.Dao = dao
+		statuses = append(statuses, types.DaoBudgetStatus{
+			Dao:       dao,
+			Budget:    budget,
+			Account:   account,
+			Remaining: account.Remaining(),
+		})
+	}
+	return statuses, nil
+}
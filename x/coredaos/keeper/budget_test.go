@@ -0,0 +1,411 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	appparams "github.com/atomone-hub/atomone/app/params"
+	"github.com/atomone-hub/atomone/x/coredaos/testutil"
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+	photontypes "github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// mockFeePool makes the distribution keeper mock store the fee pool, starting
+// with communityPool.
+func mockFeePool(m testutil.Mocks, communityPool sdk.DecCoins) *distrtypes.FeePool {
+	feePool := &distrtypes.FeePool{CommunityPool: communityPool}
+	m.DistrKeeper.EXPECT().GetFeePool(gomock.Any()).DoAndReturn(
+		func(context.Context) (distrtypes.FeePool, error) { return *feePool, nil },
+	).AnyTimes()
+	m.DistrKeeper.EXPECT().SetFeePool(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fp distrtypes.FeePool) error { *feePool = fp; return nil },
+	).AnyTimes()
+	return feePool
+}
+
+func TestFundDaoBudgets(t *testing.T) {
+	var (
+		steeringDAOAcc = sdk.AccAddress("steeringDao").String()
+		allowance      = sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 100), sdk.NewInt64Coin(photontypes.Denom, 50))
+		budget         = types.DaoBudget{Dao: types.CoreDaoSteering, EpochIdentifier: "week", Allowance: allowance}
+	)
+	tests := []struct {
+		name                  string
+		setSteeringDAO        bool
+		epochIdentifier       string
+		communityPool         sdk.DecCoins
+		previousAccount       *types.DaoBudgetAccount
+		setup                 func(testutil.Mocks)
+		expectedFunded        sdk.Coins
+		expectedCommunityPool sdk.DecCoins
+	}{
+		{
+			name:                  "other epoch identifier",
+			setSteeringDAO:        true,
+			epochIdentifier:       "day",
+			communityPool:         sdk.NewDecCoins(sdk.NewInt64DecCoin(appparams.BondDenom, 1000)),
+			expectedCommunityPool: sdk.NewDecCoins(sdk.NewInt64DecCoin(appparams.BondDenom, 1000)),
+		},
+		{
+			name:                  "disabled core DAO",
+			epochIdentifier:       "week",
+			communityPool:         sdk.NewDecCoins(sdk.NewInt64DecCoin(appparams.BondDenom, 1000)),
+			expectedCommunityPool: sdk.NewDecCoins(sdk.NewInt64DecCoin(appparams.BondDenom, 1000)),
+		},
+		{
+			name:            "full allowance",
+			setSteeringDAO:  true,
+			epochIdentifier: "week",
+			communityPool: sdk.NewDecCoins(
+				sdk.NewInt64DecCoin(appparams.BondDenom, 1000),
+				sdk.NewInt64DecCoin(photontypes.Denom, 1000),
+			),
+			setup: func(m testutil.Mocks) {
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), distrtypes.ModuleName, types.ModuleName, allowance)
+			},
+			expectedFunded: allowance,
+			expectedCommunityPool: sdk.NewDecCoins(
+				sdk.NewInt64DecCoin(appparams.BondDenom, 900),
+				sdk.NewInt64DecCoin(photontypes.Denom, 950),
+			),
+		},
+		{
+			name:            "community pool lacks funds",
+			setSteeringDAO:  true,
+			epochIdentifier: "week",
+			communityPool:   sdk.NewDecCoins(sdk.NewInt64DecCoin(appparams.BondDenom, 60)),
+			setup: func(m testutil.Mocks) {
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), distrtypes.ModuleName, types.ModuleName,
+					sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 60)))
+			},
+			expectedFunded:        sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 60)),
+			expectedCommunityPool: sdk.DecCoins{},
+		},
+		{
+			name:            "empty community pool",
+			setSteeringDAO:  true,
+			epochIdentifier: "week",
+			expectedFunded:  sdk.NewCoins(),
+		},
+		{
+			name:            "funds of a previous epoch are returned first",
+			setSteeringDAO:  true,
+			epochIdentifier: "week",
+			communityPool:   sdk.NewDecCoins(sdk.NewInt64DecCoin(appparams.BondDenom, 50)),
+			previousAccount: &types.DaoBudgetAccount{
+				Dao:             types.CoreDaoSteering,
+				EpochIdentifier: "day",
+				EpochNumber:     12,
+				Funded:          sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 80)),
+				Spent:           sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 30)),
+			},
+			setup: func(m testutil.Mocks) {
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, distrtypes.ModuleName,
+					sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 50)))
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), distrtypes.ModuleName, types.ModuleName,
+					sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 100)))
+			},
+			expectedFunded:        sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 100)),
+			expectedCommunityPool: sdk.DecCoins{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := testutil.SetupCoredaosKeeper(t)
+			params := types.DefaultParams()
+			if tt.setSteeringDAO {
+				params.SteeringDaoAddress = steeringDAOAcc
+			}
+			require.NoError(t, k.Params.Set(ctx, params))
+			require.NoError(t, k.SetDaoBudget(ctx, budget))
+			if tt.previousAccount != nil {
+				require.NoError(t, k.DaoBudgetAccounts.Set(ctx, int32(types.CoreDaoSteering), *tt.previousAccount))
+			}
+			feePool := mockFeePool(m, tt.communityPool)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			k.FundDaoBudgets(ctx, tt.epochIdentifier, 3)
+
+			account, found, err := k.GetDaoBudgetAccount(ctx, types.CoreDaoSteering)
+			require.NoError(t, err)
+			if tt.expectedFunded == nil {
+				require.Equal(t, tt.previousAccount != nil, found)
+				require.Equal(t, tt.expectedCommunityPool, feePool.CommunityPool)
+				return
+			}
+			require.True(t, found)
+			require.Equal(t, "week", account.EpochIdentifier)
+			require.Equal(t, int64(3), account.EpochNumber)
+			require.Equal(t, tt.expectedFunded.String(), account.Funded.String())
+			require.True(t, account.Spent.IsZero())
+			require.Equal(t, tt.expectedCommunityPool.String(), feePool.CommunityPool.String())
+		})
+	}
+}
+
+func TestReturnDaoBudgets(t *testing.T) {
+	k, m, ctx := testutil.SetupCoredaosKeeper(t)
+	feePool := mockFeePool(m, sdk.NewDecCoins(sdk.NewInt64DecCoin(appparams.BondDenom, 10)))
+	accounts := []types.DaoBudgetAccount{
+		{
+			Dao:             types.CoreDaoSteering,
+			EpochIdentifier: "week",
+			EpochNumber:     3,
+			Funded:          sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 100), sdk.NewInt64Coin(photontypes.Denom, 50)),
+			Spent:           sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 40)),
+		},
+		{
+			Dao:             types.CoreDaoOversight,
+			EpochIdentifier: "day",
+			EpochNumber:     20,
+			Funded:          sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 100)),
+		},
+	}
+	for _, a := range accounts {
+		require.NoError(t, k.DaoBudgetAccounts.Set(ctx, int32(a.Dao), a))
+	}
+	m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, distrtypes.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 60), sdk.NewInt64Coin(photontypes.Denom, 50)))
+
+	k.ReturnDaoBudgets(ctx, "week")
+
+	_, found, err := k.GetDaoBudgetAccount(ctx, types.CoreDaoSteering)
+	require.NoError(t, err)
+	require.False(t, found)
+	// the budget account of the other epoch identifier is left untouched
+	account, found, err := k.GetDaoBudgetAccount(ctx, types.CoreDaoOversight)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, accounts[1].EpochNumber, account.EpochNumber)
+	require.Equal(t, accounts[1].Funded.String(), account.Funded.String())
+	require.Equal(t,
+		sdk.NewDecCoins(sdk.NewInt64DecCoin(appparams.BondDenom, 70), sdk.NewInt64DecCoin(photontypes.Denom, 50)).String(),
+		feePool.CommunityPool.String(),
+	)
+}
+
+func TestMsgServerDaoSpend(t *testing.T) {
+	var (
+		steeringDAOAcc  = sdk.AccAddress("steeringDao")
+		oversightDAOAcc = sdk.AccAddress("oversightDao")
+		recipient       = sdk.AccAddress("recipient")
+		funded          = sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 100))
+	)
+	tests := []struct {
+		name           string
+		spender        sdk.AccAddress
+		amount         sdk.Coins
+		setDaos        bool
+		setDaoMembers  bool
+		spent          sdk.Coins
+		noAccount      bool
+		setup          func(testutil.Mocks)
+		expectedErr    string
+		expectedRemain sdk.Coins
+	}{
+		{
+			name:        "function disabled",
+			spender:     steeringDAOAcc,
+			amount:      funded,
+			expectedErr: "Steering DAO address and Oversight DAO address are not set: function is disabled",
+		},
+		{
+			name:        "wrong spender account",
+			spender:     recipient,
+			amount:      funded,
+			setDaos:     true,
+			expectedErr: "invalid authority; expected " + steeringDAOAcc.String() + " or " + oversightDAOAcc.String() + ", got " + recipient.String() + ": expected core DAO account as only signer for this message",
+		},
+		{
+			name:          "core DAO with members",
+			spender:       steeringDAOAcc,
+			amount:        funded,
+			setDaos:       true,
+			setDaoMembers: true,
+			expectedErr:   "Steering DAO actions must be approved by its members: expected core DAO account as only signer for this message",
+		},
+		{
+			name:        "no budget funds",
+			spender:     oversightDAOAcc,
+			amount:      funded,
+			setDaos:     true,
+			noAccount:   true,
+			expectedErr: "Oversight DAO has no budget funds in the current epoch: insufficient core DAO budget",
+		},
+		{
+			name:        "amount exceeds remaining funds",
+			spender:     steeringDAOAcc,
+			amount:      sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 61)),
+			setDaos:     true,
+			spent:       sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 40)),
+			expectedErr: "remaining 60" + appparams.BondDenom + ", requested 61" + appparams.BondDenom + ": insufficient core DAO budget",
+		},
+		{
+			name:        "denom not in budget",
+			spender:     steeringDAOAcc,
+			amount:      sdk.NewCoins(sdk.NewInt64Coin(photontypes.Denom, 1)),
+			setDaos:     true,
+			expectedErr: "remaining 100" + appparams.BondDenom + ", requested 1" + photontypes.Denom + ": insufficient core DAO budget",
+		},
+		{
+			name:    "ok",
+			spender: steeringDAOAcc,
+			amount:  sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 60)),
+			setDaos: true,
+			spent:   sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 40)),
+			setup: func(m testutil.Mocks) {
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient,
+					sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 60)))
+			},
+			expectedRemain: sdk.NewCoins(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms, k, m, ctx := testutil.SetupMsgServer(t)
+			params := types.DefaultParams()
+			if tt.setDaos {
+				params.SteeringDaoAddress = steeringDAOAcc.String()
+				params.OversightDaoAddress = oversightDAOAcc.String()
+			}
+			require.NoError(t, k.Params.Set(ctx, params))
+			if tt.setDaoMembers {
+				require.NoError(t, k.SetDaoMembers(ctx, types.DaoMembers{
+					Dao: types.CoreDaoSteering, Members: []string{recipient.String()}, Threshold: 1, ApprovalTimeout: time.Hour,
+				}))
+			}
+			if !tt.noAccount {
+				require.NoError(t, k.DaoBudgetAccounts.Set(ctx, int32(types.CoreDaoSteering), types.DaoBudgetAccount{
+					Dao: types.CoreDaoSteering, EpochIdentifier: "week", EpochNumber: 1, Funded: funded, Spent: tt.spent,
+				}))
+			}
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			msg := types.NewMsgDaoSpend(tt.spender, recipient, tt.amount, "memo")
+			require.NoError(t, msg.ValidateBasic())
+
+			_, err := ms.DaoSpend(ctx, msg)
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			account, found, err := k.GetDaoBudgetAccount(ctx, types.CoreDaoSteering)
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, tt.expectedRemain.String(), account.Remaining().String())
+		})
+	}
+}
+
+func TestMsgServerUpdateDaoBudget(t *testing.T) {
+	var (
+		authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+		allowance = sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 100), sdk.NewInt64Coin(photontypes.Denom, 50))
+	)
+	tests := []struct {
+		name        string
+		authority   string
+		budget      types.DaoBudget
+		setup       func(testutil.Mocks)
+		expectedErr string
+	}{
+		{
+			name:        "invalid authority",
+			authority:   sdk.AccAddress("other").String(),
+			budget:      types.DaoBudget{Dao: types.CoreDaoSteering, EpochIdentifier: "week", Allowance: allowance},
+			expectedErr: "invalid authority; expected " + authority + ", got " + sdk.AccAddress("other").String() + ": expected core DAO account as only signer for this message",
+		},
+		{
+			name:      "unknown epoch identifier",
+			authority: authority,
+			budget:    types.DaoBudget{Dao: types.CoreDaoSteering, EpochIdentifier: "fortnight", Allowance: allowance},
+			setup: func(m testutil.Mocks) {
+				m.EpochsKeeper.EXPECT().GetEpochInfo(gomock.Any(), "fortnight").Return(epochstypes.EpochInfo{}, errors.New("not found"))
+			},
+			expectedErr: "unknown epoch identifier fortnight: invalid request",
+		},
+		{
+			name:      "invalid allowance denom",
+			authority: authority,
+			budget: types.DaoBudget{
+				Dao: types.CoreDaoSteering, EpochIdentifier: "week", Allowance: sdk.NewCoins(sdk.NewInt64Coin("xxx", 1)),
+			},
+			setup: func(m testutil.Mocks) {
+				m.EpochsKeeper.EXPECT().GetEpochInfo(gomock.Any(), "week").Return(epochstypes.EpochInfo{Identifier: "week"}, nil)
+				m.StakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(appparams.BondDenom, nil)
+			},
+			expectedErr: "invalid allowance denom xxx, expected " + appparams.BondDenom + " or " + photontypes.Denom + ": invalid request",
+		},
+		{
+			name:      "ok",
+			authority: authority,
+			budget:    types.DaoBudget{Dao: types.CoreDaoSteering, EpochIdentifier: "week", Allowance: allowance},
+			setup: func(m testutil.Mocks) {
+				m.EpochsKeeper.EXPECT().GetEpochInfo(gomock.Any(), "week").Return(epochstypes.EpochInfo{Identifier: "week"}, nil)
+				m.StakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(appparams.BondDenom, nil)
+			},
+		},
+		{
+			name:      "ok removal",
+			authority: authority,
+			budget:    types.DaoBudget{Dao: types.CoreDaoSteering},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms, k, m, ctx := testutil.SetupMsgServer(t)
+			require.NoError(t, k.SetDaoBudget(ctx, types.DaoBudget{Dao: types.CoreDaoSteering, EpochIdentifier: "day", Allowance: allowance}))
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			msg := types.NewMsgUpdateDaoBudget(tt.authority, tt.budget)
+			require.NoError(t, msg.ValidateBasic())
+
+			_, err := ms.UpdateDaoBudget(ctx, msg)
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			budget, found, err := k.GetDaoBudget(ctx, types.CoreDaoSteering)
+			require.NoError(t, err)
+			require.Equal(t, !tt.budget.Allowance.Empty(), found)
+			if found {
+				require.Equal(t, tt.budget, budget)
+			}
+		})
+	}
+}
+
+func TestStakingHooksRejectBudgetAccount(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	require.NoError(t, k.Params.Set(ctx, types.DefaultParams()))
+	hooks := k.StakingHooks()
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	err := hooks.BeforeDelegationCreated(ctx, moduleAddr, sdk.ValAddress("validator"))
+
+	require.EqualError(t, err, "Core DAO budget account cannot stake: core DAOs cannot stake")
+
+	err = hooks.BeforeDelegationSharesModified(ctx, moduleAddr, sdk.ValAddress("validator"))
+
+	require.EqualError(t, err, "Core DAO budget account cannot stake: core DAOs cannot stake")
+	require.NoError(t, hooks.BeforeDelegationCreated(ctx, sdk.AccAddress("delegator"), sdk.ValAddress("validator")))
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+)
+
+// GetDaoMembers returns the members of dao, and false if dao has no member
+// set.
+func (k Keeper) GetDaoMembers(ctx context.Context, dao types.CoreDao) (types.DaoMembers, bool, error) {
+	daoMembers, err := k.DaoMembers.Get(ctx, int32(dao))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DaoMembers{}, false, nil
+	}
+	if err != nil {
+		return types.DaoMembers{}, false, err
+	}
+	return daoMembers, true, nil
+}
+
+// SetDaoMembers replaces the members of a Core DAO, removing the member set if
+// there are no members. The pending actions of the Core DAO are dropped since
+// they were approved by the previous members.
+func (k Keeper) SetDaoMembers(ctx context.Context, daoMembers types.DaoMembers) error {
+	var err error
+	if len(daoMembers.Members) == 0 {
+		err = k.DaoMembers.Remove(ctx, int32(daoMembers.Dao))
+	} else {
+		err = k.DaoMembers.Set(ctx, int32(daoMembers.Dao), daoMembers)
+	}
+	if err != nil {
+		return err
+	}
+
+	var dropped []uint64
+	err = k.PendingDaoActions.Walk(ctx, nil, func(id uint64, action types.PendingDaoAction) (bool, error) {
+		if action.Dao == daoMembers.Dao {
+			dropped = append(dropped, id)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, id := range dropped {
+		if err := k.PendingDaoActions.Remove(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkDirectSigning returns an error if dao has a member set, in which case
+// its actions must be approved by its members instead of being signed by the
+// Core DAO address.
+func (k Keeper) checkDirectSigning(ctx context.Context, dao types.CoreDao) error {
+	_, found, err := k.GetDaoMembers(ctx, dao)
+	if err != nil {
+		return err
+	}
+	if found {
+		return types.ErrInvalidSigner.Wrapf("%s actions must be approved by its members", dao.DisplayName())
+	}
+	return nil
+}
+
+// PruneExpiredDaoActions removes the pending Core DAO actions that have not
+// reached the threshold of their Core DAO before their expiration time.
+func (k Keeper) PruneExpiredDaoActions(ctx sdk.Context) error {
+	var expired []uint64
+	err := k.PendingDaoActions.Walk(ctx, nil, func(id uint64, action types.PendingDaoAction) (bool, error) {
+		if !ctx.BlockTime().Before(action.ExpirationTime) {
+			expired = append(expired, id)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, id := range expired {
+		if err := k.PendingDaoActions.Remove(ctx, id); err != nil {
+			return err
+		}
+		k.Logger(ctx).Info("pending core DAO action expired", "id", id)
+	}
+	return nil
+}
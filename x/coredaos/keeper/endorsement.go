@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+)
+
+// EndorsementExemptsQuorumExtension returns true if the endorsement of a
+// proposal other than a law or a constitution amendment exempts it from the
+// late quorum extension of its voting period.
+func (k Keeper) EndorsementExemptsQuorumExtension(ctx context.Context) bool {
+	return k.GetParams(ctx).EndorsementExemptsQuorumExtension
+}
+
+// QuorumExtensionExempt returns true if the proposal in voting period has been
+// exempted from the late quorum extension of its voting period by its
+// endorsement. It returns false once the voting period has ended.
+func (k Keeper) QuorumExtensionExempt(ctx context.Context, proposalID uint64) (bool, error) {
+	return k.QuorumExtensionExemptions.Has(ctx, proposalID)
+}
+
+// exemptFromQuorumExtension exempts the endorsed proposal from the late quorum
+// extension of its voting period, if the module parameters allow it for the
+// kind of the proposal. It returns true if the proposal has been exempted.
+func (k Keeper) exemptFromQuorumExtension(ctx sdk.Context, proposal govv1.Proposal) (bool, error) {
+	if !k.EndorsementExemptsQuorumExtension(ctx) {
+		return false, nil
+	}
+	kind, err := k.proposalKind(proposal)
+	if err != nil {
+		return false, err
+	}
+	if kind == types.ProposalKindLaw || kind == types.ProposalKindConstitutionAmendment {
+		return false, nil
+	}
+	if err := k.QuorumExtensionExemptions.Set(ctx, proposal.Id); err != nil {
+		return false, err
+	}
+	return true, k.RemoveFromQuorumCheckQueue(ctx, proposal.Id)
+}
+
+// RemoveFromQuorumCheckQueue removes the proposal from the x/gov quorum check
+// queue, so that its voting period is not extended when it reaches quorum
+// late.
+func (k Keeper) RemoveFromQuorumCheckQueue(ctx context.Context, proposalID uint64) error {
+	return k.quorumCheckKeeper.RemoveFromQuorumCheckQueue(ctx, proposalID)
+}
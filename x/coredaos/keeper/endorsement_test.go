@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	atomoneapp "github.com/atomone-hub/atomone/app"
+	"github.com/atomone-hub/atomone/app/helpers"
+	coredaoskeeper "github.com/atomone-hub/atomone/x/coredaos/keeper"
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+	atomonegovv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestEndorseProposalQuorumExtensionExemption(t *testing.T) {
+	steeringDAOAcc := sdk.AccAddress("steeringDao")
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	tests := []struct {
+		name         string
+		exempts      bool
+		msgs         []sdk.Msg
+		expectExempt bool
+	}{
+		{
+			name:         "endorsement exempts from quorum extension",
+			exempts:      true,
+			expectExempt: true,
+		},
+		{
+			name:         "endorsement doesn't exempt from quorum extension",
+			exempts:      false,
+			expectExempt: false,
+		},
+		{
+			name:         "endorsement doesn't exempt a law",
+			exempts:      true,
+			msgs:         []sdk.Msg{atomonegovv1.NewMsgProposeLaw(govAddr, "law", "text", "", "", 0)},
+			expectExempt: false,
+		},
+		{
+			name:    "endorsement doesn't exempt a constitution amendment",
+			exempts: true,
+			msgs: []sdk.Msg{atomonegovv1.NewMsgProposeConstitutionAmendment(govAddr,
+				"@@ -1 +1 @@\n-Line one\n+Line one amended\n")},
+			expectExempt: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := helpers.Setup(t)
+			ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+			ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+			params := types.DefaultParams()
+			params.SteeringDaoAddress = steeringDAOAcc.String()
+			params.EndorsementExemptsQuorumExtension = tt.exempts
+			require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+			require.NoError(t, app.GovKeeper.Constitution.Set(ctx, "Line one\nLine two"))
+			var p govv1.Proposal
+			if tt.msgs != nil {
+				p = submitProposalReal(t, app, ctx, tt.msgs, true)
+			} else {
+				p = submitBankSendProposalReal(t, app, ctx, true)
+			}
+			quorumTimeout := ctx.BlockTime().Add(time.Hour)
+			queueKey := collectionsJoin(quorumTimeout, p.Id)
+			entry := govv1.QuorumCheckQueueEntry{QuorumTimeoutTime: &quorumTimeout, QuorumCheckCount: 1}
+			require.NoError(t, app.GovKeeper.QuorumCheckQueue.Set(ctx, queueKey, entry))
+
+			_, err := ms.EndorseProposal(ctx, types.NewMsgEndorseProposal(steeringDAOAcc, p.Id))
+
+			require.NoError(t, err)
+			exempt, err := app.CoreDaosKeeper.QuorumExtensionExempt(ctx, p.Id)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectExempt, exempt)
+			queued, err := app.GovKeeper.QuorumCheckQueue.Has(ctx, queueKey)
+			require.NoError(t, err)
+			require.Equal(t, !tt.expectExempt, queued)
+		})
+	}
+}
+
+func TestQuorumExtensionExemptionPruning(t *testing.T) {
+	steeringDAOAcc := sdk.AccAddress("steeringDao")
+	oversightDAOAcc := sdk.AccAddress("oversightDao")
+	tests := []struct {
+		name  string
+		prune func(*testing.T, *atomoneapp.AtomOneApp, sdk.Context, govv1.Proposal)
+	}{
+		{
+			name: "voting period ended",
+			prune: func(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, p govv1.Proposal) {
+				t.Helper()
+				require.NoError(t, app.CoreDaosKeeper.GovHooks().AfterProposalVotingPeriodEnded(ctx, p.Id))
+			},
+		},
+		{
+			name: "proposal vetoed",
+			prune: func(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, p govv1.Proposal) {
+				t.Helper()
+				ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+				resp, err := ms.VetoProposal(ctx, &types.MsgVetoProposal{Vetoer: oversightDAOAcc.String(), ProposalId: p.Id})
+				require.NoError(t, err)
+				require.NoError(t, app.CoreDaosKeeper.ProcessPendingVetoes(ctx.WithBlockTime(resp.ExecutionTime)))
+				p, err = app.GovKeeper.Proposals.Get(ctx, p.Id)
+				require.NoError(t, err)
+				require.Equal(t, govv1.StatusVetoed, p.Status)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := helpers.Setup(t)
+			ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+			ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+			params := types.DefaultParams()
+			params.SteeringDaoAddress = steeringDAOAcc.String()
+			params.OversightDaoAddress = oversightDAOAcc.String()
+			params.EndorsementExemptsQuorumExtension = true
+			require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+			p := submitBankSendProposalReal(t, app, ctx, true)
+			_, err := ms.EndorseProposal(ctx, types.NewMsgEndorseProposal(steeringDAOAcc, p.Id))
+			require.NoError(t, err)
+			exempt, err := app.CoreDaosKeeper.QuorumExtensionExempt(ctx, p.Id)
+			require.NoError(t, err)
+			require.True(t, exempt)
+
+			tt.prune(t, app, ctx, p)
+
+			exempt, err = app.CoreDaosKeeper.QuorumExtensionExempt(ctx, p.Id)
+			require.NoError(t, err)
+			require.False(t, exempt)
+		})
+	}
+}
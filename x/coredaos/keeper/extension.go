@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+	atomonegovv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// proposalKind returns the kind of proposal, as classified by x/gov and by its
+// messages.
+func (k Keeper) proposalKind(proposal govv1.Proposal) (types.ProposalKind, error) {
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return types.ProposalKindUnspecified, err
+	}
+	return types.ProposalKindOf(atomonegovv1.ProposalKinds(k.govKeeper.ProposalKinds(proposal)), msgs), nil
+}
+
+// countExtensions returns the number of times dao extended the voting period
+// of proposalID, according to the action log. The extensions are counted by
+// Core DAO rather than by address, so that they still count after a change of
+// the Core DAO address.
+func (k Keeper) countExtensions(ctx context.Context, proposalID uint64, dao types.CoreDao) (uint32, error) {
+	var count uint32
+	rng := collections.NewPrefixedPairRange[uint64, uint64](proposalID)
+	err := k.ProposalActions.Walk(ctx, rng, func(_ collections.Pair[uint64, uint64], action types.DaoAction) (bool, error) {
+		if action.Action == types.DaoActionTypeExtendVotingPeriod && action.Dao == dao {
+			count++
+		}
+		return false, nil
+	})
+	return count, err
+}
@@ -6,7 +6,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/atomone-hub/atomone/x/coredaos/types"
 )
@@ -29,3 +32,174 @@ func (k Querier) Params(goCtx context.Context, req *types.QueryParamsRequest) (*
 
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
+
+// ProposalActions returns the actions taken by the Core DAOs on a proposal.
+func (k Querier) ProposalActions(goCtx context.Context, req *types.QueryProposalActionsRequest) (*types.QueryProposalActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	actions, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.ProposalActions, req.Pagination,
+		func(_ collections.Pair[uint64, uint64], action types.DaoAction) (types.DaoAction, error) {
+			return action, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.ProposalId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProposalActionsResponse{Actions: actions, Pagination: pageRes}, nil
+}
+
+// DaoActions returns the actions taken by a Core DAO.
+func (k Querier) DaoActions(goCtx context.Context, req *types.QueryDaoActionsRequest) (*types.QueryDaoActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	daoAddr, err := sdk.AccAddressFromBech32(req.DaoAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid dao address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	actions, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.DaoActions, req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], action types.DaoAction) (types.DaoAction, error) {
+			return action, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](daoAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDaoActionsResponse{Actions: actions, Pagination: pageRes}, nil
+}
+
+// PendingVetoes returns the vetoes waiting for execution.
+func (k Querier) PendingVetoes(goCtx context.Context, req *types.QueryPendingVetoesRequest) (*types.QueryPendingVetoesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vetoes, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.PendingVetoes, req.Pagination,
+		func(_ uint64, veto types.PendingVeto) (types.PendingVeto, error) {
+			return veto, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingVetoesResponse{PendingVetoes: vetoes, Pagination: pageRes}, nil
+}
+
+// DaoMembers returns the members of the Core DAOs.
+func (k Querier) DaoMembers(goCtx context.Context, req *types.QueryDaoMembersRequest) (*types.QueryDaoMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var daoMembers []types.DaoMembers
+	err := k.Keeper.DaoMembers.Walk(ctx, nil, func(_ int32, m types.DaoMembers) (bool, error) {
+		daoMembers = append(daoMembers, m)
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDaoMembersResponse{DaoMembers: daoMembers}, nil
+}
+
+// PendingDaoActions returns the actions waiting for the approval of the
+// members of the Core DAOs.
+func (k Querier) PendingDaoActions(goCtx context.Context, req *types.QueryPendingDaoActionsRequest) (*types.QueryPendingDaoActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	actions, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.PendingDaoActions, req.Pagination,
+		func(_ uint64, action types.PendingDaoAction) (types.PendingDaoAction, error) {
+			return action, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingDaoActionsResponse{PendingDaoActions: actions, Pagination: pageRes}, nil
+}
+
+// SteeringProposalCount returns the number of proposals submitted by the
+// Steering DAO in the current epoch.
+func (k Querier) SteeringProposalCount(goCtx context.Context, req *types.QuerySteeringProposalCountRequest) (*types.QuerySteeringProposalCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	count, err := k.CurrentSteeringProposalCount(ctx, params)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySteeringProposalCountResponse{Count: count, Quota: params.SteeringProposalQuota}, nil
+}
+
+// Recusals returns the recusals of the Core DAOs from a proposal.
+func (k Querier) Recusals(goCtx context.Context, req *types.QueryRecusalsRequest) (*types.QueryRecusalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var recusals []types.Recusal
+	rng := collections.NewPrefixedPairRange[uint64, int32](req.ProposalId)
+	err := k.Keeper.Recusals.Walk(ctx, rng, func(_ collections.Pair[uint64, int32], recusal types.Recusal) (bool, error) {
+		recusals = append(recusals, recusal)
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecusalsResponse{Recusals: recusals}, nil
+}
+
+// DaoBudgets returns the allowance, spent and remaining amounts of the budgets
+// of the Core DAOs.
+func (k Querier) DaoBudgets(goCtx context.Context, req *types.QueryDaoBudgetsRequest) (*types.QueryDaoBudgetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	statuses, err := k.Keeper.DaoBudgetStatuses(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDaoBudgetsResponse{DaoBudgets: statuses}, nil
+}
+
+// DaoStatuses returns the state, the last action and the time to suspension of
+// the Core DAOs.
+func (k Querier) DaoStatuses(goCtx context.Context, req *types.QueryDaoStatusesRequest) (*types.QueryDaoStatusesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	statuses, err := k.Keeper.DaoStatuses(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDaoStatusesResponse{DaoStatuses: statuses}, nil
+}
@@ -2,11 +2,20 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 
 	"github.com/atomone-hub/atomone/x/coredaos/keeper"
 	"github.com/atomone-hub/atomone/x/coredaos/testutil"
 	"github.com/atomone-hub/atomone/x/coredaos/types"
-	"github.com/stretchr/testify/require"
 )
 
 func TestParamsQuery(t *testing.T) {
@@ -19,3 +28,274 @@ func TestParamsQuery(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &types.QueryParamsResponse{Params: params}, resp)
 }
+
+func TestProposalActionsQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	steeringDAO := sdk.AccAddress("steeringDao").String()
+	oversightDAO := sdk.AccAddress("oversightDao").String()
+	actions := []types.DaoAction{
+		{Id: 1, ProposalId: 1, Signer: steeringDAO, Action: types.DaoActionTypeAnnotate, Time: ctx.BlockTime()},
+		{Id: 2, ProposalId: 2, Signer: steeringDAO, Action: types.DaoActionTypeEndorse, Time: ctx.BlockTime()},
+		{Id: 3, ProposalId: 1, Signer: oversightDAO, Action: types.DaoActionTypeVeto, Time: ctx.BlockTime()},
+	}
+	for _, a := range actions {
+		require.NoError(t, k.SetAction(ctx, a))
+	}
+	q := keeper.NewQuerier(*k)
+
+	resp, err := q.ProposalActions(ctx, &types.QueryProposalActionsRequest{ProposalId: 1})
+
+	require.NoError(t, err)
+	require.Equal(t, []types.DaoAction{actions[0], actions[2]}, resp.Actions)
+	require.EqualValues(t, 2, resp.Pagination.Total)
+
+	resp, err = q.ProposalActions(ctx, &types.QueryProposalActionsRequest{
+		ProposalId: 1,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []types.DaoAction{actions[0]}, resp.Actions)
+	require.NotEmpty(t, resp.Pagination.NextKey)
+
+	resp, err = q.ProposalActions(ctx, &types.QueryProposalActionsRequest{ProposalId: 3})
+
+	require.NoError(t, err)
+	require.Empty(t, resp.Actions)
+}
+
+func TestDaoActionsQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	steeringDAO := sdk.AccAddress("steeringDao").String()
+	oversightDAO := sdk.AccAddress("oversightDao").String()
+	actions := []types.DaoAction{
+		{Id: 1, ProposalId: 1, Signer: steeringDAO, Action: types.DaoActionTypeAnnotate, Time: ctx.BlockTime()},
+		{Id: 2, ProposalId: 2, Signer: steeringDAO, Action: types.DaoActionTypeEndorse, Time: ctx.BlockTime()},
+		{Id: 3, ProposalId: 1, Signer: oversightDAO, Action: types.DaoActionTypeVeto, Time: ctx.BlockTime()},
+	}
+	for _, a := range actions {
+		require.NoError(t, k.SetAction(ctx, a))
+	}
+	q := keeper.NewQuerier(*k)
+
+	resp, err := q.DaoActions(ctx, &types.QueryDaoActionsRequest{DaoAddress: steeringDAO})
+
+	require.NoError(t, err)
+	require.Equal(t, []types.DaoAction{actions[0], actions[1]}, resp.Actions)
+
+	resp, err = q.DaoActions(ctx, &types.QueryDaoActionsRequest{DaoAddress: oversightDAO})
+
+	require.NoError(t, err)
+	require.Equal(t, []types.DaoAction{actions[2]}, resp.Actions)
+
+	_, err = q.DaoActions(ctx, &types.QueryDaoActionsRequest{DaoAddress: "invalid"})
+
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid dao address: decoding bech32 failed: invalid bech32 string length 7")
+}
+
+func TestPendingVetoesQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	oversightDAO := sdk.AccAddress("oversightDao").String()
+	vetoes := []types.PendingVeto{
+		{ProposalId: 1, Vetoer: oversightDAO, SubmitTime: ctx.BlockTime(), ExecutionTime: ctx.BlockTime()},
+		{ProposalId: 2, Vetoer: oversightDAO, BurnDeposit: true, SubmitTime: ctx.BlockTime(), ExecutionTime: ctx.BlockTime()},
+	}
+	for _, v := range vetoes {
+		require.NoError(t, k.PendingVetoes.Set(ctx, v.ProposalId, v))
+	}
+	q := keeper.NewQuerier(*k)
+
+	resp, err := q.PendingVetoes(ctx, &types.QueryPendingVetoesRequest{})
+
+	require.NoError(t, err)
+	require.Equal(t, vetoes, resp.PendingVetoes)
+
+	resp, err = q.PendingVetoes(ctx, &types.QueryPendingVetoesRequest{
+		Pagination: &query.PageRequest{Offset: 1},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, vetoes[1:], resp.PendingVetoes)
+}
+
+func TestDaoMembersQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	q := keeper.NewQuerier(*k)
+
+	resp, err := q.DaoMembers(ctx, &types.QueryDaoMembersRequest{})
+
+	require.NoError(t, err)
+	require.Empty(t, resp.DaoMembers)
+
+	daoMembers := []types.DaoMembers{
+		{Dao: types.CoreDaoSteering, Members: []string{sdk.AccAddress("member1").String()}, Threshold: 1, ApprovalTimeout: time.Hour},
+		{Dao: types.CoreDaoOversight, Members: []string{sdk.AccAddress("member2").String()}, Threshold: 1, ApprovalTimeout: time.Hour},
+	}
+	for _, m := range daoMembers {
+		require.NoError(t, k.SetDaoMembers(ctx, m))
+	}
+
+	resp, err = q.DaoMembers(ctx, &types.QueryDaoMembersRequest{})
+
+	require.NoError(t, err)
+	require.Equal(t, daoMembers, resp.DaoMembers)
+}
+
+func TestPendingDaoActionsQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	member := sdk.AccAddress("member").String()
+	actions := []types.PendingDaoAction{
+		{Id: 0, Dao: types.CoreDaoSteering, Action: types.DaoActionTypeEndorse, ProposalId: 1, Approvals: []string{member}, SubmitTime: ctx.BlockTime(), ExpirationTime: ctx.BlockTime()},
+		{Id: 1, Dao: types.CoreDaoOversight, Action: types.DaoActionTypeVeto, ProposalId: 1, Approvals: []string{member}, SubmitTime: ctx.BlockTime(), ExpirationTime: ctx.BlockTime()},
+	}
+	for _, a := range actions {
+		require.NoError(t, k.PendingDaoActions.Set(ctx, a.Id, a))
+	}
+	q := keeper.NewQuerier(*k)
+
+	resp, err := q.PendingDaoActions(ctx, &types.QueryPendingDaoActionsRequest{})
+
+	require.NoError(t, err)
+	require.Equal(t, actions, resp.PendingDaoActions)
+
+	resp, err = q.PendingDaoActions(ctx, &types.QueryPendingDaoActionsRequest{
+		Pagination: &query.PageRequest{Offset: 1},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, actions[1:], resp.PendingDaoActions)
+}
+
+func TestSteeringProposalCountQuery(t *testing.T) {
+	k, m, ctx := testutil.SetupCoredaosKeeper(t)
+	params := types.DefaultParams()
+	require.NoError(t, k.Params.Set(ctx, params))
+	m.EpochsKeeper.EXPECT().GetEpochInfo(gomock.Any(), params.SteeringProposalEpochIdentifier).
+		Return(epochstypes.EpochInfo{CurrentEpoch: 2}, nil).AnyTimes()
+	q := keeper.NewQuerier(*k)
+
+	// the count of a previous epoch is not reported
+	require.NoError(t, k.SteeringProposalCount.Set(ctx, types.SteeringProposalCount{EpochNumber: 1, Count: 1}))
+
+	resp, err := q.SteeringProposalCount(ctx, &types.QuerySteeringProposalCountRequest{})
+
+	require.NoError(t, err)
+	require.Equal(t, types.SteeringProposalCount{EpochNumber: 2}, resp.Count)
+	require.Equal(t, params.SteeringProposalQuota, resp.Quota)
+
+	require.NoError(t, k.SteeringProposalCount.Set(ctx, types.SteeringProposalCount{EpochNumber: 2, Count: 1}))
+
+	resp, err = q.SteeringProposalCount(ctx, &types.QuerySteeringProposalCountRequest{})
+
+	require.NoError(t, err)
+	require.Equal(t, types.SteeringProposalCount{EpochNumber: 2, Count: 1}, resp.Count)
+}
+
+func TestRecusalsQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	recusals := []types.Recusal{
+		{ProposalId: 1, Dao: types.CoreDaoSteering, Reason: "conflict of interest", Time: ctx.BlockTime()},
+		{ProposalId: 1, Dao: types.CoreDaoOversight, Reason: "change of address", Mandatory: true, Time: ctx.BlockTime()},
+		{ProposalId: 2, Dao: types.CoreDaoSteering, Reason: "conflict of interest", Time: ctx.BlockTime()},
+	}
+	for _, r := range recusals {
+		require.NoError(t, k.Recusals.Set(ctx, collections.Join(r.ProposalId, int32(r.Dao)), r))
+	}
+	q := keeper.NewQuerier(*k)
+
+	resp, err := q.Recusals(ctx, &types.QueryRecusalsRequest{ProposalId: 1})
+
+	require.NoError(t, err)
+	require.Equal(t, recusals[:2], resp.Recusals)
+
+	resp, err = q.Recusals(ctx, &types.QueryRecusalsRequest{ProposalId: 3})
+
+	require.NoError(t, err)
+	require.Empty(t, resp.Recusals)
+
+	_, err = q.Recusals(ctx, nil)
+
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid request")
+}
+
+func TestDaoBudgetsQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	allowance := sdk.NewCoins(sdk.NewInt64Coin("uatone", 100))
+	budget := types.DaoBudget{Dao: types.CoreDaoSteering, EpochIdentifier: "week", Allowance: allowance}
+	require.NoError(t, k.SetDaoBudget(ctx, budget))
+	q := keeper.NewQuerier(*k)
+
+	resp, err := q.DaoBudgets(ctx, &types.QueryDaoBudgetsRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.DaoBudgets, 1)
+	require.Equal(t, budget, resp.DaoBudgets[0].Budget)
+	require.True(t, resp.DaoBudgets[0].Remaining.IsZero())
+
+	// the funds of a removed budget are still reported until they are returned
+	require.NoError(t, k.SetDaoBudget(ctx, types.DaoBudget{Dao: types.CoreDaoSteering}))
+	account := types.DaoBudgetAccount{
+		Dao:             types.CoreDaoOversight,
+		EpochIdentifier: "day",
+		EpochNumber:     4,
+		Funded:          allowance,
+		Spent:           sdk.NewCoins(sdk.NewInt64Coin("uatone", 30)),
+	}
+	require.NoError(t, k.DaoBudgetAccounts.Set(ctx, int32(types.CoreDaoOversight), account))
+
+	resp, err = q.DaoBudgets(ctx, &types.QueryDaoBudgetsRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.DaoBudgets, 1)
+	require.Equal(t, types.CoreDaoOversight, resp.DaoBudgets[0].Dao)
+	require.Equal(t, account.EpochNumber, resp.DaoBudgets[0].Account.EpochNumber)
+	require.Equal(t, "70uatone", resp.DaoBudgets[0].Remaining.String())
+
+	_, err = q.DaoBudgets(ctx, nil)
+
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid request")
+}
+
+func TestDaoStatusesQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	maxInactivity := 24 * time.Hour
+	params := types.DefaultParams()
+	params.SteeringDaoAddress = sdk.AccAddress("steeringDao").String()
+	params.MaxInactivity = &maxInactivity
+	require.NoError(t, k.Params.Set(ctx, params))
+	lastActionTime := ctx.BlockTime().Add(-time.Hour)
+	liveness := types.DaoLiveness{
+		Dao:              types.CoreDaoSteering,
+		ActiveSince:      ctx.BlockTime().Add(-2 * time.Hour),
+		LastActionHeight: 3,
+		LastActionTime:   &lastActionTime,
+	}
+	require.NoError(t, k.DaoLiveness.Set(ctx, int32(types.CoreDaoSteering), liveness))
+	q := keeper.NewQuerier(*k)
+
+	resp, err := q.DaoStatuses(ctx, &types.QueryDaoStatusesRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.DaoStatuses, 2)
+	steering := resp.DaoStatuses[0]
+	require.Equal(t, types.DaoStateActive, steering.State)
+	require.Equal(t, params.SteeringDaoAddress, steering.Address)
+	require.Equal(t, int64(3), steering.Liveness.LastActionHeight)
+	require.Equal(t, 23*time.Hour, *steering.TimeToSuspension)
+	oversight := resp.DaoStatuses[1]
+	require.Equal(t, types.DaoStateDisabled, oversight.State)
+	require.Nil(t, oversight.TimeToSuspension)
+
+	liveness.Suspended = true
+	require.NoError(t, k.DaoLiveness.Set(ctx, int32(types.CoreDaoSteering), liveness))
+
+	resp, err = q.DaoStatuses(ctx, &types.QueryDaoStatusesRequest{})
+
+	require.NoError(t, err)
+	require.Equal(t, types.DaoStateSuspended, resp.DaoStatuses[0].State)
+	require.Nil(t, resp.DaoStatuses[0].TimeToSuspension)
+
+	_, err = q.DaoStatuses(ctx, nil)
+
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid request")
+}
@@ -8,6 +8,8 @@ import (
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -81,7 +83,11 @@ func (h Hooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPk, newPk cryp
 
 // validateDelegation checks if the delegation is being performed by a core DAO
 // and returns an error if it is, since by Constitution, core DAOs cannot stake.
+// This includes the module account holding the budgets of the core DAOs.
 func validateDelegation(params types.Params, delAddr sdk.AccAddress) error {
+	if delAddr.Equals(authtypes.NewModuleAddress(types.ModuleName)) {
+		return errorsmod.Wrap(types.ErrCannotStake, "Core DAO budget account cannot stake")
+	}
 	if params.GetSteeringDaoAddress() != "" {
 		steeringDaoAddr := sdk.MustAccAddressFromBech32(params.GetSteeringDaoAddress())
 		if delAddr.Equals(steeringDaoAddr) {
@@ -97,6 +103,33 @@ func validateDelegation(params types.Params, delAddr sdk.AccAddress) error {
 	return nil
 }
 
+// EpochHooks is a wrapper struct for the coredaos keeper that implements the
+// epochs hooks.
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the epochs hooks of the coredaos keeper.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// BeforeEpochStart funds the budgets of the Core DAOs from the community pool
+// when the epoch of the budgets starts.
+func (h EpochHooks) BeforeEpochStart(goCtx context.Context, epochIdentifier string, epochNumber int64) error {
+	h.k.FundDaoBudgets(sdk.UnwrapSDKContext(goCtx), epochIdentifier, epochNumber)
+	return nil
+}
+
+// AfterEpochEnd returns the unspent funds of the budgets of the Core DAOs to
+// the community pool when the epoch of the budgets ends.
+func (h EpochHooks) AfterEpochEnd(goCtx context.Context, epochIdentifier string, _ int64) error {
+	h.k.ReturnDaoBudgets(sdk.UnwrapSDKContext(goCtx), epochIdentifier)
+	return nil
+}
+
 var _ govtypes.GovHooks = Hooks{}
 
 // GovHooks returns the gov hooks for the coredaos keeper.
@@ -104,38 +137,42 @@ func (k Keeper) GovHooks() Hooks {
 	return Hooks{k}
 }
 
-// AfterProposalSubmission rejects a proposal that bundles a coredaos MsgUpdateParams
-// changing the oversight DAO address together with other messages. Self-executing
-// authz.MsgExec wrappers are rejected upstream in gov's SubmitProposal, so only
-// top-level messages need inspection here.
+// AfterProposalSubmission enforces the rules on the proposals submitted by the
+// Steering DAO, and records the mandatory recusals of the Core DAOs from the
+// proposals that change them. Since the Oversight DAO cannot veto a proposal it
+// is recused from, such a proposal cannot bundle other messages, which would
+// otherwise escape the veto.
 func (h Hooks) AfterProposalSubmission(ctx context.Context, proposalID uint64) error {
-	params := h.k.GetParams(ctx)
-	if params.OversightDaoAddress == "" {
-		return nil
-	}
 	proposal, err := h.k.govKeeper.Proposals.Get(ctx, proposalID)
 	if err != nil {
 		return nil // proposal not found; nothing to enforce
 	}
-	if len(proposal.Messages) <= 1 {
-		return nil // bundling requires more than one message
+	if err := h.k.validateSteeringProposal(ctx, proposal); err != nil {
+		return err
 	}
-	for _, anyMsg := range proposal.Messages {
-		var msg sdk.Msg
-		if err := h.k.cdc.UnpackAny(anyMsg, &msg); err != nil {
-			continue
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := h.k.GetParams(ctx)
+	for _, dao := range []types.CoreDao{types.CoreDaoSteering, types.CoreDaoOversight} {
+		if params.DaoAddress(dao) == "" {
+			continue // the Core DAO is disabled
 		}
-		updateParams, ok := msg.(*types.MsgUpdateParams)
-		if !ok {
+		reason := h.k.mandatoryRecusalReason(ctx, proposal, dao)
+		if reason == "" {
 			continue
 		}
-		changed, err := oversightDaoAddressChanged(updateParams.Params.OversightDaoAddress, params.OversightDaoAddress)
-		if err != nil {
-			return errorsmod.Wrap(atomoneerrors.ErrUnauthorized, "failed to compare Oversight DAO addresses: "+err.Error())
+		if dao == types.CoreDaoOversight && len(proposal.Messages) > 1 {
+			return errorsmod.Wrapf(atomoneerrors.ErrUnauthorized,
+				"%s, it cannot be bundled with other messages", reason)
 		}
-		if changed {
-			return errorsmod.Wrap(atomoneerrors.ErrUnauthorized,
-				"proposal that changes the Oversight DAO address cannot be bundled with other messages")
+		err := h.k.recordRecusal(sdkCtx, types.Recusal{
+			ProposalId: proposal.Id,
+			Dao:        dao,
+			Reason:     reason,
+			Mandatory:  true,
+			Time:       sdkCtx.BlockTime(),
+		})
+		if err != nil {
+			return err
 		}
 	}
 	return nil
@@ -153,13 +190,15 @@ func (h Hooks) AfterProposalFailedMinDeposit(ctx context.Context, proposalID uin
 	return nil
 }
 
+// AfterProposalVotingPeriodEnded prunes the quorum extension exemption of the
+// proposal, which is only relevant during its voting period.
 func (h Hooks) AfterProposalVotingPeriodEnded(ctx context.Context, proposalID uint64) error {
-	return nil
+	return h.k.QuorumExtensionExemptions.Remove(ctx, proposalID)
 }
 
-// oversightDaoAddressChanged returns true if newAddr and currentAddr decode to different
+// daoAddressChanged returns true if newAddr and currentAddr decode to different
 // accounts (case-insensitive). Empty addresses are handled explicitly.
-func oversightDaoAddressChanged(newAddr, currentAddr string) (bool, error) {
+func daoAddressChanged(newAddr, currentAddr string) (bool, error) {
 	if newAddr == "" && currentAddr == "" {
 		return false, nil
 	}
@@ -70,6 +70,16 @@ func TestGovHookAfterProposalSubmission(t *testing.T) {
 		})
 	}
 
+	// The single oversight change records the mandatory recusal of the oversight DAO.
+	recusal, found, err := app.CoreDaosKeeper.GetRecusal(ctx, 2, types.CoreDaoOversight)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.True(t, recusal.Mandatory)
+	require.Equal(t, "proposal contains a change of the Oversight DAO address", recusal.Reason)
+	_, found, err = app.CoreDaosKeeper.GetRecusal(ctx, 4, types.CoreDaoOversight)
+	require.NoError(t, err)
+	require.False(t, found)
+
 	// A non-existent proposal is a no-op (nothing to enforce).
 	setOversight(current)
 	require.NoError(t, hooks.AfterProposalSubmission(ctx, 9999))
@@ -107,3 +117,13 @@ func TestGovHookWiredRejectsBundledOversightChange(t *testing.T) {
 		require.NotContains(t, err.Error(), "cannot be bundled")
 	}
 }
+
+func TestGovHookRejectsSteeringProposalMetadata(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	proposer := simtestutil.CreateRandomAccounts(1)[0]
+
+	// only MsgSubmitSteeringProposal can tag a proposal as submitted by the Steering DAO
+	_, err := app.GovKeeper.SubmitProposal(ctx, nil, types.SteeringProposalMetadata(""), "title", "summary", proposer)
+	require.ErrorContains(t, err, `metadata prefix "steering-dao:" is reserved to the Steering DAO: invalid steering DAO proposal`)
+}
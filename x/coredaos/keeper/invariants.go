@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+)
+
+const (
+	activeProposalsQueueInvariantRoute   = "active-proposals-queue"
+	vetoedProposalsInvariantRoute        = "vetoed-proposals"
+	votingPeriodExtensionsInvariantRoute = "voting-period-extensions"
+	daoDelegationsInvariantRoute         = "dao-delegations"
+)
+
+// RegisterInvariants registers all coredaos invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for _, route := range k.invariantRoutes() {
+		ir.RegisterRoute(types.ModuleName, route.name, route.invariant)
+	}
+}
+
+// AllInvariants runs all invariants of the coredaos module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, route := range k.invariantRoutes() {
+			if res, stop := route.invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+type invariantRoute struct {
+	name      string
+	invariant sdk.Invariant
+}
+
+func (k Keeper) invariantRoutes() []invariantRoute {
+	return []invariantRoute{
+		{activeProposalsQueueInvariantRoute, ActiveProposalsQueueInvariant(k)},
+		{vetoedProposalsInvariantRoute, VetoedProposalsInvariant(k)},
+		{votingPeriodExtensionsInvariantRoute, VotingPeriodExtensionsInvariant(k)},
+		{daoDelegationsInvariantRoute, DaoDelegationsInvariant(k)},
+	}
+}
+
+// ActiveProposalsQueueInvariant checks that the x/gov active proposals queue
+// holds the proposals in voting period at their voting end time, including
+// the extensions of their voting period. The proposals with a pending veto
+// must be out of the queue.
+func ActiveProposalsQueueInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		queued := make(map[uint64]bool)
+		err := k.govKeeper.ActiveProposalsQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64], _ uint64) (bool, error) {
+			proposalID := key.K2()
+			queued[proposalID] = true
+			proposal, err := k.govKeeper.Proposals.Get(ctx, proposalID)
+			if err != nil {
+				msg += fmt.Sprintf("\tqueued proposal %d not found: %v\n", proposalID, err)
+				broken = true
+				return false, nil
+			}
+			if proposal.Status != govv1.StatusVotingPeriod {
+				msg += fmt.Sprintf("\tqueued proposal %d has status %s\n", proposalID, proposal.Status)
+				broken = true
+			}
+			if proposal.VotingEndTime == nil || !proposal.VotingEndTime.Equal(key.K1()) {
+				msg += fmt.Sprintf("\tproposal %d is queued at %s but its voting period ends at %v\n", proposalID, key.K1(), proposal.VotingEndTime)
+				broken = true
+			}
+			hasPendingVeto, err := k.PendingVetoes.Has(ctx, proposalID)
+			if err != nil {
+				return true, err
+			}
+			if hasPendingVeto {
+				msg += fmt.Sprintf("\tproposal %d is queued with a pending veto\n", proposalID)
+				broken = true
+			}
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		err = k.govKeeper.Proposals.Walk(ctx, nil, func(proposalID uint64, proposal govv1.Proposal) (bool, error) {
+			if proposal.Status != govv1.StatusVotingPeriod || queued[proposalID] {
+				return false, nil
+			}
+			hasPendingVeto, err := k.PendingVetoes.Has(ctx, proposalID)
+			if err != nil {
+				return true, err
+			}
+			if !hasPendingVeto {
+				msg += fmt.Sprintf("\tproposal %d in voting period is not queued\n", proposalID)
+				broken = true
+			}
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, activeProposalsQueueInvariantRoute, msg), broken
+	}
+}
+
+// VetoedProposalsInvariant checks that the votes and the deposits of the
+// vetoed proposals have been deleted.
+func VetoedProposalsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		err := k.govKeeper.Proposals.Walk(ctx, nil, func(proposalID uint64, proposal govv1.Proposal) (bool, error) {
+			if proposal.Status != govv1.StatusVetoed {
+				return false, nil
+			}
+			rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+			var votes, deposits int
+			err := k.govKeeper.Votes.Walk(ctx, rng, func(_ collections.Pair[uint64, sdk.AccAddress], _ govv1.Vote) (bool, error) {
+				votes++
+				return false, nil
+			})
+			if err != nil {
+				return true, err
+			}
+			err = k.govKeeper.Deposits.Walk(ctx, rng, func(_ collections.Pair[uint64, sdk.AccAddress], _ govv1.Deposit) (bool, error) {
+				deposits++
+				return false, nil
+			})
+			if err != nil {
+				return true, err
+			}
+			if votes > 0 || deposits > 0 {
+				msg += fmt.Sprintf("\tvetoed proposal %d has %d votes and %d deposits\n", proposalID, votes, deposits)
+				broken = true
+			}
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, vetoedProposalsInvariantRoute, msg), broken
+	}
+}
+
+// VotingPeriodExtensionsInvariant checks that the voting period of the
+// proposals in voting period has not been extended more times than allowed, in
+// total and by each Core DAO. Each extension is checked against the limits
+// recorded in the action log when it was granted, so that lowering the limits
+// through governance doesn't break the invariant. The extensions logged
+// without limits, before they were recorded, are not checked.
+func VotingPeriodExtensionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		err := k.govKeeper.Proposals.Walk(ctx, nil, func(proposalID uint64, proposal govv1.Proposal) (bool, error) {
+			if proposal.Status != govv1.StatusVotingPeriod {
+				return false, nil
+			}
+			var (
+				timesExtended    uint32
+				daoTimesExtended = make(map[types.CoreDao]uint32)
+				lastLimit        uint32
+			)
+			rng := collections.NewPrefixedPairRange[uint64, uint64](proposalID)
+			err := k.ProposalActions.Walk(ctx, rng, func(_ collections.Pair[uint64, uint64], action types.DaoAction) (bool, error) {
+				if action.Action != types.DaoActionTypeExtendVotingPeriod {
+					return false, nil
+				}
+				timesExtended++
+				daoTimesExtended[action.Dao]++
+				if action.ExtensionsLimit == 0 {
+					return false, nil
+				}
+				lastLimit = action.ExtensionsLimit
+				if timesExtended > action.ExtensionsLimit {
+					msg += fmt.Sprintf("\tproposal %d voting period extended %d times by action %d, limit was %d\n",
+						proposalID, timesExtended, action.Id, action.ExtensionsLimit)
+					broken = true
+				}
+				if daoTimesExtended[action.Dao] > action.DaoExtensionsLimit {
+					msg += fmt.Sprintf("\tproposal %d voting period extended %d times by the %s by action %d, limit was %d\n",
+						proposalID, daoTimesExtended[action.Dao], action.Dao.DisplayName(), action.Id, action.DaoExtensionsLimit)
+					broken = true
+				}
+				return false, nil
+			})
+			if err != nil {
+				return true, err
+			}
+			// The voting period of the proposal is only extended by the Core
+			// DAOs, so it can't have been extended more times than allowed by
+			// the last extension.
+			if lastLimit > 0 && proposal.TimesVotingPeriodExtended > lastLimit {
+				msg += fmt.Sprintf("\tproposal %d voting period extended %d times, limit was %d\n",
+					proposalID, proposal.TimesVotingPeriodExtended, lastLimit)
+				broken = true
+			}
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, votingPeriodExtensionsInvariantRoute, msg), broken
+	}
+}
+
+// DaoDelegationsInvariant checks that the Core DAOs hold no delegations, since
+// by Constitution, core DAOs cannot stake.
+func DaoDelegationsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		params := k.GetParams(ctx)
+		for _, dao := range []types.CoreDao{types.CoreDaoSteering, types.CoreDaoOversight} {
+			if params.DaoAddress(dao) == "" {
+				continue
+			}
+			daoAddr := sdk.MustAccAddressFromBech32(params.DaoAddress(dao))
+			bonded, err := k.stakingKeeper.GetDelegatorBonded(ctx, daoAddr)
+			if err != nil {
+				panic(err)
+			}
+			unbonding, err := k.stakingKeeper.GetDelegatorUnbonding(ctx, daoAddr)
+			if err != nil {
+				panic(err)
+			}
+			if !bonded.IsZero() || !unbonding.IsZero() {
+				msg += fmt.Sprintf("\t%s holds %s bonded and %s unbonding tokens\n", dao.DisplayName(), bonded, unbonding)
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, daoDelegationsInvariantRoute, msg), broken
+	}
+}
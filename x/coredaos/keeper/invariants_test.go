@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	atomoneapp "github.com/atomone-hub/atomone/app"
+	"github.com/atomone-hub/atomone/app/helpers"
+	"github.com/atomone-hub/atomone/x/coredaos/keeper"
+	"github.com/atomone-hub/atomone/x/coredaos/testutil"
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+)
+
+func TestProposalInvariants(t *testing.T) {
+	steeringDAOAcc := sdk.AccAddress("steeringDao")
+	voter := sdk.AccAddress("voter")
+	tests := []struct {
+		name                   string
+		setup                  func(*testing.T, *atomoneapp.AtomOneApp, sdk.Context, govv1.Proposal)
+		expectQueueBroken      bool
+		expectVetoedBroken     bool
+		expectExtensionsBroken bool
+	}{
+		{
+			name: "ok",
+		},
+		{
+			name: "ok with extended voting period",
+			setup: func(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, p govv1.Proposal) {
+				t.Helper()
+				ms := keeper.NewMsgServer(app.CoreDaosKeeper)
+				_, err := ms.ExtendVotingPeriod(ctx, types.NewMsgExtendVotingPeriod(steeringDAOAcc, p.Id))
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "ok with pending veto",
+			setup: func(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, p govv1.Proposal) {
+				t.Helper()
+				require.NoError(t, app.CoreDaosKeeper.PendingVetoes.Set(ctx, p.Id, types.PendingVeto{ProposalId: p.Id}))
+				require.NoError(t, app.CoreDaosKeeper.SuspendProposal(ctx, p.Id))
+			},
+		},
+		{
+			name: "voting end time not updated in queue",
+			setup: func(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, p govv1.Proposal) {
+				t.Helper()
+				endTime := p.VotingEndTime.Add(time.Hour)
+				p.VotingEndTime = &endTime
+				require.NoError(t, app.GovKeeper.SetProposal(ctx, p))
+			},
+			expectQueueBroken: true,
+		},
+		{
+			name: "proposal in voting period not queued",
+			setup: func(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, p govv1.Proposal) {
+				t.Helper()
+				require.NoError(t, app.GovKeeper.ActiveProposalsQueue.Remove(ctx, collectionsJoin(*p.VotingEndTime, p.Id)))
+			},
+			expectQueueBroken: true,
+		},
+		{
+			name: "queued proposal with pending veto",
+			setup: func(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, p govv1.Proposal) {
+				t.Helper()
+				require.NoError(t, app.CoreDaosKeeper.PendingVetoes.Set(ctx, p.Id, types.PendingVeto{ProposalId: p.Id}))
+			},
+			expectQueueBroken: true,
+		},
+		{
+			name: "vetoed proposal with votes",
+			setup: func(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, p govv1.Proposal) {
+				t.Helper()
+				require.NoError(t, app.GovKeeper.ActiveProposalsQueue.Remove(ctx, collectionsJoin(*p.VotingEndTime, p.Id)))
+				p.Status = govv1.StatusVetoed
+				require.NoError(t, app.GovKeeper.SetProposal(ctx, p))
+				vote := govv1.NewVote(p.Id, voter, govv1.NewNonSplitVoteOption(govv1.OptionYes), "")
+				require.NoError(t, app.GovKeeper.Votes.Set(ctx, collections.Join(p.Id, voter), vote))
+			},
+			expectVetoedBroken: true,
+		},
+		{
+			name: "vetoed proposal with deposits",
+			setup: func(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, p govv1.Proposal) {
+				t.Helper()
+				require.NoError(t, app.GovKeeper.ActiveProposalsQueue.Remove(ctx, collectionsJoin(*p.VotingEndTime, p.Id)))
+				p.Status = govv1.StatusVetoed
+				require.NoError(t, app.GovKeeper.SetProposal(ctx, p))
+				deposit := govv1.NewDeposit(p.Id, voter, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+				require.NoError(t, app.GovKeeper.Deposits.Set(ctx, collections.Join(p.Id, voter), deposit))
+			},
+			expectVetoedBroken: true,
+		},
+		{
+			name: "ok with extension limits lowered",
+			setup: func(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, p govv1.Proposal) {
+				t.Helper()
+				ms := keeper.NewMsgServer(app.CoreDaosKeeper)
+				for range 2 {
+					_, err := ms.ExtendVotingPeriod(ctx, types.NewMsgExtendVotingPeriod(steeringDAOAcc, p.Id))
+					require.NoError(t, err)
+				}
+				params := app.CoreDaosKeeper.GetParams(ctx)
+				params.VotingPeriodExtensionsLimit = 1
+				require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+			},
+		},
+		{
+			name: "ok with extensions logged without limits",
+			setup: func(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, p govv1.Proposal) {
+				t.Helper()
+				require.NoError(t, app.CoreDaosKeeper.SetAction(ctx, types.DaoAction{
+					ProposalId: p.Id,
+					Signer:     steeringDAOAcc.String(),
+					Action:     types.DaoActionTypeExtendVotingPeriod,
+					Dao:        types.CoreDaoSteering,
+				}))
+				p.TimesVotingPeriodExtended = types.DefaultVotingPeriodExtensionsLimit + 1
+				require.NoError(t, app.GovKeeper.SetProposal(ctx, p))
+			},
+		},
+		{
+			name: "voting period extended above the limit",
+			setup: func(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, p govv1.Proposal) {
+				t.Helper()
+				ms := keeper.NewMsgServer(app.CoreDaosKeeper)
+				_, err := ms.ExtendVotingPeriod(ctx, types.NewMsgExtendVotingPeriod(steeringDAOAcc, p.Id))
+				require.NoError(t, err)
+				p, err = app.GovKeeper.Proposals.Get(ctx, p.Id)
+				require.NoError(t, err)
+				p.TimesVotingPeriodExtended = types.DefaultVotingPeriodExtensionsLimit + 1
+				require.NoError(t, app.GovKeeper.SetProposal(ctx, p))
+			},
+			expectExtensionsBroken: true,
+		},
+		{
+			name: "voting period extended above the limit of an extension",
+			setup: func(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, p govv1.Proposal) {
+				t.Helper()
+				for id := range uint64(2) {
+					require.NoError(t, app.CoreDaosKeeper.SetAction(ctx, types.DaoAction{
+						Id:                 id,
+						ProposalId:         p.Id,
+						Signer:             steeringDAOAcc.String(),
+						Action:             types.DaoActionTypeExtendVotingPeriod,
+						Dao:                types.CoreDaoSteering,
+						ExtensionsLimit:    1,
+						DaoExtensionsLimit: 2,
+					}))
+				}
+				p.TimesVotingPeriodExtended = 2
+				require.NoError(t, app.GovKeeper.SetProposal(ctx, p))
+			},
+			expectExtensionsBroken: true,
+		},
+		{
+			name: "voting period extended above the Core DAO limit of an extension",
+			setup: func(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, p govv1.Proposal) {
+				t.Helper()
+				for id := range uint64(2) {
+					require.NoError(t, app.CoreDaosKeeper.SetAction(ctx, types.DaoAction{
+						Id:                 id,
+						ProposalId:         p.Id,
+						Signer:             steeringDAOAcc.String(),
+						Action:             types.DaoActionTypeExtendVotingPeriod,
+						Dao:                types.CoreDaoSteering,
+						ExtensionsLimit:    2,
+						DaoExtensionsLimit: 1,
+					}))
+				}
+				p.TimesVotingPeriodExtended = 2
+				require.NoError(t, app.GovKeeper.SetProposal(ctx, p))
+			},
+			expectExtensionsBroken: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := helpers.Setup(t)
+			ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+			params := types.DefaultParams()
+			params.SteeringDaoAddress = steeringDAOAcc.String()
+			require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+			proposal := submitBankSendProposalReal(t, app, ctx, true)
+			if tt.setup != nil {
+				tt.setup(t, app, ctx, proposal)
+			}
+			k := *app.CoreDaosKeeper
+
+			_, queueBroken := keeper.ActiveProposalsQueueInvariant(k)(ctx)
+			_, vetoedBroken := keeper.VetoedProposalsInvariant(k)(ctx)
+			_, extensionsBroken := keeper.VotingPeriodExtensionsInvariant(k)(ctx)
+			_, allBroken := keeper.AllInvariants(k)(ctx)
+
+			require.Equal(t, tt.expectQueueBroken, queueBroken)
+			require.Equal(t, tt.expectVetoedBroken, vetoedBroken)
+			require.Equal(t, tt.expectExtensionsBroken, extensionsBroken)
+			require.Equal(t, tt.expectQueueBroken || tt.expectVetoedBroken || tt.expectExtensionsBroken, allBroken)
+		})
+	}
+}
+
+func TestDaoDelegationsInvariant(t *testing.T) {
+	steeringDAOAcc := sdk.AccAddress("steeringDao")
+	tests := []struct {
+		name         string
+		bonded       math.Int
+		unbonding    math.Int
+		expectBroken bool
+	}{
+		{
+			name:      "ok",
+			bonded:    math.ZeroInt(),
+			unbonding: math.ZeroInt(),
+		},
+		{
+			name:         "bonded tokens",
+			bonded:       math.OneInt(),
+			unbonding:    math.ZeroInt(),
+			expectBroken: true,
+		},
+		{
+			name:         "unbonding tokens",
+			bonded:       math.ZeroInt(),
+			unbonding:    math.OneInt(),
+			expectBroken: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := testutil.SetupCoredaosKeeper(t)
+			params := types.DefaultParams()
+			params.SteeringDaoAddress = steeringDAOAcc.String()
+			require.NoError(t, k.Params.Set(ctx, params))
+			m.StakingKeeper.EXPECT().GetDelegatorBonded(gomock.Any(), steeringDAOAcc).Return(tt.bonded, nil)
+			m.StakingKeeper.EXPECT().GetDelegatorUnbonding(gomock.Any(), steeringDAOAcc).Return(tt.unbonding, nil)
+
+			_, broken := keeper.DaoDelegationsInvariant(*k)(ctx)
+
+			require.Equal(t, tt.expectBroken, broken)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"context"
+
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
@@ -19,9 +21,50 @@ type Keeper struct {
 
 	govKeeper     *govkeeper.Keeper
 	stakingKeeper types.StakingKeeper
+	epochsKeeper  types.EpochsKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+	// quorumCheckKeeper takes the endorsed proposals out of the x/gov quorum
+	// check queue.
+	quorumCheckKeeper types.QuorumCheckKeeper
+	// commitRevealKeeper maintains the commit-reveal voting of the vetoed and
+	// extended proposals.
+	commitRevealKeeper types.CommitRevealKeeper
 
 	Schema collections.Schema
 	Params collections.Item[types.Params]
+	// ActionSequence provides the ids of the Core DAOs actions.
+	ActionSequence collections.Sequence
+	// ProposalActions holds the Core DAOs action log, keyed by proposal id.
+	ProposalActions collections.Map[collections.Pair[uint64, uint64], types.DaoAction]
+	// DaoActions holds the Core DAOs action log, keyed by Core DAO address.
+	DaoActions collections.Map[collections.Pair[sdk.AccAddress, uint64], types.DaoAction]
+	// PendingVetoes holds the vetoes waiting for execution, keyed by proposal id.
+	PendingVetoes collections.Map[uint64, types.PendingVeto]
+	// DaoMembers holds the members of the Core DAOs, keyed by Core DAO.
+	DaoMembers collections.Map[int32, types.DaoMembers]
+	// DaoActionSequence provides the ids of the pending Core DAO actions.
+	DaoActionSequence collections.Sequence
+	// PendingDaoActions holds the actions waiting for the approval of the
+	// members of the Core DAOs, keyed by id.
+	PendingDaoActions collections.Map[uint64, types.PendingDaoAction]
+	// SteeringProposalCount holds the number of proposals submitted by the
+	// Steering DAO in the current epoch.
+	SteeringProposalCount collections.Item[types.SteeringProposalCount]
+	// Recusals holds the recusals of the Core DAOs, keyed by proposal id and
+	// Core DAO.
+	Recusals collections.Map[collections.Pair[uint64, int32], types.Recusal]
+	// DaoBudgets holds the budgets granted to the Core DAOs, keyed by Core DAO.
+	DaoBudgets collections.Map[int32, types.DaoBudget]
+	// DaoBudgetAccounts holds the funds of the current epoch of the budgets of
+	// the Core DAOs, keyed by Core DAO.
+	DaoBudgetAccounts collections.Map[int32, types.DaoBudgetAccount]
+	// DaoLiveness holds the activity of the Core DAOs, keyed by Core DAO.
+	DaoLiveness collections.Map[int32, types.DaoLiveness]
+	// QuorumExtensionExemptions holds the ids of the proposals in voting
+	// period exempted from the late quorum extension by their endorsement.
+	// They are pruned when the voting period ends or the proposal is vetoed.
+	QuorumExtensionExemptions collections.KeySet[uint64]
 }
 
 func NewKeeper(
@@ -30,6 +73,9 @@ func NewKeeper(
 	authority string,
 	govKeeper *govkeeper.Keeper,
 	stakingKeeper types.StakingKeeper,
+	epochsKeeper types.EpochsKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
 ) *Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(err)
@@ -37,12 +83,64 @@ func NewKeeper(
 
 	sb := collections.NewSchemaBuilder(storeService)
 	k := &Keeper{
-		cdc:           cdc,
-		storeService:  storeService,
-		authority:     authority,
-		govKeeper:     govKeeper,
-		stakingKeeper: stakingKeeper,
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		cdc:            cdc,
+		storeService:   storeService,
+		authority:      authority,
+		govKeeper:      govKeeper,
+		stakingKeeper:  stakingKeeper,
+		epochsKeeper:   epochsKeeper,
+		bankKeeper:     bankKeeper,
+		distrKeeper:    distrKeeper,
+		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		ActionSequence: collections.NewSequence(sb, types.ActionSequenceKey, "action_sequence"),
+		ProposalActions: collections.NewMap(
+			sb, types.ProposalActionsKeyPrefix, "proposal_actions",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+			codec.CollValue[types.DaoAction](cdc),
+		),
+		DaoActions: collections.NewMap(
+			sb, types.DaoActionsKeyPrefix, "dao_actions",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			codec.CollValue[types.DaoAction](cdc),
+		),
+		PendingVetoes: collections.NewMap(
+			sb, types.PendingVetoesKeyPrefix, "pending_vetoes",
+			collections.Uint64Key, codec.CollValue[types.PendingVeto](cdc),
+		),
+		DaoMembers: collections.NewMap(
+			sb, types.DaoMembersKeyPrefix, "dao_members",
+			collections.Int32Key, codec.CollValue[types.DaoMembers](cdc),
+		),
+		DaoActionSequence: collections.NewSequence(sb, types.DaoActionSequenceKey, "dao_action_sequence"),
+		PendingDaoActions: collections.NewMap(
+			sb, types.PendingDaoActionsPrefix, "pending_dao_actions",
+			collections.Uint64Key, codec.CollValue[types.PendingDaoAction](cdc),
+		),
+		SteeringProposalCount: collections.NewItem(
+			sb, types.SteeringProposalCountKey, "steering_proposal_count",
+			codec.CollValue[types.SteeringProposalCount](cdc),
+		),
+		Recusals: collections.NewMap(
+			sb, types.RecusalsKeyPrefix, "recusals",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Int32Key),
+			codec.CollValue[types.Recusal](cdc),
+		),
+		DaoBudgets: collections.NewMap(
+			sb, types.DaoBudgetsKeyPrefix, "dao_budgets",
+			collections.Int32Key, codec.CollValue[types.DaoBudget](cdc),
+		),
+		DaoBudgetAccounts: collections.NewMap(
+			sb, types.DaoBudgetAccountsPrefix, "dao_budget_accounts",
+			collections.Int32Key, codec.CollValue[types.DaoBudgetAccount](cdc),
+		),
+		DaoLiveness: collections.NewMap(
+			sb, types.DaoLivenessKeyPrefix, "dao_liveness",
+			collections.Int32Key, codec.CollValue[types.DaoLiveness](cdc),
+		),
+		QuorumExtensionExemptions: collections.NewKeySet(
+			sb, types.QuorumExemptionsKeyPrefix, "quorum_extension_exemptions",
+			collections.Uint64Key,
+		),
 	}
 
 	schema, err := sb.Build()
@@ -53,6 +151,20 @@ func NewKeeper(
 	return k
 }
 
+// SetQuorumCheckKeeper sets the keeper taking the endorsed proposals out of
+// the x/gov quorum check queue.
+func (k *Keeper) SetQuorumCheckKeeper(qk types.QuorumCheckKeeper) *Keeper {
+	k.quorumCheckKeeper = qk
+	return k
+}
+
+// SetCommitRevealKeeper sets the keeper maintaining the commit-reveal voting
+// of the vetoed and extended proposals.
+func (k *Keeper) SetCommitRevealKeeper(ck types.CommitRevealKeeper) *Keeper {
+	k.commitRevealKeeper = ck
+	return k
+}
+
 // Logger returns a coredaos module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -62,3 +174,62 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
+
+// SetAction stores action in the Core DAOs action log.
+func (k Keeper) SetAction(ctx context.Context, action types.DaoAction) error {
+	signer, err := sdk.AccAddressFromBech32(action.Signer)
+	if err != nil {
+		return err
+	}
+	if err := k.ProposalActions.Set(ctx, collections.Join(action.ProposalId, action.Id), action); err != nil {
+		return err
+	}
+	return k.DaoActions.Set(ctx, collections.Join(signer, action.Id), action)
+}
+
+// logAction appends an action taken by signer on proposalID to the Core DAOs
+// action log, and records the activity of the Core DAO. previousAnnotation is
+// the annotation of the proposal before the action, and is only relevant for
+// annotations.
+func (k Keeper) logAction(ctx sdk.Context, proposalID uint64, signer string,
+	actionType types.DaoActionType, previousAnnotation string,
+) error {
+	return k.appendAction(ctx, types.DaoAction{
+		ProposalId:         proposalID,
+		Signer:             signer,
+		Action:             actionType,
+		PreviousAnnotation: previousAnnotation,
+	})
+}
+
+// logExtension appends a voting period extension of proposalID by signer to
+// the Core DAOs action log, along with the extensions limits that applied.
+func (k Keeper) logExtension(ctx sdk.Context, proposalID uint64, signer string,
+	extensionsLimit, daoExtensionsLimit uint32,
+) error {
+	return k.appendAction(ctx, types.DaoAction{
+		ProposalId:         proposalID,
+		Signer:             signer,
+		Action:             types.DaoActionTypeExtendVotingPeriod,
+		ExtensionsLimit:    extensionsLimit,
+		DaoExtensionsLimit: daoExtensionsLimit,
+	})
+}
+
+// appendAction assigns the next id, the block and the Core DAO of the signer
+// to action, stores it in the action log and records the activity of the Core
+// DAO.
+func (k Keeper) appendAction(ctx sdk.Context, action types.DaoAction) error {
+	id, err := k.ActionSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+	action.Id = id
+	action.BlockHeight = ctx.BlockHeight()
+	action.Time = ctx.BlockTime()
+	action.Dao = k.GetParams(ctx).CoreDaoOf(action.Signer)
+	if err := k.SetAction(ctx, action); err != nil {
+		return err
+	}
+	return k.recordDaoActivity(ctx, action.Dao)
+}
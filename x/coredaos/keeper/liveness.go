@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+)
+
+// GetDaoLiveness returns the liveness of dao, and false if the activity of dao
+// is not tracked yet.
+func (k Keeper) GetDaoLiveness(ctx context.Context, dao types.CoreDao) (types.DaoLiveness, bool, error) {
+	liveness, err := k.DaoLiveness.Get(ctx, int32(dao))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DaoLiveness{}, false, nil
+	}
+	if err != nil {
+		return types.DaoLiveness{}, false, err
+	}
+	return liveness, true, nil
+}
+
+// recordDaoActivity records an action of dao in the current block.
+func (k Keeper) recordDaoActivity(ctx sdk.Context, dao types.CoreDao) error {
+	if dao == types.CoreDaoUnspecified {
+		return nil
+	}
+	liveness, found, err := k.GetDaoLiveness(ctx, dao)
+	if err != nil {
+		return err
+	}
+	if !found {
+		liveness = types.DaoLiveness{Dao: dao, ActiveSince: ctx.BlockTime()}
+	}
+	blockTime := ctx.BlockTime()
+	liveness.LastActionHeight = ctx.BlockHeight()
+	liveness.LastActionTime = &blockTime
+	return k.DaoLiveness.Set(ctx, int32(dao), liveness)
+}
+
+// checkDaoActive returns an error if the powers of dao are suspended for
+// inactivity.
+func (k Keeper) checkDaoActive(ctx context.Context, dao types.CoreDao) error {
+	liveness, _, err := k.GetDaoLiveness(ctx, dao)
+	if err != nil {
+		return err
+	}
+	if liveness.Suspended {
+		return types.ErrDaoSuspended.Wrapf("%s must be reconfirmed by governance", dao.DisplayName())
+	}
+	return nil
+}
+
+// resetDaoLiveness stops tracking the activity of the Core DAOs whose address
+// differs between oldParams and newParams, so that a new Core DAO address is
+// not affected by the inactivity of the previous one.
+func (k Keeper) resetDaoLiveness(ctx context.Context, oldParams, newParams types.Params) error {
+	for _, dao := range []types.CoreDao{types.CoreDaoSteering, types.CoreDaoOversight} {
+		if oldParams.DaoAddress(dao) == newParams.DaoAddress(dao) {
+			continue
+		}
+		if err := k.DaoLiveness.Remove(ctx, int32(dao)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconfirmDao lifts the suspension of dao, and restarts the count of its
+// inactivity from the current block.
+func (k Keeper) reconfirmDao(ctx sdk.Context, dao types.CoreDao) error {
+	liveness, found, err := k.GetDaoLiveness(ctx, dao)
+	if err != nil {
+		return err
+	}
+	if !found {
+		liveness = types.DaoLiveness{Dao: dao}
+	}
+	liveness.ActiveSince = ctx.BlockTime()
+	liveness.Suspended = false
+	if err := k.DaoLiveness.Set(ctx, int32(dao), liveness); err != nil {
+		return err
+	}
+
+	k.emitDaoStatus(ctx, dao, types.DaoStateActive)
+	return nil
+}
+
+// SuspendInactiveDaos starts tracking the activity of the enabled Core DAOs,
+// and suspends the powers of the Core DAOs which have not acted for longer
+// than the max inactivity defined in the module parameters.
+func (k Keeper) SuspendInactiveDaos(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	for _, dao := range []types.CoreDao{types.CoreDaoSteering, types.CoreDaoOversight} {
+		if params.DaoAddress(dao) == "" {
+			continue
+		}
+		liveness, found, err := k.GetDaoLiveness(ctx, dao)
+		if err != nil {
+			return err
+		}
+		if !found {
+			liveness = types.DaoLiveness{Dao: dao, ActiveSince: ctx.BlockTime()}
+			if err := k.DaoLiveness.Set(ctx, int32(dao), liveness); err != nil {
+				return err
+			}
+			continue
+		}
+		if liveness.Suspended || !params.SuspendsInactiveDaos() {
+			continue
+		}
+		if ctx.BlockTime().Before(liveness.InactiveSince().Add(*params.MaxInactivity)) {
+			continue
+		}
+		liveness.Suspended = true
+		if err := k.DaoLiveness.Set(ctx, int32(dao), liveness); err != nil {
+			return err
+		}
+
+		k.Logger(ctx).Info(
+			"core DAO suspended for inactivity",
+			"dao", dao,
+			"inactive_since", liveness.InactiveSince(),
+		)
+
+		k.emitDaoStatus(ctx, dao, types.DaoStateSuspended)
+	}
+	return nil
+}
+
+// emitDaoStatus emits the event of a change of the state of dao.
+func (k Keeper) emitDaoStatus(ctx sdk.Context, dao types.CoreDao, state types.DaoState) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDaoStatus,
+			sdk.NewAttribute(types.AttributeKeyDao, dao.String()),
+			sdk.NewAttribute(types.AttributeKeyState, state.String()),
+		),
+	})
+}
+
+// DaoStatuses returns the state, the activity and the time to suspension of
+// the Core DAOs.
+func (k Keeper) DaoStatuses(ctx sdk.Context) ([]types.DaoStatus, error) {
+	params := k.GetParams(ctx)
+	statuses := make([]types.DaoStatus, 0, 2)
+	for _, dao := range []types.CoreDao{types.CoreDaoSteering, types.CoreDaoOversight} {
+		liveness, found, err := k.GetDaoLiveness(ctx, dao)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			// the tracking of the Core DAO starts at the end of the block
+			liveness = types.DaoLiveness{Dao: dao, ActiveSince: ctx.BlockTime()}
+		}
+		status := types.DaoStatus{
+			Dao:      dao,
+			Address:  params.DaoAddress(dao),
+			State:    types.DaoStateActive,
+			Liveness: liveness,
+		}
+		switch {
+		case status.Address == "":
+			status.State = types.DaoStateDisabled
+		case liveness.Suspended:
+			status.State = types.DaoStateSuspended
+		case params.SuspendsInactiveDaos():
+			timeToSuspension := max(liveness.InactiveSince().Add(*params.MaxInactivity).Sub(ctx.BlockTime()), time.Duration(0))
+			status.TimeToSuspension = &timeToSuspension
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/atomone-hub/atomone/x/coredaos/keeper"
+	"github.com/atomone-hub/atomone/x/coredaos/testutil"
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+)
+
+func TestSuspendInactiveDaos(t *testing.T) {
+	var (
+		steeringDAOAcc  = sdk.AccAddress("steeringDao")
+		oversightDAOAcc = sdk.AccAddress("oversightDao")
+		recipient       = sdk.AccAddress("recipient")
+		maxInactivity   = 24 * time.Hour
+	)
+	k, m, ctx := testutil.SetupCoredaosKeeper(t)
+	ms := keeper.NewMsgServer(k)
+	params := types.DefaultParams()
+	params.SteeringDaoAddress = steeringDAOAcc.String()
+	params.OversightDaoAddress = oversightDAOAcc.String()
+	params.MaxInactivity = &maxInactivity
+	require.NoError(t, k.Params.Set(ctx, params))
+	start := ctx.BlockTime()
+
+	// the tracking of the Core DAOs starts at the first block
+	require.NoError(t, k.SuspendInactiveDaos(ctx))
+
+	liveness, found, err := k.GetDaoLiveness(ctx, types.CoreDaoSteering)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.True(t, start.Equal(liveness.ActiveSince))
+	require.Nil(t, liveness.LastActionTime)
+
+	// the Steering DAO acts before the end of the max inactivity
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(start.Add(12 * time.Hour))
+	require.NoError(t, k.DaoBudgetAccounts.Set(ctx, int32(types.CoreDaoSteering), types.DaoBudgetAccount{
+		Dao: types.CoreDaoSteering, EpochIdentifier: "week", EpochNumber: 1, Funded: sdk.NewCoins(sdk.NewInt64Coin("uatone", 10)),
+	}))
+	m.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient, gomock.Any())
+	_, err = ms.DaoSpend(ctx, types.NewMsgDaoSpend(steeringDAOAcc, recipient, sdk.NewCoins(sdk.NewInt64Coin("uatone", 1)), ""))
+	require.NoError(t, err)
+
+	// only the Oversight DAO is suspended after the max inactivity
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(start.Add(maxInactivity))
+	require.NoError(t, k.SuspendInactiveDaos(ctx))
+
+	liveness, _, err = k.GetDaoLiveness(ctx, types.CoreDaoSteering)
+	require.NoError(t, err)
+	require.False(t, liveness.Suspended)
+	require.Equal(t, int64(10), liveness.LastActionHeight)
+	require.True(t, start.Add(12*time.Hour).Equal(*liveness.LastActionTime))
+	liveness, _, err = k.GetDaoLiveness(ctx, types.CoreDaoOversight)
+	require.NoError(t, err)
+	require.True(t, liveness.Suspended)
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeDaoStatus, events[len(events)-1].Type)
+
+	// the suspended Core DAO cannot act
+	_, err = ms.DaoSpend(ctx, types.NewMsgDaoSpend(oversightDAOAcc, recipient, sdk.NewCoins(sdk.NewInt64Coin("uatone", 1)), ""))
+	require.EqualError(t, err, "Oversight DAO must be reconfirmed by governance: core DAO is suspended for inactivity")
+
+	// the Steering DAO is suspended after the max inactivity from its last
+	// action
+	ctx = ctx.WithBlockTime(start.Add(12*time.Hour + maxInactivity))
+	require.NoError(t, k.SuspendInactiveDaos(ctx))
+
+	liveness, _, err = k.GetDaoLiveness(ctx, types.CoreDaoSteering)
+	require.NoError(t, err)
+	require.True(t, liveness.Suspended)
+}
+
+func TestSuspendInactiveDaosDisabled(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	params := types.DefaultParams()
+	params.SteeringDaoAddress = sdk.AccAddress("steeringDao").String()
+	params.MaxInactivity = nil
+	require.NoError(t, k.Params.Set(ctx, params))
+	require.NoError(t, k.SuspendInactiveDaos(ctx))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(365 * 24 * time.Hour))
+	require.NoError(t, k.SuspendInactiveDaos(ctx))
+
+	liveness, found, err := k.GetDaoLiveness(ctx, types.CoreDaoSteering)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.False(t, liveness.Suspended)
+	// the activity of a disabled Core DAO is not tracked
+	_, found, err = k.GetDaoLiveness(ctx, types.CoreDaoOversight)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestMsgServerReconfirmDao(t *testing.T) {
+	var (
+		authority      = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+		steeringDAOAcc = sdk.AccAddress("steeringDao").String()
+	)
+	tests := []struct {
+		name        string
+		authority   string
+		dao         types.CoreDao
+		expectedErr string
+	}{
+		{
+			name:        "invalid authority",
+			authority:   steeringDAOAcc,
+			dao:         types.CoreDaoSteering,
+			expectedErr: "invalid authority; expected " + authority + ", got " + steeringDAOAcc + ": expected core DAO account as only signer for this message",
+		},
+		{
+			name:        "disabled core DAO",
+			authority:   authority,
+			dao:         types.CoreDaoOversight,
+			expectedErr: "Oversight DAO address is not set: function is disabled",
+		},
+		{
+			name:      "ok",
+			authority: authority,
+			dao:       types.CoreDaoSteering,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms, k, _, ctx := testutil.SetupMsgServer(t)
+			params := types.DefaultParams()
+			params.SteeringDaoAddress = steeringDAOAcc
+			require.NoError(t, k.Params.Set(ctx, params))
+			lastActionTime := ctx.BlockTime().Add(-200 * 24 * time.Hour)
+			require.NoError(t, k.DaoLiveness.Set(ctx, int32(types.CoreDaoSteering), types.DaoLiveness{
+				Dao:              types.CoreDaoSteering,
+				ActiveSince:      lastActionTime,
+				LastActionHeight: 5,
+				LastActionTime:   &lastActionTime,
+				Suspended:        true,
+			}))
+			msg := types.NewMsgReconfirmDao(tt.authority, tt.dao)
+			require.NoError(t, msg.ValidateBasic())
+
+			_, err := ms.ReconfirmDao(ctx, msg)
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			liveness, _, err := k.GetDaoLiveness(ctx, types.CoreDaoSteering)
+			require.NoError(t, err)
+			require.False(t, liveness.Suspended)
+			require.True(t, ctx.BlockTime().Equal(liveness.ActiveSince))
+			require.Equal(t, int64(5), liveness.LastActionHeight)
+			// the reconfirmed Core DAO is not suspended again right away
+			require.NoError(t, k.SuspendInactiveDaos(ctx))
+			liveness, _, err = k.GetDaoLiveness(ctx, types.CoreDaoSteering)
+			require.NoError(t, err)
+			require.False(t, liveness.Suspended)
+		})
+	}
+}
+
+func TestMsgServerUpdateParamsResetsDaoLiveness(t *testing.T) {
+	ms, k, m, ctx := testutil.SetupMsgServer(t)
+	steeringDAOAcc := sdk.AccAddress("steeringDao").String()
+	oversightDAOAcc := sdk.AccAddress("oversightDao").String()
+	params := types.DefaultParams()
+	params.SteeringDaoAddress = steeringDAOAcc
+	params.OversightDaoAddress = oversightDAOAcc
+	require.NoError(t, k.Params.Set(ctx, params))
+	for _, dao := range []types.CoreDao{types.CoreDaoSteering, types.CoreDaoOversight} {
+		require.NoError(t, k.DaoLiveness.Set(ctx, int32(dao), types.DaoLiveness{Dao: dao, ActiveSince: ctx.BlockTime(), Suspended: true}))
+	}
+	m.StakingKeeper.EXPECT().GetDelegatorBonded(gomock.Any(), gomock.Any()).Return(math.ZeroInt(), nil).AnyTimes()
+	m.StakingKeeper.EXPECT().GetDelegatorUnbonding(gomock.Any(), gomock.Any()).Return(math.ZeroInt(), nil).AnyTimes()
+	newParams := params
+	newParams.OversightDaoAddress = sdk.AccAddress("newOversightDao").String()
+
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: newParams})
+
+	require.NoError(t, err)
+	// the liveness of the Core DAO whose address didn't change is kept
+	liveness, found, err := k.GetDaoLiveness(ctx, types.CoreDaoSteering)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.True(t, liveness.Suspended)
+	_, found, err = k.GetDaoLiveness(ctx, types.CoreDaoOversight)
+	require.NoError(t, err)
+	require.False(t, found)
+}
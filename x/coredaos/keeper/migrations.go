@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It sets the veto delay to its
+// default value, so that vetoes can be withdrawn before their execution.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	vetoDelay := types.DefaultVetoDelay
+	params.VetoDelay = &vetoDelay
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate2to3 migrates from version 2 to 3. It sets the max inactivity to its
+// default value, so that the Core DAOs which stop acting are suspended. The
+// inactivity of the Core DAOs is counted from the first block after the
+// migration.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	maxInactivity := types.DefaultMaxInactivity
+	params.MaxInactivity = &maxInactivity
+	return m.keeper.Params.Set(ctx, params)
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/atomone-hub/atomone/x/coredaos/keeper"
+	"github.com/atomone-hub/atomone/x/coredaos/testutil"
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	extDuration := time.Hour
+	require.NoError(t, k.Params.Set(ctx, types.Params{VotingPeriodExtensionDuration: &extDuration}))
+
+	err := keeper.NewMigrator(*k).Migrate1to2(ctx)
+
+	require.NoError(t, err)
+	vetoDelay := types.DefaultVetoDelay
+	require.Equal(t, types.Params{
+		VotingPeriodExtensionDuration: &extDuration,
+		VetoDelay:                     &vetoDelay,
+	}, k.GetParams(ctx))
+}
+
+func TestMigrate2to3(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	extDuration := time.Hour
+	require.NoError(t, k.Params.Set(ctx, types.Params{VotingPeriodExtensionDuration: &extDuration}))
+
+	err := keeper.NewMigrator(*k).Migrate2to3(ctx)
+
+	require.NoError(t, err)
+	maxInactivity := types.DefaultMaxInactivity
+	require.Equal(t, types.Params{
+		VotingPeriodExtensionDuration: &extDuration,
+		MaxInactivity:                 &maxInactivity,
+	}, k.GetParams(ctx))
+}
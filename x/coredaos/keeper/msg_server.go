@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
@@ -74,6 +75,11 @@ func (ms MsgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 		// normalize address
 		params.OversightDaoAddress = oversightDaoAddr.String()
 	}
+	// a new Core DAO address starts active, regardless of the inactivity of
+	// the previous address
+	if err := ms.k.resetDaoLiveness(ctx, ms.k.GetParams(ctx), params); err != nil {
+		return nil, errors.Wrapf(err, "error resetting core DAO liveness")
+	}
 	if err := ms.k.Params.Set(ctx, params); err != nil {
 		return nil, errors.Wrapf(err, "error setting params")
 	}
@@ -107,6 +113,20 @@ func (ms MsgServer) AnnotateProposal(goCtx context.Context, msg *types.MsgAnnota
 		return nil, types.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", params.SteeringDaoAddress, msg.Annotator)
 	}
 
+	if err := ms.k.checkDaoActive(ctx, types.CoreDaoSteering); err != nil {
+		return nil, err
+	}
+	if err := ms.k.checkDirectSigning(ctx, types.CoreDaoSteering); err != nil {
+		return nil, err
+	}
+	return ms.annotateProposal(ctx, msg)
+}
+
+// annotateProposal annotates the proposal on behalf of the Steering DAO, once the
+// annotator has been authorized.
+func (ms MsgServer) annotateProposal(ctx sdk.Context, msg *types.MsgAnnotateProposal) (*types.MsgAnnotateProposalResponse, error) {
+	logger := ms.k.Logger(ctx)
+
 	proposal, err := ms.k.govKeeper.Proposals.Get(ctx, msg.ProposalId)
 	if err != nil {
 		logger.Error(
@@ -139,10 +159,14 @@ func (ms MsgServer) AnnotateProposal(goCtx context.Context, msg *types.MsgAnnota
 		return nil, types.ErrAnnotationAlreadyPresent.Wrapf("proposal with ID %d already has an annotation", msg.ProposalId)
 	}
 
+	previousAnnotation := proposal.Annotation
 	proposal.Annotation = msg.Annotation
 	if err := ms.k.govKeeper.SetProposal(ctx, proposal); err != nil {
 		return nil, errors.Wrapf(err, "error setting proposal")
 	}
+	if err := ms.k.logAction(ctx, proposal.Id, msg.Annotator, types.DaoActionTypeAnnotate, previousAnnotation); err != nil {
+		return nil, errors.Wrapf(err, "error logging action")
+	}
 
 	logger.Info(
 		"proposal annotated",
@@ -165,6 +189,8 @@ func (ms MsgServer) AnnotateProposal(goCtx context.Context, msg *types.MsgAnnota
 // EndorseProposal allows the Steering DAO to endorse a proposal.
 // It requires the proposal to be in the voting period, and the endorsing account must be the Steering DAO.
 // A proposal can only be endorsed once.
+// If the module parameters allow it, the endorsement exempts a proposal which is neither a law nor
+// a constitution amendment from the late quorum extension of its voting period.
 func (ms MsgServer) EndorseProposal(goCtx context.Context, msg *types.MsgEndorseProposal) (*types.MsgEndorseProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	params := ms.k.GetParams(ctx)
@@ -187,6 +213,20 @@ func (ms MsgServer) EndorseProposal(goCtx context.Context, msg *types.MsgEndorse
 		return nil, types.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", params.SteeringDaoAddress, msg.Endorser)
 	}
 
+	if err := ms.k.checkDaoActive(ctx, types.CoreDaoSteering); err != nil {
+		return nil, err
+	}
+	if err := ms.k.checkDirectSigning(ctx, types.CoreDaoSteering); err != nil {
+		return nil, err
+	}
+	return ms.endorseProposal(ctx, msg)
+}
+
+// endorseProposal endorses the proposal on behalf of the Steering DAO, once the
+// endorser has been authorized.
+func (ms MsgServer) endorseProposal(ctx sdk.Context, msg *types.MsgEndorseProposal) (*types.MsgEndorseProposalResponse, error) {
+	logger := ms.k.Logger(ctx)
+
 	proposal, err := ms.k.govKeeper.Proposals.Get(ctx, msg.ProposalId)
 	if err != nil {
 		logger.Error(
@@ -216,16 +256,27 @@ func (ms MsgServer) EndorseProposal(goCtx context.Context, msg *types.MsgEndorse
 
 		return nil, types.ErrProposalAlreadyEndorsed.Wrapf("proposal with ID %d has already been endorsed", msg.ProposalId)
 	}
+	if err := ms.k.checkRecusal(ctx, proposal, types.CoreDaoSteering); err != nil {
+		return nil, err
+	}
 
 	proposal.Endorsed = true
 	if err := ms.k.govKeeper.SetProposal(ctx, proposal); err != nil {
 		return nil, errors.Wrapf(err, "error setting proposal")
 	}
+	exempt, err := ms.k.exemptFromQuorumExtension(ctx, proposal)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error exempting proposal from quorum extension")
+	}
+	if err := ms.k.logAction(ctx, proposal.Id, msg.Endorser, types.DaoActionTypeEndorse, ""); err != nil {
+		return nil, errors.Wrapf(err, "error logging action")
+	}
 
 	logger.Info(
 		"proposal endorsed",
 		"proposal", proposal.Id,
 		"authority", msg.Endorser,
+		"quorum_extension_exempt", exempt,
 	)
 
 	// Emit event for proposal endorsement
@@ -234,6 +285,7 @@ func (ms MsgServer) EndorseProposal(goCtx context.Context, msg *types.MsgEndorse
 			types.EventTypeEndorseProposal,
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
 			sdk.NewAttribute(types.AttributeKeySigner, msg.Endorser),
+			sdk.NewAttribute(types.AttributeKeyQuorumExtensionExempt, strconv.FormatBool(exempt)),
 		),
 	})
 
@@ -245,6 +297,9 @@ func (ms MsgServer) EndorseProposal(goCtx context.Context, msg *types.MsgEndorse
 // Steering DAO or Oversight DAO.
 // The voting period cannot be extended further than the maximum defined in the module parameters.
 // The extension duration is defined in the module parameters.
+// If the module parameters define an extension policy for the kind of the proposal, the extension
+// duration is the one of the policy, and each Core DAO cannot extend the voting period more than
+// its limit in the policy.
 func (ms MsgServer) ExtendVotingPeriod(goCtx context.Context, msg *types.MsgExtendVotingPeriod) (*types.MsgExtendVotingPeriodResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	params := ms.k.GetParams(ctx)
@@ -278,6 +333,25 @@ func (ms MsgServer) ExtendVotingPeriod(goCtx context.Context, msg *types.MsgExte
 		return nil, types.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", addressesString, msg.Extender)
 	}
 
+	dao := types.CoreDaoOversight
+	if isSteeringDao {
+		dao = types.CoreDaoSteering
+	}
+	if err := ms.k.checkDaoActive(ctx, dao); err != nil {
+		return nil, err
+	}
+	if err := ms.k.checkDirectSigning(ctx, dao); err != nil {
+		return nil, err
+	}
+	return ms.extendVotingPeriod(ctx, msg)
+}
+
+// extendVotingPeriod extends the voting period of the proposal on behalf of a Core
+// DAO, once the extender has been authorized.
+func (ms MsgServer) extendVotingPeriod(ctx sdk.Context, msg *types.MsgExtendVotingPeriod) (*types.MsgExtendVotingPeriodResponse, error) {
+	params := ms.k.GetParams(ctx)
+	logger := ms.k.Logger(ctx)
+
 	proposal, err := ms.k.govKeeper.Proposals.Get(ctx, msg.ProposalId)
 	if err != nil {
 		logger.Error(
@@ -298,7 +372,13 @@ func (ms MsgServer) ExtendVotingPeriod(goCtx context.Context, msg *types.MsgExte
 
 		return nil, sdkgovtypes.ErrInactiveProposal.Wrapf("proposal with ID %d is not in voting period", msg.ProposalId)
 	}
-	if proposal.TimesVotingPeriodExtended >= params.VotingPeriodExtensionsLimit {
+	kind, err := ms.k.proposalKind(proposal)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting proposal kind")
+	}
+	dao := params.CoreDaoOf(msg.Extender)
+	totalLimit, daoLimit := params.ExtensionsLimits(kind, dao)
+	if proposal.TimesVotingPeriodExtended >= totalLimit {
 		logger.Error(
 			"proposal has reached the maximum number of voting period extensions",
 			"proposal", proposal.Id,
@@ -308,8 +388,45 @@ func (ms MsgServer) ExtendVotingPeriod(goCtx context.Context, msg *types.MsgExte
 
 		return nil, sdkgovtypes.ErrInvalidProposalContent.Wrapf("proposal with ID %d has reached the maximum number of voting period extensions", msg.ProposalId)
 	}
+	timesExtended, err := ms.k.countExtensions(ctx, proposal.Id, dao)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error counting voting period extensions")
+	}
+	if timesExtended >= daoLimit {
+		logger.Error(
+			"core DAO has reached the maximum number of voting period extensions",
+			"proposal", proposal.Id,
+			"proposal_kind", kind,
+			"times_extended", timesExtended,
+			"authority", msg.Extender,
+		)
 
-	newEndTime := proposal.VotingEndTime.Add(*params.VotingPeriodExtensionDuration)
+		return nil, sdkgovtypes.ErrInvalidProposalContent.Wrapf(
+			"proposal with ID %d has reached the maximum number of voting period extensions by the %s",
+			msg.ProposalId, dao.DisplayName(),
+		)
+	}
+	extensionDuration := *params.VotingPeriodExtensionDuration
+	if policy, ok := params.ExtensionPolicyOf(kind); ok {
+		extensionDuration = policy.ExtensionDuration
+	}
+	// The proposal is out of the active proposals queue while its veto is
+	// pending, so its voting period cannot be extended.
+	hasPendingVeto, err := ms.k.PendingVetoes.Has(ctx, proposal.Id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting pending veto")
+	}
+	if hasPendingVeto {
+		return nil, types.ErrVetoPending.Wrapf("proposal with ID %d has a pending veto", msg.ProposalId)
+	}
+	if err := ms.k.checkRecusal(ctx, proposal, dao); err != nil {
+		return nil, err
+	}
+	if err := ms.k.commitRevealKeeper.CheckVotingPeriodExtension(ctx, proposal.Id); err != nil {
+		return nil, err
+	}
+
+	newEndTime := proposal.VotingEndTime.Add(extensionDuration)
 
 	// Update ActiveProposalsQueue with new VotingEndTime
 	if err := ms.k.govKeeper.ActiveProposalsQueue.Remove(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id)); err != nil {
@@ -324,6 +441,9 @@ func (ms MsgServer) ExtendVotingPeriod(goCtx context.Context, msg *types.MsgExte
 	if err := ms.k.govKeeper.SetProposal(ctx, proposal); err != nil {
 		return nil, errors.Wrapf(err, "error setting proposal")
 	}
+	if err := ms.k.logExtension(ctx, proposal.Id, msg.Extender, totalLimit, daoLimit); err != nil {
+		return nil, errors.Wrapf(err, "error logging action")
+	}
 
 	logger.Info(
 		"voting period extended",
@@ -349,7 +469,9 @@ func (ms MsgServer) ExtendVotingPeriod(goCtx context.Context, msg *types.MsgExte
 
 // VetoProposal allows the signer to veto a proposal.
 // The proposal must be in the voting period, and the signer must be the designated Oversight DAO.
-// If the proposal is vetoed, it will be removed from the active proposals queue and rejected.
+// The veto is pending for the veto delay defined in the module parameters, during which the proposal
+// is removed from the active proposals queue and the veto can be withdrawn. Once the delay has
+// elapsed, the veto is executed in the EndBlocker and the proposal is rejected.
 func (ms MsgServer) VetoProposal(goCtx context.Context, msg *types.MsgVetoProposal) (*types.MsgVetoProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	params := ms.k.GetParams(ctx)
@@ -372,6 +494,20 @@ func (ms MsgServer) VetoProposal(goCtx context.Context, msg *types.MsgVetoPropos
 		return nil, types.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", params.OversightDaoAddress, msg.Vetoer)
 	}
 
+	if err := ms.k.checkDaoActive(ctx, types.CoreDaoOversight); err != nil {
+		return nil, err
+	}
+	if err := ms.k.checkDirectSigning(ctx, types.CoreDaoOversight); err != nil {
+		return nil, err
+	}
+	return ms.vetoProposal(ctx, msg)
+}
+
+// vetoProposal submits the veto of the proposal on behalf of the Oversight DAO,
+// once the vetoer has been authorized.
+func (ms MsgServer) vetoProposal(ctx sdk.Context, msg *types.MsgVetoProposal) (*types.MsgVetoProposalResponse, error) {
+	logger := ms.k.Logger(ctx)
+
 	proposal, err := ms.k.govKeeper.Proposals.Get(ctx, msg.ProposalId)
 	if err != nil {
 		logger.Error(
@@ -392,82 +528,700 @@ func (ms MsgServer) VetoProposal(goCtx context.Context, msg *types.MsgVetoPropos
 
 		return nil, sdkgovtypes.ErrInactiveProposal.Wrapf("proposal with ID %d is not in voting period", msg.ProposalId)
 	}
+	hasPendingVeto, err := ms.k.PendingVetoes.Has(ctx, proposal.Id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting pending veto")
+	}
+	if hasPendingVeto {
+		return nil, types.ErrVetoPending.Wrapf("proposal with ID %d already has a pending veto", msg.ProposalId)
+	}
 
-	// Check if the proposal contains a change of the oversight DAO address.
-	// If so, vetoing the proposal would create a scenario where the current oversight DAO can prevent its own replacement.
-	// Self-executing authz.MsgExec wrappers are rejected at submission, so only top-level messages need inspection.
-	for _, anyMsg := range proposal.Messages {
-		var flatMsg sdk.Msg
-		if err := ms.k.cdc.UnpackAny(anyMsg, &flatMsg); err != nil {
-			continue
-		}
-		updateParamsMsg, ok := flatMsg.(*types.MsgUpdateParams)
-		if !ok {
-			continue
-		}
-		proposedOversightAddr, err := sdk.AccAddressFromBech32(updateParamsMsg.Params.OversightDaoAddress)
-		// treat parse error as a change of address, not using MustAccAddressFromBech32 because address could be empty
-		if err != nil || !proposedOversightAddr.Equals(sdk.MustAccAddressFromBech32(params.OversightDaoAddress)) {
-			logger.Error(
-				"proposal contains a change of the oversight DAO address, vetoing it would prevent the replacement of the current oversight DAO",
-				"proposal", proposal.Id,
-				"current_oversight_dao_address", params.OversightDaoAddress,
-				"new_oversight_dao_address", updateParamsMsg.Params.OversightDaoAddress,
-			)
-			return nil, types.ErrInvalidVeto.Wrapf("proposal with ID %d contains a change of the oversight DAO address, vetoing it would prevent the replacement of the current oversight DAO", proposal.Id)
-		}
+	// The Oversight DAO cannot veto a proposal it is recused from, in particular
+	// a proposal that changes its address or members, otherwise the current
+	// oversight DAO could prevent its own replacement.
+	if err := ms.k.checkRecusal(ctx, proposal, types.CoreDaoOversight); err != nil {
+		logger.Error(
+			"oversight DAO is recused from proposal",
+			"proposal", proposal.Id,
+			"error", err,
+		)
+		return nil, err
 	}
 
-	// follows the same logic as in x/gov/abci.go for rejected proposals
-	if msg.BurnDeposit {
-		if err := ms.k.govKeeper.DeleteAndBurnDeposits(ctx, proposal.Id); err != nil {
-			return nil, errors.Wrapf(err, "error deleting and burning deposits")
-		}
-	} else {
-		if err := ms.k.govKeeper.RefundAndDeleteDeposits(ctx, proposal.Id); err != nil {
-			return nil, errors.Wrapf(err, "error refunding and deleting deposits")
-		}
+	vetoDelay := ms.k.GetVetoDelay(ctx)
+	veto := types.PendingVeto{
+		ProposalId:    proposal.Id,
+		Vetoer:        msg.Vetoer,
+		BurnDeposit:   msg.BurnDeposit,
+		SubmitTime:    ctx.BlockTime(),
+		ExecutionTime: ctx.BlockTime().Add(vetoDelay),
+	}
+	if err := ms.k.PendingVetoes.Set(ctx, proposal.Id, veto); err != nil {
+		return nil, errors.Wrapf(err, "error setting pending veto")
+	}
+	// Remove the proposal from the active proposals queue so that it cannot
+	// be executed while the veto is pending.
+	if err := ms.k.SuspendProposal(ctx, proposal.Id); err != nil {
+		return nil, errors.Wrapf(err, "error removing proposal from active proposal queue")
+	}
+	if err := ms.k.logAction(ctx, proposal.Id, msg.Vetoer, types.DaoActionTypeVeto, ""); err != nil {
+		return nil, errors.Wrapf(err, "error logging action")
 	}
-	proposal.Status = govv1.StatusVetoed
 
-	// Since the proposal is vetoed, we set the final tally result to an empty tally
-	// and the voting period ends immediately
-	emptyTally := govv1.EmptyTallyResult()
-	proposal.FinalTallyResult = &emptyTally
-	origEndTime := proposal.VotingEndTime
-	blockTime := ctx.BlockTime()
-	proposal.VotingEndTime = &blockTime
+	logger.Info(
+		"proposal veto submitted",
+		"proposal", proposal.Id,
+		"authority", msg.Vetoer,
+		"execution_time", veto.ExecutionTime,
+	)
 
-	if err := ms.k.govKeeper.SetProposal(ctx, proposal); err != nil {
-		return nil, errors.Wrapf(err, "error setting proposal")
+	// Emit event for pending veto
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePendingVeto,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Vetoer),
+			sdk.NewAttribute(types.AttributeKeyExecutionTime, veto.ExecutionTime.String()),
+		),
+	})
+
+	return &types.MsgVetoProposalResponse{ExecutionTime: veto.ExecutionTime}, nil
+}
+
+// WithdrawVeto allows the signer to withdraw a pending veto.
+// The signer must be the designated Oversight DAO, and the veto must not have
+// been executed yet. The proposal resumes its voting period.
+func (ms MsgServer) WithdrawVeto(goCtx context.Context, msg *types.MsgWithdrawVeto) (*types.MsgWithdrawVetoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := ms.k.GetParams(ctx)
+
+	logger := ms.k.Logger(ctx)
+
+	if params.OversightDaoAddress == "" {
+		logger.Info("Oversight DAO address is not set, function is disabled")
+
+		return nil, types.ErrFunctionDisabled.Wrapf("Oversight DAO address is not set")
+	}
+
+	if !sdk.MustAccAddressFromBech32(msg.Vetoer).Equals(sdk.MustAccAddressFromBech32(params.OversightDaoAddress)) {
+		logger.Error(
+			"invalid authority for withdrawing veto",
+			"expected", params.OversightDaoAddress,
+			"got", msg.Vetoer,
+		)
+
+		return nil, types.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", params.OversightDaoAddress, msg.Vetoer)
 	}
-	// Delete all votes for the proposal. Votes are stored as
-	// collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.Vote].
-	if err := ms.k.govKeeper.Votes.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)); err != nil {
-		return nil, errors.Wrapf(err, "error deleting votes")
+
+	if err := ms.k.checkDaoActive(ctx, types.CoreDaoOversight); err != nil {
+		return nil, err
 	}
-	if err := ms.k.govKeeper.ActiveProposalsQueue.Remove(ctx, collections.Join(*origEndTime, proposal.Id)); err != nil {
-		return nil, errors.Wrapf(err, "error removing proposal from active proposal queue")
+	if err := ms.k.checkDirectSigning(ctx, types.CoreDaoOversight); err != nil {
+		return nil, err
+	}
+	return ms.withdrawVeto(ctx, msg)
+}
+
+// withdrawVeto withdraws the pending veto of the proposal on behalf of the
+// Oversight DAO, once the vetoer has been authorized.
+func (ms MsgServer) withdrawVeto(ctx sdk.Context, msg *types.MsgWithdrawVeto) (*types.MsgWithdrawVetoResponse, error) {
+	logger := ms.k.Logger(ctx)
+
+	has, err := ms.k.PendingVetoes.Has(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting pending veto")
 	}
+	if !has {
+		return nil, types.ErrNoPendingVeto.Wrapf("proposal with ID %d has no pending veto", msg.ProposalId)
+	}
+	proposal, err := ms.k.govKeeper.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		logger.Error(
+			"proposal not found",
+			"proposal_id", msg.ProposalId,
+			"authority", msg.Vetoer,
+		)
 
-	ms.k.govKeeper.UpdateMinInitialDeposit(ctx, true)
-	ms.k.govKeeper.UpdateMinDeposit(ctx, true)
+		return nil, types.ErrUnknownProposal.Wrapf("proposal with ID %d not found", msg.ProposalId)
+	}
+
+	if err := ms.k.PendingVetoes.Remove(ctx, proposal.Id); err != nil {
+		return nil, errors.Wrapf(err, "error removing pending veto")
+	}
+	if err := ms.k.resumeProposal(ctx, proposal); err != nil {
+		return nil, errors.Wrapf(err, "error inserting proposal into active proposal queue")
+	}
+	if err := ms.k.logAction(ctx, proposal.Id, msg.Vetoer, types.DaoActionTypeWithdrawVeto, ""); err != nil {
+		return nil, errors.Wrapf(err, "error logging action")
+	}
 
 	logger.Info(
-		"proposal vetoed",
+		"proposal veto withdrawn",
 		"proposal", proposal.Id,
 		"authority", msg.Vetoer,
 	)
 
-	// Emit event for proposal veto
+	// Emit event for veto withdrawal
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeVetoProposal,
+			types.EventTypeWithdrawVeto,
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
 			sdk.NewAttribute(types.AttributeKeySigner, msg.Vetoer),
-			sdk.NewAttribute(sdkgovtypes.AttributeKeyProposalResult, types.AttributeValueProposalVetoed),
 		),
 	})
 
-	return &types.MsgVetoProposalResponse{}, nil
+	return &types.MsgWithdrawVetoResponse{}, nil
+}
+
+// SubmitSteeringProposal allows the Steering DAO to submit a governance proposal without deposit.
+// The proposal enters the voting period immediately, bypassing the MinInitialDeposit and MinDeposit
+// of x/gov, and its metadata is tagged as submitted by the Steering DAO. The number of proposals
+// submitted this way is limited per epoch by the Steering DAO proposal quota.
+func (ms MsgServer) SubmitSteeringProposal(goCtx context.Context, msg *types.MsgSubmitSteeringProposal) (*types.MsgSubmitSteeringProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := ms.k.GetParams(ctx)
+
+	logger := ms.k.Logger(ctx)
+
+	if params.SteeringDaoAddress == "" {
+		logger.Info("Steering DAO address is not set, function is disabled")
+
+		return nil, types.ErrFunctionDisabled.Wrapf("Steering DAO address is not set")
+	}
+
+	if !sdk.MustAccAddressFromBech32(msg.Proposer).Equals(sdk.MustAccAddressFromBech32(params.SteeringDaoAddress)) {
+		logger.Error(
+			"invalid authority for submitting proposal",
+			"expected", params.SteeringDaoAddress,
+			"got", msg.Proposer,
+		)
+
+		return nil, types.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", params.SteeringDaoAddress, msg.Proposer)
+	}
+
+	if params.SteeringProposalQuota == 0 {
+		logger.Info("Steering DAO proposal quota is not set, function is disabled")
+
+		return nil, types.ErrFunctionDisabled.Wrapf("Steering DAO proposal quota is not set")
+	}
+
+	if err := ms.k.checkDaoActive(ctx, types.CoreDaoSteering); err != nil {
+		return nil, err
+	}
+	if err := ms.k.checkDirectSigning(ctx, types.CoreDaoSteering); err != nil {
+		return nil, err
+	}
+
+	count, err := ms.k.trackSteeringProposal(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+	proposer := sdk.MustAccAddressFromBech32(msg.Proposer)
+	proposal, err := ms.k.govKeeper.SubmitProposal(
+		withSteeringProposal(ctx), msgs, types.SteeringProposalMetadata(msg.Metadata), msg.Title, msg.Summary, proposer,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error submitting proposal")
+	}
+	if err := ms.k.govKeeper.ActivateVotingPeriod(ctx, proposal); err != nil {
+		return nil, errors.Wrapf(err, "error activating voting period")
+	}
+	if err := ms.k.logAction(ctx, proposal.Id, msg.Proposer, types.DaoActionTypeSubmitProposal, ""); err != nil {
+		return nil, errors.Wrapf(err, "error logging action")
+	}
+
+	logger.Info(
+		"proposal submitted by Steering DAO",
+		"proposal", proposal.Id,
+		"authority", msg.Proposer,
+		"epoch", count.EpochNumber,
+		"count", count.Count,
+	)
+
+	// Emit event for proposal submission by the Steering DAO
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSteeringProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Proposer),
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", count.EpochNumber)),
+			sdk.NewAttribute(types.AttributeKeyProposalCount, fmt.Sprintf("%d", count.Count)),
+		),
+	})
+
+	return &types.MsgSubmitSteeringProposalResponse{ProposalId: proposal.Id}, nil
+}
+
+// RecuseFromProposal allows the signer to recuse itself from a proposal, for example because of a
+// conflict of interest. The signer must be the designated Steering DAO or Oversight DAO, and the
+// proposal must be in the deposit or voting period. Once recused, the Core DAO cannot endorse, extend
+// the voting period of, or veto the proposal.
+func (ms MsgServer) RecuseFromProposal(goCtx context.Context, msg *types.MsgRecuseFromProposal) (*types.MsgRecuseFromProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := ms.k.GetParams(ctx)
+
+	logger := ms.k.Logger(ctx)
+
+	if params.SteeringDaoAddress == "" && params.OversightDaoAddress == "" {
+		logger.Info("Steering DAO address and Oversight DAO address are not set, function is disabled")
+
+		return nil, types.ErrFunctionDisabled.Wrapf("Steering DAO address and Oversight DAO address are not set")
+	}
+
+	dao := params.CoreDaoOf(msg.Recuser)
+	if dao == types.CoreDaoUnspecified {
+		// one of the two addresses must be set otherwise it would have been caught earlier
+		addressesString := fmt.Sprintf("%s or %s", params.SteeringDaoAddress, params.OversightDaoAddress)
+		if params.SteeringDaoAddress == "" {
+			addressesString = params.OversightDaoAddress
+		} else if params.OversightDaoAddress == "" {
+			addressesString = params.SteeringDaoAddress
+		}
+
+		logger.Error(
+			"invalid authority for recusing from proposal",
+			"expected", addressesString,
+			"got", msg.Recuser,
+		)
+
+		return nil, types.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", addressesString, msg.Recuser)
+	}
+
+	if err := ms.k.checkDaoActive(ctx, dao); err != nil {
+		return nil, err
+	}
+	if err := ms.k.checkDirectSigning(ctx, dao); err != nil {
+		return nil, err
+	}
+	return ms.recuseFromProposal(ctx, msg)
+}
+
+// recuseFromProposal records the recusal of a Core DAO from the proposal, once the
+// recuser has been authorized.
+func (ms MsgServer) recuseFromProposal(ctx sdk.Context, msg *types.MsgRecuseFromProposal) (*types.MsgRecuseFromProposalResponse, error) {
+	params := ms.k.GetParams(ctx)
+	logger := ms.k.Logger(ctx)
+
+	proposal, err := ms.k.govKeeper.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		logger.Error(
+			"proposal not found",
+			"proposal_id", msg.ProposalId,
+			"authority", msg.Recuser,
+		)
+
+		return nil, types.ErrUnknownProposal.Wrapf("proposal with ID %d not found", msg.ProposalId)
+	}
+	if proposal.Status != govv1.StatusDepositPeriod && proposal.Status != govv1.StatusVotingPeriod {
+		logger.Error(
+			"proposal is not in deposit or voting period",
+			"proposal", proposal.Id,
+			"status", proposal.Status,
+			"authority", msg.Recuser,
+		)
+
+		return nil, sdkgovtypes.ErrInactiveProposal.Wrapf("proposal with ID %d is not in deposit or voting period", msg.ProposalId)
+	}
+	dao := params.CoreDaoOf(msg.Recuser)
+	if err := ms.k.checkRecusal(ctx, proposal, dao); err != nil {
+		return nil, err
+	}
+	if dao == types.CoreDaoOversight {
+		// The veto must be withdrawn first, a recused Oversight DAO cannot veto
+		// the proposal.
+		hasPendingVeto, err := ms.k.PendingVetoes.Has(ctx, proposal.Id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting pending veto")
+		}
+		if hasPendingVeto {
+			return nil, types.ErrVetoPending.Wrapf("proposal with ID %d has a pending veto", msg.ProposalId)
+		}
+	}
+
+	err = ms.k.recordRecusal(ctx, types.Recusal{
+		ProposalId: proposal.Id,
+		Dao:        dao,
+		Reason:     msg.Reason,
+		Time:       ctx.BlockTime(),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error setting recusal")
+	}
+	if err := ms.k.logAction(ctx, proposal.Id, msg.Recuser, types.DaoActionTypeRecuse, ""); err != nil {
+		return nil, errors.Wrapf(err, "error logging action")
+	}
+
+	return &types.MsgRecuseFromProposalResponse{}, nil
+}
+
+// DaoSpend allows the signer to spend from its budget. The signer must be the designated Steering
+// DAO or Oversight DAO, and the amount cannot exceed the remaining funds of the current epoch of
+// its budget.
+func (ms MsgServer) DaoSpend(goCtx context.Context, msg *types.MsgDaoSpend) (*types.MsgDaoSpendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := ms.k.GetParams(ctx)
+
+	logger := ms.k.Logger(ctx)
+
+	if params.SteeringDaoAddress == "" && params.OversightDaoAddress == "" {
+		logger.Info("Steering DAO address and Oversight DAO address are not set, function is disabled")
+
+		return nil, types.ErrFunctionDisabled.Wrapf("Steering DAO address and Oversight DAO address are not set")
+	}
+
+	dao := params.CoreDaoOf(msg.Spender)
+	if dao == types.CoreDaoUnspecified {
+		// one of the two addresses must be set otherwise it would have been caught earlier
+		addressesString := fmt.Sprintf("%s or %s", params.SteeringDaoAddress, params.OversightDaoAddress)
+		if params.SteeringDaoAddress == "" {
+			addressesString = params.OversightDaoAddress
+		} else if params.OversightDaoAddress == "" {
+			addressesString = params.SteeringDaoAddress
+		}
+
+		logger.Error(
+			"invalid authority for spending from core DAO budget",
+			"expected", addressesString,
+			"got", msg.Spender,
+		)
+
+		return nil, types.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", addressesString, msg.Spender)
+	}
+
+	if err := ms.k.checkDaoActive(ctx, dao); err != nil {
+		return nil, err
+	}
+	if err := ms.k.checkDirectSigning(ctx, dao); err != nil {
+		return nil, err
+	}
+	return ms.daoSpend(ctx, msg)
+}
+
+// daoSpend sends the funds from the budget of a Core DAO to the recipient, once the spender
+// has been authorized.
+func (ms MsgServer) daoSpend(ctx sdk.Context, msg *types.MsgDaoSpend) (*types.MsgDaoSpendResponse, error) {
+	dao := ms.k.GetParams(ctx).CoreDaoOf(msg.Spender)
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid recipient address")
+	}
+	if err := ms.k.spendDaoBudget(ctx, dao, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+	if err := ms.k.recordDaoActivity(ctx, dao); err != nil {
+		return nil, errors.Wrapf(err, "error recording core DAO activity")
+	}
+
+	ms.k.Logger(ctx).Info(
+		"core DAO budget spent",
+		"dao", dao,
+		"recipient", msg.Recipient,
+		"amount", msg.Amount.String(),
+	)
+
+	// Emit event for core DAO spend
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDaoSpend,
+			sdk.NewAttribute(types.AttributeKeyDao, dao.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo),
+		),
+	})
+
+	return &types.MsgDaoSpendResponse{}, nil
+}
+
+// SubmitDaoAction allows a member of a Core DAO to submit an action on behalf of the Core DAO.
+// The submission counts as the approval of the member. The action is executed once approved by
+// the threshold of members of the Core DAO, and expires if the threshold is not reached before
+// the approval timeout of the Core DAO.
+func (ms MsgServer) SubmitDaoAction(goCtx context.Context, msg *types.MsgSubmitDaoAction) (*types.MsgSubmitDaoActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	daoMembers, err := ms.authorizeDaoMember(ctx, msg.Dao, msg.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := ms.k.DaoActionSequence.Next(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting next core DAO action id")
+	}
+	action := types.PendingDaoAction{
+		Id:             id,
+		Dao:            msg.Dao,
+		Action:         msg.Action,
+		ProposalId:     msg.ProposalId,
+		Annotation:     msg.Annotation,
+		Overwrite:      msg.Overwrite,
+		BurnDeposit:    msg.BurnDeposit,
+		Reason:         msg.Reason,
+		Recipient:      msg.Recipient,
+		Amount:         msg.Amount,
+		Memo:           msg.Memo,
+		Approvals:      []string{msg.Member},
+		SubmitTime:     ctx.BlockTime(),
+		ExpirationTime: ctx.BlockTime().Add(daoMembers.ApprovalTimeout),
+	}
+	executed, err := ms.processDaoAction(ctx, daoMembers, action)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit event for core DAO action submission
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSubmitDaoAction,
+			sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyDao, msg.Dao.String()),
+			sdk.NewAttribute(types.AttributeKeyAction, msg.Action.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", msg.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Member),
+			sdk.NewAttribute(types.AttributeKeyExecuted, fmt.Sprintf("%t", executed)),
+		),
+	})
+
+	return &types.MsgSubmitDaoActionResponse{Id: id, Executed: executed}, nil
+}
+
+// ApproveAction allows a member of a Core DAO to approve a pending action of the Core DAO.
+// The action is executed when the approval reaches the threshold of the Core DAO.
+func (ms MsgServer) ApproveAction(goCtx context.Context, msg *types.MsgApproveAction) (*types.MsgApproveActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	action, err := ms.k.PendingDaoActions.Get(ctx, msg.Id)
+	if err != nil {
+		return nil, types.ErrUnknownDaoAction.Wrapf("core DAO action with ID %d not found", msg.Id)
+	}
+	if !ctx.BlockTime().Before(action.ExpirationTime) {
+		return nil, types.ErrUnknownDaoAction.Wrapf("core DAO action with ID %d has expired", msg.Id)
+	}
+	daoMembers, err := ms.authorizeDaoMember(ctx, action.Dao, msg.Member)
+	if err != nil {
+		return nil, err
+	}
+	member := sdk.MustAccAddressFromBech32(msg.Member)
+	for _, approval := range action.Approvals {
+		if sdk.MustAccAddressFromBech32(approval).Equals(member) {
+			return nil, types.ErrActionAlreadyApproved.Wrapf("core DAO action with ID %d already approved by %s", msg.Id, msg.Member)
+		}
+	}
+
+	action.Approvals = append(action.Approvals, msg.Member)
+	executed, err := ms.processDaoAction(ctx, daoMembers, action)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit event for core DAO action approval
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApproveDaoAction,
+			sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", action.Id)),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Member),
+			sdk.NewAttribute(types.AttributeKeyApprovals, fmt.Sprintf("%d", len(action.Approvals))),
+			sdk.NewAttribute(types.AttributeKeyExecuted, fmt.Sprintf("%t", executed)),
+		),
+	})
+
+	return &types.MsgApproveActionResponse{Executed: executed}, nil
+}
+
+// UpdateDaoMembers defines a method that replaces the members of a Core DAO. The signer of the
+// message must be the module authority. The pending actions of the Core DAO are dropped.
+func (ms MsgServer) UpdateDaoMembers(goCtx context.Context, msg *types.MsgUpdateDaoMembers) (*types.MsgUpdateDaoMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if ms.k.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", ms.k.GetAuthority(), msg.Authority)
+	}
+	if err := ms.k.SetDaoMembers(ctx, msg.DaoMembers); err != nil {
+		return nil, errors.Wrapf(err, "error setting core DAO members")
+	}
+
+	ms.k.Logger(ctx).Info(
+		"core DAO members updated",
+		"dao", msg.DaoMembers.Dao,
+		"members", msg.DaoMembers.Members,
+		"threshold", msg.DaoMembers.Threshold,
+	)
+
+	// Emit event for core DAO members update
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateDaoMembers,
+			sdk.NewAttribute(types.AttributeKeyDao, msg.DaoMembers.Dao.String()),
+		),
+	})
+
+	return &types.MsgUpdateDaoMembersResponse{}, nil
+}
+
+// UpdateDaoBudget defines a method that updates the budget of a Core DAO. The signer of the
+// message must be the module authority. The allowance can only contain the bond denom and
+// photon, and the epoch identifier must be registered in x/epochs.
+func (ms MsgServer) UpdateDaoBudget(goCtx context.Context, msg *types.MsgUpdateDaoBudget) (*types.MsgUpdateDaoBudgetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if ms.k.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", ms.k.GetAuthority(), msg.Authority)
+	}
+	if !msg.DaoBudget.Allowance.Empty() {
+		if err := ms.k.validateDaoBudget(ctx, msg.DaoBudget); err != nil {
+			return nil, err
+		}
+	}
+	if err := ms.k.SetDaoBudget(ctx, msg.DaoBudget); err != nil {
+		return nil, errors.Wrapf(err, "error setting core DAO budget")
+	}
+
+	ms.k.Logger(ctx).Info(
+		"core DAO budget updated",
+		"dao", msg.DaoBudget.Dao,
+		"epoch_identifier", msg.DaoBudget.EpochIdentifier,
+		"allowance", msg.DaoBudget.Allowance.String(),
+	)
+
+	// Emit event for core DAO budget update
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateDaoBudget,
+			sdk.NewAttribute(types.AttributeKeyDao, msg.DaoBudget.Dao.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.DaoBudget.Allowance.String()),
+		),
+	})
+
+	return &types.MsgUpdateDaoBudgetResponse{}, nil
+}
+
+// ReconfirmDao defines a method that reconfirms a Core DAO, lifting its suspension for
+// inactivity. The signer of the message must be the module authority. The inactivity of the
+// Core DAO is counted again from the reconfirmation.
+func (ms MsgServer) ReconfirmDao(goCtx context.Context, msg *types.MsgReconfirmDao) (*types.MsgReconfirmDaoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if ms.k.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", ms.k.GetAuthority(), msg.Authority)
+	}
+	if ms.k.GetParams(ctx).DaoAddress(msg.Dao) == "" {
+		return nil, types.ErrFunctionDisabled.Wrapf("%s address is not set", msg.Dao.DisplayName())
+	}
+	if err := ms.k.reconfirmDao(ctx, msg.Dao); err != nil {
+		return nil, errors.Wrapf(err, "error reconfirming core DAO")
+	}
+
+	ms.k.Logger(ctx).Info(
+		"core DAO reconfirmed",
+		"dao", msg.Dao,
+	)
+
+	return &types.MsgReconfirmDaoResponse{}, nil
+}
+
+// authorizeDaoMember returns the members of dao if dao is enabled and not suspended, has a
+// member set, and memberAddress is one of its members.
+func (ms MsgServer) authorizeDaoMember(ctx sdk.Context, dao types.CoreDao, memberAddress string) (types.DaoMembers, error) {
+	params := ms.k.GetParams(ctx)
+	if params.DaoAddress(dao) == "" {
+		return types.DaoMembers{}, types.ErrFunctionDisabled.Wrapf("%s address is not set", dao.DisplayName())
+	}
+	if err := ms.k.checkDaoActive(ctx, dao); err != nil {
+		return types.DaoMembers{}, err
+	}
+	daoMembers, found, err := ms.k.GetDaoMembers(ctx, dao)
+	if err != nil {
+		return types.DaoMembers{}, errors.Wrapf(err, "error getting core DAO members")
+	}
+	if !found {
+		return types.DaoMembers{}, types.ErrFunctionDisabled.Wrapf("%s has no members", dao.DisplayName())
+	}
+	if !daoMembers.IsMember(sdk.MustAccAddressFromBech32(memberAddress)) {
+		ms.k.Logger(ctx).Error(
+			"signer is not a member of the core DAO",
+			"dao", dao,
+			"got", memberAddress,
+		)
+
+		return types.DaoMembers{}, types.ErrNotDaoMember.Wrapf("%s is not a member of the %s", memberAddress, dao.DisplayName())
+	}
+	return daoMembers, nil
+}
+
+// processDaoAction executes the action if it has reached the threshold of its Core DAO,
+// otherwise stores it until the next approval. It returns true if the action was executed.
+func (ms MsgServer) processDaoAction(ctx sdk.Context, daoMembers types.DaoMembers, action types.PendingDaoAction) (bool, error) {
+	if len(action.Approvals) < int(daoMembers.Threshold) {
+		if err := ms.k.PendingDaoActions.Set(ctx, action.Id, action); err != nil {
+			return false, errors.Wrapf(err, "error setting pending core DAO action")
+		}
+		return false, nil
+	}
+
+	if err := ms.k.PendingDaoActions.Remove(ctx, action.Id); err != nil {
+		return false, errors.Wrapf(err, "error removing pending core DAO action")
+	}
+	if err := ms.executeDaoAction(ctx, action); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// executeDaoAction executes an action approved by the members of its Core DAO, on behalf of
+// the Core DAO address.
+func (ms MsgServer) executeDaoAction(ctx sdk.Context, action types.PendingDaoAction) error {
+	daoAddress := ms.k.GetParams(ctx).DaoAddress(action.Dao)
+	var err error
+	switch action.Action {
+	case types.DaoActionTypeAnnotate:
+		_, err = ms.annotateProposal(ctx, &types.MsgAnnotateProposal{
+			Annotator:  daoAddress,
+			ProposalId: action.ProposalId,
+			Annotation: action.Annotation,
+			Overwrite:  action.Overwrite,
+		})
+	case types.DaoActionTypeEndorse:
+		_, err = ms.endorseProposal(ctx, &types.MsgEndorseProposal{
+			Endorser:   daoAddress,
+			ProposalId: action.ProposalId,
+		})
+	case types.DaoActionTypeExtendVotingPeriod:
+		_, err = ms.extendVotingPeriod(ctx, &types.MsgExtendVotingPeriod{
+			Extender:   daoAddress,
+			ProposalId: action.ProposalId,
+		})
+	case types.DaoActionTypeVeto:
+		_, err = ms.vetoProposal(ctx, &types.MsgVetoProposal{
+			Vetoer:      daoAddress,
+			ProposalId:  action.ProposalId,
+			BurnDeposit: action.BurnDeposit,
+		})
+	case types.DaoActionTypeWithdrawVeto:
+		_, err = ms.withdrawVeto(ctx, &types.MsgWithdrawVeto{
+			Vetoer:     daoAddress,
+			ProposalId: action.ProposalId,
+		})
+	case types.DaoActionTypeRecuse:
+		_, err = ms.recuseFromProposal(ctx, &types.MsgRecuseFromProposal{
+			Recuser:    daoAddress,
+			ProposalId: action.ProposalId,
+			Reason:     action.Reason,
+		})
+	case types.DaoActionTypeSpend:
+		_, err = ms.daoSpend(ctx, &types.MsgDaoSpend{
+			Spender:   daoAddress,
+			Recipient: action.Recipient,
+			Amount:    action.Amount,
+			Memo:      action.Memo,
+		})
+	default:
+		return types.ErrUnknownDaoAction.Wrapf("invalid action %s", action.Action)
+	}
+	return err
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -24,6 +25,7 @@ import (
 	coredaoskeeper "github.com/atomone-hub/atomone/x/coredaos/keeper"
 	"github.com/atomone-hub/atomone/x/coredaos/testutil"
 	"github.com/atomone-hub/atomone/x/coredaos/types"
+	atomonegovv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 )
 
 func TestMsgServerUpdateParams(t *testing.T) {
@@ -67,6 +69,18 @@ func TestMsgServerUpdateParams(t *testing.T) {
 			expectedErr: "voting period extension duration must not be nil",
 			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
 		},
+		{
+			name: "steering proposal quota without epoch identifier",
+			msg: &types.MsgUpdateParams{
+				Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+				Params: types.Params{
+					VotingPeriodExtensionDuration: &timeDuration,
+					SteeringProposalQuota:         1,
+				},
+			},
+			expectedErr: "steering proposal epoch identifier must be set when the steering proposal quota is used",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
 		{
 			name: "ok",
 			msg: &types.MsgUpdateParams{
@@ -344,6 +358,9 @@ func TestMsgServerAnnotateProposal(t *testing.T) {
 		// assertAnnotation, if non-empty, is the expected annotation after a
 		// successful call.
 		assertAnnotation string
+		// assertPreviousAnnotation is the expected previous annotation recorded
+		// in the action log after a successful call.
+		assertPreviousAnnotation string
 	}{
 		{
 			name:        "empty msg",
@@ -429,9 +446,10 @@ func TestMsgServerAnnotateProposal(t *testing.T) {
 				Annotation: "New annotation",
 				Overwrite:  true,
 			},
-			proposalState:    "voting-annotated",
-			setSteeringDAO:   true,
-			assertAnnotation: "New annotation",
+			proposalState:            "voting-annotated",
+			setSteeringDAO:           true,
+			assertAnnotation:         "New annotation",
+			assertPreviousAnnotation: "Existing",
 		},
 	}
 	for _, tt := range tests {
@@ -479,6 +497,14 @@ func TestMsgServerAnnotateProposal(t *testing.T) {
 				got, err := app.GovKeeper.Proposals.Get(ctx, tt.msg.ProposalId)
 				require.NoError(t, err)
 				require.Equal(t, tt.assertAnnotation, got.Annotation)
+
+				resp, err := coredaoskeeper.NewQuerier(app.CoreDaosKeeper).ProposalActions(ctx,
+					&types.QueryProposalActionsRequest{ProposalId: tt.msg.ProposalId})
+				require.NoError(t, err)
+				require.Len(t, resp.Actions, 1)
+				require.Equal(t, types.DaoActionTypeAnnotate, resp.Actions[0].Action)
+				require.Equal(t, steeringDAOAcc, resp.Actions[0].Signer)
+				require.Equal(t, tt.assertPreviousAnnotation, resp.Actions[0].PreviousAnnotation)
 			}
 		})
 	}
@@ -699,6 +725,16 @@ func TestMsgServerExtendVotingPeriod(t *testing.T) {
 			proposalState:  "voting-maxed",
 			setSteeringDAO: true,
 		},
+		{
+			name: "proposal with pending veto",
+			msg: &types.MsgExtendVotingPeriod{
+				Extender: steeringDAOAcc,
+			},
+			expectedErr:     "has a pending veto: proposal has a pending veto",
+			proposalState:   "voting-pending-veto",
+			setSteeringDAO:  true,
+			setOversightDAO: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -729,6 +765,11 @@ func TestMsgServerExtendVotingPeriod(t *testing.T) {
 				p.TimesVotingPeriodExtended = params.VotingPeriodExtensionsLimit
 				require.NoError(t, app.GovKeeper.SetProposal(ctx, p))
 				tt.msg.ProposalId = p.Id
+			case "voting-pending-veto":
+				p := submitBankSendProposalReal(t, app, ctx, true)
+				_, err := ms.VetoProposal(ctx, &types.MsgVetoProposal{Vetoer: oversightDAOAcc, ProposalId: p.Id})
+				require.NoError(t, err)
+				tt.msg.ProposalId = p.Id
 			}
 
 			if err := tt.msg.ValidateBasic(); err != nil {
@@ -760,6 +801,161 @@ func TestMsgServerExtendVotingPeriod(t *testing.T) {
 	}
 }
 
+func TestMsgServerExtendVotingPeriodPolicy(t *testing.T) {
+	testAcc := simtestutil.CreateRandomAccounts(2)
+	steeringDAOAcc := testAcc[0].String()
+	oversightDAOAcc := testAcc[1].String()
+	policy := types.ExtensionPolicy{
+		ProposalKind:                types.ProposalKindAny,
+		ExtensionDuration:           2 * time.Hour,
+		SteeringDaoExtensionsLimit:  2,
+		OversightDaoExtensionsLimit: 1,
+		TotalExtensionsLimit:        2,
+	}
+
+	t.Run("policy of the proposal kind", func(t *testing.T) {
+		app := helpers.Setup(t)
+		ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+		ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+		params := types.DefaultParams()
+		params.SteeringDaoAddress = steeringDAOAcc
+		params.OversightDaoAddress = oversightDAOAcc
+		params.ExtensionPolicies = []types.ExtensionPolicy{policy}
+		require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+		p := submitBankSendProposalReal(t, app, ctx, true)
+		origEndTime := *p.VotingEndTime
+
+		_, err := ms.ExtendVotingPeriod(ctx, &types.MsgExtendVotingPeriod{Extender: oversightDAOAcc, ProposalId: p.Id})
+		require.NoError(t, err)
+		_, err = ms.ExtendVotingPeriod(ctx, &types.MsgExtendVotingPeriod{Extender: oversightDAOAcc, ProposalId: p.Id})
+		require.ErrorContains(t, err, "has reached the maximum number of voting period extensions by the Oversight DAO: invalid proposal content")
+
+		// the extensions of the Oversight DAO don't count towards the limit of
+		// the Steering DAO, but towards the total limit of the policy
+		_, err = ms.ExtendVotingPeriod(ctx, &types.MsgExtendVotingPeriod{Extender: steeringDAOAcc, ProposalId: p.Id})
+		require.NoError(t, err)
+		_, err = ms.ExtendVotingPeriod(ctx, &types.MsgExtendVotingPeriod{Extender: steeringDAOAcc, ProposalId: p.Id})
+		require.ErrorContains(t, err, "has reached the maximum number of voting period extensions: invalid proposal content")
+
+		got, err := app.GovKeeper.Proposals.Get(ctx, p.Id)
+		require.NoError(t, err)
+		require.Equal(t, policy.TotalExtensionsLimit, got.TimesVotingPeriodExtended)
+		require.WithinDuration(t, origEndTime.Add(2*policy.ExtensionDuration), *got.VotingEndTime, time.Second)
+	})
+
+	t.Run("policy replaces the voting period extensions limit", func(t *testing.T) {
+		app := helpers.Setup(t)
+		ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+		ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+		params := types.DefaultParams()
+		params.SteeringDaoAddress = steeringDAOAcc
+		params.VotingPeriodExtensionsLimit = 1
+		params.ExtensionPolicies = []types.ExtensionPolicy{policy}
+		require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+		p := submitBankSendProposalReal(t, app, ctx, true)
+
+		// the Steering DAO can extend the voting period more times than
+		// VotingPeriodExtensionsLimit allows
+		for range policy.SteeringDaoExtensionsLimit {
+			_, err := ms.ExtendVotingPeriod(ctx, &types.MsgExtendVotingPeriod{Extender: steeringDAOAcc, ProposalId: p.Id})
+			require.NoError(t, err)
+		}
+		_, err := ms.ExtendVotingPeriod(ctx, &types.MsgExtendVotingPeriod{Extender: steeringDAOAcc, ProposalId: p.Id})
+		require.ErrorContains(t, err, "has reached the maximum number of voting period extensions: invalid proposal content")
+
+		// the limits of the policy are recorded with the extensions
+		resp, err := coredaoskeeper.NewQuerier(app.CoreDaosKeeper).ProposalActions(ctx,
+			&types.QueryProposalActionsRequest{ProposalId: p.Id})
+		require.NoError(t, err)
+		require.Len(t, resp.Actions, int(policy.SteeringDaoExtensionsLimit))
+		for _, action := range resp.Actions {
+			require.Equal(t, policy.TotalExtensionsLimit, action.ExtensionsLimit)
+			require.Equal(t, policy.SteeringDaoExtensionsLimit, action.DaoExtensionsLimit)
+		}
+	})
+
+	t.Run("extensions counted by Core DAO", func(t *testing.T) {
+		app := helpers.Setup(t)
+		ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+		ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+		params := types.DefaultParams()
+		params.SteeringDaoAddress = steeringDAOAcc
+		// the total limit of the policy isn't reached by the Steering DAO alone
+		countedPolicy := policy
+		countedPolicy.TotalExtensionsLimit = 3
+		params.ExtensionPolicies = []types.ExtensionPolicy{countedPolicy}
+		require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+		p := submitBankSendProposalReal(t, app, ctx, true)
+
+		for range policy.SteeringDaoExtensionsLimit {
+			_, err := ms.ExtendVotingPeriod(ctx, &types.MsgExtendVotingPeriod{Extender: steeringDAOAcc, ProposalId: p.Id})
+			require.NoError(t, err)
+		}
+		// the extensions of the previous Steering DAO address still count
+		newSteeringDAOAcc := simtestutil.CreateRandomAccounts(1)[0].String()
+		params.SteeringDaoAddress = newSteeringDAOAcc
+		require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+		_, err := ms.ExtendVotingPeriod(ctx, &types.MsgExtendVotingPeriod{Extender: newSteeringDAOAcc, ProposalId: p.Id})
+		require.ErrorContains(t, err, "has reached the maximum number of voting period extensions by the Steering DAO: invalid proposal content")
+	})
+
+	t.Run("no policy of the proposal kind", func(t *testing.T) {
+		app := helpers.Setup(t)
+		ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+		ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+		params := types.DefaultParams()
+		params.SteeringDaoAddress = steeringDAOAcc
+		lawPolicy := policy
+		lawPolicy.ProposalKind = types.ProposalKindLaw
+		params.ExtensionPolicies = []types.ExtensionPolicy{lawPolicy}
+		require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+		p := submitBankSendProposalReal(t, app, ctx, true)
+		origEndTime := *p.VotingEndTime
+
+		_, err := ms.ExtendVotingPeriod(ctx, &types.MsgExtendVotingPeriod{Extender: steeringDAOAcc, ProposalId: p.Id})
+
+		require.NoError(t, err)
+		got, err := app.GovKeeper.Proposals.Get(ctx, p.Id)
+		require.NoError(t, err)
+		require.WithinDuration(t, origEndTime.Add(*params.VotingPeriodExtensionDuration), *got.VotingEndTime, time.Second)
+	})
+}
+
+func TestMsgServerExtendVotingPeriodCommitReveal(t *testing.T) {
+	steeringDAOAcc := simtestutil.CreateRandomAccounts(1)[0].String()
+	revealPeriod := time.Hour
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+	params := types.DefaultParams()
+	params.SteeringDaoAddress = steeringDAOAcc
+	require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+	p := submitBankSendProposalReal(t, app, ctx, true)
+	require.NoError(t, app.GovKeeperWrapper.CommitRevealVotings.Set(ctx, p.Id, atomonegovv1.CommitRevealVoting{
+		ProposalId:   p.Id,
+		RevealPeriod: revealPeriod,
+	}))
+
+	// the voting period can be extended while the votes are committed to
+	_, err := ms.ExtendVotingPeriod(ctx, &types.MsgExtendVotingPeriod{Extender: steeringDAOAcc, ProposalId: p.Id})
+	require.NoError(t, err)
+	got, err := app.GovKeeper.Proposals.Get(ctx, p.Id)
+	require.NoError(t, err)
+	require.WithinDuration(t, p.VotingEndTime.Add(*params.VotingPeriodExtensionDuration), *got.VotingEndTime, time.Second)
+
+	// but no longer once the votes can be revealed, as it would move the
+	// reveal window and let the votes be committed to again
+	revealStart := got.VotingEndTime.Add(-revealPeriod)
+	ctx = ctx.WithBlockTime(revealStart)
+	_, err = ms.ExtendVotingPeriod(ctx, &types.MsgExtendVotingPeriod{Extender: steeringDAOAcc, ProposalId: p.Id})
+	require.EqualError(t, err, fmt.Sprintf(
+		"the voting period of proposal %d can no longer be extended since its reveal window started at %s: wrong commit-reveal voting phase",
+		p.Id, revealStart))
+	got, err = app.GovKeeper.Proposals.Get(ctx, p.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), got.TimesVotingPeriodExtended)
+}
+
 func TestMsgServerVetoProposal(t *testing.T) {
 	testAcc := simtestutil.CreateRandomAccounts(3)
 	vetoerAcc := testAcc[0].String()
@@ -840,12 +1036,21 @@ func TestMsgServerVetoProposal(t *testing.T) {
 			proposalState:   "deposit",
 			setOversightDAO: true,
 		},
+		{
+			name: "proposal with pending veto",
+			msg: &types.MsgVetoProposal{
+				Vetoer: oversightDAOAcc,
+			},
+			expectedErr:     "already has a pending veto: proposal has a pending veto",
+			proposalState:   "voting-pending-veto",
+			setOversightDAO: true,
+		},
 		{
 			name: "veto proposal with change to oversight DAO address",
 			msg: &types.MsgVetoProposal{
 				Vetoer: oversightDAOAcc,
 			},
-			expectedErr:     "contains a change of the oversight DAO address, vetoing it would prevent the replacement of the current oversight DAO: oversight DAO cannot veto this proposal",
+			expectedErr:     "proposal contains a change of the Oversight DAO address: core DAO is recused from this proposal",
 			proposalState:   "voting-change-oversight",
 			setOversightDAO: true,
 		},
@@ -854,7 +1059,7 @@ func TestMsgServerVetoProposal(t *testing.T) {
 			msg: &types.MsgVetoProposal{
 				Vetoer: oversightDAOAcc,
 			},
-			expectedErr:     "contains a change of the oversight DAO address, vetoing it would prevent the replacement of the current oversight DAO: oversight DAO cannot veto this proposal",
+			expectedErr:     "proposal contains a change of the Oversight DAO address: core DAO is recused from this proposal",
 			proposalState:   "voting-disable-oversight",
 			setOversightDAO: true,
 		},
@@ -896,6 +1101,11 @@ func TestMsgServerVetoProposal(t *testing.T) {
 			case "deposit":
 				p := submitBankSendProposalReal(t, app, ctx, false)
 				tt.msg.ProposalId = p.Id
+			case "voting-pending-veto":
+				p := submitBankSendProposalReal(t, app, ctx, true)
+				_, err := ms.VetoProposal(ctx, &types.MsgVetoProposal{Vetoer: oversightDAOAcc, ProposalId: p.Id})
+				require.NoError(t, err)
+				tt.msg.ProposalId = p.Id
 			case "voting-change-oversight":
 				p := submitProposalReal(t, app, ctx, []sdk.Msg{changeOversightMsg}, true)
 				tt.msg.ProposalId = p.Id
@@ -911,7 +1121,7 @@ func TestMsgServerVetoProposal(t *testing.T) {
 				}
 				require.NoError(t, err)
 			}
-			_, err := ms.VetoProposal(ctx, tt.msg)
+			resp, err := ms.VetoProposal(ctx, tt.msg)
 			if tt.expectedErr != "" {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), tt.expectedErr)
@@ -919,8 +1129,32 @@ func TestMsgServerVetoProposal(t *testing.T) {
 			}
 			require.NoError(t, err)
 			if tt.assertVetoed {
+				// the veto is pending until the veto delay has elapsed
+				require.Equal(t, ctx.BlockTime().Add(types.DefaultVetoDelay), resp.ExecutionTime)
+				pending, err := app.CoreDaosKeeper.PendingVetoes.Get(ctx, tt.msg.ProposalId)
+				require.NoError(t, err)
+				require.Equal(t, tt.msg.BurnDeposit, pending.BurnDeposit)
 				got, err := app.GovKeeper.Proposals.Get(ctx, tt.msg.ProposalId)
 				require.NoError(t, err)
+				require.Equal(t, govv1.StatusVotingPeriod, got.Status)
+				// proposal is suspended from the active queue while the veto is pending
+				has, err := app.GovKeeper.ActiveProposalsQueue.Has(ctx, collectionsJoin(*got.VotingEndTime, got.Id))
+				require.NoError(t, err)
+				require.False(t, has)
+
+				// the veto is not executed before its execution time
+				require.NoError(t, app.CoreDaosKeeper.ProcessPendingVetoes(ctx.WithBlockTime(resp.ExecutionTime.Add(-time.Second))))
+				got, err = app.GovKeeper.Proposals.Get(ctx, tt.msg.ProposalId)
+				require.NoError(t, err)
+				require.Equal(t, govv1.StatusVotingPeriod, got.Status)
+
+				ctx = ctx.WithBlockTime(resp.ExecutionTime)
+				require.NoError(t, app.CoreDaosKeeper.ProcessPendingVetoes(ctx))
+				has, err = app.CoreDaosKeeper.PendingVetoes.Has(ctx, tt.msg.ProposalId)
+				require.NoError(t, err)
+				require.False(t, has)
+				got, err = app.GovKeeper.Proposals.Get(ctx, tt.msg.ProposalId)
+				require.NoError(t, err)
 				require.Equal(t, govv1.StatusVetoed, got.Status)
 				// final tally must be reset to empty
 				emptyTally := govv1.EmptyTallyResult()
@@ -928,10 +1162,589 @@ func TestMsgServerVetoProposal(t *testing.T) {
 				// voting ends immediately (set to block time)
 				require.WithinDuration(t, ctx.BlockTime(), *got.VotingEndTime, time.Second)
 				// proposal removed from active queue under its original end time
-				has, err := app.GovKeeper.ActiveProposalsQueue.Has(ctx, collectionsJoin(*got.VotingEndTime, got.Id))
+				has, err = app.GovKeeper.ActiveProposalsQueue.Has(ctx, collectionsJoin(*got.VotingEndTime, got.Id))
 				require.NoError(t, err)
 				require.False(t, has)
 			}
 		})
 	}
 }
+
+func TestMsgServerWithdrawVeto(t *testing.T) {
+	testAcc := simtestutil.CreateRandomAccounts(2)
+	vetoerAcc := testAcc[0].String()
+	oversightDAOAcc := testAcc[1].String()
+
+	tests := []struct {
+		name            string
+		msg             *types.MsgWithdrawVeto
+		expectedErr     string
+		proposalState   string
+		setOversightDAO bool
+	}{
+		{
+			name:        "empty msg",
+			msg:         &types.MsgWithdrawVeto{},
+			expectedErr: "invalid vetoer address: empty address string is not allowed: invalid address",
+		},
+		{
+			name: "function disabled",
+			msg: &types.MsgWithdrawVeto{
+				Vetoer: vetoerAcc,
+			},
+			expectedErr: "Oversight DAO address is not set: function is disabled",
+		},
+		{
+			name: "wrong vetoer account",
+			msg: &types.MsgWithdrawVeto{
+				Vetoer: vetoerAcc,
+			},
+			expectedErr:     "invalid authority; expected " + oversightDAOAcc + ", got " + vetoerAcc + ": expected core DAO account as only signer for this message",
+			setOversightDAO: true,
+		},
+		{
+			name: "no pending veto",
+			msg: &types.MsgWithdrawVeto{
+				Vetoer: oversightDAOAcc,
+			},
+			expectedErr:     "has no pending veto: proposal has no pending veto",
+			proposalState:   "voting",
+			setOversightDAO: true,
+		},
+		{
+			name: "ok",
+			msg: &types.MsgWithdrawVeto{
+				Vetoer: oversightDAOAcc,
+			},
+			proposalState:   "voting-pending-veto",
+			setOversightDAO: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := helpers.Setup(t)
+			ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+			ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+
+			params := types.DefaultParams()
+			if tt.setOversightDAO {
+				params.OversightDaoAddress = oversightDAOAcc
+			}
+			require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+
+			switch tt.proposalState {
+			case "voting":
+				p := submitBankSendProposalReal(t, app, ctx, true)
+				tt.msg.ProposalId = p.Id
+			case "voting-pending-veto":
+				p := submitBankSendProposalReal(t, app, ctx, true)
+				_, err := ms.VetoProposal(ctx, &types.MsgVetoProposal{Vetoer: oversightDAOAcc, ProposalId: p.Id})
+				require.NoError(t, err)
+				tt.msg.ProposalId = p.Id
+			}
+
+			if err := tt.msg.ValidateBasic(); err != nil {
+				if tt.expectedErr != "" {
+					require.EqualError(t, err, tt.expectedErr)
+					return
+				}
+				require.NoError(t, err)
+			}
+			_, err := ms.WithdrawVeto(ctx, tt.msg)
+			if tt.expectedErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			has, err := app.CoreDaosKeeper.PendingVetoes.Has(ctx, tt.msg.ProposalId)
+			require.NoError(t, err)
+			require.False(t, has)
+			// the proposal is back in the active queue and is not vetoed later on
+			got, err := app.GovKeeper.Proposals.Get(ctx, tt.msg.ProposalId)
+			require.NoError(t, err)
+			has, err = app.GovKeeper.ActiveProposalsQueue.Has(ctx, collectionsJoin(*got.VotingEndTime, got.Id))
+			require.NoError(t, err)
+			require.True(t, has)
+			require.NoError(t, app.CoreDaosKeeper.ProcessPendingVetoes(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultVetoDelay))))
+			got, err = app.GovKeeper.Proposals.Get(ctx, tt.msg.ProposalId)
+			require.NoError(t, err)
+			require.Equal(t, govv1.StatusVotingPeriod, got.Status)
+			// both the veto and its withdrawal are in the action log
+			resp, err := coredaoskeeper.NewQuerier(app.CoreDaosKeeper).ProposalActions(ctx,
+				&types.QueryProposalActionsRequest{ProposalId: tt.msg.ProposalId})
+			require.NoError(t, err)
+			require.Len(t, resp.Actions, 2)
+			require.Equal(t, types.DaoActionTypeVeto, resp.Actions[0].Action)
+			require.Equal(t, types.DaoActionTypeWithdrawVeto, resp.Actions[1].Action)
+		})
+	}
+}
+
+func TestProcessPendingVetoes(t *testing.T) {
+	oversightDAOAcc := simtestutil.CreateRandomAccounts(1)[0].String()
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+	params := types.DefaultParams()
+	params.OversightDaoAddress = oversightDAOAcc
+	require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+	removed := submitBankSendProposalReal(t, app, ctx, true)
+	requeued := submitBankSendProposalReal(t, app, ctx, true)
+	for _, p := range []govv1.Proposal{removed, requeued} {
+		_, err := ms.VetoProposal(ctx, &types.MsgVetoProposal{Vetoer: oversightDAOAcc, ProposalId: p.Id})
+		require.NoError(t, err)
+	}
+	// the first proposal is deleted while its veto is pending, e.g. canceled
+	require.NoError(t, app.GovKeeper.DeleteProposal(ctx, removed.Id))
+	// the second proposal is put back in the active queue, e.g. by a quorum check
+	require.NoError(t, app.GovKeeper.ActiveProposalsQueue.Set(ctx, collectionsJoin(*requeued.VotingEndTime, requeued.Id), requeued.Id))
+
+	require.NoError(t, app.CoreDaosKeeper.ProcessPendingVetoes(ctx))
+
+	has, err := app.CoreDaosKeeper.PendingVetoes.Has(ctx, removed.Id)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = app.CoreDaosKeeper.PendingVetoes.Has(ctx, requeued.Id)
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = app.GovKeeper.ActiveProposalsQueue.Has(ctx, collectionsJoin(*requeued.VotingEndTime, requeued.Id))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestProcessPendingVetoesCommitReveal(t *testing.T) {
+	oversightDAOAcc := simtestutil.CreateRandomAccounts(1)[0].String()
+	voter := sdk.AccAddress("voter")
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+	params := types.DefaultParams()
+	params.OversightDaoAddress = oversightDAOAcc
+	require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+	p := submitBankSendProposalReal(t, app, ctx, true)
+	require.NoError(t, app.GovKeeperWrapper.CommitRevealVotings.Set(ctx, p.Id, atomonegovv1.CommitRevealVoting{
+		ProposalId:   p.Id,
+		RevealPeriod: time.Hour,
+	}))
+	require.NoError(t, app.GovKeeperWrapper.VoteCommitments.Set(ctx, collections.Join(p.Id, voter), []byte("commitment")))
+
+	resp, err := ms.VetoProposal(ctx, &types.MsgVetoProposal{Vetoer: oversightDAOAcc, ProposalId: p.Id})
+	require.NoError(t, err)
+	require.NoError(t, app.CoreDaosKeeper.ProcessPendingVetoes(ctx.WithBlockTime(resp.ExecutionTime)))
+
+	// the vote commitments and the commit-reveal voting of the vetoed
+	// proposal are removed
+	got, err := app.GovKeeper.Proposals.Get(ctx, p.Id)
+	require.NoError(t, err)
+	require.Equal(t, govv1.StatusVetoed, got.Status)
+	has, err := app.GovKeeperWrapper.VoteCommitments.Has(ctx, collections.Join(p.Id, voter))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = app.GovKeeperWrapper.CommitRevealVotings.Has(ctx, p.Id)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestMsgServerUpdateDaoMembers(t *testing.T) {
+	testAcc := simtestutil.CreateRandomAccounts(2)
+	members := []string{testAcc[0].String(), testAcc[1].String()}
+	steeringMembers := types.DaoMembers{
+		Dao:             types.CoreDaoSteering,
+		Members:         members,
+		Threshold:       2,
+		ApprovalTimeout: time.Hour,
+	}
+
+	tests := []struct {
+		name        string
+		msg         *types.MsgUpdateDaoMembers
+		expectedErr string
+	}{
+		{
+			name:        "invalid authority",
+			msg:         types.NewMsgUpdateDaoMembers(members[0], steeringMembers),
+			expectedErr: "invalid authority; expected " + govModuleAddr() + ", got " + members[0] + ": expected core DAO account as only signer for this message",
+		},
+		{
+			name: "ok",
+			msg:  types.NewMsgUpdateDaoMembers(govModuleAddr(), steeringMembers),
+		},
+		{
+			name: "ok remove members",
+			msg:  types.NewMsgUpdateDaoMembers(govModuleAddr(), types.DaoMembers{Dao: types.CoreDaoSteering}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := helpers.Setup(t)
+			ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+			ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+			// existing members with a pending action, which is dropped by the update
+			require.NoError(t, app.CoreDaosKeeper.DaoMembers.Set(ctx, int32(types.CoreDaoSteering), steeringMembers))
+			pending := types.PendingDaoAction{Id: 1, Dao: types.CoreDaoSteering, Action: types.DaoActionTypeEndorse, Approvals: members[:1]}
+			require.NoError(t, app.CoreDaosKeeper.PendingDaoActions.Set(ctx, pending.Id, pending))
+
+			_, err := ms.UpdateDaoMembers(ctx, tt.msg)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			daoMembers, found, err := app.CoreDaosKeeper.GetDaoMembers(ctx, types.CoreDaoSteering)
+			require.NoError(t, err)
+			if len(tt.msg.DaoMembers.Members) == 0 {
+				require.False(t, found)
+			} else {
+				require.True(t, found)
+				require.Equal(t, tt.msg.DaoMembers, daoMembers)
+			}
+			has, err := app.CoreDaosKeeper.PendingDaoActions.Has(ctx, pending.Id)
+			require.NoError(t, err)
+			require.False(t, has)
+		})
+	}
+}
+
+func TestMsgServerSubmitAndApproveDaoAction(t *testing.T) {
+	testAcc := simtestutil.CreateRandomAccounts(5)
+	steeringDAOAcc := testAcc[0].String()
+	members := []string{testAcc[1].String(), testAcc[2].String(), testAcc[3].String()}
+	nonMember := testAcc[4].String()
+
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+	params := types.DefaultParams()
+	params.SteeringDaoAddress = steeringDAOAcc
+	require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+	proposal := submitBankSendProposalReal(t, app, ctx, true)
+
+	submit := &types.MsgSubmitDaoAction{
+		Member:     members[0],
+		Dao:        types.CoreDaoSteering,
+		Action:     types.DaoActionTypeAnnotate,
+		ProposalId: proposal.Id,
+		Annotation: "annotation",
+	}
+
+	// no member set
+	_, err := ms.SubmitDaoAction(ctx, submit)
+	require.EqualError(t, err, "Steering DAO has no members: function is disabled")
+
+	_, err = ms.UpdateDaoMembers(ctx, types.NewMsgUpdateDaoMembers(govModuleAddr(), types.DaoMembers{
+		Dao:             types.CoreDaoSteering,
+		Members:         members,
+		Threshold:       2,
+		ApprovalTimeout: time.Hour,
+	}))
+	require.NoError(t, err)
+
+	// the Steering DAO address can no longer sign directly
+	_, err = ms.AnnotateProposal(ctx, types.NewMsgAnnotateProposal(sdk.MustAccAddressFromBech32(steeringDAOAcc), proposal.Id, "annotation"))
+	require.EqualError(t, err, "Steering DAO actions must be approved by its members: expected core DAO account as only signer for this message")
+
+	// non-member submission
+	_, err = ms.SubmitDaoAction(ctx, &types.MsgSubmitDaoAction{
+		Member:     nonMember,
+		Dao:        types.CoreDaoSteering,
+		Action:     types.DaoActionTypeEndorse,
+		ProposalId: proposal.Id,
+	})
+	require.EqualError(t, err, nonMember+" is not a member of the Steering DAO: signer is not a member of the core DAO")
+
+	// first approval, below threshold
+	res, err := ms.SubmitDaoAction(ctx, submit)
+	require.NoError(t, err)
+	require.False(t, res.Executed)
+	pending, err := app.CoreDaosKeeper.PendingDaoActions.Get(ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, []string{members[0]}, pending.Approvals)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), pending.ExpirationTime)
+
+	_, err = ms.ApproveAction(ctx, types.NewMsgApproveAction(sdk.MustAccAddressFromBech32(nonMember), res.Id))
+	require.EqualError(t, err, nonMember+" is not a member of the Steering DAO: signer is not a member of the core DAO")
+	_, err = ms.ApproveAction(ctx, types.NewMsgApproveAction(sdk.MustAccAddressFromBech32(members[0]), res.Id))
+	require.EqualError(t, err, "core DAO action with ID 0 already approved by "+members[0]+": core DAO action already approved by member")
+	_, err = ms.ApproveAction(ctx, types.NewMsgApproveAction(sdk.MustAccAddressFromBech32(members[0]), res.Id+1))
+	require.EqualError(t, err, "core DAO action with ID 1 not found: unknown core DAO action")
+
+	// second approval reaches the threshold and executes the action on behalf of the Steering DAO
+	approveRes, err := ms.ApproveAction(ctx, types.NewMsgApproveAction(sdk.MustAccAddressFromBech32(members[1]), res.Id))
+	require.NoError(t, err)
+	require.True(t, approveRes.Executed)
+	has, err := app.CoreDaosKeeper.PendingDaoActions.Has(ctx, res.Id)
+	require.NoError(t, err)
+	require.False(t, has)
+	proposal, err = app.GovKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, "annotation", proposal.Annotation)
+
+	// expired actions can no longer be approved and are pruned
+	submit.Action = types.DaoActionTypeEndorse
+	submit.Annotation = ""
+	res, err = ms.SubmitDaoAction(ctx, submit)
+	require.NoError(t, err)
+	require.False(t, res.Executed)
+	expiredCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, err = ms.ApproveAction(expiredCtx, types.NewMsgApproveAction(sdk.MustAccAddressFromBech32(members[1]), res.Id))
+	require.EqualError(t, err, "core DAO action with ID 1 has expired: unknown core DAO action")
+	require.NoError(t, app.CoreDaosKeeper.PruneExpiredDaoActions(expiredCtx))
+	has, err = app.CoreDaosKeeper.PendingDaoActions.Has(ctx, res.Id)
+	require.NoError(t, err)
+	require.False(t, has)
+	proposal, err = app.GovKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.False(t, proposal.Endorsed)
+}
+
+func TestMsgServerSubmitSteeringProposal(t *testing.T) {
+	testAcc := simtestutil.CreateRandomAccounts(2)
+	proposerAcc := testAcc[0].String()
+	steeringDAOAcc := testAcc[1].String()
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	sendMsg := banktypes.NewMsgSend(govAddr, testAcc[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1))))
+	updateDaoMembersMsg := types.NewMsgUpdateDaoMembers(govModuleAddr(), types.DaoMembers{Dao: types.CoreDaoOversight})
+
+	tests := []struct {
+		name           string
+		proposer       string
+		msgs           []sdk.Msg
+		expectedErr    string
+		setSteeringDAO bool
+		quota          uint32
+		submitted      uint32
+	}{
+		{
+			name:        "function disabled",
+			proposer:    proposerAcc,
+			expectedErr: "Steering DAO address is not set: function is disabled",
+			quota:       1,
+		},
+		{
+			name:           "wrong proposer account",
+			proposer:       proposerAcc,
+			expectedErr:    "invalid authority; expected " + steeringDAOAcc + ", got " + proposerAcc + ": expected core DAO account as only signer for this message",
+			setSteeringDAO: true,
+			quota:          1,
+		},
+		{
+			name:           "quota not set",
+			proposer:       steeringDAOAcc,
+			expectedErr:    "Steering DAO proposal quota is not set: function is disabled",
+			setSteeringDAO: true,
+		},
+		{
+			name:           "quota reached",
+			proposer:       steeringDAOAcc,
+			msgs:           []sdk.Msg{sendMsg},
+			expectedErr:    "2 proposals already submitted in epoch 0: steering DAO proposal quota reached",
+			setSteeringDAO: true,
+			quota:          2,
+			submitted:      2,
+		},
+		{
+			name:           "change of the core DAO members",
+			proposer:       steeringDAOAcc,
+			msgs:           []sdk.Msg{updateDaoMembersMsg},
+			expectedErr:    "proposal cannot contain /atomone.coredaos.v1.MsgUpdateDaoMembers: invalid steering DAO proposal",
+			setSteeringDAO: true,
+			quota:          1,
+		},
+		{
+			name:           "ok",
+			proposer:       steeringDAOAcc,
+			msgs:           []sdk.Msg{sendMsg},
+			setSteeringDAO: true,
+			quota:          2,
+			submitted:      1,
+		},
+		{
+			name:           "ok text proposal",
+			proposer:       steeringDAOAcc,
+			setSteeringDAO: true,
+			quota:          1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := helpers.Setup(t)
+			ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+			ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+
+			params := types.DefaultParams()
+			params.SteeringProposalQuota = tt.quota
+			if tt.setSteeringDAO {
+				params.SteeringDaoAddress = steeringDAOAcc
+			}
+			require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+			require.NoError(t, app.CoreDaosKeeper.SteeringProposalCount.Set(ctx, types.SteeringProposalCount{Count: tt.submitted}))
+
+			msg, err := types.NewMsgSubmitSteeringProposal(sdk.MustAccAddressFromBech32(tt.proposer), tt.msgs, "metadata", "title", "summary")
+			require.NoError(t, err)
+			require.NoError(t, msg.ValidateBasic())
+
+			resp, err := ms.SubmitSteeringProposal(ctx, msg)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			// the proposal skips the deposit period and is tagged as a Steering DAO proposal
+			proposal, err := app.GovKeeper.Proposals.Get(ctx, resp.ProposalId)
+			require.NoError(t, err)
+			require.Equal(t, govv1.StatusVotingPeriod, proposal.Status)
+			require.Equal(t, types.SteeringProposalMetadata("metadata"), proposal.Metadata)
+			require.Empty(t, proposal.TotalDeposit)
+			has, err := app.GovKeeper.ActiveProposalsQueue.Has(ctx, collectionsJoin(*proposal.VotingEndTime, proposal.Id))
+			require.NoError(t, err)
+			require.True(t, has)
+			count, err := app.CoreDaosKeeper.CurrentSteeringProposalCount(ctx, params)
+			require.NoError(t, err)
+			require.Equal(t, tt.submitted+1, count.Count)
+			action, err := app.CoreDaosKeeper.ProposalActions.Get(ctx, collections.Join(proposal.Id, uint64(0)))
+			require.NoError(t, err)
+			require.Equal(t, types.DaoActionTypeSubmitProposal, action.Action)
+			require.Equal(t, steeringDAOAcc, action.Signer)
+		})
+	}
+}
+
+func TestMsgServerRecuseFromProposal(t *testing.T) {
+	testAcc := simtestutil.CreateRandomAccounts(4)
+	recuserAcc := testAcc[0].String()
+	steeringDAOAcc := testAcc[1].String()
+	oversightDAOAcc := testAcc[2].String()
+	newSteeringDAOAcc := testAcc[3].String()
+
+	tests := []struct {
+		name            string
+		recuser         string
+		expectedErr     string
+		proposalState   string
+		setSteeringDAO  bool
+		setOversightDAO bool
+	}{
+		{
+			name:        "function disabled",
+			recuser:     recuserAcc,
+			expectedErr: "Steering DAO address and Oversight DAO address are not set: function is disabled",
+		},
+		{
+			name:            "wrong recuser account",
+			recuser:         recuserAcc,
+			expectedErr:     "invalid authority; expected " + steeringDAOAcc + " or " + oversightDAOAcc + ", got " + recuserAcc + ": expected core DAO account as only signer for this message",
+			setSteeringDAO:  true,
+			setOversightDAO: true,
+		},
+		{
+			name:           "non existing proposal",
+			recuser:        steeringDAOAcc,
+			expectedErr:    "proposal with ID 9999 not found: unknown proposal",
+			proposalState:  "none",
+			setSteeringDAO: true,
+		},
+		{
+			name:           "already recused",
+			recuser:        steeringDAOAcc,
+			expectedErr:    "Steering DAO is recused from proposal with ID 1: conflict of interest: core DAO is recused from this proposal",
+			proposalState:  "voting-recused",
+			setSteeringDAO: true,
+		},
+		{
+			name:           "mandatory recusal",
+			recuser:        steeringDAOAcc,
+			expectedErr:    "Steering DAO is recused from proposal with ID 1: proposal contains a change of the Steering DAO address: core DAO is recused from this proposal",
+			proposalState:  "voting-change-steering",
+			setSteeringDAO: true,
+		},
+		{
+			name:            "proposal with pending veto",
+			recuser:         oversightDAOAcc,
+			expectedErr:     "proposal with ID 1 has a pending veto: proposal has a pending veto",
+			proposalState:   "voting-pending-veto",
+			setOversightDAO: true,
+		},
+		{
+			name:           "ok recused steering DAO",
+			recuser:        steeringDAOAcc,
+			proposalState:  "deposit",
+			setSteeringDAO: true,
+		},
+		{
+			name:            "ok recused oversight DAO",
+			recuser:         oversightDAOAcc,
+			proposalState:   "voting",
+			setOversightDAO: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := helpers.Setup(t)
+			ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+			ms := coredaoskeeper.NewMsgServer(app.CoreDaosKeeper)
+
+			params := types.DefaultParams()
+			if tt.setSteeringDAO {
+				params.SteeringDaoAddress = steeringDAOAcc
+			}
+			if tt.setOversightDAO {
+				params.OversightDaoAddress = oversightDAOAcc
+			}
+			require.NoError(t, app.CoreDaosKeeper.Params.Set(ctx, params))
+
+			msg := types.NewMsgRecuseFromProposal(sdk.MustAccAddressFromBech32(tt.recuser), 9999, "conflict of interest")
+			switch tt.proposalState {
+			case "voting":
+				msg.ProposalId = submitBankSendProposalReal(t, app, ctx, true).Id
+			case "deposit":
+				msg.ProposalId = submitBankSendProposalReal(t, app, ctx, false).Id
+			case "voting-recused":
+				msg.ProposalId = submitBankSendProposalReal(t, app, ctx, true).Id
+				_, err := ms.RecuseFromProposal(ctx, msg)
+				require.NoError(t, err)
+			case "voting-change-steering":
+				changeParams := params
+				changeParams.SteeringDaoAddress = newSteeringDAOAcc
+				changeSteeringMsg := types.NewMsgUpdateParams(govModuleAddr(), changeParams)
+				msg.ProposalId = submitProposalReal(t, app, ctx, []sdk.Msg{changeSteeringMsg}, true).Id
+			case "voting-pending-veto":
+				msg.ProposalId = submitBankSendProposalReal(t, app, ctx, true).Id
+				_, err := ms.VetoProposal(ctx, &types.MsgVetoProposal{Vetoer: oversightDAOAcc, ProposalId: msg.ProposalId})
+				require.NoError(t, err)
+			}
+			require.NoError(t, msg.ValidateBasic())
+
+			_, err := ms.RecuseFromProposal(ctx, msg)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			dao := params.CoreDaoOf(tt.recuser)
+			recusal, found, err := app.CoreDaosKeeper.GetRecusal(ctx, msg.ProposalId, dao)
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, "conflict of interest", recusal.Reason)
+			require.False(t, recusal.Mandatory)
+			action, err := app.CoreDaosKeeper.ProposalActions.Get(ctx, collections.Join(msg.ProposalId, uint64(0)))
+			require.NoError(t, err)
+			require.Equal(t, types.DaoActionTypeRecuse, action.Action)
+			// the recused core DAO can no longer act on the proposal, even once
+			// it enters the voting period
+			proposal, err := app.GovKeeper.Proposals.Get(ctx, msg.ProposalId)
+			require.NoError(t, err)
+			if proposal.Status == govv1.StatusDepositPeriod {
+				require.NoError(t, app.GovKeeper.ActivateVotingPeriod(ctx, proposal))
+			}
+			_, err = ms.ExtendVotingPeriod(ctx, &types.MsgExtendVotingPeriod{Extender: tt.recuser, ProposalId: msg.ProposalId})
+			require.ErrorIs(t, err, types.ErrRecused)
+			if dao == types.CoreDaoSteering {
+				_, err = ms.EndorseProposal(ctx, &types.MsgEndorseProposal{Endorser: tt.recuser, ProposalId: msg.ProposalId})
+			} else {
+				_, err = ms.VetoProposal(ctx, &types.MsgVetoProposal{Vetoer: tt.recuser, ProposalId: msg.ProposalId})
+			}
+			require.ErrorIs(t, err, types.ErrRecused)
+		})
+	}
+}
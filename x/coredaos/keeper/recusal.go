@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+)
+
+// GetRecusal returns the recusal of dao from proposalID, and false if dao has
+// not recused itself from the proposal.
+func (k Keeper) GetRecusal(ctx context.Context, proposalID uint64, dao types.CoreDao) (types.Recusal, bool, error) {
+	recusal, err := k.Recusals.Get(ctx, collections.Join(proposalID, int32(dao)))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Recusal{}, false, nil
+	}
+	if err != nil {
+		return types.Recusal{}, false, err
+	}
+	return recusal, true, nil
+}
+
+// recordRecusal stores recusal and emits the corresponding event.
+func (k Keeper) recordRecusal(ctx sdk.Context, recusal types.Recusal) error {
+	if err := k.Recusals.Set(ctx, collections.Join(recusal.ProposalId, int32(recusal.Dao)), recusal); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(
+		"core DAO recused from proposal",
+		"proposal", recusal.ProposalId,
+		"dao", recusal.Dao,
+		"mandatory", recusal.Mandatory,
+	)
+
+	// Emit event for core DAO recusal
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRecuseFromProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", recusal.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyDao, recusal.Dao.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, recusal.Reason),
+			sdk.NewAttribute(types.AttributeKeyMandatory, fmt.Sprintf("%t", recusal.Mandatory)),
+		),
+	})
+	return nil
+}
+
+// mandatoryRecusalReason returns the reason why dao must recuse itself from
+// proposal, or an empty string if it does not have to. A Core DAO must recuse
+// itself from the proposals that change its address or its members, otherwise
+// it could prevent its own replacement.
+// Self-executing authz.MsgExec wrappers are rejected at submission, so only
+// top-level messages need inspection.
+func (k Keeper) mandatoryRecusalReason(ctx context.Context, proposal govv1.Proposal, dao types.CoreDao) string {
+	currentAddress := k.GetParams(ctx).DaoAddress(dao)
+	for _, anyMsg := range proposal.Messages {
+		var msg sdk.Msg
+		if err := k.cdc.UnpackAny(anyMsg, &msg); err != nil {
+			continue
+		}
+		switch msg := msg.(type) {
+		case *types.MsgUpdateParams:
+			changed, err := daoAddressChanged(msg.Params.DaoAddress(dao), currentAddress)
+			// treat parse error as a change of address
+			if err != nil || changed {
+				return fmt.Sprintf("proposal contains a change of the %s address", dao.DisplayName())
+			}
+		case *types.MsgUpdateDaoMembers:
+			if msg.DaoMembers.Dao == dao {
+				return fmt.Sprintf("proposal contains a change of the %s members", dao.DisplayName())
+			}
+		}
+	}
+	return ""
+}
+
+// checkRecusal returns an error if dao is recused from proposal, either
+// because it recused itself or because the recusal is mandatory.
+func (k Keeper) checkRecusal(ctx context.Context, proposal govv1.Proposal, dao types.CoreDao) error {
+	recusal, found, err := k.GetRecusal(ctx, proposal.Id, dao)
+	if err != nil {
+		return err
+	}
+	reason := recusal.Reason
+	if !found {
+		if reason = k.mandatoryRecusalReason(ctx, proposal, dao); reason == "" {
+			return nil
+		}
+	}
+	return types.ErrRecused.Wrapf("%s is recused from proposal with ID %d: %s", dao.DisplayName(), proposal.Id, reason)
+}
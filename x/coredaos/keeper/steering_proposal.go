@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+)
+
+// steeringProposalKey flags the context of the proposal submissions made by
+// MsgSubmitSteeringProposal, so that the x/gov hooks can tell them apart.
+type steeringProposalKey struct{}
+
+// withSteeringProposal returns a context flagged as submitting a proposal on
+// behalf of the Steering DAO.
+func withSteeringProposal(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(steeringProposalKey{}, true)
+}
+
+// isSteeringProposal returns true if ctx is submitting a proposal on behalf of
+// the Steering DAO.
+func isSteeringProposal(ctx context.Context) bool {
+	flag, ok := ctx.Value(steeringProposalKey{}).(bool)
+	return ok && flag
+}
+
+// CurrentSteeringProposalCount returns the number of proposals submitted by
+// the Steering DAO in the current epoch of the Steering DAO proposal quota.
+func (k Keeper) CurrentSteeringProposalCount(ctx context.Context, params types.Params) (types.SteeringProposalCount, error) {
+	var epochNumber int64
+	if params.SteeringProposalEpochIdentifier != "" {
+		epochInfo, err := k.epochsKeeper.GetEpochInfo(ctx, params.SteeringProposalEpochIdentifier)
+		if err != nil {
+			return types.SteeringProposalCount{}, errorsmod.Wrapf(err, "failed to get epoch info %s", params.SteeringProposalEpochIdentifier)
+		}
+		epochNumber = epochInfo.CurrentEpoch
+	}
+	count, err := k.SteeringProposalCount.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.SteeringProposalCount{}, err
+	}
+	if count.EpochNumber != epochNumber {
+		count = types.SteeringProposalCount{EpochNumber: epochNumber}
+	}
+	return count, nil
+}
+
+// trackSteeringProposal counts a proposal submitted by the Steering DAO in the
+// current epoch. It returns an error if the Steering DAO proposal quota is
+// exceeded.
+func (k Keeper) trackSteeringProposal(ctx context.Context, params types.Params) (types.SteeringProposalCount, error) {
+	count, err := k.CurrentSteeringProposalCount(ctx, params)
+	if err != nil {
+		return types.SteeringProposalCount{}, err
+	}
+	if count.Count >= params.SteeringProposalQuota {
+		return types.SteeringProposalCount{}, types.ErrProposalQuotaReached.Wrapf(
+			"%d proposals already submitted in epoch %d", count.Count, count.EpochNumber,
+		)
+	}
+	count.Count++
+	if err := k.SteeringProposalCount.Set(ctx, count); err != nil {
+		return types.SteeringProposalCount{}, err
+	}
+	return count, nil
+}
+
+// validateSteeringProposal enforces the rules on the proposals submitted by
+// the Steering DAO without deposit. Only MsgSubmitSteeringProposal can submit
+// proposals tagged as Steering DAO proposals, and these proposals cannot
+// change the x/coredaos parameters nor the members of the Core DAOs.
+func (k Keeper) validateSteeringProposal(ctx context.Context, proposal govv1.Proposal) error {
+	if !isSteeringProposal(ctx) {
+		if types.IsSteeringProposalMetadata(proposal.Metadata) {
+			return types.ErrInvalidSteeringProposal.Wrapf(
+				"metadata prefix %q is reserved to the Steering DAO", types.SteeringProposalMetadataPrefix,
+			)
+		}
+		return nil
+	}
+	for _, anyMsg := range proposal.Messages {
+		var msg sdk.Msg
+		if err := k.cdc.UnpackAny(anyMsg, &msg); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidSteeringProposal, "failed to unpack message: %s", err)
+		}
+		switch msg.(type) {
+		case *types.MsgUpdateParams, *types.MsgUpdateDaoMembers:
+			return types.ErrInvalidSteeringProposal.Wrapf(
+				"proposal cannot contain %s", sdk.MsgTypeURL(msg),
+			)
+		}
+	}
+	return nil
+}
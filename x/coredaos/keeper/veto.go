@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkgovtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+)
+
+// GetVetoDelay returns the delay between the submission of a veto and its
+// execution. A nil veto delay is treated as no delay.
+func (k Keeper) GetVetoDelay(ctx context.Context) time.Duration {
+	params := k.GetParams(ctx)
+	if params.VetoDelay == nil {
+		return 0
+	}
+	return *params.VetoDelay
+}
+
+// SuspendProposal removes the proposal from the x/gov active proposals queue,
+// so that it is not tallied nor executed while it has a pending veto.
+func (k Keeper) SuspendProposal(ctx context.Context, proposalID uint64) error {
+	proposal, err := k.govKeeper.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	if proposal.VotingEndTime == nil {
+		return fmt.Errorf("proposal %d has no voting end time", proposalID)
+	}
+	return k.govKeeper.ActiveProposalsQueue.Remove(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id))
+}
+
+// resumeProposal puts the proposal back in the x/gov active proposals queue
+// once its pending veto is withdrawn. If the voting period of the proposal has
+// already ended, it is tallied in the next x/gov end blocker.
+func (k Keeper) resumeProposal(ctx context.Context, proposal govv1.Proposal) error {
+	return k.govKeeper.ActiveProposalsQueue.Set(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id), proposal.Id)
+}
+
+// ProcessPendingVetoes executes the pending vetoes whose execution time has
+// been reached, and keeps the other vetoed proposals out of the x/gov active
+// proposals queue.
+func (k Keeper) ProcessPendingVetoes(ctx sdk.Context) error {
+	logger := k.Logger(ctx)
+
+	var vetoes []types.PendingVeto
+	err := k.PendingVetoes.Walk(ctx, nil, func(_ uint64, veto types.PendingVeto) (bool, error) {
+		vetoes = append(vetoes, veto)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, veto := range vetoes {
+		proposal, err := k.govKeeper.Proposals.Get(ctx, veto.ProposalId)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if err != nil || proposal.Status != govv1.StatusVotingPeriod {
+			// The proposal was removed (e.g. canceled) while the veto was
+			// pending, there is nothing left to veto.
+			logger.Info(
+				"dropping pending veto of proposal no longer in voting period",
+				"proposal", veto.ProposalId,
+			)
+			if err := k.PendingVetoes.Remove(ctx, veto.ProposalId); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if ctx.BlockTime().Before(veto.ExecutionTime) {
+			// The x/gov end blocker may have put the proposal back in the
+			// active proposals queue, e.g. when its voting period is extended
+			// by a quorum check.
+			if err := k.SuspendProposal(ctx, proposal.Id); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := k.PendingVetoes.Remove(ctx, veto.ProposalId); err != nil {
+			return err
+		}
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.executeVeto(cacheCtx, proposal, veto.Vetoer, veto.BurnDeposit); err != nil {
+			logger.Error(
+				"failed to execute veto, resuming proposal",
+				"proposal", proposal.Id,
+				"error", err,
+			)
+			if err := k.resumeProposal(ctx, proposal); err != nil {
+				return err
+			}
+			continue
+		}
+		writeCache()
+	}
+	return nil
+}
+
+// executeVeto rejects the proposal, burning or refunding its deposits and
+// deleting its votes.
+func (k Keeper) executeVeto(ctx sdk.Context, proposal govv1.Proposal, vetoer string, burnDeposit bool) error {
+	// follows the same logic as in x/gov/abci.go for rejected proposals
+	if burnDeposit {
+		if err := k.govKeeper.DeleteAndBurnDeposits(ctx, proposal.Id); err != nil {
+			return errorsmod.Wrapf(err, "error deleting and burning deposits")
+		}
+	} else {
+		if err := k.govKeeper.RefundAndDeleteDeposits(ctx, proposal.Id); err != nil {
+			return errorsmod.Wrapf(err, "error refunding and deleting deposits")
+		}
+	}
+	proposal.Status = govv1.StatusVetoed
+
+	// Since the proposal is vetoed, we set the final tally result to an empty tally
+	// and the voting period ends immediately
+	emptyTally := govv1.EmptyTallyResult()
+	proposal.FinalTallyResult = &emptyTally
+	origEndTime := proposal.VotingEndTime
+	blockTime := ctx.BlockTime()
+	proposal.VotingEndTime = &blockTime
+
+	if err := k.govKeeper.SetProposal(ctx, proposal); err != nil {
+		return errorsmod.Wrapf(err, "error setting proposal")
+	}
+	// Delete all votes for the proposal. Votes are stored as
+	// collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.Vote].
+	if err := k.govKeeper.Votes.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)); err != nil {
+		return errorsmod.Wrapf(err, "error deleting votes")
+	}
+	// The vote commitments would never be revealed
+	if err := k.commitRevealKeeper.ClearCommitRevealVoting(ctx, proposal.Id); err != nil {
+		return errorsmod.Wrapf(err, "error clearing commit-reveal voting")
+	}
+	// The proposal is normally already out of the queue since the veto was
+	// submitted, removing it again is a no-op.
+	if err := k.govKeeper.ActiveProposalsQueue.Remove(ctx, collections.Join(*origEndTime, proposal.Id)); err != nil {
+		return errorsmod.Wrapf(err, "error removing proposal from active proposal queue")
+	}
+	if err := k.QuorumExtensionExemptions.Remove(ctx, proposal.Id); err != nil {
+		return errorsmod.Wrapf(err, "error removing quorum extension exemption")
+	}
+
+	k.govKeeper.UpdateMinInitialDeposit(ctx, true)
+	k.govKeeper.UpdateMinDeposit(ctx, true)
+
+	k.Logger(ctx).Info(
+		"proposal vetoed",
+		"proposal", proposal.Id,
+		"authority", vetoer,
+	)
+
+	// Emit event for proposal veto
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVetoProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeySigner, vetoer),
+			sdk.NewAttribute(sdkgovtypes.AttributeKeyProposalResult, types.AttributeValueProposalVetoed),
+		),
+	})
+
+	return nil
+}
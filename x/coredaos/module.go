@@ -28,14 +28,15 @@ import (
 )
 
 // ConsensusVersion is the x/coredaos module's consensus version identifier.
-const ConsensusVersion = 1
+const ConsensusVersion = 3
 
 var (
 	_ module.AppModuleBasic = AppModuleBasic{}
 	_ module.HasServices    = AppModule{}
 	_ module.HasGenesis     = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -134,10 +135,20 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(&am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the module's genesis initialization. It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) {
@@ -154,6 +165,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// EndBlock executes all ABCI EndBlock logic respective to the coredaos module.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return EndBlocker(sdk.UnwrapSDKContext(ctx), am.keeper)
+}
+
 // ConsensusVersion is a sequence number for state-breaking change of the module. It should be incremented on each consensus-breaking change introduced by the module. To avoid wrong/empty versions, the initial version should be set to 1
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
@@ -179,8 +195,10 @@ type Inputs struct {
 
 	GovKeeper     *govkeeper.Keeper
 	StakingKeeper types.StakingKeeper
+	EpochsKeeper  types.EpochsKeeper
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
+	DistrKeeper   types.DistributionKeeper
 }
 
 type Outputs struct {
@@ -210,6 +228,9 @@ func ProvideModule(in Inputs) Outputs {
 		authority.String(),
 		in.GovKeeper,
 		in.StakingKeeper,
+		in.EpochsKeeper,
+		in.BankKeeper,
+		in.DistrKeeper,
 	)
 
 	m := NewAppModule(in.Cdc, *Keeper, in.GovKeeper, in.StakingKeeper, in.AccountKeeper, in.BankKeeper)
@@ -1,9 +1,16 @@
 package coredaos
 
 import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	sdksimulation "github.com/cosmos/cosmos-sdk/x/simulation"
 
+	"github.com/atomone-hub/atomone/x/coredaos/keeper"
 	"github.com/atomone-hub/atomone/x/coredaos/simulation"
 	"github.com/atomone-hub/atomone/x/coredaos/types"
 )
@@ -18,12 +25,35 @@ func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
 	sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
 }
 
-func (AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
-	return simulation.ProposalMsgs()
+func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs(am.keeper)
 }
 
 // WeightedOperations returns the all the module operations with their respective weights.
+// The coredaos invariants are asserted after each operation, since the app
+// doesn't run the registered invariants. As the operations are interleaved
+// with the gov operations, this also covers the gov proposals lifecycle.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
-	return simulation.WeightedOperations(simState.AppParams, simState.Cdc,
+	operations := simulation.WeightedOperations(simState.AppParams, simState.Cdc,
 		am.govKeeper, am.stakingKeeper, am.accountKeeper, am.bankKeeper, am.keeper)
+	for i, op := range operations {
+		operations[i] = sdksimulation.NewWeightedOperation(op.Weight(), assertInvariants(op.Op(), am.keeper))
+	}
+	return operations
+}
+
+// assertInvariants returns an operation that runs op and then returns an error
+// if one of the coredaos invariants is broken.
+func assertInvariants(op simtypes.Operation, k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		opMsg, futureOps, err := op(r, app, ctx, accs, chainID)
+		if err != nil {
+			return opMsg, futureOps, err
+		}
+		if msg, broken := keeper.AllInvariants(k)(ctx); broken {
+			return opMsg, futureOps, fmt.Errorf("invariant broken after %s: %s", opMsg.Name, msg)
+		}
+		return opMsg, futureOps, nil
+	}
 }
@@ -15,6 +15,7 @@ import (
 const (
 	VotingPeriodExtensionsLimit   = "voting_period_extensions_limit"
 	VotingPeriodExtensionDuration = "voting_period_extension_duration"
+	VetoDelay                     = "veto_delay"
 	SteeringDaoAddress            = "steering_dao_address"
 	OversightDaoAddress           = "steering_dao_address"
 	DAOAccountsNumber             = 10
@@ -39,6 +40,12 @@ func GenVotingPeriodExtensionDuration(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 60*60*6)) * time.Second
 }
 
+// GenVetoDelay generates a random veto delay
+// The delay is between 0 and 6 hours
+func GenVetoDelay(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60*6)) * time.Second
+}
+
 // GenSteeringDaoAddress picks a random address to be used for a DAO
 // with a probability of 50%, otherwise returns an empty string account (meaning that
 // the Dao is disabled
@@ -69,6 +76,9 @@ func GenOversightDaoAddress(r *rand.Rand, simState *module.SimulationState) stri
 
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
+	// forget the DAO accounts of a previous simulation
+	SteeringDaoAccount, OversightDaoAccount = simulation.Account{}, simulation.Account{}
+
 	var votingPeriodExtensionsLimit uint32
 	simState.AppParams.GetOrGenerate(
 		VotingPeriodExtensionsLimit, &votingPeriodExtensionsLimit, simState.Rand,
@@ -80,6 +90,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { votingPeriodExtensionDuration = GenVotingPeriodExtensionDuration(r) },
 	)
 
+	var vetoDelay time.Duration
+	simState.AppParams.GetOrGenerate(
+		VetoDelay, &vetoDelay, simState.Rand,
+		func(r *rand.Rand) { vetoDelay = GenVetoDelay(r) },
+	)
+
 	var steeringDaoAddress string
 	simState.AppParams.GetOrGenerate(
 		SteeringDaoAddress, &steeringDaoAddress, simState.Rand,
@@ -98,6 +114,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 			oversightDaoAddress,
 			votingPeriodExtensionsLimit,
 			votingPeriodExtensionDuration,
+			vetoDelay,
 		),
 	)
 	bz, err := json.MarshalIndent(&coredaosGenesis, "", " ")
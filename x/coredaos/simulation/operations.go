@@ -1,6 +1,7 @@
 package simulation
 
 import (
+	"fmt"
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -16,14 +17,13 @@ import (
 	"github.com/atomone-hub/atomone/x/coredaos/types"
 )
 
-var initialProposalID = uint64(100000000000000)
-
 // CoreDaos message types
 var (
 	TypeMsgAnnotateProposal   = sdk.MsgTypeURL(&types.MsgAnnotateProposal{})
 	TypeMsgEndorseProposal    = sdk.MsgTypeURL(&types.MsgEndorseProposal{})
 	TypeMsgExtendVotingPeriod = sdk.MsgTypeURL(&types.MsgExtendVotingPeriod{})
 	TypeMsgVetoProposal       = sdk.MsgTypeURL(&types.MsgVetoProposal{})
+	TypeMsgWithdrawVeto       = sdk.MsgTypeURL(&types.MsgWithdrawVeto{})
 )
 
 // Simulation operation weights for CoreDaos module
@@ -38,6 +38,8 @@ const (
 	DefaultWeightMsgExtendVotingPeriod = 100
 	OpWeightMsgVetoProposal            = "op_weight_msg_veto_proposal"
 	DefaultWeightMsgVetoProposal       = 100
+	OpWeightMsgWithdrawVeto            = "op_weight_msg_withdraw_veto"
+	DefaultWeightMsgWithdrawVeto       = 50
 )
 
 // WeightedOperations returns all the operations from the CoreDaos module with their respective weights
@@ -70,6 +72,13 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, gk *g
 		},
 	)
 
+	var weightMsgWithdrawVeto int
+	appParams.GetOrGenerate(OpWeightMsgWithdrawVeto, &weightMsgWithdrawVeto, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawVeto = DefaultWeightMsgWithdrawVeto
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgAnnotateProposal,
@@ -87,6 +96,10 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, gk *g
 			weightMsgVetoProposal,
 			SimulateMsgVetoProposal(gk, sk, ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdrawVeto,
+			SimulateMsgWithdrawVeto(gk, sk, ak, bk, k),
+		),
 	}
 }
 
@@ -97,7 +110,11 @@ func SimulateMsgAnnotateProposal(gk *govkeeper.Keeper, sk types.StakingKeeper, a
 		if params.SteeringDaoAddress == "" {
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAnnotateProposal, "Annotations are disabled"), nil, nil
 		}
-		ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, SteeringDaoAccount.Address))
+		steeringDaoAccount, ok := daoAccount(params, types.CoreDaoSteering)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAnnotateProposal, "unknown Steering DAO account"), nil, nil
+		}
+		ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, steeringDaoAccount.Address))
 		proposal, ok := randomProposal(r, gk, ctx)
 		if !ok {
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAnnotateProposal, "unable to generate proposalID"), nil, nil
@@ -108,10 +125,13 @@ func SimulateMsgAnnotateProposal(gk *govkeeper.Keeper, sk types.StakingKeeper, a
 		}
 
 		msg := types.NewMsgAnnotateProposal(
-			SteeringDaoAccount.Address,
+			steeringDaoAccount.Address,
 			proposal.GetId(),
 			simtypes.RandStringOfLength(r, 100),
 		)
+		if err := checkDelivery(app, ctx, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAnnotateProposal, err.Error()), nil, nil
+		}
 		txCtx := simulation.OperationInput{
 			R:             r,
 			App:           app,
@@ -119,7 +139,7 @@ func SimulateMsgAnnotateProposal(gk *govkeeper.Keeper, sk types.StakingKeeper, a
 			Cdc:           nil,
 			Msg:           msg,
 			Context:       ctx,
-			SimAccount:    SteeringDaoAccount,
+			SimAccount:    steeringDaoAccount,
 			AccountKeeper: ak,
 			Bankkeeper:    bk,
 			ModuleName:    types.ModuleName,
@@ -136,8 +156,12 @@ func SimulateMsgEndorseProposal(gk *govkeeper.Keeper, sk types.StakingKeeper, ak
 		if params.SteeringDaoAddress == "" {
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgEndorseProposal, "Endorsements are disabled"), nil, nil
 		}
+		steeringDaoAccount, ok := daoAccount(params, types.CoreDaoSteering)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgEndorseProposal, "unknown Steering DAO account"), nil, nil
+		}
 
-		ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, SteeringDaoAccount.Address))
+		ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, steeringDaoAccount.Address))
 		proposal, ok := randomProposal(r, gk, ctx)
 		if !ok {
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgEndorseProposal, "unable to generate proposalID"), nil, nil
@@ -148,9 +172,12 @@ func SimulateMsgEndorseProposal(gk *govkeeper.Keeper, sk types.StakingKeeper, ak
 		}
 
 		msg := types.NewMsgEndorseProposal(
-			SteeringDaoAccount.Address,
+			steeringDaoAccount.Address,
 			proposal.GetId(),
 		)
+		if err := checkDelivery(app, ctx, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgEndorseProposal, err.Error()), nil, nil
+		}
 		txCtx := simulation.OperationInput{
 			R:             r,
 			App:           app,
@@ -158,7 +185,7 @@ func SimulateMsgEndorseProposal(gk *govkeeper.Keeper, sk types.StakingKeeper, ak
 			Cdc:           nil,
 			Msg:           msg,
 			Context:       ctx,
-			SimAccount:    SteeringDaoAccount,
+			SimAccount:    steeringDaoAccount,
 			AccountKeeper: ak,
 			Bankkeeper:    bk,
 			ModuleName:    types.ModuleName,
@@ -175,20 +202,16 @@ func SimulateMsgExtendVotingPeriod(gk *govkeeper.Keeper, sk types.StakingKeeper,
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExtendVotingPeriod, "Voting period extensions are disabled"), nil, nil
 		}
 
-		var fromAccount simtypes.Account
-		if params.SteeringDaoAddress != "" && params.OversightDaoAddress != "" {
-			randInt := r.Intn(2)
-			if randInt%2 == 0 {
-				fromAccount = SteeringDaoAccount
-			} else {
-				fromAccount = OversightDaoAccount
-			}
-		} else {
-			if params.SteeringDaoAddress != "" {
-				fromAccount = SteeringDaoAccount
-			} else {
-				fromAccount = OversightDaoAccount
-			}
+		dao := types.CoreDaoSteering
+		switch {
+		case params.SteeringDaoAddress == "":
+			dao = types.CoreDaoOversight
+		case params.OversightDaoAddress != "" && r.Intn(2) == 1:
+			dao = types.CoreDaoOversight
+		}
+		fromAccount, ok := daoAccount(params, dao)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExtendVotingPeriod, fmt.Sprintf("unknown %s account", dao.DisplayName())), nil, nil
 		}
 
 		ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, fromAccount.Address))
@@ -197,14 +220,17 @@ func SimulateMsgExtendVotingPeriod(gk *govkeeper.Keeper, sk types.StakingKeeper,
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExtendVotingPeriod, "unable to generate proposal"), nil, nil
 		}
 
-		if proposal.TimesVotingPeriodExtended >= params.VotingPeriodExtensionsLimit {
-			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExtendVotingPeriod, "proposal voting period extended too many times"), nil, nil
+		if has, _ := k.PendingVetoes.Has(ctx, proposal.Id); has {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExtendVotingPeriod, "proposal has a pending veto"), nil, nil
 		}
 
 		msg := types.NewMsgExtendVotingPeriod(
 			fromAccount.Address,
 			proposal.GetId(),
 		)
+		if err := checkDelivery(app, ctx, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExtendVotingPeriod, err.Error()), nil, nil
+		}
 		txCtx := simulation.OperationInput{
 			R:             r,
 			App:           app,
@@ -227,14 +253,21 @@ func SimulateMsgVetoProposal(gk *govkeeper.Keeper, sk types.StakingKeeper, ak ty
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		params := k.GetParams(ctx)
 		if params.OversightDaoAddress == "" {
-			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVetoProposal, "Voting period extensions are disabled"), nil, nil
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVetoProposal, "Vetoes are disabled"), nil, nil
+		}
+		oversightDaoAccount, ok := daoAccount(params, types.CoreDaoOversight)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVetoProposal, "unknown Oversight DAO account"), nil, nil
 		}
 
-		ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, OversightDaoAccount.Address))
+		ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, oversightDaoAccount.Address))
 		proposal, ok := randomProposal(r, gk, ctx)
 		if !ok {
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVetoProposal, "unable to generate proposal"), nil, nil
 		}
+		if has, _ := k.PendingVetoes.Has(ctx, proposal.Id); has {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVetoProposal, "proposal already has a pending veto"), nil, nil
+		}
 
 		for _, msg := range proposal.Messages {
 			if msg.GetTypeUrl() == sdk.MsgTypeURL(&types.MsgUpdateParams{}) {
@@ -258,10 +291,13 @@ func SimulateMsgVetoProposal(gk *govkeeper.Keeper, sk types.StakingKeeper, ak ty
 			burnDeposit = false
 		}
 		msg := types.NewMsgVetoProposal(
-			OversightDaoAccount.Address,
+			oversightDaoAccount.Address,
 			proposal.GetId(),
 			burnDeposit,
 		)
+		if err := checkDelivery(app, ctx, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVetoProposal, err.Error()), nil, nil
+		}
 
 		txCtx := simulation.OperationInput{
 			R:             r,
@@ -270,7 +306,7 @@ func SimulateMsgVetoProposal(gk *govkeeper.Keeper, sk types.StakingKeeper, ak ty
 			Cdc:           nil,
 			Msg:           msg,
 			Context:       ctx,
-			SimAccount:    OversightDaoAccount,
+			SimAccount:    oversightDaoAccount,
 			AccountKeeper: ak,
 			Bankkeeper:    bk,
 			ModuleName:    types.ModuleName,
@@ -280,28 +316,104 @@ func SimulateMsgVetoProposal(gk *govkeeper.Keeper, sk types.StakingKeeper, ak ty
 	}
 }
 
-// Pick a random proposal between the initial proposal ID
-// (defined in gov GenesisState) and the latest proposal ID
-// that has voting period status
-// It does not provide a default ID.
-func randomProposal(r *rand.Rand, k *govkeeper.Keeper, ctx sdk.Context) (proposal govv1.Proposal, found bool) {
-	proposalID, _ := k.ProposalID.Peek(ctx)
+func SimulateMsgWithdrawVeto(gk *govkeeper.Keeper, sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParams(ctx)
+		if params.OversightDaoAddress == "" {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgWithdrawVeto, "Vetoes are disabled"), nil, nil
+		}
+		oversightDaoAccount, ok := daoAccount(params, types.CoreDaoOversight)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgWithdrawVeto, "unknown Oversight DAO account"), nil, nil
+		}
 
-	switch {
-	case proposalID > initialProposalID:
-		// select a random ID between [initialProposalID, proposalID]
-		proposalID = uint64(simtypes.RandIntBetween(r, int(initialProposalID), int(proposalID)))
+		ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, oversightDaoAccount.Address))
+		veto, ok := randomPendingVeto(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgWithdrawVeto, "no pending veto"), nil, nil
+		}
+
+		msg := types.NewMsgWithdrawVeto(
+			oversightDaoAccount.Address,
+			veto.ProposalId,
+		)
+		if err := checkDelivery(app, ctx, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgWithdrawVeto, err.Error()), nil, nil
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    oversightDaoAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
 
-	default:
-		// This is called on the first call to this funcion
-		// in order to update the global variable
-		initialProposalID = proposalID
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// daoAccount returns the simulation account of dao, or false if dao is
+// disabled or its address is not known by the simulation.
+func daoAccount(params types.Params, dao types.CoreDao) (simtypes.Account, bool) {
+	account := SteeringDaoAccount
+	if dao == types.CoreDaoOversight {
+		account = OversightDaoAccount
 	}
+	address := params.DaoAddress(dao)
+	if address == "" || account.Address == nil || address != account.Address.String() {
+		return simtypes.Account{}, false
+	}
+	return account, true
+}
 
-	proposal, err := k.Proposals.Get(ctx, proposalID)
-	if err != nil || proposal.Status != govv1.StatusVotingPeriod {
+// checkDelivery runs msg on a cached context, so that the operations skip the
+// messages rejected by the keeper, e.g. because the Core DAO is suspended or
+// recused, instead of failing the simulation.
+func checkDelivery(app *baseapp.BaseApp, ctx sdk.Context, msg sdk.Msg) error {
+	handler := app.MsgServiceRouter().Handler(msg)
+	if handler == nil {
+		return fmt.Errorf("no handler for %s", sdk.MsgTypeURL(msg))
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := handler(cacheCtx, msg)
+	return err
+}
+
+// randomProposal picks a random proposal in voting period among the live
+// proposals created by the gov simulation.
+// It does not provide a default proposal.
+func randomProposal(r *rand.Rand, k *govkeeper.Keeper, ctx sdk.Context) (proposal govv1.Proposal, found bool) {
+	var proposals []govv1.Proposal
+	err := k.Proposals.Walk(ctx, nil, func(_ uint64, proposal govv1.Proposal) (bool, error) {
+		if proposal.Status == govv1.StatusVotingPeriod {
+			proposals = append(proposals, proposal)
+		}
+		return false, nil
+	})
+	if err != nil || len(proposals) == 0 {
 		return proposal, false
 	}
 
-	return proposal, true
+	return proposals[r.Intn(len(proposals))], true
+}
+
+// randomPendingVeto picks a random pending veto.
+func randomPendingVeto(r *rand.Rand, k keeper.Keeper, ctx sdk.Context) (veto types.PendingVeto, found bool) {
+	var vetoes []types.PendingVeto
+	err := k.PendingVetoes.Walk(ctx, nil, func(_ uint64, veto types.PendingVeto) (bool, error) {
+		vetoes = append(vetoes, veto)
+		return false, nil
+	})
+	if err != nil || len(vetoes) == 0 {
+		return veto, false
+	}
+
+	return vetoes[r.Intn(len(vetoes))], true
 }
@@ -9,6 +9,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
+	"github.com/atomone-hub/atomone/x/coredaos/keeper"
 	"github.com/atomone-hub/atomone/x/coredaos/types"
 )
 
@@ -20,45 +21,57 @@ const (
 )
 
 // ProposalMsgs defines the module weighted proposals' contents
-func ProposalMsgs() []simtypes.WeightedProposalMsg {
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
 	return []simtypes.WeightedProposalMsg{
 		simulation.NewWeightedProposalMsg(
 			OpWeightMsgUpdateParams,
 			DefaultWeightMsgUpdateParams,
-			SimulateMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
 		),
 	}
 }
 
-// SimulateMsgUpdateParams returns a random MsgUpdateParams
-func SimulateMsgUpdateParams(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
-	// use the default gov module account address as authority
-	var authority sdk.AccAddress = address.Module("gov")
+// SimulateMsgUpdateParams returns a random MsgUpdateParams.
+// The voting period extension limits are kept, since lowering them would leave
+// the proposals already extended above the new limits.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		// use the default gov module account address as authority
+		var authority sdk.AccAddress = address.Module("gov")
 
-	params := types.DefaultParams()
+		params := k.GetParams(ctx)
 
-	params.VotingPeriodExtensionsLimit = uint32(simtypes.RandIntBetween(r, 0, 10))                       // Random limit between 0 and 9
-	votingPeriodExtensionDuration := time.Duration(simtypes.RandIntBetween(r, 1, 60*60*6)) * time.Second // Random duration between 1 second and 6 hours
-	params.VotingPeriodExtensionDuration = &votingPeriodExtensionDuration
+		votingPeriodExtensionDuration := time.Duration(simtypes.RandIntBetween(r, 1, 60*60*6)) * time.Second // Random duration between 1 second and 6 hours
+		params.VotingPeriodExtensionDuration = &votingPeriodExtensionDuration
 
-	randInt := r.Intn(2)
-	if randInt%2 == 0 {
-		SteeringDaoAccount := simtypes.RandomAccounts(r, 1)[0]
-		params.SteeringDaoAddress = SteeringDaoAccount.Address.String()
-	} else {
-		params.SteeringDaoAddress = ""
-	}
+		randInt := r.Intn(2)
+		if randInt%2 == 0 {
+			params.SteeringDaoAddress = knownDaoAccount(r, &SteeringDaoAccount).Address.String()
+		} else {
+			params.SteeringDaoAddress = ""
+		}
+
+		randInt = r.Intn(2)
+		if randInt%2 == 0 {
+			params.OversightDaoAddress = knownDaoAccount(r, &OversightDaoAccount).Address.String()
+		} else {
+			params.OversightDaoAddress = ""
+		}
 
-	randInt = r.Intn(2)
-	if randInt%2 == 0 {
-		OversightDaoAccount := simtypes.RandomAccounts(r, 1)[0]
-		params.OversightDaoAddress = OversightDaoAccount.Address.String()
-	} else {
-		params.OversightDaoAddress = ""
+		return &types.MsgUpdateParams{
+			Authority: authority.String(),
+			Params:    params,
+		}
 	}
+}
 
-	return &types.MsgUpdateParams{
-		Authority: authority.String(),
-		Params:    params,
+// knownDaoAccount returns account, after generating it if the Core DAO was
+// disabled until now, so that the operations can sign the messages of the Core
+// DAOs enabled by governance.
+func knownDaoAccount(r *rand.Rand, account *simtypes.Account) simtypes.Account {
+	generated := simtypes.RandomAccounts(r, 1)[0]
+	if account.Address == nil {
+		*account = generated
 	}
+	return *account
 }
@@ -10,6 +10,8 @@ import (
 
 	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
+	types0 "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/epochs/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -36,6 +38,21 @@ func (m *MockStakingKeeper) EXPECT() *MockStakingKeeperMockRecorder {
 	return m.recorder
 }
 
+// BondDenom mocks base method.
+func (m *MockStakingKeeper) BondDenom(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BondDenom", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BondDenom indicates an expected call of BondDenom.
+func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), ctx)
+}
+
 // GetDelegatorBonded mocks base method.
 func (m *MockStakingKeeper) GetDelegatorBonded(ctx context.Context, delegator types.AccAddress) (math.Int, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +83,132 @@ func (mr *MockStakingKeeperMockRecorder) GetDelegatorUnbonding(ctx, delegator in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatorUnbonding", reflect.TypeOf((*MockStakingKeeper)(nil).GetDelegatorUnbonding), ctx, delegator)
 }
 
+// MockEpochsKeeper is a mock of EpochsKeeper interface.
+type MockEpochsKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockEpochsKeeperMockRecorder
+}
+
+// MockEpochsKeeperMockRecorder is the mock recorder for MockEpochsKeeper.
+type MockEpochsKeeperMockRecorder struct {
+	mock *MockEpochsKeeper
+}
+
+// NewMockEpochsKeeper creates a new mock instance.
+func NewMockEpochsKeeper(ctrl *gomock.Controller) *MockEpochsKeeper {
+	mock := &MockEpochsKeeper{ctrl: ctrl}
+	mock.recorder = &MockEpochsKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEpochsKeeper) EXPECT() *MockEpochsKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetEpochInfo mocks base method.
+func (m *MockEpochsKeeper) GetEpochInfo(ctx context.Context, identifier string) (types1.EpochInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEpochInfo", ctx, identifier)
+	ret0, _ := ret[0].(types1.EpochInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEpochInfo indicates an expected call of GetEpochInfo.
+func (mr *MockEpochsKeeperMockRecorder) GetEpochInfo(ctx, identifier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpochInfo", reflect.TypeOf((*MockEpochsKeeper)(nil).GetEpochInfo), ctx, identifier)
+}
+
+// MockQuorumCheckKeeper is a mock of QuorumCheckKeeper interface.
+type MockQuorumCheckKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuorumCheckKeeperMockRecorder
+}
+
+// MockQuorumCheckKeeperMockRecorder is the mock recorder for MockQuorumCheckKeeper.
+type MockQuorumCheckKeeperMockRecorder struct {
+	mock *MockQuorumCheckKeeper
+}
+
+// NewMockQuorumCheckKeeper creates a new mock instance.
+func NewMockQuorumCheckKeeper(ctrl *gomock.Controller) *MockQuorumCheckKeeper {
+	mock := &MockQuorumCheckKeeper{ctrl: ctrl}
+	mock.recorder = &MockQuorumCheckKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuorumCheckKeeper) EXPECT() *MockQuorumCheckKeeperMockRecorder {
+	return m.recorder
+}
+
+// RemoveFromQuorumCheckQueue mocks base method.
+func (m *MockQuorumCheckKeeper) RemoveFromQuorumCheckQueue(ctx context.Context, proposalID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromQuorumCheckQueue", ctx, proposalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromQuorumCheckQueue indicates an expected call of RemoveFromQuorumCheckQueue.
+func (mr *MockQuorumCheckKeeperMockRecorder) RemoveFromQuorumCheckQueue(ctx, proposalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromQuorumCheckQueue", reflect.TypeOf((*MockQuorumCheckKeeper)(nil).RemoveFromQuorumCheckQueue), ctx, proposalID)
+}
+
+// MockCommitRevealKeeper is a mock of CommitRevealKeeper interface.
+type MockCommitRevealKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommitRevealKeeperMockRecorder
+}
+
+// MockCommitRevealKeeperMockRecorder is the mock recorder for MockCommitRevealKeeper.
+type MockCommitRevealKeeperMockRecorder struct {
+	mock *MockCommitRevealKeeper
+}
+
+// NewMockCommitRevealKeeper creates a new mock instance.
+func NewMockCommitRevealKeeper(ctrl *gomock.Controller) *MockCommitRevealKeeper {
+	mock := &MockCommitRevealKeeper{ctrl: ctrl}
+	mock.recorder = &MockCommitRevealKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommitRevealKeeper) EXPECT() *MockCommitRevealKeeperMockRecorder {
+	return m.recorder
+}
+
+// CheckVotingPeriodExtension mocks base method.
+func (m *MockCommitRevealKeeper) CheckVotingPeriodExtension(ctx context.Context, proposalID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckVotingPeriodExtension", ctx, proposalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckVotingPeriodExtension indicates an expected call of CheckVotingPeriodExtension.
+func (mr *MockCommitRevealKeeperMockRecorder) CheckVotingPeriodExtension(ctx, proposalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVotingPeriodExtension", reflect.TypeOf((*MockCommitRevealKeeper)(nil).CheckVotingPeriodExtension), ctx, proposalID)
+}
+
+// ClearCommitRevealVoting mocks base method.
+func (m *MockCommitRevealKeeper) ClearCommitRevealVoting(ctx context.Context, proposalID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearCommitRevealVoting", ctx, proposalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearCommitRevealVoting indicates an expected call of ClearCommitRevealVoting.
+func (mr *MockCommitRevealKeeperMockRecorder) ClearCommitRevealVoting(ctx, proposalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCommitRevealVoting", reflect.TypeOf((*MockCommitRevealKeeper)(nil).ClearCommitRevealVoting), ctx, proposalID)
+}
+
 // MockAccountKeeper is a mock of AccountKeeper interface.
 type MockAccountKeeper struct {
 	ctrl     *gomock.Controller
@@ -152,6 +295,34 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// SendCoinsFromModuleToAccount mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types.AccAddress, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToAccount indicates an expected call of SendCoinsFromModuleToAccount.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
+}
+
+// SendCoinsFromModuleToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToModule", ctx, senderModule, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToModule indicates an expected call of SendCoinsFromModuleToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
+}
+
 // SpendableCoins mocks base method.
 func (m *MockBankKeeper) SpendableCoins(ctx context.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -165,3 +336,55 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
+
+// MockDistributionKeeper is a mock of DistributionKeeper interface.
+type MockDistributionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDistributionKeeperMockRecorder
+}
+
+// MockDistributionKeeperMockRecorder is the mock recorder for MockDistributionKeeper.
+type MockDistributionKeeperMockRecorder struct {
+	mock *MockDistributionKeeper
+}
+
+// NewMockDistributionKeeper creates a new mock instance.
+func NewMockDistributionKeeper(ctrl *gomock.Controller) *MockDistributionKeeper {
+	mock := &MockDistributionKeeper{ctrl: ctrl}
+	mock.recorder = &MockDistributionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetFeePool mocks base method.
+func (m *MockDistributionKeeper) GetFeePool(ctx context.Context) (types0.FeePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeePool", ctx)
+	ret0, _ := ret[0].(types0.FeePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeePool indicates an expected call of GetFeePool.
+func (mr *MockDistributionKeeperMockRecorder) GetFeePool(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeePool", reflect.TypeOf((*MockDistributionKeeper)(nil).GetFeePool), ctx)
+}
+
+// SetFeePool mocks base method.
+func (m *MockDistributionKeeper) SetFeePool(ctx context.Context, feePool types0.FeePool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeePool", ctx, feePool)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFeePool indicates an expected call of SetFeePool.
+func (mr *MockDistributionKeeperMockRecorder) SetFeePool(ctx, feePool interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeePool", reflect.TypeOf((*MockDistributionKeeper)(nil).SetFeePool), ctx, feePool)
+}
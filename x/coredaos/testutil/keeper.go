@@ -24,6 +24,9 @@ import (
 
 type Mocks struct {
 	StakingKeeper *MockStakingKeeper
+	EpochsKeeper  *MockEpochsKeeper
+	BankKeeper    *MockBankKeeper
+	DistrKeeper   *MockDistributionKeeper
 }
 
 func SetupMsgServer(t *testing.T) (types.MsgServer, *keeper.Keeper, Mocks, sdk.Context) {
@@ -41,6 +44,9 @@ func SetupCoredaosKeeper(t *testing.T) (
 	ctrl := gomock.NewController(t)
 	m := Mocks{
 		StakingKeeper: NewMockStakingKeeper(ctrl),
+		EpochsKeeper:  NewMockEpochsKeeper(ctrl),
+		BankKeeper:    NewMockBankKeeper(ctrl),
+		DistrKeeper:   NewMockDistributionKeeper(ctrl),
 	}
 
 	key := storetypes.NewKVStoreKey(types.StoreKey)
@@ -54,5 +60,5 @@ func SetupCoredaosKeeper(t *testing.T) (
 	// The gov keeper is not exercised by tests that use this lightweight
 	// harness (e.g. UpdateParams), so a nil gov keeper is sufficient here.
 	// Tests that interact with gov use the full app via helpers.Setup.
-	return keeper.NewKeeper(encCfg.Codec, storeService, authority, nil, m.StakingKeeper), m, ctx
+	return keeper.NewKeeper(encCfg.Codec, storeService, authority, nil, m.StakingKeeper, m.EpochsKeeper, m.BankKeeper, m.DistrKeeper), m, ctx
 }
@@ -13,13 +13,24 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgEndorseProposal{}, "atomone/v1/MsgEndorseProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgExtendVotingPeriod{}, "atomone/v1/MsgExtendVotingPeriod")
 	legacy.RegisterAminoMsg(cdc, &MsgVetoProposal{}, "atomone/v1/MsgVetoProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawVeto{}, "atomone/coredaos/v1/MsgWithdrawVeto")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitSteeringProposal{}, "atomone/v1/MsgSubmitSteeringProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgRecuseFromProposal{}, "atomone/v1/MsgRecuseFromProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgDaoSpend{}, "atomone/coredaos/v1/MsgDaoSpend")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitDaoAction{}, "atomone/coredaos/v1/MsgSubmitDaoAction")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveAction{}, "atomone/coredaos/v1/MsgApproveAction")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateDaoMembers{}, "atomone/coredaos/v1/MsgUpdateDaoMembers")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateDaoBudget{}, "atomone/coredaos/v1/MsgUpdateDaoBudget")
+	legacy.RegisterAminoMsg(cdc, &MsgReconfirmDao{}, "atomone/coredaos/v1/MsgReconfirmDao")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "atomone/x/coredaos/v1/MsgUpdateParams")
 	cdc.RegisterConcrete(&Params{}, "atomone/coredaos/v1/Params", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
-		&MsgAnnotateProposal{}, &MsgEndorseProposal{}, &MsgExtendVotingPeriod{}, &MsgVetoProposal{}, &MsgUpdateParams{},
+		&MsgAnnotateProposal{}, &MsgEndorseProposal{}, &MsgExtendVotingPeriod{}, &MsgVetoProposal{}, &MsgWithdrawVeto{},
+		&MsgSubmitSteeringProposal{}, &MsgRecuseFromProposal{}, &MsgDaoSpend{}, &MsgSubmitDaoAction{}, &MsgApproveAction{}, &MsgUpdateDaoMembers{},
+		&MsgUpdateDaoBudget{}, &MsgReconfirmDao{}, &MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
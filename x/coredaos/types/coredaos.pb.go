@@ -6,10 +6,14 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -28,6 +32,183 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProposalKind defines the kind of a proposal, as classified by x/gov.
+type ProposalKind int32
+
+const (
+	// PROPOSAL_KIND_UNSPECIFIED defines an unspecified proposal kind.
+	ProposalKindUnspecified ProposalKind = 0
+	// PROPOSAL_KIND_ANY defines the proposals which are none of the other
+	// kinds, such as community pool spends or software upgrades.
+	ProposalKindAny ProposalKind = 1
+	// PROPOSAL_KIND_LAW defines the law proposals.
+	ProposalKindLaw ProposalKind = 2
+	// PROPOSAL_KIND_CONSTITUTION_AMENDMENT defines the constitution amendment
+	// proposals.
+	ProposalKindConstitutionAmendment ProposalKind = 3
+	// PROPOSAL_KIND_PARAM_CHANGE defines the proposals which change the params
+	// of a module, with its MsgUpdateParams or with a legacy param change
+	// proposal.
+	ProposalKindParamChange ProposalKind = 4
+	// PROPOSAL_KIND_TEXT defines the proposals without messages, or which only
+	// execute legacy text proposals.
+	ProposalKindText ProposalKind = 5
+)
+
+var ProposalKind_name = map[int32]string{
+	0: "PROPOSAL_KIND_UNSPECIFIED",
+	1: "PROPOSAL_KIND_ANY",
+	2: "PROPOSAL_KIND_LAW",
+	3: "PROPOSAL_KIND_CONSTITUTION_AMENDMENT",
+	4: "PROPOSAL_KIND_PARAM_CHANGE",
+	5: "PROPOSAL_KIND_TEXT",
+}
+
+var ProposalKind_value = map[string]int32{
+	"PROPOSAL_KIND_UNSPECIFIED":            0,
+	"PROPOSAL_KIND_ANY":                    1,
+	"PROPOSAL_KIND_LAW":                    2,
+	"PROPOSAL_KIND_CONSTITUTION_AMENDMENT": 3,
+	"PROPOSAL_KIND_PARAM_CHANGE":           4,
+	"PROPOSAL_KIND_TEXT":                   5,
+}
+
+func (x ProposalKind) String() string {
+	return proto.EnumName(ProposalKind_name, int32(x))
+}
+
+func (ProposalKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a0229a660a0bf4dd, []int{0}
+}
+
+// DaoActionType defines the type of an action taken by a Core DAO.
+type DaoActionType int32
+
+const (
+	// DAO_ACTION_TYPE_UNSPECIFIED defines an unspecified action.
+	DaoActionTypeUnspecified DaoActionType = 0
+	// DAO_ACTION_TYPE_ANNOTATE defines a proposal annotation.
+	DaoActionTypeAnnotate DaoActionType = 1
+	// DAO_ACTION_TYPE_ENDORSE defines a proposal endorsement.
+	DaoActionTypeEndorse DaoActionType = 2
+	// DAO_ACTION_TYPE_EXTEND_VOTING_PERIOD defines a proposal voting period
+	// extension.
+	DaoActionTypeExtendVotingPeriod DaoActionType = 3
+	// DAO_ACTION_TYPE_VETO defines a proposal veto.
+	DaoActionTypeVeto DaoActionType = 4
+	// DAO_ACTION_TYPE_WITHDRAW_VETO defines the withdrawal of a pending veto.
+	DaoActionTypeWithdrawVeto DaoActionType = 5
+	// DAO_ACTION_TYPE_SUBMIT_PROPOSAL defines the submission of a proposal by
+	// the Steering DAO.
+	DaoActionTypeSubmitProposal DaoActionType = 6
+	// DAO_ACTION_TYPE_RECUSE defines the recusal of a Core DAO from a
+	// proposal.
+	DaoActionTypeRecuse DaoActionType = 7
+	// DAO_ACTION_TYPE_SPEND defines a spend from the budget of a Core DAO.
+	DaoActionTypeSpend DaoActionType = 8
+)
+
+var DaoActionType_name = map[int32]string{
+	0: "DAO_ACTION_TYPE_UNSPECIFIED",
+	1: "DAO_ACTION_TYPE_ANNOTATE",
+	2: "DAO_ACTION_TYPE_ENDORSE",
+	3: "DAO_ACTION_TYPE_EXTEND_VOTING_PERIOD",
+	4: "DAO_ACTION_TYPE_VETO",
+	5: "DAO_ACTION_TYPE_WITHDRAW_VETO",
+	6: "DAO_ACTION_TYPE_SUBMIT_PROPOSAL",
+	7: "DAO_ACTION_TYPE_RECUSE",
+	8: "DAO_ACTION_TYPE_SPEND",
+}
+
+var DaoActionType_value = map[string]int32{
+	"DAO_ACTION_TYPE_UNSPECIFIED":          0,
+	"DAO_ACTION_TYPE_ANNOTATE":             1,
+	"DAO_ACTION_TYPE_ENDORSE":              2,
+	"DAO_ACTION_TYPE_EXTEND_VOTING_PERIOD": 3,
+	"DAO_ACTION_TYPE_VETO":                 4,
+	"DAO_ACTION_TYPE_WITHDRAW_VETO":        5,
+	"DAO_ACTION_TYPE_SUBMIT_PROPOSAL":      6,
+	"DAO_ACTION_TYPE_RECUSE":               7,
+	"DAO_ACTION_TYPE_SPEND":                8,
+}
+
+func (x DaoActionType) String() string {
+	return proto.EnumName(DaoActionType_name, int32(x))
+}
+
+func (DaoActionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a0229a660a0bf4dd, []int{1}
+}
+
+// CoreDao defines a Core DAO.
+type CoreDao int32
+
+const (
+	// CORE_DAO_UNSPECIFIED defines an unspecified Core DAO.
+	CoreDaoUnspecified CoreDao = 0
+	// CORE_DAO_STEERING defines the Steering DAO.
+	CoreDaoSteering CoreDao = 1
+	// CORE_DAO_OVERSIGHT defines the Oversight DAO.
+	CoreDaoOversight CoreDao = 2
+)
+
+var CoreDao_name = map[int32]string{
+	0: "CORE_DAO_UNSPECIFIED",
+	1: "CORE_DAO_STEERING",
+	2: "CORE_DAO_OVERSIGHT",
+}
+
+var CoreDao_value = map[string]int32{
+	"CORE_DAO_UNSPECIFIED": 0,
+	"CORE_DAO_STEERING":    1,
+	"CORE_DAO_OVERSIGHT":   2,
+}
+
+func (x CoreDao) String() string {
+	return proto.EnumName(CoreDao_name, int32(x))
+}
+
+func (CoreDao) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a0229a660a0bf4dd, []int{2}
+}
+
+// DaoState defines the state of a Core DAO.
+type DaoState int32
+
+const (
+	// DAO_STATE_UNSPECIFIED defines an unspecified state.
+	DaoStateUnspecified DaoState = 0
+	// DAO_STATE_DISABLED defines a Core DAO whose address is not set.
+	DaoStateDisabled DaoState = 1
+	// DAO_STATE_ACTIVE defines a Core DAO which can exercise its powers.
+	DaoStateActive DaoState = 2
+	// DAO_STATE_SUSPENDED defines a Core DAO whose powers are suspended for
+	// inactivity, until it is reconfirmed by governance.
+	DaoStateSuspended DaoState = 3
+)
+
+var DaoState_name = map[int32]string{
+	0: "DAO_STATE_UNSPECIFIED",
+	1: "DAO_STATE_DISABLED",
+	2: "DAO_STATE_ACTIVE",
+	3: "DAO_STATE_SUSPENDED",
+}
+
+var DaoState_value = map[string]int32{
+	"DAO_STATE_UNSPECIFIED": 0,
+	"DAO_STATE_DISABLED":    1,
+	"DAO_STATE_ACTIVE":      2,
+	"DAO_STATE_SUSPENDED":   3,
+}
+
+func (x DaoState) String() string {
+	return proto.EnumName(DaoState_name, int32(x))
+}
+
+func (DaoState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a0229a660a0bf4dd, []int{3}
+}
+
 // Params defines the parameters for the x/coredaos module.
 type Params struct {
 	// steering_dao_address defines the address which has authority
@@ -42,6 +223,30 @@ type Params struct {
 	// voting_period_extension_duration defines the duration for which
 	// a proposal's voting period can be extended.
 	VotingPeriodExtensionDuration *time.Duration `protobuf:"bytes,4,opt,name=voting_period_extension_duration,json=votingPeriodExtensionDuration,proto3,stdduration" json:"voting_period_extension_duration,omitempty"`
+	// veto_delay defines the duration between the submission of a veto by the
+	// Oversight DAO and its execution. During that delay the proposal cannot
+	// be executed and the veto can be withdrawn.
+	VetoDelay *time.Duration `protobuf:"bytes,5,opt,name=veto_delay,json=vetoDelay,proto3,stdduration" json:"veto_delay,omitempty"`
+	// steering_proposal_quota defines the maximum number of proposals the
+	// Steering DAO can submit without deposit per epoch. Zero disables the
+	// submission of proposals by the Steering DAO.
+	SteeringProposalQuota uint32 `protobuf:"varint,6,opt,name=steering_proposal_quota,json=steeringProposalQuota,proto3" json:"steering_proposal_quota,omitempty"`
+	// steering_proposal_epoch_identifier defines the x/epochs identifier of
+	// the epoch used by the Steering DAO proposal quota.
+	SteeringProposalEpochIdentifier string `protobuf:"bytes,7,opt,name=steering_proposal_epoch_identifier,json=steeringProposalEpochIdentifier,proto3" json:"steering_proposal_epoch_identifier,omitempty"`
+	// extension_policies defines the voting period extension policies per
+	// proposal kind. The proposals of a kind without policy use
+	// voting_period_extension_duration and voting_period_extensions_limit.
+	ExtensionPolicies []ExtensionPolicy `protobuf:"bytes,8,rep,name=extension_policies,json=extensionPolicies,proto3" json:"extension_policies"`
+	// max_inactivity defines the duration after which a Core DAO that has not
+	// taken any action is suspended, until it is reconfirmed by governance.
+	// Nil or zero disables the suspension of inactive Core DAOs.
+	MaxInactivity *time.Duration `protobuf:"bytes,9,opt,name=max_inactivity,json=maxInactivity,proto3,stdduration" json:"max_inactivity,omitempty"`
+	// endorsement_exempts_quorum_extension defines whether the endorsement of
+	// a proposal by the Steering DAO exempts it from the late quorum extension
+	// of its voting period (ADR-001). Laws and constitution amendments are
+	// never exempted.
+	EndorsementExemptsQuorumExtension bool `protobuf:"varint,10,opt,name=endorsement_exempts_quorum_extension,json=endorsementExemptsQuorumExtension,proto3" json:"endorsement_exempts_quorum_extension,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -105,134 +310,4179 @@ func (m *Params) GetVotingPeriodExtensionDuration() *time.Duration {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "atomone.coredaos.v1.Params")
+func (m *Params) GetVetoDelay() *time.Duration {
+	if m != nil {
+		return m.VetoDelay
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("atomone/coredaos/v1/coredaos.proto", fileDescriptor_a0229a660a0bf4dd)
+func (m *Params) GetSteeringProposalQuota() uint32 {
+	if m != nil {
+		return m.SteeringProposalQuota
+	}
+	return 0
 }
 
-var fileDescriptor_a0229a660a0bf4dd = []byte{
-	// 348 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xb1, 0x4e, 0xeb, 0x30,
-	0x14, 0x86, 0xeb, 0xde, 0xaa, 0xd2, 0xcd, 0xd5, 0x5d, 0xd2, 0x5e, 0x29, 0xed, 0x15, 0x26, 0xea,
-	0xd4, 0xa5, 0xb1, 0x0a, 0x4f, 0x40, 0x29, 0x03, 0xa8, 0x43, 0x55, 0x36, 0x96, 0xc8, 0x69, 0x8c,
-	0x63, 0xa9, 0xc9, 0xa9, 0x6c, 0x27, 0x2a, 0x6f, 0xc1, 0xc8, 0x83, 0xf0, 0x10, 0x8c, 0x85, 0x89,
-	0x0d, 0xd4, 0xbe, 0x08, 0x4a, 0x9c, 0x04, 0x90, 0x40, 0x6c, 0xe7, 0xf8, 0xff, 0xfe, 0xdf, 0x47,
-	0xc7, 0xb6, 0x06, 0x54, 0x43, 0x0c, 0x09, 0x23, 0x4b, 0x90, 0x2c, 0xa4, 0xa0, 0x48, 0x36, 0xae,
-	0x6b, 0x6f, 0x2d, 0x41, 0x83, 0xdd, 0x29, 0x19, 0xaf, 0x3e, 0xcf, 0xc6, 0xfd, 0x2e, 0x07, 0x0e,
-	0x85, 0x4e, 0xf2, 0xca, 0xa0, 0x7d, 0xcc, 0x01, 0xf8, 0x8a, 0x91, 0xa2, 0x0b, 0xd2, 0x6b, 0x12,
-	0xa6, 0x92, 0x6a, 0x01, 0x49, 0xa9, 0xf7, 0x96, 0xa0, 0x62, 0x50, 0xbe, 0x31, 0x9a, 0xc6, 0x48,
-	0x83, 0xc7, 0xa6, 0xd5, 0x9e, 0x53, 0x49, 0x63, 0x65, 0x5f, 0x58, 0x5d, 0xa5, 0x19, 0x93, 0x22,
-	0xe1, 0x7e, 0x48, 0xc1, 0xa7, 0x61, 0x28, 0x99, 0x52, 0x0e, 0x72, 0xd1, 0xf0, 0xf7, 0xc4, 0x79,
-	0xba, 0x1f, 0x75, 0x4b, 0xeb, 0x89, 0x51, 0x2e, 0x75, 0xce, 0x2e, 0xec, 0xca, 0x35, 0xa5, 0x50,
-	0x2a, 0xf6, 0xcc, 0xfa, 0x07, 0x19, 0x93, 0x4a, 0xf0, 0x48, 0x7f, 0x0a, 0x6b, 0xfe, 0x10, 0xd6,
-	0xa9, 0x6d, 0x1f, 0xd2, 0x4e, 0x2d, 0x9c, 0x81, 0xce, 0xe7, 0x5a, 0x33, 0x29, 0x20, 0xf4, 0xd9,
-	0x46, 0xb3, 0x44, 0x09, 0x48, 0x94, 0xbf, 0x12, 0xb1, 0xd0, 0xce, 0x2f, 0x17, 0x0d, 0xff, 0x2e,
-	0xfe, 0x1b, 0x6a, 0x5e, 0x40, 0x67, 0x35, 0x33, 0xcb, 0x11, 0x3b, 0xb2, 0xdc, 0x6f, 0x42, 0xfc,
-	0x6a, 0x5d, 0x4e, 0xcb, 0x45, 0xc3, 0x3f, 0x47, 0x3d, 0xcf, 0xec, 0xd3, 0xab, 0xf6, 0xe9, 0x4d,
-	0x4b, 0x60, 0xd2, 0xba, 0x7b, 0x39, 0x44, 0x8b, 0x83, 0x2f, 0xef, 0xa9, 0xa1, 0xf3, 0x87, 0x1d,
-	0x46, 0xdb, 0x1d, 0x46, 0xaf, 0x3b, 0x8c, 0x6e, 0xf7, 0xb8, 0xb1, 0xdd, 0xe3, 0xc6, 0xf3, 0x1e,
-	0x37, 0xae, 0x08, 0x17, 0x3a, 0x4a, 0x03, 0x6f, 0x09, 0x31, 0x29, 0x9f, 0x77, 0x14, 0xa5, 0x41,
-	0x55, 0x93, 0xcd, 0xfb, 0x87, 0xd0, 0x37, 0x6b, 0xa6, 0x82, 0x76, 0x31, 0xc2, 0xf1, 0x5b, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x9b, 0x7b, 0x58, 0xaa, 0x31, 0x02, 0x00, 0x00,
+func (m *Params) GetSteeringProposalEpochIdentifier() string {
+	if m != nil {
+		return m.SteeringProposalEpochIdentifier
+	}
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetExtensionPolicies() []ExtensionPolicy {
+	if m != nil {
+		return m.ExtensionPolicies
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetMaxInactivity() *time.Duration {
+	if m != nil {
+		return m.MaxInactivity
+	}
+	return nil
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotingPeriodExtensionDuration != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriodExtensionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriodExtensionDuration):])
-		if err1 != nil {
-			return 0, err1
+func (m *Params) GetEndorsementExemptsQuorumExtension() bool {
+	if m != nil {
+		return m.EndorsementExemptsQuorumExtension
+	}
+	return false
+}
+
+// ExtensionPolicy defines how the Core DAOs can extend the voting period of
+// the proposals of a kind.
+type ExtensionPolicy struct {
+	// proposal_kind is the kind of the proposals the policy applies to.
+	ProposalKind ProposalKind `protobuf:"varint,1,opt,name=proposal_kind,json=proposalKind,proto3,enum=atomone.coredaos.v1.ProposalKind" json:"proposal_kind,omitempty"`
+	// extension_duration defines the duration for which the voting period of
+	// a proposal is extended.
+	ExtensionDuration time.Duration `protobuf:"bytes,2,opt,name=extension_duration,json=extensionDuration,proto3,stdduration" json:"extension_duration"`
+	// steering_dao_extensions_limit defines the maximum number of times the
+	// Steering DAO can extend the voting period of a proposal.
+	SteeringDaoExtensionsLimit uint32 `protobuf:"varint,3,opt,name=steering_dao_extensions_limit,json=steeringDaoExtensionsLimit,proto3" json:"steering_dao_extensions_limit,omitempty"`
+	// oversight_dao_extensions_limit defines the maximum number of times the
+	// Oversight DAO can extend the voting period of a proposal.
+	OversightDaoExtensionsLimit uint32 `protobuf:"varint,4,opt,name=oversight_dao_extensions_limit,json=oversightDaoExtensionsLimit,proto3" json:"oversight_dao_extensions_limit,omitempty"`
+	// total_extensions_limit defines the maximum number of times the voting
+	// period of a proposal can be extended by both Core DAOs together. It
+	// replaces voting_period_extensions_limit for the proposals of the kind.
+	TotalExtensionsLimit uint32 `protobuf:"varint,5,opt,name=total_extensions_limit,json=totalExtensionsLimit,proto3" json:"total_extensions_limit,omitempty"`
+}
+
+func (m *ExtensionPolicy) Reset()         { *m = ExtensionPolicy{} }
+func (m *ExtensionPolicy) String() string { return proto.CompactTextString(m) }
+func (*ExtensionPolicy) ProtoMessage()    {}
+func (*ExtensionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0229a660a0bf4dd, []int{1}
+}
+func (m *ExtensionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n1
-		i = encodeVarintCoredaos(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.VotingPeriodExtensionsLimit != 0 {
-		i = encodeVarintCoredaos(dAtA, i, uint64(m.VotingPeriodExtensionsLimit))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *ExtensionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionPolicy.Merge(m, src)
+}
+func (m *ExtensionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionPolicy proto.InternalMessageInfo
+
+func (m *ExtensionPolicy) GetProposalKind() ProposalKind {
+	if m != nil {
+		return m.ProposalKind
 	}
-	if len(m.OversightDaoAddress) > 0 {
-		i -= len(m.OversightDaoAddress)
-		copy(dAtA[i:], m.OversightDaoAddress)
-		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.OversightDaoAddress)))
-		i--
-		dAtA[i] = 0x12
+	return ProposalKindUnspecified
+}
+
+func (m *ExtensionPolicy) GetExtensionDuration() time.Duration {
+	if m != nil {
+		return m.ExtensionDuration
 	}
-	if len(m.SteeringDaoAddress) > 0 {
-		i -= len(m.SteeringDaoAddress)
-		copy(dAtA[i:], m.SteeringDaoAddress)
-		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.SteeringDaoAddress)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ExtensionPolicy) GetSteeringDaoExtensionsLimit() uint32 {
+	if m != nil {
+		return m.SteeringDaoExtensionsLimit
+	}
+	return 0
+}
+
+func (m *ExtensionPolicy) GetOversightDaoExtensionsLimit() uint32 {
+	if m != nil {
+		return m.OversightDaoExtensionsLimit
+	}
+	return 0
+}
+
+func (m *ExtensionPolicy) GetTotalExtensionsLimit() uint32 {
+	if m != nil {
+		return m.TotalExtensionsLimit
+	}
+	return 0
+}
+
+// DaoAction defines an entry of the Core DAOs action log.
+type DaoAction struct {
+	// id is the unique identifier of the action, in order of execution.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// proposal_id is the identifier of the proposal the action applies to.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// signer is the address of the Core DAO that took the action.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// action is the type of the action.
+	Action DaoActionType `protobuf:"varint,4,opt,name=action,proto3,enum=atomone.coredaos.v1.DaoActionType" json:"action,omitempty"`
+	// block_height is the height of the block in which the action was taken.
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// time is the time of the block in which the action was taken.
+	Time time.Time `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time"`
+	// previous_annotation is the annotation of the proposal before an
+	// annotation action. It is empty if the proposal had no annotation, or for
+	// other actions.
+	PreviousAnnotation string `protobuf:"bytes,7,opt,name=previous_annotation,json=previousAnnotation,proto3" json:"previous_annotation,omitempty"`
+	// dao is the Core DAO that took the action, i.e. the role of signer when
+	// the action was taken.
+	Dao CoreDao `protobuf:"varint,8,opt,name=dao,proto3,enum=atomone.coredaos.v1.CoreDao" json:"dao,omitempty"`
+	// extensions_limit is the maximum number of times the voting period of
+	// the proposal could be extended when a voting period extension action
+	// was taken. It is zero for other actions.
+	ExtensionsLimit uint32 `protobuf:"varint,9,opt,name=extensions_limit,json=extensionsLimit,proto3" json:"extensions_limit,omitempty"`
+	// dao_extensions_limit is the maximum number of times dao could extend the
+	// voting period of the proposal when a voting period extension action was
+	// taken. It is zero for other actions.
+	DaoExtensionsLimit uint32 `protobuf:"varint,10,opt,name=dao_extensions_limit,json=daoExtensionsLimit,proto3" json:"dao_extensions_limit,omitempty"`
+}
+
+func (m *DaoAction) Reset()         { *m = DaoAction{} }
+func (m *DaoAction) String() string { return proto.CompactTextString(m) }
+func (*DaoAction) ProtoMessage()    {}
+func (*DaoAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0229a660a0bf4dd, []int{2}
+}
+func (m *DaoAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaoAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DaoAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DaoAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaoAction.Merge(m, src)
+}
+func (m *DaoAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaoAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaoAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaoAction proto.InternalMessageInfo
+
+func (m *DaoAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DaoAction) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *DaoAction) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *DaoAction) GetAction() DaoActionType {
+	if m != nil {
+		return m.Action
+	}
+	return DaoActionTypeUnspecified
+}
+
+func (m *DaoAction) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *DaoAction) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *DaoAction) GetPreviousAnnotation() string {
+	if m != nil {
+		return m.PreviousAnnotation
+	}
+	return ""
+}
+
+func (m *DaoAction) GetDao() CoreDao {
+	if m != nil {
+		return m.Dao
+	}
+	return CoreDaoUnspecified
+}
+
+func (m *DaoAction) GetExtensionsLimit() uint32 {
+	if m != nil {
+		return m.ExtensionsLimit
+	}
+	return 0
+}
+
+func (m *DaoAction) GetDaoExtensionsLimit() uint32 {
+	if m != nil {
+		return m.DaoExtensionsLimit
+	}
+	return 0
+}
+
+// PendingVeto defines a veto submitted by the Oversight DAO that has not been
+// executed yet.
+type PendingVeto struct {
+	// proposal_id is the identifier of the vetoed proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// vetoer is the address of the Oversight DAO that submitted the veto.
+	Vetoer string `protobuf:"bytes,2,opt,name=vetoer,proto3" json:"vetoer,omitempty"`
+	// burn_deposit indicates whether the deposits of the proposal are burned
+	// when the veto is executed.
+	BurnDeposit bool `protobuf:"varint,3,opt,name=burn_deposit,json=burnDeposit,proto3" json:"burn_deposit,omitempty"`
+	// submit_time is the time the veto was submitted.
+	SubmitTime time.Time `protobuf:"bytes,4,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time"`
+	// execution_time is the time after which the veto is executed.
+	ExecutionTime time.Time `protobuf:"bytes,5,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
+}
+
+func (m *PendingVeto) Reset()         { *m = PendingVeto{} }
+func (m *PendingVeto) String() string { return proto.CompactTextString(m) }
+func (*PendingVeto) ProtoMessage()    {}
+func (*PendingVeto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0229a660a0bf4dd, []int{3}
+}
+func (m *PendingVeto) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingVeto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingVeto.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingVeto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingVeto.Merge(m, src)
+}
+func (m *PendingVeto) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingVeto) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingVeto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingVeto proto.InternalMessageInfo
+
+func (m *PendingVeto) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *PendingVeto) GetVetoer() string {
+	if m != nil {
+		return m.Vetoer
+	}
+	return ""
+}
+
+func (m *PendingVeto) GetBurnDeposit() bool {
+	if m != nil {
+		return m.BurnDeposit
+	}
+	return false
+}
+
+func (m *PendingVeto) GetSubmitTime() time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return time.Time{}
+}
+
+func (m *PendingVeto) GetExecutionTime() time.Time {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return time.Time{}
+}
+
+// DaoMembers defines the members of a Core DAO, and the number of their
+// approvals required to execute an action on behalf of the Core DAO.
+type DaoMembers struct {
+	// dao is the Core DAO the members belong to.
+	Dao CoreDao `protobuf:"varint,1,opt,name=dao,proto3,enum=atomone.coredaos.v1.CoreDao" json:"dao,omitempty"`
+	// members are the addresses of the members of the Core DAO.
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// threshold is the number of member approvals required to execute an
+	// action.
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// approval_timeout is the duration after which an action that has not
+	// reached the threshold expires.
+	ApprovalTimeout time.Duration `protobuf:"bytes,4,opt,name=approval_timeout,json=approvalTimeout,proto3,stdduration" json:"approval_timeout"`
+}
+
+func (m *DaoMembers) Reset()         { *m = DaoMembers{} }
+func (m *DaoMembers) String() string { return proto.CompactTextString(m) }
+func (*DaoMembers) ProtoMessage()    {}
+func (*DaoMembers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0229a660a0bf4dd, []int{4}
+}
+func (m *DaoMembers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaoMembers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DaoMembers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DaoMembers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaoMembers.Merge(m, src)
+}
+func (m *DaoMembers) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaoMembers) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaoMembers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaoMembers proto.InternalMessageInfo
+
+func (m *DaoMembers) GetDao() CoreDao {
+	if m != nil {
+		return m.Dao
+	}
+	return CoreDaoUnspecified
+}
+
+func (m *DaoMembers) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *DaoMembers) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *DaoMembers) GetApprovalTimeout() time.Duration {
+	if m != nil {
+		return m.ApprovalTimeout
+	}
+	return 0
+}
+
+// PendingDaoAction defines an action submitted by a member of a Core DAO that
+// waits for the approval of the other members.
+type PendingDaoAction struct {
+	// id is the unique identifier of the pending action.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// dao is the Core DAO the action is taken on behalf of.
+	Dao CoreDao `protobuf:"varint,2,opt,name=dao,proto3,enum=atomone.coredaos.v1.CoreDao" json:"dao,omitempty"`
+	// action is the type of the action.
+	Action DaoActionType `protobuf:"varint,3,opt,name=action,proto3,enum=atomone.coredaos.v1.DaoActionType" json:"action,omitempty"`
+	// proposal_id is the identifier of the proposal the action applies to.
+	ProposalId uint64 `protobuf:"varint,4,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// annotation is the annotation to add to the proposal, for annotations.
+	Annotation string `protobuf:"bytes,5,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	// overwrite indicates whether to overwrite the existing annotation of the
+	// proposal, for annotations.
+	Overwrite bool `protobuf:"varint,6,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	// burn_deposit indicates whether to burn the deposits of the proposal, for
+	// vetoes.
+	BurnDeposit bool `protobuf:"varint,7,opt,name=burn_deposit,json=burnDeposit,proto3" json:"burn_deposit,omitempty"`
+	// approvals are the addresses of the members that approved the action,
+	// starting with the member that submitted it.
+	Approvals []string `protobuf:"bytes,8,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// submit_time is the time the action was submitted.
+	SubmitTime time.Time `protobuf:"bytes,9,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time"`
+	// expiration_time is the time after which the action expires if it has
+	// not reached the threshold.
+	ExpirationTime time.Time `protobuf:"bytes,10,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time"`
+	// reason is the reason of the recusal, for recusals.
+	Reason string `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	// recipient is the address receiving the funds, for spends.
+	Recipient string `protobuf:"bytes,12,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount to spend from the budget of the Core DAO, for
+	// spends.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// memo is the memo of the spend, for spends.
+	Memo string `protobuf:"bytes,14,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *PendingDaoAction) Reset()         { *m = PendingDaoAction{} }
+func (m *PendingDaoAction) String() string { return proto.CompactTextString(m) }
+func (*PendingDaoAction) ProtoMessage()    {}
+func (*PendingDaoAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0229a660a0bf4dd, []int{5}
+}
+func (m *PendingDaoAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingDaoAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingDaoAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingDaoAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingDaoAction.Merge(m, src)
+}
+func (m *PendingDaoAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingDaoAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingDaoAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingDaoAction proto.InternalMessageInfo
+
+func (m *PendingDaoAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingDaoAction) GetDao() CoreDao {
+	if m != nil {
+		return m.Dao
+	}
+	return CoreDaoUnspecified
+}
+
+func (m *PendingDaoAction) GetAction() DaoActionType {
+	if m != nil {
+		return m.Action
+	}
+	return DaoActionTypeUnspecified
+}
+
+func (m *PendingDaoAction) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *PendingDaoAction) GetAnnotation() string {
+	if m != nil {
+		return m.Annotation
+	}
+	return ""
+}
+
+func (m *PendingDaoAction) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
+}
+
+func (m *PendingDaoAction) GetBurnDeposit() bool {
+	if m != nil {
+		return m.BurnDeposit
+	}
+	return false
+}
+
+func (m *PendingDaoAction) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *PendingDaoAction) GetSubmitTime() time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return time.Time{}
+}
+
+func (m *PendingDaoAction) GetExpirationTime() time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return time.Time{}
+}
+
+func (m *PendingDaoAction) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *PendingDaoAction) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *PendingDaoAction) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *PendingDaoAction) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+// SteeringProposalCount holds the number of proposals submitted by the
+// Steering DAO in the current epoch.
+type SteeringProposalCount struct {
+	// epoch_number is the number of the epoch the count applies to.
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// count is the number of proposals submitted in the epoch.
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *SteeringProposalCount) Reset()         { *m = SteeringProposalCount{} }
+func (m *SteeringProposalCount) String() string { return proto.CompactTextString(m) }
+func (*SteeringProposalCount) ProtoMessage()    {}
+func (*SteeringProposalCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0229a660a0bf4dd, []int{6}
+}
+func (m *SteeringProposalCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SteeringProposalCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SteeringProposalCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SteeringProposalCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SteeringProposalCount.Merge(m, src)
+}
+func (m *SteeringProposalCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *SteeringProposalCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_SteeringProposalCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SteeringProposalCount proto.InternalMessageInfo
+
+func (m *SteeringProposalCount) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *SteeringProposalCount) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// Recusal defines the recusal of a Core DAO from a proposal. A recused Core
+// DAO cannot endorse, extend the voting period of, or veto the proposal.
+type Recusal struct {
+	// proposal_id is the identifier of the proposal the Core DAO is recused
+	// from.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// dao is the recused Core DAO.
+	Dao CoreDao `protobuf:"varint,2,opt,name=dao,proto3,enum=atomone.coredaos.v1.CoreDao" json:"dao,omitempty"`
+	// reason is the reason of the recusal, such as a conflict of interest.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// mandatory indicates whether the recusal is required because the
+	// proposal changes the Core DAO itself.
+	Mandatory bool `protobuf:"varint,4,opt,name=mandatory,proto3" json:"mandatory,omitempty"`
+	// time is the time of the block in which the recusal was recorded.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *Recusal) Reset()         { *m = Recusal{} }
+func (m *Recusal) String() string { return proto.CompactTextString(m) }
+func (*Recusal) ProtoMessage()    {}
+func (*Recusal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0229a660a0bf4dd, []int{7}
+}
+func (m *Recusal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Recusal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Recusal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Recusal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Recusal.Merge(m, src)
+}
+func (m *Recusal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Recusal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Recusal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Recusal proto.InternalMessageInfo
+
+func (m *Recusal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *Recusal) GetDao() CoreDao {
+	if m != nil {
+		return m.Dao
+	}
+	return CoreDaoUnspecified
+}
+
+func (m *Recusal) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Recusal) GetMandatory() bool {
+	if m != nil {
+		return m.Mandatory
+	}
+	return false
+}
+
+func (m *Recusal) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// DaoBudget defines the per-epoch allowance granted by governance to a Core
+// DAO. The allowance is withdrawn from the community pool at the start of each
+// epoch, and the unspent funds are returned at the end of the epoch.
+type DaoBudget struct {
+	// dao is the Core DAO the budget is granted to.
+	Dao CoreDao `protobuf:"varint,1,opt,name=dao,proto3,enum=atomone.coredaos.v1.CoreDao" json:"dao,omitempty"`
+	// epoch_identifier is the identifier of the x/epochs epoch of the budget.
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// allowance is the amount granted to the Core DAO for each epoch.
+	Allowance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=allowance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"allowance"`
+}
+
+func (m *DaoBudget) Reset()         { *m = DaoBudget{} }
+func (m *DaoBudget) String() string { return proto.CompactTextString(m) }
+func (*DaoBudget) ProtoMessage()    {}
+func (*DaoBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0229a660a0bf4dd, []int{8}
+}
+func (m *DaoBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaoBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DaoBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DaoBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaoBudget.Merge(m, src)
+}
+func (m *DaoBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaoBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaoBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaoBudget proto.InternalMessageInfo
+
+func (m *DaoBudget) GetDao() CoreDao {
+	if m != nil {
+		return m.Dao
+	}
+	return CoreDaoUnspecified
+}
+
+func (m *DaoBudget) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *DaoBudget) GetAllowance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Allowance
+	}
+	return nil
+}
+
+// DaoBudgetAccount holds the funds of the current epoch of the budget of a
+// Core DAO. The funds are held by the x/coredaos module account.
+type DaoBudgetAccount struct {
+	// dao is the Core DAO the funds belong to.
+	Dao CoreDao `protobuf:"varint,1,opt,name=dao,proto3,enum=atomone.coredaos.v1.CoreDao" json:"dao,omitempty"`
+	// epoch_identifier is the identifier of the x/epochs epoch the funds were
+	// granted for.
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// epoch_number is the number of the epoch the funds were granted for.
+	EpochNumber int64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// funded is the amount withdrawn from the community pool at the start of
+	// the epoch, which is lower than the allowance if the community pool
+	// lacks funds.
+	Funded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=funded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funded"`
+	// spent is the amount spent by the Core DAO during the epoch.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *DaoBudgetAccount) Reset()         { *m = DaoBudgetAccount{} }
+func (m *DaoBudgetAccount) String() string { return proto.CompactTextString(m) }
+func (*DaoBudgetAccount) ProtoMessage()    {}
+func (*DaoBudgetAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0229a660a0bf4dd, []int{9}
+}
+func (m *DaoBudgetAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaoBudgetAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DaoBudgetAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DaoBudgetAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaoBudgetAccount.Merge(m, src)
+}
+func (m *DaoBudgetAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaoBudgetAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaoBudgetAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaoBudgetAccount proto.InternalMessageInfo
+
+func (m *DaoBudgetAccount) GetDao() CoreDao {
+	if m != nil {
+		return m.Dao
+	}
+	return CoreDaoUnspecified
+}
+
+func (m *DaoBudgetAccount) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *DaoBudgetAccount) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *DaoBudgetAccount) GetFunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Funded
+	}
+	return nil
+}
+
+func (m *DaoBudgetAccount) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+// DaoLiveness defines the activity of a Core DAO, used to suspend the Core
+// DAOs that stop acting.
+type DaoLiveness struct {
+	// dao is the tracked Core DAO.
+	Dao CoreDao `protobuf:"varint,1,opt,name=dao,proto3,enum=atomone.coredaos.v1.CoreDao" json:"dao,omitempty"`
+	// active_since is the time the Core DAO was last confirmed by governance,
+	// or the time its tracking started.
+	ActiveSince time.Time `protobuf:"bytes,2,opt,name=active_since,json=activeSince,proto3,stdtime" json:"active_since"`
+	// last_action_height is the height of the block of the last action of the
+	// Core DAO. It is zero if the Core DAO has not acted yet.
+	LastActionHeight int64 `protobuf:"varint,3,opt,name=last_action_height,json=lastActionHeight,proto3" json:"last_action_height,omitempty"`
+	// last_action_time is the time of the last action of the Core DAO.
+	LastActionTime *time.Time `protobuf:"bytes,4,opt,name=last_action_time,json=lastActionTime,proto3,stdtime" json:"last_action_time,omitempty"`
+	// suspended indicates whether the powers of the Core DAO are suspended for
+	// inactivity.
+	Suspended bool `protobuf:"varint,5,opt,name=suspended,proto3" json:"suspended,omitempty"`
+}
+
+func (m *DaoLiveness) Reset()         { *m = DaoLiveness{} }
+func (m *DaoLiveness) String() string { return proto.CompactTextString(m) }
+func (*DaoLiveness) ProtoMessage()    {}
+func (*DaoLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0229a660a0bf4dd, []int{10}
+}
+func (m *DaoLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaoLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DaoLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DaoLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaoLiveness.Merge(m, src)
+}
+func (m *DaoLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaoLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaoLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaoLiveness proto.InternalMessageInfo
+
+func (m *DaoLiveness) GetDao() CoreDao {
+	if m != nil {
+		return m.Dao
+	}
+	return CoreDaoUnspecified
+}
+
+func (m *DaoLiveness) GetActiveSince() time.Time {
+	if m != nil {
+		return m.ActiveSince
+	}
+	return time.Time{}
+}
+
+func (m *DaoLiveness) GetLastActionHeight() int64 {
+	if m != nil {
+		return m.LastActionHeight
+	}
+	return 0
+}
+
+func (m *DaoLiveness) GetLastActionTime() *time.Time {
+	if m != nil {
+		return m.LastActionTime
+	}
+	return nil
+}
+
+func (m *DaoLiveness) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("atomone.coredaos.v1.ProposalKind", ProposalKind_name, ProposalKind_value)
+	proto.RegisterEnum("atomone.coredaos.v1.DaoActionType", DaoActionType_name, DaoActionType_value)
+	proto.RegisterEnum("atomone.coredaos.v1.CoreDao", CoreDao_name, CoreDao_value)
+	proto.RegisterEnum("atomone.coredaos.v1.DaoState", DaoState_name, DaoState_value)
+	proto.RegisterType((*Params)(nil), "atomone.coredaos.v1.Params")
+	proto.RegisterType((*ExtensionPolicy)(nil), "atomone.coredaos.v1.ExtensionPolicy")
+	proto.RegisterType((*DaoAction)(nil), "atomone.coredaos.v1.DaoAction")
+	proto.RegisterType((*PendingVeto)(nil), "atomone.coredaos.v1.PendingVeto")
+	proto.RegisterType((*DaoMembers)(nil), "atomone.coredaos.v1.DaoMembers")
+	proto.RegisterType((*PendingDaoAction)(nil), "atomone.coredaos.v1.PendingDaoAction")
+	proto.RegisterType((*SteeringProposalCount)(nil), "atomone.coredaos.v1.SteeringProposalCount")
+	proto.RegisterType((*Recusal)(nil), "atomone.coredaos.v1.Recusal")
+	proto.RegisterType((*DaoBudget)(nil), "atomone.coredaos.v1.DaoBudget")
+	proto.RegisterType((*DaoBudgetAccount)(nil), "atomone.coredaos.v1.DaoBudgetAccount")
+	proto.RegisterType((*DaoLiveness)(nil), "atomone.coredaos.v1.DaoLiveness")
+}
+
+func init() {
+	proto.RegisterFile("atomone/coredaos/v1/coredaos.proto", fileDescriptor_a0229a660a0bf4dd)
+}
+
+var fileDescriptor_a0229a660a0bf4dd = []byte{
+	// 2049 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0xf5, 0xe1, 0x8f, 0x27, 0x7f, 0x30, 0x63, 0x3b, 0xa1, 0x95, 0x44, 0x66, 0xd4, 0x14,
+	0x70, 0x83, 0x8d, 0x94, 0x78, 0xbb, 0xdb, 0x62, 0x8b, 0x16, 0xa5, 0x45, 0x26, 0xd1, 0xc6, 0x96,
+	0xb4, 0x14, 0xed, 0x6c, 0x7a, 0x21, 0x68, 0x71, 0x22, 0x11, 0x11, 0x39, 0x2a, 0x49, 0x39, 0xf6,
+	0x7f, 0x50, 0xe8, 0xb4, 0xb7, 0x16, 0x28, 0xd4, 0x4b, 0x0f, 0x2d, 0x7a, 0xea, 0x61, 0xff, 0x85,
+	0x02, 0x8b, 0xa2, 0x87, 0x45, 0x0f, 0x45, 0x4f, 0xbb, 0x45, 0x52, 0xa0, 0x87, 0xfe, 0x13, 0xc5,
+	0x0c, 0x3f, 0x24, 0x52, 0xce, 0x3a, 0x2a, 0x90, 0x8b, 0x4d, 0xce, 0xfc, 0x7e, 0x8f, 0xf3, 0xde,
+	0xfb, 0xbd, 0x99, 0x37, 0x82, 0xb2, 0xe1, 0x13, 0x9b, 0x38, 0xb8, 0xda, 0x21, 0x2e, 0x36, 0x0d,
+	0xe2, 0x55, 0xcf, 0x1e, 0xc6, 0xcf, 0x95, 0x81, 0x4b, 0x7c, 0x82, 0x36, 0x43, 0x4c, 0x25, 0x1e,
+	0x3f, 0x7b, 0x58, 0xdc, 0xea, 0x92, 0x2e, 0x61, 0xf3, 0x55, 0xfa, 0x14, 0x40, 0x8b, 0xa5, 0x2e,
+	0x21, 0xdd, 0x3e, 0xae, 0xb2, 0xb7, 0xd3, 0xe1, 0x8b, 0xaa, 0x39, 0x74, 0x0d, 0xdf, 0x22, 0x4e,
+	0x38, 0xbf, 0x9b, 0x9e, 0xf7, 0x2d, 0x1b, 0x7b, 0xbe, 0x61, 0x0f, 0x42, 0xc0, 0x4e, 0x87, 0x78,
+	0x36, 0xf1, 0xf4, 0xc0, 0x72, 0xf0, 0x12, 0xd9, 0x0e, 0xde, 0xaa, 0xa7, 0x86, 0x87, 0xab, 0x67,
+	0x0f, 0x4f, 0xb1, 0x6f, 0xd0, 0xa5, 0x5a, 0x91, 0xed, 0x6b, 0x86, 0x6d, 0x39, 0xa4, 0xca, 0xfe,
+	0x06, 0x43, 0xe5, 0xd1, 0x22, 0x2c, 0xb6, 0x0c, 0xd7, 0xb0, 0x3d, 0xf4, 0x29, 0x6c, 0x79, 0x3e,
+	0xc6, 0xae, 0xe5, 0x74, 0x75, 0xd3, 0x20, 0xba, 0x61, 0x9a, 0x2e, 0xf6, 0x3c, 0x81, 0x13, 0xb9,
+	0xbd, 0x95, 0x03, 0xe1, 0xef, 0x5f, 0xde, 0xdf, 0x0a, 0xbf, 0x26, 0x05, 0x33, 0x6d, 0x9f, 0x62,
+	0x55, 0x14, 0xb1, 0x64, 0x83, 0x84, 0x33, 0xe8, 0x10, 0xb6, 0xc9, 0x19, 0x76, 0x3d, 0xab, 0xdb,
+	0xf3, 0x13, 0xc6, 0x32, 0x57, 0x18, 0xdb, 0x8c, 0x69, 0x53, 0xd6, 0x6a, 0x50, 0x3a, 0x23, 0x3e,
+	0x5d, 0xd7, 0x00, 0xbb, 0x16, 0x31, 0x75, 0x7c, 0xee, 0x63, 0xc7, 0xb3, 0x88, 0xe3, 0xe9, 0x7d,
+	0xcb, 0xb6, 0x7c, 0x21, 0x2b, 0x72, 0x7b, 0x6b, 0xea, 0xcd, 0x00, 0xd5, 0x62, 0x20, 0x25, 0xc6,
+	0x1c, 0x52, 0x08, 0xea, 0x81, 0xf8, 0x16, 0x23, 0x7a, 0x94, 0x02, 0x21, 0x27, 0x72, 0x7b, 0x85,
+	0xfd, 0x9d, 0x4a, 0x90, 0x83, 0x4a, 0x94, 0x83, 0x8a, 0x1c, 0x02, 0x0e, 0x72, 0xbf, 0xf9, 0x76,
+	0x97, 0x53, 0x6f, 0x5f, 0xfa, 0x9d, 0x08, 0x84, 0x7e, 0x06, 0x70, 0x86, 0x7d, 0xa2, 0x9b, 0xb8,
+	0x6f, 0x5c, 0x08, 0xf9, 0x77, 0xb3, 0xb9, 0x42, 0x29, 0x32, 0x65, 0xa0, 0x8f, 0xe1, 0x46, 0x9c,
+	0x88, 0x81, 0x4b, 0x06, 0xc4, 0x33, 0xfa, 0xfa, 0x2f, 0x87, 0xc4, 0x37, 0x84, 0x45, 0xe6, 0xe7,
+	0x76, 0x34, 0xdd, 0x0a, 0x67, 0x3f, 0xa3, 0x93, 0xe8, 0x29, 0x94, 0x67, 0x79, 0x78, 0x40, 0x3a,
+	0x3d, 0xdd, 0x32, 0xb1, 0xe3, 0x5b, 0x2f, 0x2c, 0xec, 0x0a, 0x4b, 0x34, 0x03, 0xea, 0x6e, 0xda,
+	0x84, 0x42, 0x71, 0xf5, 0x18, 0x86, 0x9e, 0x03, 0x9a, 0x04, 0x68, 0x40, 0xfa, 0x56, 0xc7, 0xc2,
+	0x9e, 0xb0, 0x2c, 0x66, 0xf7, 0x0a, 0xfb, 0x77, 0x2b, 0x97, 0xe8, 0xbd, 0x12, 0x07, 0xa2, 0x45,
+	0xd1, 0x17, 0x07, 0xb9, 0xaf, 0xbe, 0xd9, 0x5d, 0x50, 0xaf, 0xe1, 0xc4, 0xb0, 0x85, 0x3d, 0xf4,
+	0x08, 0xd6, 0x6d, 0xe3, 0x5c, 0xb7, 0x1c, 0xa3, 0xe3, 0x5b, 0x67, 0x96, 0x7f, 0x21, 0xac, 0xbc,
+	0x5b, 0x8c, 0xd6, 0x6c, 0xe3, 0xbc, 0x1e, 0xb3, 0x50, 0x13, 0xee, 0x62, 0xc7, 0x24, 0xae, 0x87,
+	0x6d, 0xec, 0xf8, 0x3a, 0x3e, 0xc7, 0xf6, 0xc0, 0xf7, 0x68, 0xa4, 0xdc, 0xa1, 0x3d, 0x49, 0xaf,
+	0x00, 0x22, 0xb7, 0xb7, 0xac, 0xde, 0x99, 0xc2, 0x2a, 0x01, 0xf4, 0x33, 0x86, 0x8c, 0xd7, 0x5d,
+	0xfe, 0x6f, 0x06, 0x36, 0x52, 0x5e, 0xa0, 0x47, 0xb0, 0x16, 0xc7, 0xf2, 0xa5, 0xe5, 0x98, 0xac,
+	0x1c, 0xd6, 0xf7, 0xef, 0x5c, 0x1a, 0x82, 0x28, 0x98, 0x4f, 0x2d, 0xc7, 0x54, 0x57, 0x07, 0x53,
+	0x6f, 0x48, 0x05, 0x74, 0x89, 0xe0, 0x32, 0x57, 0x39, 0xbe, 0x4c, 0x83, 0xc8, 0x9c, 0x9f, 0x04,
+	0x32, 0x16, 0x9a, 0x04, 0xb7, 0x13, 0x15, 0xfb, 0x96, 0xb2, 0x28, 0x4e, 0x15, 0x68, 0xba, 0x2a,
+	0x6a, 0x50, 0x4a, 0x16, 0xea, 0x8c, 0x8d, 0x5c, 0x50, 0x5a, 0xd3, 0x75, 0x99, 0x36, 0xf2, 0x43,
+	0xb8, 0xee, 0x13, 0x9f, 0x8a, 0x2d, 0x4d, 0xce, 0x33, 0xf2, 0x16, 0x9b, 0x4d, 0xb1, 0xca, 0x7f,
+	0xc9, 0xc2, 0x0a, 0x2d, 0xf2, 0x0e, 0xf3, 0x65, 0x1d, 0x32, 0x56, 0x10, 0xdc, 0x9c, 0x9a, 0xb1,
+	0x4c, 0xb4, 0x0b, 0x85, 0x38, 0xee, 0x96, 0xc9, 0x02, 0x95, 0x53, 0x21, 0x1a, 0xaa, 0x9b, 0xe8,
+	0x01, 0x2c, 0x7a, 0x56, 0xd7, 0xc1, 0xae, 0x90, 0xbd, 0x62, 0x4f, 0x09, 0x71, 0xe8, 0x13, 0x58,
+	0x34, 0x3a, 0x71, 0x9d, 0xaf, 0xef, 0x97, 0x2f, 0xcd, 0x61, 0xbc, 0x24, 0xed, 0x62, 0x80, 0xd5,
+	0x90, 0x81, 0xee, 0xc0, 0xea, 0x69, 0x9f, 0x74, 0x5e, 0xea, 0x3d, 0x4c, 0x83, 0xc0, 0x1c, 0xcb,
+	0xaa, 0x05, 0x36, 0xf6, 0x84, 0x0d, 0xa1, 0x1f, 0x43, 0x8e, 0xee, 0xd5, 0xac, 0x46, 0x0b, 0xfb,
+	0xc5, 0x99, 0x9c, 0x6a, 0xd1, 0x46, 0x1e, 0x24, 0xf5, 0x0b, 0x9a, 0x54, 0xc6, 0x40, 0x55, 0xd8,
+	0x1c, 0xb8, 0xf8, 0xcc, 0x22, 0x43, 0x4f, 0x37, 0x1c, 0x87, 0xf8, 0x81, 0x38, 0x82, 0x4a, 0x45,
+	0xd1, 0x94, 0x14, 0xcf, 0xa0, 0x0a, 0x64, 0x4d, 0x83, 0x08, 0xcb, 0xcc, 0x8d, 0x5b, 0x97, 0xba,
+	0x51, 0x23, 0x2e, 0x96, 0x0d, 0xa2, 0x52, 0x20, 0xfa, 0x01, 0xf0, 0x33, 0xa9, 0x59, 0x61, 0xa9,
+	0xd9, 0xc0, 0xa9, 0x5c, 0x3e, 0x80, 0xad, 0x4b, 0x65, 0x00, 0x0c, 0x8e, 0xcc, 0x99, 0xec, 0x97,
+	0x7f, 0x9d, 0x81, 0x42, 0x0b, 0x3b, 0xa6, 0xe5, 0x74, 0x4f, 0xb0, 0x4f, 0xd2, 0x99, 0xe3, 0x2e,
+	0xcb, 0x1c, 0xdd, 0xec, 0xb0, 0x7b, 0xe5, 0x69, 0x10, 0xe2, 0x58, 0xf4, 0x87, 0xae, 0xa3, 0x9b,
+	0x78, 0x40, 0xbc, 0x50, 0xd7, 0xcb, 0x6a, 0x81, 0x8e, 0xc9, 0xc1, 0x10, 0x52, 0xa0, 0xe0, 0x0d,
+	0x4f, 0x6d, 0xcb, 0xd7, 0x59, 0x12, 0x72, 0x73, 0x24, 0x01, 0x02, 0x22, 0x9d, 0x42, 0x4f, 0x61,
+	0x1d, 0x9f, 0xe3, 0xce, 0x90, 0x86, 0x39, 0xb0, 0x94, 0x9f, 0xc3, 0xd2, 0x5a, 0xcc, 0xa5, 0xb3,
+	0xe5, 0x6f, 0x38, 0x00, 0xd9, 0x20, 0x47, 0xd8, 0x3e, 0xc5, 0xae, 0x17, 0x65, 0x8d, 0x7b, 0xd7,
+	0xac, 0xed, 0xc3, 0x92, 0x1d, 0x50, 0x85, 0x8c, 0x98, 0xfd, 0xce, 0x40, 0x45, 0x40, 0x74, 0x0b,
+	0x56, 0xfc, 0x9e, 0x8b, 0xbd, 0x1e, 0xe9, 0x9b, 0x61, 0xf9, 0x4f, 0x06, 0x50, 0x03, 0x78, 0x63,
+	0x30, 0x70, 0xc9, 0x99, 0xd1, 0x67, 0xce, 0x91, 0xa1, 0x7f, 0xf5, 0x99, 0x37, 0xd9, 0x82, 0x36,
+	0x22, 0xb2, 0x16, 0x70, 0xcb, 0x7f, 0xc8, 0x03, 0x1f, 0xa6, 0xfe, 0xed, 0x95, 0x1c, 0xba, 0x9d,
+	0x79, 0x57, 0xb7, 0x27, 0x65, 0x9a, 0x9d, 0xbb, 0x4c, 0x53, 0xda, 0xcb, 0xcd, 0x68, 0xaf, 0x04,
+	0x30, 0x55, 0x61, 0x79, 0x56, 0x61, 0x53, 0x23, 0x34, 0x7e, 0x74, 0xa7, 0x7b, 0xe5, 0x5a, 0x7e,
+	0x50, 0xc9, 0xcb, 0xea, 0x64, 0x60, 0x46, 0x87, 0x4b, 0xb3, 0x3a, 0xfc, 0x18, 0x56, 0xa2, 0x28,
+	0x05, 0xc7, 0xe5, 0x77, 0xa5, 0x6d, 0x02, 0x4d, 0xeb, 0x77, 0xe5, 0xff, 0xd4, 0xef, 0x11, 0x6c,
+	0xe0, 0xf3, 0x81, 0x15, 0xa4, 0x2e, 0x30, 0x05, 0x73, 0x98, 0x5a, 0x9f, 0x90, 0x99, 0xb9, 0xeb,
+	0xb0, 0xe8, 0x62, 0xc3, 0x23, 0x8e, 0x50, 0x60, 0xa1, 0x0a, 0xdf, 0xa8, 0x97, 0x2e, 0xee, 0x58,
+	0x03, 0x0b, 0x3b, 0xbe, 0xb0, 0x7a, 0x45, 0x15, 0x4f, 0xa0, 0xa8, 0x03, 0x8b, 0x86, 0x4d, 0x86,
+	0x8e, 0x2f, 0xac, 0xb1, 0x4e, 0x62, 0xa7, 0x12, 0x32, 0x68, 0xcb, 0x5a, 0x09, 0x5b, 0xd6, 0x4a,
+	0x8d, 0x58, 0xce, 0xc1, 0x03, 0xba, 0xa8, 0x3f, 0x7d, 0xbb, 0xbb, 0xd7, 0xb5, 0xfc, 0xde, 0xf0,
+	0xb4, 0xd2, 0x21, 0x76, 0xd8, 0xed, 0x86, 0xff, 0xee, 0x7b, 0xe6, 0xcb, 0xaa, 0x7f, 0x31, 0xc0,
+	0x1e, 0x23, 0x78, 0x6a, 0x68, 0x1a, 0x21, 0xc8, 0xd9, 0xd8, 0x26, 0xc2, 0x3a, 0x5b, 0x32, 0x7b,
+	0x2e, 0xb7, 0x60, 0xbb, 0x9d, 0xea, 0x78, 0x6a, 0x0c, 0x7c, 0x07, 0x56, 0x83, 0x16, 0xc9, 0x19,
+	0xd2, 0x0a, 0x62, 0xba, 0xcd, 0xaa, 0x05, 0x36, 0xd6, 0x60, 0x43, 0x68, 0x0b, 0xf2, 0x1d, 0xb6,
+	0xe6, 0x0c, 0xab, 0xa7, 0xe0, 0xa5, 0xfc, 0x57, 0x0e, 0x96, 0x54, 0xdc, 0x19, 0x7a, 0x46, 0xff,
+	0xea, 0x2d, 0x6f, 0xde, 0x1a, 0x98, 0xc4, 0x3d, 0x9b, 0x88, 0xfb, 0x2d, 0x58, 0xb1, 0x0d, 0xc7,
+	0x34, 0x7c, 0xe2, 0x5e, 0x30, 0x75, 0x2f, 0xab, 0x93, 0x81, 0xf8, 0x04, 0xca, 0xcf, 0x7b, 0x02,
+	0x95, 0xff, 0xc1, 0xb1, 0xb3, 0xf8, 0x60, 0x68, 0x76, 0xb1, 0x3f, 0xf7, 0x46, 0x45, 0x8f, 0x97,
+	0x74, 0x9b, 0xc9, 0xb6, 0x76, 0x75, 0x03, 0xa7, 0xda, 0x4a, 0x07, 0x56, 0x8c, 0x7e, 0x9f, 0xbc,
+	0x32, 0x9c, 0x0e, 0x16, 0xb2, 0x57, 0x69, 0xe0, 0xa3, 0x79, 0x35, 0xf0, 0xc7, 0xff, 0xfc, 0xf9,
+	0x1e, 0xa7, 0x4e, 0x3e, 0x51, 0x7e, 0x9d, 0x01, 0x3e, 0x76, 0x4c, 0xea, 0xb0, 0xd4, 0xbd, 0x4f,
+	0xff, 0xd2, 0x72, 0xca, 0xce, 0xca, 0xa9, 0x07, 0x8b, 0x2f, 0x86, 0x8e, 0x89, 0xe9, 0xf6, 0xf4,
+	0x7e, 0xfc, 0x0f, 0xed, 0xa3, 0x17, 0x90, 0xf7, 0x06, 0xb4, 0x42, 0xf3, 0xef, 0xe9, 0x43, 0x81,
+	0xf9, 0xf2, 0xef, 0x32, 0x50, 0x90, 0x0d, 0x72, 0x68, 0x9d, 0x61, 0x87, 0xde, 0xd7, 0xe6, 0x8d,
+	0xef, 0x63, 0x58, 0x65, 0x4d, 0x3d, 0xd6, 0x3d, 0x8b, 0xea, 0x22, 0x33, 0x87, 0x7e, 0x0b, 0x01,
+	0xb3, 0x4d, 0x89, 0xe8, 0x03, 0x40, 0x7d, 0xc3, 0xf3, 0xf5, 0xe0, 0x34, 0x88, 0x7a, 0xb5, 0x20,
+	0x07, 0x3c, 0x9d, 0x09, 0x8e, 0x8c, 0xb0, 0x61, 0xfb, 0x14, 0xf8, 0x69, 0xf4, 0x3b, 0xf6, 0x0d,
+	0xb9, 0x60, 0xa3, 0x9c, 0x58, 0xa3, 0x53, 0xb4, 0x30, 0xbd, 0x21, 0x8d, 0x06, 0xcd, 0x6b, 0x3e,
+	0x28, 0xcc, 0x78, 0xe0, 0xde, 0xbf, 0x33, 0xb0, 0x3a, 0x7d, 0x37, 0x40, 0x9f, 0xc0, 0x4e, 0x4b,
+	0x6d, 0xb6, 0x9a, 0x6d, 0xe9, 0x50, 0x7f, 0x5a, 0x6f, 0xc8, 0xfa, 0x71, 0xa3, 0xdd, 0x52, 0x6a,
+	0xf5, 0x47, 0x75, 0x45, 0xe6, 0x17, 0x8a, 0x37, 0x47, 0x63, 0xf1, 0xc6, 0x34, 0xe1, 0xd8, 0xf1,
+	0x06, 0xb8, 0x43, 0x25, 0x66, 0xa2, 0x7b, 0x70, 0x2d, 0xc9, 0x95, 0x1a, 0xcf, 0x79, 0xae, 0xb8,
+	0x39, 0x1a, 0x8b, 0x1b, 0xd3, 0x1c, 0xc9, 0xb9, 0x98, 0xc5, 0x1e, 0x4a, 0xcf, 0xf8, 0xcc, 0x2c,
+	0xf6, 0xd0, 0x78, 0x45, 0xaf, 0x53, 0x49, 0x6c, 0xad, 0xd9, 0x68, 0x6b, 0x75, 0xed, 0x58, 0xab,
+	0x37, 0x1b, 0xba, 0x74, 0xa4, 0x34, 0xe4, 0x23, 0xa5, 0xa1, 0xf1, 0xd9, 0xe2, 0xf7, 0x47, 0x63,
+	0xf1, 0xce, 0x34, 0xbd, 0x46, 0x1c, 0xcf, 0xb7, 0x7c, 0xd6, 0xf9, 0x48, 0x36, 0x76, 0x4c, 0x7a,
+	0xc9, 0x42, 0x3f, 0x81, 0x62, 0xd2, 0x60, 0x4b, 0x52, 0xa5, 0x23, 0xbd, 0xf6, 0x44, 0x6a, 0x3c,
+	0x56, 0xf8, 0xdc, 0xac, 0x97, 0xec, 0x87, 0x88, 0x5a, 0xcf, 0x70, 0xba, 0x2c, 0x95, 0x49, 0xb2,
+	0xa6, 0x7c, 0xae, 0xf1, 0xf9, 0xe2, 0xd6, 0x68, 0x2c, 0xf2, 0xd3, 0x24, 0x0d, 0x9f, 0xfb, 0xc5,
+	0xdc, 0xaf, 0x7e, 0x5f, 0x5a, 0xb8, 0xf7, 0x65, 0x0e, 0xd6, 0x12, 0x7d, 0x01, 0xfa, 0x29, 0xdc,
+	0x94, 0xa5, 0xa6, 0x2e, 0xd5, 0x98, 0x0f, 0xda, 0xf3, 0x96, 0x92, 0x8a, 0xf4, 0xad, 0xd1, 0x58,
+	0x14, 0x12, 0x9c, 0xe9, 0x50, 0xff, 0x08, 0x84, 0x34, 0x5d, 0x6a, 0x34, 0x9a, 0x9a, 0xa4, 0x29,
+	0x3c, 0x57, 0xdc, 0x19, 0x8d, 0xc5, 0xed, 0x04, 0x37, 0x6c, 0xd1, 0x31, 0xfa, 0x08, 0x6e, 0xa4,
+	0x89, 0x4a, 0x43, 0x6e, 0xaa, 0x6d, 0x85, 0xcf, 0x14, 0x85, 0xd1, 0x58, 0xdc, 0x4a, 0xf0, 0x94,
+	0xe0, 0x6a, 0x8a, 0x8e, 0xe0, 0xee, 0x0c, 0xed, 0x73, 0x4d, 0x69, 0xc8, 0xfa, 0x49, 0x53, 0xab,
+	0x37, 0x1e, 0xeb, 0x2d, 0x45, 0xad, 0x37, 0x65, 0x3e, 0x5b, 0xfc, 0xde, 0x68, 0x2c, 0xee, 0x26,
+	0x6d, 0xd0, 0xb6, 0xdc, 0x3c, 0x99, 0xfa, 0x65, 0x02, 0x55, 0x61, 0x2b, 0x6d, 0xee, 0x44, 0xd1,
+	0x9a, 0x7c, 0xae, 0xb8, 0x3d, 0x1a, 0x8b, 0xd7, 0x12, 0x74, 0xd6, 0xba, 0xff, 0x1c, 0x6e, 0xa7,
+	0x09, 0xcf, 0xea, 0xda, 0x13, 0x59, 0x95, 0x9e, 0x05, 0xcc, 0x7c, 0xf1, 0xf6, 0x68, 0x2c, 0xee,
+	0x24, 0x98, 0xcf, 0x2c, 0xbf, 0x67, 0xba, 0xc6, 0x2b, 0x66, 0x41, 0x86, 0xdd, 0xb4, 0x85, 0xf6,
+	0xf1, 0xc1, 0x51, 0x5d, 0xd3, 0xa3, 0x6c, 0xf2, 0x8b, 0xc5, 0xdd, 0xd1, 0x58, 0xbc, 0x99, 0xb0,
+	0xd1, 0x66, 0x1d, 0x4c, 0x94, 0x56, 0xf4, 0x21, 0x5c, 0x4f, 0x5b, 0x51, 0x95, 0xda, 0x71, 0x5b,
+	0xe1, 0x97, 0x8a, 0x37, 0x46, 0x63, 0x71, 0x33, 0x41, 0x66, 0xa7, 0x30, 0x46, 0x0f, 0x61, 0x7b,
+	0xe6, 0xd3, 0x2d, 0xa5, 0x21, 0xf3, 0xcb, 0xc5, 0xeb, 0xa3, 0xb1, 0x88, 0x92, 0x1f, 0xa4, 0x95,
+	0x19, 0xca, 0xe6, 0xb7, 0x1c, 0x2c, 0x85, 0xfb, 0x11, 0xbd, 0xfe, 0xd4, 0x9a, 0xaa, 0xa2, 0x53,
+	0x4b, 0x49, 0xa5, 0x30, 0x1b, 0x21, 0x2c, 0x55, 0x8e, 0x31, 0xa3, 0xad, 0x29, 0x8a, 0x5a, 0x6f,
+	0x3c, 0x8e, 0xca, 0x31, 0x84, 0x47, 0x9d, 0x07, 0x15, 0x75, 0x8c, 0x6d, 0x9e, 0x28, 0x6a, 0xbb,
+	0xfe, 0xf8, 0x89, 0xc6, 0x67, 0x02, 0x51, 0x87, 0xe0, 0x66, 0x74, 0xd1, 0x0e, 0x57, 0xf7, 0x37,
+	0x0e, 0x96, 0x99, 0x0d, 0xaa, 0xab, 0xfd, 0xc0, 0xc7, 0x36, 0x55, 0x60, 0x6a, 0x7d, 0x51, 0x5c,
+	0x18, 0x70, 0x7a, 0x81, 0x1f, 0x00, 0x9a, 0x70, 0xe4, 0x7a, 0x5b, 0x3a, 0x38, 0x54, 0x64, 0x9e,
+	0x0b, 0x3e, 0x1a, 0x11, 0x64, 0xcb, 0x33, 0x4e, 0xfb, 0xd8, 0x44, 0x7b, 0xc0, 0x4f, 0xd0, 0x34,
+	0x96, 0x27, 0x54, 0xb2, 0x68, 0x34, 0x16, 0xd7, 0x23, 0xac, 0xc4, 0x76, 0x5c, 0x54, 0x81, 0xcd,
+	0x09, 0xb2, 0x7d, 0xcc, 0x62, 0xad, 0x50, 0x6d, 0x46, 0xe2, 0x62, 0xe0, 0x76, 0xb4, 0x09, 0x06,
+	0xee, 0x1c, 0xd4, 0xbf, 0x7a, 0x5d, 0xe2, 0xbe, 0x7e, 0x5d, 0xe2, 0xfe, 0xf5, 0xba, 0xc4, 0x7d,
+	0xf1, 0xa6, 0xb4, 0xf0, 0xf5, 0x9b, 0xd2, 0xc2, 0x3f, 0xdf, 0x94, 0x16, 0x7e, 0x51, 0x9d, 0x3a,
+	0x7b, 0xc2, 0x23, 0xe3, 0x7e, 0x6f, 0x78, 0x1a, 0x3d, 0x57, 0xcf, 0x27, 0xbf, 0xc0, 0xb2, 0x83,
+	0xe8, 0x74, 0x91, 0xed, 0xce, 0x1f, 0xfe, 0x6f, 0x00, 0xcd, 0xfd, 0xf6, 0xa5, 0xa2, 0x15, 0x00,
+	0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndorsementExemptsQuorumExtension {
+		i--
+		if m.EndorsementExemptsQuorumExtension {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxInactivity != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxInactivity, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxInactivity):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintCoredaos(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ExtensionPolicies) > 0 {
+		for iNdEx := len(m.ExtensionPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExtensionPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoredaos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SteeringProposalEpochIdentifier) > 0 {
+		i -= len(m.SteeringProposalEpochIdentifier)
+		copy(dAtA[i:], m.SteeringProposalEpochIdentifier)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.SteeringProposalEpochIdentifier)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SteeringProposalQuota != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.SteeringProposalQuota))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VetoDelay != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VetoDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VetoDelay):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintCoredaos(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.VotingPeriodExtensionDuration != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriodExtensionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriodExtensionDuration):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintCoredaos(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VotingPeriodExtensionsLimit != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.VotingPeriodExtensionsLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OversightDaoAddress) > 0 {
+		i -= len(m.OversightDaoAddress)
+		copy(dAtA[i:], m.OversightDaoAddress)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.OversightDaoAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SteeringDaoAddress) > 0 {
+		i -= len(m.SteeringDaoAddress)
+		copy(dAtA[i:], m.SteeringDaoAddress)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.SteeringDaoAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalExtensionsLimit != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.TotalExtensionsLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OversightDaoExtensionsLimit != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.OversightDaoExtensionsLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SteeringDaoExtensionsLimit != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.SteeringDaoExtensionsLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExtensionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExtensionDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintCoredaos(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalKind != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.ProposalKind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DaoAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaoAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaoAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DaoExtensionsLimit != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.DaoExtensionsLimit))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ExtensionsLimit != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.ExtensionsLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Dao != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Dao))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PreviousAnnotation) > 0 {
+		i -= len(m.PreviousAnnotation)
+		copy(dAtA[i:], m.PreviousAnnotation)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.PreviousAnnotation)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintCoredaos(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	if m.BlockHeight != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Action != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingVeto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingVeto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingVeto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintCoredaos(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintCoredaos(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if m.BurnDeposit {
+		i--
+		if m.BurnDeposit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Vetoer) > 0 {
+		i -= len(m.Vetoer)
+		copy(dAtA[i:], m.Vetoer)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Vetoer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DaoMembers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaoMembers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaoMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ApprovalTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ApprovalTimeout):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintCoredaos(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if m.Threshold != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Dao != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Dao))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingDaoAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingDaoAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingDaoAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoredaos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintCoredaos(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x52
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintCoredaos(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.BurnDeposit {
+		i--
+		if m.BurnDeposit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Overwrite {
+		i--
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Annotation) > 0 {
+		i -= len(m.Annotation)
+		copy(dAtA[i:], m.Annotation)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Annotation)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Action != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Dao != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Dao))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SteeringProposalCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SteeringProposalCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SteeringProposalCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Recusal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Recusal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Recusal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintCoredaos(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x2a
+	if m.Mandatory {
+		i--
+		if m.Mandatory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Dao != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Dao))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DaoBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaoBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaoBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowance) > 0 {
+		for iNdEx := len(m.Allowance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoredaos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Dao != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Dao))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DaoBudgetAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaoBudgetAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaoBudgetAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoredaos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Funded) > 0 {
+		for iNdEx := len(m.Funded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoredaos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Dao != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Dao))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DaoLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaoLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaoLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastActionTime != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastActionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastActionTime):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintCoredaos(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastActionHeight != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.LastActionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ActiveSince, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActiveSince):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintCoredaos(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	if m.Dao != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Dao))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCoredaos(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCoredaos(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SteeringDaoAddress)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	l = len(m.OversightDaoAddress)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.VotingPeriodExtensionsLimit != 0 {
+		n += 1 + sovCoredaos(uint64(m.VotingPeriodExtensionsLimit))
+	}
+	if m.VotingPeriodExtensionDuration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriodExtensionDuration)
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.VetoDelay != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VetoDelay)
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.SteeringProposalQuota != 0 {
+		n += 1 + sovCoredaos(uint64(m.SteeringProposalQuota))
+	}
+	l = len(m.SteeringProposalEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if len(m.ExtensionPolicies) > 0 {
+		for _, e := range m.ExtensionPolicies {
+			l = e.Size()
+			n += 1 + l + sovCoredaos(uint64(l))
+		}
+	}
+	if m.MaxInactivity != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxInactivity)
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.EndorsementExemptsQuorumExtension {
+		n += 2
+	}
+	return n
+}
+
+func (m *ExtensionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalKind != 0 {
+		n += 1 + sovCoredaos(uint64(m.ProposalKind))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExtensionDuration)
+	n += 1 + l + sovCoredaos(uint64(l))
+	if m.SteeringDaoExtensionsLimit != 0 {
+		n += 1 + sovCoredaos(uint64(m.SteeringDaoExtensionsLimit))
+	}
+	if m.OversightDaoExtensionsLimit != 0 {
+		n += 1 + sovCoredaos(uint64(m.OversightDaoExtensionsLimit))
+	}
+	if m.TotalExtensionsLimit != 0 {
+		n += 1 + sovCoredaos(uint64(m.TotalExtensionsLimit))
+	}
+	return n
+}
+
+func (m *DaoAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCoredaos(uint64(m.Id))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovCoredaos(uint64(m.ProposalId))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovCoredaos(uint64(m.Action))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovCoredaos(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCoredaos(uint64(l))
+	l = len(m.PreviousAnnotation)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.Dao != 0 {
+		n += 1 + sovCoredaos(uint64(m.Dao))
+	}
+	if m.ExtensionsLimit != 0 {
+		n += 1 + sovCoredaos(uint64(m.ExtensionsLimit))
+	}
+	if m.DaoExtensionsLimit != 0 {
+		n += 1 + sovCoredaos(uint64(m.DaoExtensionsLimit))
+	}
+	return n
+}
+
+func (m *PendingVeto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovCoredaos(uint64(m.ProposalId))
+	}
+	l = len(m.Vetoer)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.BurnDeposit {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime)
+	n += 1 + l + sovCoredaos(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime)
+	n += 1 + l + sovCoredaos(uint64(l))
+	return n
+}
+
+func (m *DaoMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dao != 0 {
+		n += 1 + sovCoredaos(uint64(m.Dao))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovCoredaos(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovCoredaos(uint64(m.Threshold))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ApprovalTimeout)
+	n += 1 + l + sovCoredaos(uint64(l))
+	return n
+}
+
+func (m *PendingDaoAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCoredaos(uint64(m.Id))
+	}
+	if m.Dao != 0 {
+		n += 1 + sovCoredaos(uint64(m.Dao))
+	}
+	if m.Action != 0 {
+		n += 1 + sovCoredaos(uint64(m.Action))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovCoredaos(uint64(m.ProposalId))
+	}
+	l = len(m.Annotation)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.Overwrite {
+		n += 2
+	}
+	if m.BurnDeposit {
+		n += 2
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovCoredaos(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime)
+	n += 1 + l + sovCoredaos(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovCoredaos(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCoredaos(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	return n
+}
+
+func (m *SteeringProposalCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovCoredaos(uint64(m.EpochNumber))
+	}
+	if m.Count != 0 {
+		n += 1 + sovCoredaos(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *Recusal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovCoredaos(uint64(m.ProposalId))
+	}
+	if m.Dao != 0 {
+		n += 1 + sovCoredaos(uint64(m.Dao))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.Mandatory {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCoredaos(uint64(l))
+	return n
+}
+
+func (m *DaoBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dao != 0 {
+		n += 1 + sovCoredaos(uint64(m.Dao))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if len(m.Allowance) > 0 {
+		for _, e := range m.Allowance {
+			l = e.Size()
+			n += 1 + l + sovCoredaos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DaoBudgetAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dao != 0 {
+		n += 1 + sovCoredaos(uint64(m.Dao))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovCoredaos(uint64(m.EpochNumber))
+	}
+	if len(m.Funded) > 0 {
+		for _, e := range m.Funded {
+			l = e.Size()
+			n += 1 + l + sovCoredaos(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovCoredaos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DaoLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dao != 0 {
+		n += 1 + sovCoredaos(uint64(m.Dao))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActiveSince)
+	n += 1 + l + sovCoredaos(uint64(l))
+	if m.LastActionHeight != 0 {
+		n += 1 + sovCoredaos(uint64(m.LastActionHeight))
+	}
+	if m.LastActionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastActionTime)
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.Suspended {
+		n += 2
+	}
+	return n
+}
+
+func sovCoredaos(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCoredaos(x uint64) (n int) {
+	return sovCoredaos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SteeringDaoAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SteeringDaoAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OversightDaoAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OversightDaoAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodExtensionsLimit", wireType)
+			}
+			m.VotingPeriodExtensionsLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPeriodExtensionsLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodExtensionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriodExtensionDuration == nil {
+				m.VotingPeriodExtensionDuration = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriodExtensionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VetoDelay == nil {
+				m.VetoDelay = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VetoDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SteeringProposalQuota", wireType)
+			}
+			m.SteeringProposalQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SteeringProposalQuota |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SteeringProposalEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SteeringProposalEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionPolicies = append(m.ExtensionPolicies, ExtensionPolicy{})
+			if err := m.ExtensionPolicies[len(m.ExtensionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInactivity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxInactivity == nil {
+				m.MaxInactivity = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxInactivity, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndorsementExemptsQuorumExtension", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EndorsementExemptsQuorumExtension = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalKind", wireType)
+			}
+			m.ProposalKind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalKind |= ProposalKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExtensionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SteeringDaoExtensionsLimit", wireType)
+			}
+			m.SteeringDaoExtensionsLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SteeringDaoExtensionsLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OversightDaoExtensionsLimit", wireType)
+			}
+			m.OversightDaoExtensionsLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OversightDaoExtensionsLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalExtensionsLimit", wireType)
+			}
+			m.TotalExtensionsLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalExtensionsLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DaoAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaoAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaoAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= DaoActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAnnotation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousAnnotation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
+			}
+			m.Dao = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dao |= CoreDao(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionsLimit", wireType)
+			}
+			m.ExtensionsLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtensionsLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoExtensionsLimit", wireType)
+			}
+			m.DaoExtensionsLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaoExtensionsLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingVeto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingVeto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingVeto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vetoer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDeposit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnDeposit = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DaoMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaoMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaoMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
+			}
+			m.Dao = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dao |= CoreDao(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ApprovalTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingDaoAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingDaoAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingDaoAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
+			}
+			m.Dao = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dao |= CoreDao(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= DaoActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDeposit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnDeposit = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SteeringProposalCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SteeringProposalCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SteeringProposalCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Recusal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Recusal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Recusal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
+			}
+			m.Dao = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dao |= CoreDao(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mandatory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mandatory = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DaoBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaoBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaoBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
+			}
+			m.Dao = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dao |= CoreDao(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowance = append(m.Allowance, types.Coin{})
+			if err := m.Allowance[len(m.Allowance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintCoredaos(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCoredaos(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SteeringDaoAddress)
-	if l > 0 {
-		n += 1 + l + sovCoredaos(uint64(l))
-	}
-	l = len(m.OversightDaoAddress)
-	if l > 0 {
-		n += 1 + l + sovCoredaos(uint64(l))
-	}
-	if m.VotingPeriodExtensionsLimit != 0 {
-		n += 1 + sovCoredaos(uint64(m.VotingPeriodExtensionsLimit))
-	}
-	if m.VotingPeriodExtensionDuration != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriodExtensionDuration)
-		n += 1 + l + sovCoredaos(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovCoredaos(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCoredaos(x uint64) (n int) {
-	return sovCoredaos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DaoBudgetAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -255,15 +4505,34 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DaoBudgetAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DaoBudgetAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
+			}
+			m.Dao = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dao |= CoreDao(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SteeringDaoAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -291,13 +4560,169 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SteeringDaoAddress = string(dAtA[iNdEx:postIndex])
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funded = append(m.Funded, types.Coin{})
+			if err := m.Funded[len(m.Funded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DaoLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaoLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaoLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
+			}
+			m.Dao = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dao |= CoreDao(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OversightDaoAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSince", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCoredaos
@@ -307,29 +4732,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCoredaos
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCoredaos
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OversightDaoAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ActiveSince, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodExtensionsLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActionHeight", wireType)
 			}
-			m.VotingPeriodExtensionsLimit = 0
+			m.LastActionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCoredaos
@@ -339,14 +4765,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPeriodExtensionsLimit |= uint32(b&0x7F) << shift
+				m.LastActionHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodExtensionDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -373,13 +4799,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingPeriodExtensionDuration == nil {
-				m.VotingPeriodExtensionDuration = new(time.Duration)
+			if m.LastActionTime == nil {
+				m.LastActionTime = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriodExtensionDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastActionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCoredaos(dAtA[iNdEx:])
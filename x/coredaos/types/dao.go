@@ -0,0 +1,223 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SteeringProposalMetadataPrefix tags the metadata of the proposals submitted
+// by the Steering DAO.
+const SteeringProposalMetadataPrefix = "steering-dao:"
+
+// SteeringProposalMetadata returns the metadata of a proposal submitted by the
+// Steering DAO with the given metadata.
+func SteeringProposalMetadata(metadata string) string {
+	return SteeringProposalMetadataPrefix + metadata
+}
+
+// IsSteeringProposalMetadata returns true if metadata is tagged as the
+// metadata of a proposal submitted by the Steering DAO.
+func IsSteeringProposalMetadata(metadata string) bool {
+	return strings.HasPrefix(metadata, SteeringProposalMetadataPrefix)
+}
+
+// DisplayName returns the name of the Core DAO, as used in messages.
+func (d CoreDao) DisplayName() string {
+	switch d {
+	case CoreDaoSteering:
+		return "Steering DAO"
+	case CoreDaoOversight:
+		return "Oversight DAO"
+	default:
+		return d.String()
+	}
+}
+
+// DaoAddress returns the address of the Core DAO, which is empty if the Core
+// DAO is disabled.
+func (p Params) DaoAddress(dao CoreDao) string {
+	switch dao {
+	case CoreDaoSteering:
+		return p.SteeringDaoAddress
+	case CoreDaoOversight:
+		return p.OversightDaoAddress
+	default:
+		return ""
+	}
+}
+
+// CoreDaoOf returns the Core DAO whose address is address, or
+// CoreDaoUnspecified if address is not the address of a Core DAO.
+func (p Params) CoreDaoOf(address string) CoreDao {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return CoreDaoUnspecified
+	}
+	for _, dao := range []CoreDao{CoreDaoSteering, CoreDaoOversight} {
+		daoAddress := p.DaoAddress(dao)
+		if daoAddress != "" && addr.Equals(sdk.MustAccAddressFromBech32(daoAddress)) {
+			return dao
+		}
+	}
+	return CoreDaoUnspecified
+}
+
+// ValidateRecusalReason returns an error if reason is not a valid recusal
+// reason.
+func ValidateRecusalReason(reason string) error {
+	if len(reason) == 0 {
+		return fmt.Errorf("recusal reason cannot be empty")
+	}
+	if len(reason) > MaxRecusalReasonLength {
+		return fmt.Errorf("invalid recusal reason length; got: %d, max: %d", len(reason), MaxRecusalReasonLength)
+	}
+	return nil
+}
+
+// ValidateSpend returns an error if the spend of amount to recipient with memo
+// is invalid.
+func ValidateSpend(recipient string, amount sdk.Coins, memo string) error {
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return fmt.Errorf("invalid recipient address: %w", err)
+	}
+	if amount.Empty() {
+		return fmt.Errorf("spend amount cannot be empty")
+	}
+	if err := amount.Validate(); err != nil {
+		return fmt.Errorf("invalid spend amount: %w", err)
+	}
+	if len(memo) > MaxSpendMemoLength {
+		return fmt.Errorf("invalid memo length; got: %d, max: %d", len(memo), MaxSpendMemoLength)
+	}
+	return nil
+}
+
+// ValidateDaoAction returns an error if action cannot be taken by dao.
+func ValidateDaoAction(dao CoreDao, action DaoActionType) error {
+	switch action {
+	case DaoActionTypeAnnotate, DaoActionTypeEndorse:
+		if dao != CoreDaoSteering {
+			return fmt.Errorf("action %s is only available to the Steering DAO", action)
+		}
+	case DaoActionTypeVeto, DaoActionTypeWithdrawVeto:
+		if dao != CoreDaoOversight {
+			return fmt.Errorf("action %s is only available to the Oversight DAO", action)
+		}
+	case DaoActionTypeSubmitProposal:
+		return fmt.Errorf("action %s cannot be submitted by the members of a Core DAO", action)
+	case DaoActionTypeExtendVotingPeriod, DaoActionTypeRecuse, DaoActionTypeSpend:
+		if dao != CoreDaoSteering && dao != CoreDaoOversight {
+			return fmt.Errorf("action %s is only available to the Steering DAO and the Oversight DAO", action)
+		}
+	default:
+		return fmt.Errorf("invalid action %s", action)
+	}
+	return nil
+}
+
+// Validate returns an error if the member set is invalid. An empty member
+// set is valid and removes the members of the Core DAO.
+func (m DaoMembers) Validate() error {
+	if m.Dao != CoreDaoSteering && m.Dao != CoreDaoOversight {
+		return fmt.Errorf("invalid dao %s", m.Dao)
+	}
+	if len(m.Members) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool, len(m.Members))
+	for _, member := range m.Members {
+		addr, err := sdk.AccAddressFromBech32(member)
+		if err != nil {
+			return fmt.Errorf("invalid member address %s: %w", member, err)
+		}
+		if seen[addr.String()] {
+			return fmt.Errorf("duplicate member %s", member)
+		}
+		seen[addr.String()] = true
+	}
+	if m.Threshold == 0 || int(m.Threshold) > len(m.Members) {
+		return fmt.Errorf("threshold must be between 1 and the number of members %d: %d", len(m.Members), m.Threshold)
+	}
+	if m.ApprovalTimeout <= 0 {
+		return fmt.Errorf("approval timeout must be positive: %s", m.ApprovalTimeout)
+	}
+	return nil
+}
+
+// IsMember returns true if addr is a member of the Core DAO.
+func (m DaoMembers) IsMember(addr sdk.AccAddress) bool {
+	for _, member := range m.Members {
+		if sdk.MustAccAddressFromBech32(member).Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error if the budget is invalid. A budget with an empty
+// allowance is valid and removes the budget of the Core DAO.
+func (b DaoBudget) Validate() error {
+	if b.Dao != CoreDaoSteering && b.Dao != CoreDaoOversight {
+		return fmt.Errorf("invalid dao %s", b.Dao)
+	}
+	if b.Allowance.Empty() {
+		return nil
+	}
+	if b.EpochIdentifier == "" {
+		return fmt.Errorf("epoch identifier cannot be empty")
+	}
+	if err := b.Allowance.Validate(); err != nil {
+		return fmt.Errorf("invalid allowance: %w", err)
+	}
+	return nil
+}
+
+// Validate returns an error if the budget account is invalid.
+func (a DaoBudgetAccount) Validate() error {
+	if a.Dao != CoreDaoSteering && a.Dao != CoreDaoOversight {
+		return fmt.Errorf("invalid dao %s", a.Dao)
+	}
+	if a.EpochIdentifier == "" {
+		return fmt.Errorf("epoch identifier cannot be empty")
+	}
+	if err := a.Funded.Validate(); err != nil {
+		return fmt.Errorf("invalid funded amount: %w", err)
+	}
+	if err := a.Spent.Validate(); err != nil {
+		return fmt.Errorf("invalid spent amount: %w", err)
+	}
+	if !a.Spent.IsAllLTE(a.Funded) {
+		return fmt.Errorf("spent amount %s exceeds funded amount %s", a.Spent, a.Funded)
+	}
+	return nil
+}
+
+// Remaining returns the amount the Core DAO can still spend from the budget
+// account.
+func (a DaoBudgetAccount) Remaining() sdk.Coins {
+	return a.Funded.Sub(a.Spent...)
+}
+
+// Validate returns an error if the liveness is invalid.
+func (l DaoLiveness) Validate() error {
+	if l.Dao != CoreDaoSteering && l.Dao != CoreDaoOversight {
+		return fmt.Errorf("invalid dao %s", l.Dao)
+	}
+	if l.LastActionHeight < 0 {
+		return fmt.Errorf("last action height cannot be negative: %d", l.LastActionHeight)
+	}
+	return nil
+}
+
+// InactiveSince returns the time from which the inactivity of the Core DAO is
+// counted, which is the latest of its last action and of the start of its
+// activity.
+func (l DaoLiveness) InactiveSince() time.Time {
+	if l.LastActionTime != nil && l.LastActionTime.After(l.ActiveSince) {
+		return *l.LastActionTime
+	}
+	return l.ActiveSince
+}
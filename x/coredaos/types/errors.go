@@ -13,4 +13,14 @@ var (
 	ErrCannotStake              = errorsmod.Register(ModuleName, 5, "core DAOs cannot stake")
 	ErrInvalidVeto              = errorsmod.Register(ModuleName, 6, "oversight DAO cannot veto this proposal")
 	ErrUnknownProposal          = errorsmod.Register(ModuleName, 7, "unknown proposal")
+	ErrVetoPending              = errorsmod.Register(ModuleName, 8, "proposal has a pending veto")
+	ErrNoPendingVeto            = errorsmod.Register(ModuleName, 9, "proposal has no pending veto")
+	ErrNotDaoMember             = errorsmod.Register(ModuleName, 10, "signer is not a member of the core DAO")
+	ErrUnknownDaoAction         = errorsmod.Register(ModuleName, 11, "unknown core DAO action")
+	ErrActionAlreadyApproved    = errorsmod.Register(ModuleName, 12, "core DAO action already approved by member")
+	ErrProposalQuotaReached     = errorsmod.Register(ModuleName, 13, "steering DAO proposal quota reached")
+	ErrInvalidSteeringProposal  = errorsmod.Register(ModuleName, 14, "invalid steering DAO proposal")
+	ErrRecused                  = errorsmod.Register(ModuleName, 15, "core DAO is recused from this proposal")
+	ErrInsufficientBudget       = errorsmod.Register(ModuleName, 16, "insufficient core DAO budget")
+	ErrDaoSuspended             = errorsmod.Register(ModuleName, 17, "core DAO is suspended for inactivity")
 )
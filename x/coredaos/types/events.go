@@ -6,11 +6,39 @@ const (
 	EventTypeEndorseProposal    = "endorse_proposal"
 	EventTypeExtendVotingPeriod = "extend_voting_period"
 	EventTypeVetoProposal       = "veto_proposal"
+	EventTypePendingVeto        = "pending_veto"
+	EventTypeWithdrawVeto       = "withdraw_veto"
+	EventTypeSubmitDaoAction    = "submit_dao_action"
+	EventTypeApproveDaoAction   = "approve_dao_action"
+	EventTypeUpdateDaoMembers   = "update_dao_members"
+	EventTypeSteeringProposal   = "submit_steering_proposal"
+	EventTypeRecuseFromProposal = "recuse_from_proposal"
+	EventTypeUpdateDaoBudget    = "update_dao_budget"
+	EventTypeFundDaoBudget      = "fund_dao_budget"
+	EventTypeReturnDaoBudget    = "return_dao_budget"
+	EventTypeDaoSpend           = "dao_spend"
+	EventTypeDaoStatus          = "dao_status"
 
 	AttributeKeyProposalID    = "proposal_id"
 	AttributeKeySigner        = "signer"
 	AttributeKeyNewEndTime    = "new_end_time"
 	AttributeKeyTimesExtended = "times_extended"
+	AttributeKeyExecutionTime = "execution_time"
+	AttributeKeyActionID      = "action_id"
+	AttributeKeyAction        = "action"
+	AttributeKeyDao           = "dao"
+	AttributeKeyApprovals     = "approvals"
+	AttributeKeyExecuted      = "executed"
+	AttributeKeyEpochNumber   = "epoch_number"
+	AttributeKeyProposalCount = "proposal_count"
+	AttributeKeyReason        = "reason"
+	AttributeKeyMandatory     = "mandatory"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyMemo          = "memo"
+	AttributeKeyState         = "state"
+
+	AttributeKeyQuorumExtensionExempt = "quorum_extension_exempt"
 
 	AttributeValueProposalVetoed = "proposal_vetoed"
 )
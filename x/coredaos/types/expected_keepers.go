@@ -6,6 +6,8 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 )
 
 // StakingKeeper defines the expected interface needed to interact with the
@@ -15,6 +17,29 @@ type StakingKeeper interface {
 	GetDelegatorBonded(ctx context.Context, delegator sdk.AccAddress) (math.Int, error)
 	// GetDelegatorUnbonding returns the total amount a delegator has unbonding.
 	GetDelegatorUnbonding(ctx context.Context, delegator sdk.AccAddress) (math.Int, error)
+	// BondDenom returns the denom of the staking token.
+	BondDenom(ctx context.Context) (string, error)
+}
+
+// EpochsKeeper defines the expected epochs keeper, used by the Steering DAO
+// proposal quota and the Core DAO budgets.
+type EpochsKeeper interface {
+	GetEpochInfo(ctx context.Context, identifier string) (epochstypes.EpochInfo, error)
+}
+
+// QuorumCheckKeeper defines the expected AtomOne gov keeper, used to exempt
+// the endorsed proposals from the late quorum extension of their voting
+// period.
+type QuorumCheckKeeper interface {
+	RemoveFromQuorumCheckQueue(ctx context.Context, proposalID uint64) error
+}
+
+// CommitRevealKeeper defines the expected AtomOne gov keeper, used to keep the
+// commit-reveal voting of the proposals consistent with the Core DAOs
+// actions.
+type CommitRevealKeeper interface {
+	ClearCommitRevealVoting(ctx context.Context, proposalID uint64) error
+	CheckVotingPeriodExtension(ctx context.Context, proposalID uint64) error
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -24,7 +49,17 @@ type AccountKeeper interface {
 	SetAccount(ctx context.Context, acc sdk.AccountI)
 }
 
-// BankKeeper defines the expected account keeper used for simulations (noalias)
+// BankKeeper defines the expected bank keeper, used by the Core DAO budgets and
+// for simulations (noalias)
 type BankKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// DistributionKeeper defines the expected distribution keeper, used to fund
+// the Core DAO budgets from the community pool.
+type DistributionKeeper interface {
+	GetFeePool(ctx context.Context) (distrtypes.FeePool, error)
+	SetFeePool(ctx context.Context, feePool distrtypes.FeePool) error
 }
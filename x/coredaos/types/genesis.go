@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new genesis state for the governance module
 func NewGenesisState(params Params) *GenesisState {
 	return &GenesisState{
@@ -15,5 +21,124 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.ValidateBasic()
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+	seenActionIDs := make(map[uint64]bool, len(gs.Actions))
+	for _, action := range gs.Actions {
+		if seenActionIDs[action.Id] {
+			return fmt.Errorf("duplicate action id %d", action.Id)
+		}
+		seenActionIDs[action.Id] = true
+		if _, err := sdk.AccAddressFromBech32(action.Signer); err != nil {
+			return fmt.Errorf("invalid signer of action %d: %w", action.Id, err)
+		}
+		if _, ok := DaoActionType_name[int32(action.Action)]; !ok || action.Action == DaoActionTypeUnspecified {
+			return fmt.Errorf("invalid type %d of action %d", action.Action, action.Id)
+		}
+	}
+	seenVetoedProposals := make(map[uint64]bool, len(gs.PendingVetoes))
+	for _, veto := range gs.PendingVetoes {
+		if seenVetoedProposals[veto.ProposalId] {
+			return fmt.Errorf("duplicate pending veto for proposal %d", veto.ProposalId)
+		}
+		seenVetoedProposals[veto.ProposalId] = true
+		if _, err := sdk.AccAddressFromBech32(veto.Vetoer); err != nil {
+			return fmt.Errorf("invalid vetoer of pending veto for proposal %d: %w", veto.ProposalId, err)
+		}
+		if veto.ExecutionTime.Before(veto.SubmitTime) {
+			return fmt.Errorf("execution time of pending veto for proposal %d is before its submit time", veto.ProposalId)
+		}
+	}
+	seenDaos := make(map[CoreDao]bool, len(gs.DaoMembers))
+	for _, daoMembers := range gs.DaoMembers {
+		if err := daoMembers.Validate(); err != nil {
+			return fmt.Errorf("invalid members of %s: %w", daoMembers.Dao, err)
+		}
+		if len(daoMembers.Members) == 0 {
+			return fmt.Errorf("empty members of %s", daoMembers.Dao)
+		}
+		if seenDaos[daoMembers.Dao] {
+			return fmt.Errorf("duplicate members of %s", daoMembers.Dao)
+		}
+		seenDaos[daoMembers.Dao] = true
+	}
+	seenDaoActionIDs := make(map[uint64]bool, len(gs.PendingDaoActions))
+	for _, action := range gs.PendingDaoActions {
+		if seenDaoActionIDs[action.Id] {
+			return fmt.Errorf("duplicate pending dao action id %d", action.Id)
+		}
+		seenDaoActionIDs[action.Id] = true
+		if !seenDaos[action.Dao] {
+			return fmt.Errorf("pending dao action %d of %s which has no members", action.Id, action.Dao)
+		}
+		if err := ValidateDaoAction(action.Dao, action.Action); err != nil {
+			return fmt.Errorf("invalid pending dao action %d: %w", action.Id, err)
+		}
+		if len(action.Approvals) == 0 {
+			return fmt.Errorf("pending dao action %d has no approvals", action.Id)
+		}
+		for _, approval := range action.Approvals {
+			if _, err := sdk.AccAddressFromBech32(approval); err != nil {
+				return fmt.Errorf("invalid approval of pending dao action %d: %w", action.Id, err)
+			}
+		}
+	}
+	seenRecusals := make(map[uint64]map[CoreDao]bool, len(gs.Recusals))
+	for _, recusal := range gs.Recusals {
+		if recusal.Dao != CoreDaoSteering && recusal.Dao != CoreDaoOversight {
+			return fmt.Errorf("invalid dao %s of recusal from proposal %d", recusal.Dao, recusal.ProposalId)
+		}
+		if seenRecusals[recusal.ProposalId] == nil {
+			seenRecusals[recusal.ProposalId] = make(map[CoreDao]bool)
+		}
+		if seenRecusals[recusal.ProposalId][recusal.Dao] {
+			return fmt.Errorf("duplicate recusal of %s from proposal %d", recusal.Dao, recusal.ProposalId)
+		}
+		seenRecusals[recusal.ProposalId][recusal.Dao] = true
+		if err := ValidateRecusalReason(recusal.Reason); err != nil {
+			return fmt.Errorf("invalid recusal of %s from proposal %d: %w", recusal.Dao, recusal.ProposalId, err)
+		}
+	}
+	seenBudgets := make(map[CoreDao]bool, len(gs.DaoBudgets))
+	for _, budget := range gs.DaoBudgets {
+		if err := budget.Validate(); err != nil {
+			return fmt.Errorf("invalid budget of %s: %w", budget.Dao, err)
+		}
+		if budget.Allowance.Empty() {
+			return fmt.Errorf("empty allowance of budget of %s", budget.Dao)
+		}
+		if seenBudgets[budget.Dao] {
+			return fmt.Errorf("duplicate budget of %s", budget.Dao)
+		}
+		seenBudgets[budget.Dao] = true
+	}
+	seenBudgetAccounts := make(map[CoreDao]bool, len(gs.DaoBudgetAccounts))
+	for _, account := range gs.DaoBudgetAccounts {
+		if err := account.Validate(); err != nil {
+			return fmt.Errorf("invalid budget account of %s: %w", account.Dao, err)
+		}
+		if seenBudgetAccounts[account.Dao] {
+			return fmt.Errorf("duplicate budget account of %s", account.Dao)
+		}
+		seenBudgetAccounts[account.Dao] = true
+	}
+	seenLiveness := make(map[CoreDao]bool, len(gs.DaoLiveness))
+	for _, liveness := range gs.DaoLiveness {
+		if err := liveness.Validate(); err != nil {
+			return fmt.Errorf("invalid liveness of %s: %w", liveness.Dao, err)
+		}
+		if seenLiveness[liveness.Dao] {
+			return fmt.Errorf("duplicate liveness of %s", liveness.Dao)
+		}
+		seenLiveness[liveness.Dao] = true
+	}
+	seenExemptions := make(map[uint64]bool, len(gs.QuorumExtensionExemptions))
+	for _, proposalID := range gs.QuorumExtensionExemptions {
+		if seenExemptions[proposalID] {
+			return fmt.Errorf("duplicate quorum extension exemption of proposal %d", proposalID)
+		}
+		seenExemptions[proposalID] = true
+	}
+	return nil
 }
@@ -27,6 +27,27 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the x/coredaos module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// actions holds the Core DAOs action log.
+	Actions []DaoAction `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions"`
+	// pending_vetoes holds the vetoes waiting for execution.
+	PendingVetoes []PendingVeto `protobuf:"bytes,3,rep,name=pending_vetoes,json=pendingVetoes,proto3" json:"pending_vetoes"`
+	// dao_members holds the members of the Core DAOs.
+	DaoMembers []DaoMembers `protobuf:"bytes,4,rep,name=dao_members,json=daoMembers,proto3" json:"dao_members"`
+	// pending_dao_actions holds the actions waiting for the approval of the
+	// members of the Core DAOs.
+	PendingDaoActions []PendingDaoAction `protobuf:"bytes,5,rep,name=pending_dao_actions,json=pendingDaoActions,proto3" json:"pending_dao_actions"`
+	// recusals holds the recusals of the Core DAOs from proposals.
+	Recusals []Recusal `protobuf:"bytes,6,rep,name=recusals,proto3" json:"recusals"`
+	// dao_budgets holds the budgets granted to the Core DAOs.
+	DaoBudgets []DaoBudget `protobuf:"bytes,7,rep,name=dao_budgets,json=daoBudgets,proto3" json:"dao_budgets"`
+	// dao_budget_accounts holds the funds of the current epoch of the budgets
+	// of the Core DAOs.
+	DaoBudgetAccounts []DaoBudgetAccount `protobuf:"bytes,8,rep,name=dao_budget_accounts,json=daoBudgetAccounts,proto3" json:"dao_budget_accounts"`
+	// dao_liveness holds the activity of the Core DAOs.
+	DaoLiveness []DaoLiveness `protobuf:"bytes,9,rep,name=dao_liveness,json=daoLiveness,proto3" json:"dao_liveness"`
+	// quorum_extension_exemptions holds the ids of the proposals exempted from
+	// the late quorum extension by their endorsement.
+	QuorumExtensionExemptions []uint64 `protobuf:"varint,10,rep,packed,name=quorum_extension_exemptions,json=quorumExtensionExemptions,proto3" json:"quorum_extension_exemptions,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +90,69 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetActions() []DaoAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingVetoes() []PendingVeto {
+	if m != nil {
+		return m.PendingVetoes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDaoMembers() []DaoMembers {
+	if m != nil {
+		return m.DaoMembers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingDaoActions() []PendingDaoAction {
+	if m != nil {
+		return m.PendingDaoActions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecusals() []Recusal {
+	if m != nil {
+		return m.Recusals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDaoBudgets() []DaoBudget {
+	if m != nil {
+		return m.DaoBudgets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDaoBudgetAccounts() []DaoBudgetAccount {
+	if m != nil {
+		return m.DaoBudgetAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDaoLiveness() []DaoLiveness {
+	if m != nil {
+		return m.DaoLiveness
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQuorumExtensionExemptions() []uint64 {
+	if m != nil {
+		return m.QuorumExtensionExemptions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "atomone.coredaos.v1.GenesisState")
 }
@@ -76,21 +160,37 @@ func init() {
 func init() { proto.RegisterFile("atomone/coredaos/v1/genesis.proto", fileDescriptor_e640711505872f82) }
 
 var fileDescriptor_e640711505872f82 = []byte{
-	// 216 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0x2c, 0xc9, 0xcf,
-	0xcd, 0xcf, 0x4b, 0xd5, 0x4f, 0xce, 0x2f, 0x4a, 0x4d, 0x49, 0xcc, 0x2f, 0xd6, 0x2f, 0x33, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x2a, 0xd1, 0x83, 0x29, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb,
-	0x83, 0x58, 0x10, 0xa5, 0x52, 0x4a, 0xd8, 0x4c, 0x83, 0x6b, 0x83, 0xa8, 0x11, 0x4c, 0xcc, 0xcd,
-	0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x21, 0x25, 0x3f, 0x2e, 0x1e, 0x77, 0x88, 0x95, 0xc1, 0x25,
-	0x89, 0x25, 0xa9, 0x42, 0x76, 0x5c, 0x6c, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0xdc, 0x46, 0xd2, 0x7a, 0x58, 0x9c, 0xa0, 0x17, 0x00, 0x56, 0xe2, 0xc4, 0x79, 0xe2,
-	0x9e, 0x3c, 0xc3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xa0, 0xba, 0x9c, 0x3c, 0x4f, 0x3c, 0x92,
-	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c,
-	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f,
-	0x39, 0x3f, 0x57, 0x1f, 0x6a, 0xa6, 0x6e, 0x46, 0x69, 0x12, 0x8c, 0xad, 0x5f, 0x81, 0x70, 0x79,
-	0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x85, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x8d, 0x48, 0xfd, 0x29, 0x28, 0x01, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x37, 0xee, 0x76, 0xdb, 0xce, 0x56, 0xa1, 0x53, 0x0f, 0xb1, 0x95, 0x74, 0x2d, 0x08,
+	0x8b, 0x60, 0x42, 0xeb, 0x7d, 0xa1, 0x4b, 0x57, 0x29, 0x58, 0x90, 0x15, 0x3c, 0xe8, 0x21, 0x4c,
+	0x92, 0x47, 0x1a, 0x68, 0xe6, 0xc5, 0xcc, 0x64, 0x59, 0xbf, 0x85, 0x1f, 0xc3, 0xa3, 0x1f, 0xa3,
+	0xc7, 0x3d, 0x7a, 0x12, 0xd9, 0x3d, 0x78, 0xf6, 0x1b, 0x48, 0x66, 0x26, 0x59, 0x59, 0x62, 0x2e,
+	0x61, 0x78, 0xf9, 0xbd, 0x5f, 0xde, 0x7f, 0x26, 0x43, 0x9e, 0x31, 0x89, 0x29, 0x72, 0xf0, 0x42,
+	0xcc, 0x21, 0x62, 0x28, 0xbc, 0xf9, 0xb9, 0x17, 0x03, 0x07, 0x91, 0x08, 0x37, 0xcb, 0x51, 0x22,
+	0x3d, 0x32, 0x88, 0x5b, 0x21, 0xee, 0xfc, 0xfc, 0xf8, 0x71, 0x8c, 0x31, 0xaa, 0xf7, 0x5e, 0xb9,
+	0xd2, 0xe8, 0xf1, 0x59, 0x93, 0xad, 0x6e, 0xd3, 0xcc, 0x21, 0x4b, 0x13, 0x8e, 0x9e, 0x7a, 0xea,
+	0xd2, 0xd9, 0x9f, 0x1d, 0x72, 0xf0, 0x46, 0x7f, 0xf3, 0xbd, 0x64, 0x12, 0xe8, 0x98, 0xf4, 0x33,
+	0x96, 0xb3, 0x54, 0xd8, 0xd6, 0xd0, 0x1a, 0x0d, 0x2e, 0x4e, 0xdc, 0x86, 0x19, 0xdc, 0x77, 0x0a,
+	0x99, 0xec, 0xdf, 0xff, 0x3c, 0xed, 0x7c, 0xfb, 0xfd, 0xfd, 0x85, 0x35, 0x33, 0x5d, 0x74, 0x4c,
+	0x76, 0x59, 0x28, 0x13, 0xe4, 0xc2, 0x7e, 0x30, 0xec, 0x8e, 0x06, 0x17, 0x4e, 0xa3, 0xe0, 0x8a,
+	0xe1, 0xa5, 0xc2, 0x26, 0xbd, 0xd2, 0x31, 0xab, 0x9a, 0xe8, 0x0d, 0x79, 0x94, 0x01, 0x8f, 0x12,
+	0x1e, 0xfb, 0x73, 0x90, 0x08, 0xc2, 0xee, 0x2a, 0xcd, 0xb0, 0x79, 0x0e, 0x8d, 0x7e, 0x00, 0x89,
+	0x46, 0xf4, 0x30, 0xdb, 0x94, 0x40, 0xd0, 0xd7, 0x64, 0x10, 0x31, 0xf4, 0x53, 0x48, 0x03, 0xc8,
+	0x85, 0xdd, 0x53, 0xae, 0xd3, 0xff, 0x8d, 0x74, 0xa3, 0x31, 0xa3, 0x22, 0x51, 0x5d, 0xa1, 0x9f,
+	0xc8, 0x51, 0x35, 0x56, 0xe9, 0xab, 0x22, 0xee, 0x28, 0xdf, 0xf3, 0xb6, 0xd9, 0xb6, 0x93, 0x1e,
+	0x66, 0x5b, 0xf5, 0x72, 0xcf, 0xf6, 0x72, 0x08, 0x0b, 0xc1, 0xee, 0x84, 0xdd, 0x57, 0xc6, 0xa7,
+	0x8d, 0xc6, 0x99, 0x86, 0x8c, 0xa8, 0xee, 0xa1, 0x53, 0x1d, 0x32, 0x28, 0xa2, 0x18, 0xa4, 0xb0,
+	0x77, 0xdb, 0xf7, 0x7d, 0xa2, 0xb0, 0x7f, 0x32, 0xea, 0x82, 0xca, 0xb8, 0xd1, 0xf8, 0x2c, 0x0c,
+	0xb1, 0xe0, 0x52, 0xd8, 0x7b, 0x2d, 0x19, 0x6b, 0xdd, 0xa5, 0xa6, 0xab, 0x8c, 0xd1, 0x56, 0x5d,
+	0xd0, 0x6b, 0x72, 0x50, 0xca, 0xef, 0x92, 0x79, 0xf9, 0xb7, 0x09, 0x7b, 0xbf, 0xe5, 0x54, 0xaf,
+	0x18, 0xbe, 0x35, 0x9c, 0x11, 0x0e, 0xa2, 0x4d, 0x89, 0x8e, 0xc9, 0xc9, 0xe7, 0x02, 0xf3, 0x22,
+	0xf5, 0x61, 0x21, 0x81, 0x8b, 0x04, 0xb9, 0x0f, 0x0b, 0x48, 0x33, 0x7d, 0x26, 0x64, 0xd8, 0x1d,
+	0xf5, 0x66, 0x4f, 0x34, 0x32, 0xad, 0x88, 0x69, 0x0d, 0x4c, 0xae, 0xef, 0x57, 0x8e, 0xb5, 0x5c,
+	0x39, 0xd6, 0xaf, 0x95, 0x63, 0x7d, 0x5d, 0x3b, 0x9d, 0xe5, 0xda, 0xe9, 0xfc, 0x58, 0x3b, 0x9d,
+	0x8f, 0x5e, 0x9c, 0xc8, 0xdb, 0x22, 0x70, 0x43, 0x4c, 0x3d, 0x33, 0xd8, 0xcb, 0xdb, 0x22, 0xa8,
+	0xd6, 0xde, 0x62, 0x73, 0xbb, 0xe4, 0x97, 0x0c, 0x44, 0xd0, 0x57, 0xb7, 0xe8, 0xd5, 0xdf, 0x01,
+	0x00, 0x83, 0xd4, 0xfb, 0x2e, 0xcc, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +213,136 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QuorumExtensionExemptions) > 0 {
+		dAtA2 := make([]byte, len(m.QuorumExtensionExemptions)*10)
+		var j1 int
+		for _, num := range m.QuorumExtensionExemptions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.DaoLiveness) > 0 {
+		for iNdEx := len(m.DaoLiveness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DaoLiveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DaoBudgetAccounts) > 0 {
+		for iNdEx := len(m.DaoBudgetAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DaoBudgetAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DaoBudgets) > 0 {
+		for iNdEx := len(m.DaoBudgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DaoBudgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Recusals) > 0 {
+		for iNdEx := len(m.Recusals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recusals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PendingDaoActions) > 0 {
+		for iNdEx := len(m.PendingDaoActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDaoActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DaoMembers) > 0 {
+		for iNdEx := len(m.DaoMembers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DaoMembers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PendingVetoes) > 0 {
+		for iNdEx := len(m.PendingVetoes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingVetoes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -145,6 +375,61 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingVetoes) > 0 {
+		for _, e := range m.PendingVetoes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DaoMembers) > 0 {
+		for _, e := range m.DaoMembers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingDaoActions) > 0 {
+		for _, e := range m.PendingDaoActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Recusals) > 0 {
+		for _, e := range m.Recusals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DaoBudgets) > 0 {
+		for _, e := range m.DaoBudgets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DaoBudgetAccounts) > 0 {
+		for _, e := range m.DaoBudgetAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DaoLiveness) > 0 {
+		for _, e := range m.DaoLiveness {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QuorumExtensionExemptions) > 0 {
+		l = 0
+		for _, e := range m.QuorumExtensionExemptions {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -216,6 +501,354 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, DaoAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingVetoes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingVetoes = append(m.PendingVetoes, PendingVeto{})
+			if err := m.PendingVetoes[len(m.PendingVetoes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DaoMembers = append(m.DaoMembers, DaoMembers{})
+			if err := m.DaoMembers[len(m.DaoMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDaoActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingDaoActions = append(m.PendingDaoActions, PendingDaoAction{})
+			if err := m.PendingDaoActions[len(m.PendingDaoActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recusals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recusals = append(m.Recusals, Recusal{})
+			if err := m.Recusals[len(m.Recusals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoBudgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DaoBudgets = append(m.DaoBudgets, DaoBudget{})
+			if err := m.DaoBudgets[len(m.DaoBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoBudgetAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DaoBudgetAccounts = append(m.DaoBudgetAccounts, DaoBudgetAccount{})
+			if err := m.DaoBudgetAccounts[len(m.DaoBudgetAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoLiveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DaoLiveness = append(m.DaoLiveness, DaoLiveness{})
+			if err := m.DaoLiveness[len(m.DaoLiveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.QuorumExtensionExemptions = append(m.QuorumExtensionExemptions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.QuorumExtensionExemptions) == 0 {
+					m.QuorumExtensionExemptions = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.QuorumExtensionExemptions = append(m.QuorumExtensionExemptions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumExtensionExemptions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,9 +6,20 @@ import (
 
 	"github.com/atomone-hub/atomone/x/coredaos/types"
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	daoAddr := sdk.AccAddress("steeringDao").String()
+	now := time.Now()
+	steeringMembers := types.DaoMembers{
+		Dao:             types.CoreDaoSteering,
+		Members:         []string{daoAddr},
+		Threshold:       1,
+		ApprovalTimeout: time.Hour,
+	}
+	allowance := sdk.NewCoins(sdk.NewInt64Coin("uatone", 100))
 	tests := []struct {
 		desc     string
 		genState func() *types.GenesisState
@@ -64,6 +75,397 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid genesis state with actions",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params: types.DefaultParams(),
+					Actions: []types.DaoAction{
+						{Id: 0, ProposalId: 1, Signer: daoAddr, Action: types.DaoActionTypeAnnotate},
+						{Id: 1, ProposalId: 1, Signer: daoAddr, Action: types.DaoActionTypeEndorse},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state duplicate action id",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params: types.DefaultParams(),
+					Actions: []types.DaoAction{
+						{Id: 1, ProposalId: 1, Signer: daoAddr, Action: types.DaoActionTypeAnnotate},
+						{Id: 1, ProposalId: 2, Signer: daoAddr, Action: types.DaoActionTypeVeto},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state wrong action signer",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params: types.DefaultParams(),
+					Actions: []types.DaoAction{
+						{Id: 0, ProposalId: 1, Signer: "cosmosincorrectaddress", Action: types.DaoActionTypeAnnotate},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state unspecified action type",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params: types.DefaultParams(),
+					Actions: []types.DaoAction{
+						{Id: 0, ProposalId: 1, Signer: daoAddr},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state nil veto delay",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.VetoDelay = nil
+				return &types.GenesisState{Params: params}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state negative veto delay",
+			genState: func() *types.GenesisState {
+				negativeTimeDuration := time.Duration(-1)
+				params := types.DefaultParams()
+				params.VetoDelay = &negativeTimeDuration
+				return &types.GenesisState{Params: params}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state with pending vetoes",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params: types.DefaultParams(),
+					PendingVetoes: []types.PendingVeto{
+						{ProposalId: 1, Vetoer: daoAddr, SubmitTime: now, ExecutionTime: now.Add(time.Hour)},
+						{ProposalId: 2, Vetoer: daoAddr, SubmitTime: now, ExecutionTime: now},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state duplicate pending veto",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params: types.DefaultParams(),
+					PendingVetoes: []types.PendingVeto{
+						{ProposalId: 1, Vetoer: daoAddr, SubmitTime: now, ExecutionTime: now},
+						{ProposalId: 1, Vetoer: daoAddr, SubmitTime: now, ExecutionTime: now},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state wrong vetoer",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params: types.DefaultParams(),
+					PendingVetoes: []types.PendingVeto{
+						{ProposalId: 1, Vetoer: "cosmosincorrectaddress", SubmitTime: now, ExecutionTime: now},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state veto execution before submission",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params: types.DefaultParams(),
+					PendingVetoes: []types.PendingVeto{
+						{ProposalId: 1, Vetoer: daoAddr, SubmitTime: now, ExecutionTime: now.Add(-time.Hour)},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state with dao members and pending dao actions",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params:     types.DefaultParams(),
+					DaoMembers: []types.DaoMembers{steeringMembers},
+					PendingDaoActions: []types.PendingDaoAction{
+						{Id: 1, Dao: types.CoreDaoSteering, Action: types.DaoActionTypeEndorse, Approvals: []string{daoAddr}},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state empty dao members",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params:     types.DefaultParams(),
+					DaoMembers: []types.DaoMembers{{Dao: types.CoreDaoSteering}},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state duplicate dao members",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params:     types.DefaultParams(),
+					DaoMembers: []types.DaoMembers{steeringMembers, steeringMembers},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state pending dao action of dao without members",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params:     types.DefaultParams(),
+					DaoMembers: []types.DaoMembers{steeringMembers},
+					PendingDaoActions: []types.PendingDaoAction{
+						{Id: 1, Dao: types.CoreDaoOversight, Action: types.DaoActionTypeVeto, Approvals: []string{daoAddr}},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state pending dao action not available to dao",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params:     types.DefaultParams(),
+					DaoMembers: []types.DaoMembers{steeringMembers},
+					PendingDaoActions: []types.PendingDaoAction{
+						{Id: 1, Dao: types.CoreDaoSteering, Action: types.DaoActionTypeVeto, Approvals: []string{daoAddr}},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state pending dao action without approvals",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params:     types.DefaultParams(),
+					DaoMembers: []types.DaoMembers{steeringMembers},
+					PendingDaoActions: []types.PendingDaoAction{
+						{Id: 1, Dao: types.CoreDaoSteering, Action: types.DaoActionTypeEndorse},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state duplicate pending dao action",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params:     types.DefaultParams(),
+					DaoMembers: []types.DaoMembers{steeringMembers},
+					PendingDaoActions: []types.PendingDaoAction{
+						{Id: 1, Dao: types.CoreDaoSteering, Action: types.DaoActionTypeEndorse, Approvals: []string{daoAddr}},
+						{Id: 1, Dao: types.CoreDaoSteering, Action: types.DaoActionTypeEndorse, Approvals: []string{daoAddr}},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state with recusals",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params: types.DefaultParams(),
+					Recusals: []types.Recusal{
+						{ProposalId: 1, Dao: types.CoreDaoSteering, Reason: "reason", Time: now},
+						{ProposalId: 1, Dao: types.CoreDaoOversight, Reason: "reason", Mandatory: true, Time: now},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state recusal of unspecified dao",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params:   types.DefaultParams(),
+					Recusals: []types.Recusal{{ProposalId: 1, Reason: "reason", Time: now}},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state duplicate recusal",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params: types.DefaultParams(),
+					Recusals: []types.Recusal{
+						{ProposalId: 1, Dao: types.CoreDaoSteering, Reason: "reason", Time: now},
+						{ProposalId: 1, Dao: types.CoreDaoSteering, Reason: "other reason", Time: now},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state recusal without reason",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params:   types.DefaultParams(),
+					Recusals: []types.Recusal{{ProposalId: 1, Dao: types.CoreDaoSteering, Time: now}},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state with extension policies",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.ExtensionPolicies = []types.ExtensionPolicy{
+					{ProposalKind: types.ProposalKindAny, ExtensionDuration: time.Hour, SteeringDaoExtensionsLimit: 1, TotalExtensionsLimit: 1},
+					{ProposalKind: types.ProposalKindConstitutionAmendment, ExtensionDuration: time.Hour, OversightDaoExtensionsLimit: 2, TotalExtensionsLimit: 2},
+				}
+				return &types.GenesisState{Params: params}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state extension policy without proposal kind",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.ExtensionPolicies = []types.ExtensionPolicy{{ExtensionDuration: time.Hour}}
+				return &types.GenesisState{Params: params}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state extension policy without duration",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.ExtensionPolicies = []types.ExtensionPolicy{{ProposalKind: types.ProposalKindLaw}}
+				return &types.GenesisState{Params: params}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state duplicate extension policy",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				policy := types.ExtensionPolicy{ProposalKind: types.ProposalKindLaw, ExtensionDuration: time.Hour}
+				params.ExtensionPolicies = []types.ExtensionPolicy{policy, policy}
+				return &types.GenesisState{Params: params}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state with liveness",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params: types.DefaultParams(),
+					DaoLiveness: []types.DaoLiveness{
+						{Dao: types.CoreDaoSteering, ActiveSince: now, LastActionHeight: 3, LastActionTime: &now},
+						{Dao: types.CoreDaoOversight, ActiveSince: now, Suspended: true},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state duplicate liveness",
+			genState: func() *types.GenesisState {
+				liveness := types.DaoLiveness{Dao: types.CoreDaoSteering, ActiveSince: now}
+				return &types.GenesisState{
+					Params:      types.DefaultParams(),
+					DaoLiveness: []types.DaoLiveness{liveness, liveness},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state negative max inactivity",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				maxInactivity := -time.Hour
+				params.MaxInactivity = &maxInactivity
+				return &types.GenesisState{Params: params}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state with budgets",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params:     types.DefaultParams(),
+					DaoBudgets: []types.DaoBudget{{Dao: types.CoreDaoSteering, EpochIdentifier: "week", Allowance: allowance}},
+					DaoBudgetAccounts: []types.DaoBudgetAccount{{
+						Dao: types.CoreDaoSteering, EpochIdentifier: "week", EpochNumber: 1, Funded: allowance, Spent: allowance,
+					}},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state budget with empty allowance",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params:     types.DefaultParams(),
+					DaoBudgets: []types.DaoBudget{{Dao: types.CoreDaoSteering, EpochIdentifier: "week"}},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state duplicate budget",
+			genState: func() *types.GenesisState {
+				budget := types.DaoBudget{Dao: types.CoreDaoOversight, EpochIdentifier: "week", Allowance: allowance}
+				return &types.GenesisState{
+					Params:     types.DefaultParams(),
+					DaoBudgets: []types.DaoBudget{budget, budget},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state budget account spent exceeds funded",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params: types.DefaultParams(),
+					DaoBudgetAccounts: []types.DaoBudgetAccount{{
+						Dao: types.CoreDaoSteering, EpochIdentifier: "week", EpochNumber: 1, Spent: allowance,
+					}},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state with quorum extension exemptions",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params:                    types.DefaultParams(),
+					QuorumExtensionExemptions: []uint64{1, 2},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state duplicate quorum extension exemption",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params:                    types.DefaultParams(),
+					QuorumExtensionExemptions: []uint64{1, 1},
+				}
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -13,4 +13,19 @@ const (
 	RouterKey = ModuleName
 )
 
-var ParamsKey = collections.NewPrefix(0)
+var (
+	ParamsKey                 = collections.NewPrefix(0)
+	ActionSequenceKey         = collections.NewPrefix(1)
+	ProposalActionsKeyPrefix  = collections.NewPrefix(2)
+	DaoActionsKeyPrefix       = collections.NewPrefix(3)
+	PendingVetoesKeyPrefix    = collections.NewPrefix(4)
+	DaoMembersKeyPrefix       = collections.NewPrefix(5)
+	DaoActionSequenceKey      = collections.NewPrefix(6)
+	PendingDaoActionsPrefix   = collections.NewPrefix(7)
+	SteeringProposalCountKey  = collections.NewPrefix(8)
+	RecusalsKeyPrefix         = collections.NewPrefix(9)
+	DaoBudgetsKeyPrefix       = collections.NewPrefix(10)
+	DaoBudgetAccountsPrefix   = collections.NewPrefix(11)
+	DaoLivenessKeyPrefix      = collections.NewPrefix(12)
+	QuorumExemptionsKeyPrefix = collections.NewPrefix(13)
+)
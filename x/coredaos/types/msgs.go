@@ -3,15 +3,25 @@ package types
 import (
 	errorsmod "cosmossdk.io/errors"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 const (
-	MaxAnnotationLength = 5000
+	MaxAnnotationLength    = 5000
+	MaxRecusalReasonLength = 1000
+	MaxSpendMemoLength     = 256
 )
 
-var _, _, _, _, _ sdk.Msg = &MsgAnnotateProposal{}, &MsgEndorseProposal{}, &MsgExtendVotingPeriod{}, &MsgVetoProposal{}, &MsgUpdateParams{}
+var _, _, _, _, _, _ sdk.Msg = &MsgAnnotateProposal{}, &MsgEndorseProposal{}, &MsgExtendVotingPeriod{}, &MsgVetoProposal{}, &MsgWithdrawVeto{}, &MsgUpdateParams{}
+
+var _, _, _, _, _ sdk.Msg = &MsgSubmitSteeringProposal{}, &MsgRecuseFromProposal{}, &MsgSubmitDaoAction{}, &MsgApproveAction{}, &MsgUpdateDaoMembers{}
+
+var _, _, _ sdk.Msg = &MsgDaoSpend{}, &MsgUpdateDaoBudget{}, &MsgReconfirmDao{}
+
+var _ codectypes.UnpackInterfacesMessage = &MsgSubmitSteeringProposal{}
 
 // NewMsgAnnotateProposal creates a new MsgAnnotateProposal instance
 func NewMsgAnnotateProposal(signer sdk.AccAddress, proposalID uint64, annotation string) *MsgAnnotateProposal {
@@ -127,6 +137,319 @@ func (msg *MsgVetoProposal) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgWithdrawVeto creates a new MsgWithdrawVeto instance
+func NewMsgWithdrawVeto(signer sdk.AccAddress, proposalID uint64) *MsgWithdrawVeto {
+	return &MsgWithdrawVeto{
+		Vetoer:     signer.String(),
+		ProposalId: proposalID,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgWithdrawVeto) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgWithdrawVeto) Type() string {
+	return sdk.MsgTypeURL(msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgWithdrawVeto) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Vetoer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid vetoer address: %s", err)
+	}
+	return nil
+}
+
+// NewMsgSubmitSteeringProposal creates a new MsgSubmitSteeringProposal instance
+func NewMsgSubmitSteeringProposal(
+	proposer sdk.AccAddress, messages []sdk.Msg, metadata, title, summary string,
+) (*MsgSubmitSteeringProposal, error) {
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgSubmitSteeringProposal{
+		Proposer: proposer.String(),
+		Messages: anys,
+		Metadata: metadata,
+		Title:    title,
+		Summary:  summary,
+	}, nil
+}
+
+// GetMsgs unpacks the messages of the proposal.
+func (msg *MsgSubmitSteeringProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Messages, "sdk.MsgProposal")
+}
+
+// UnpackInterfaces implements the codectypes.UnpackInterfacesMessage interface.
+func (msg *MsgSubmitSteeringProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Messages)
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgSubmitSteeringProposal) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgSubmitSteeringProposal) Type() string {
+	return sdk.MsgTypeURL(msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgSubmitSteeringProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address: %s", err)
+	}
+	if len(msg.Title) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "proposal title cannot be empty")
+	}
+	if len(msg.Summary) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "proposal summary cannot be empty")
+	}
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	for idx, m := range msgs {
+		if m, ok := m.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "proposal message %d: %s", idx, err)
+			}
+		}
+	}
+	return nil
+}
+
+// NewMsgRecuseFromProposal creates a new MsgRecuseFromProposal instance
+func NewMsgRecuseFromProposal(signer sdk.AccAddress, proposalID uint64, reason string) *MsgRecuseFromProposal {
+	return &MsgRecuseFromProposal{
+		Recuser:    signer.String(),
+		ProposalId: proposalID,
+		Reason:     reason,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgRecuseFromProposal) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgRecuseFromProposal) Type() string {
+	return sdk.MsgTypeURL(msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgRecuseFromProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Recuser); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recuser address: %s", err)
+	}
+	if err := ValidateRecusalReason(msg.Reason); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// NewMsgDaoSpend creates a new MsgDaoSpend instance
+func NewMsgDaoSpend(signer, recipient sdk.AccAddress, amount sdk.Coins, memo string) *MsgDaoSpend {
+	return &MsgDaoSpend{
+		Spender:   signer.String(),
+		Recipient: recipient.String(),
+		Amount:    amount,
+		Memo:      memo,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgDaoSpend) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgDaoSpend) Type() string {
+	return sdk.MsgTypeURL(msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgDaoSpend) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Spender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid spender address: %s", err)
+	}
+	if err := ValidateSpend(msg.Recipient, msg.Amount, msg.Memo); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// NewMsgSubmitDaoAction creates a new MsgSubmitDaoAction instance
+func NewMsgSubmitDaoAction(member sdk.AccAddress, dao CoreDao, action DaoActionType, proposalID uint64) *MsgSubmitDaoAction {
+	return &MsgSubmitDaoAction{
+		Member:     member.String(),
+		Dao:        dao,
+		Action:     action,
+		ProposalId: proposalID,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgSubmitDaoAction) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgSubmitDaoAction) Type() string {
+	return sdk.MsgTypeURL(msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgSubmitDaoAction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Member); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address: %s", err)
+	}
+	if err := ValidateDaoAction(msg.Dao, msg.Action); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.Action == DaoActionTypeAnnotate {
+		if len(msg.Annotation) == 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "annotation cannot be empty")
+		}
+		if len(msg.Annotation) > MaxAnnotationLength {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"invalid annotation length; got: %d, max: %d", len(msg.Annotation), MaxAnnotationLength,
+			)
+		}
+	}
+	if msg.Action == DaoActionTypeRecuse {
+		if err := ValidateRecusalReason(msg.Reason); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	if msg.Action == DaoActionTypeSpend {
+		if err := ValidateSpend(msg.Recipient, msg.Amount, msg.Memo); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	return nil
+}
+
+// NewMsgApproveAction creates a new MsgApproveAction instance
+func NewMsgApproveAction(member sdk.AccAddress, id uint64) *MsgApproveAction {
+	return &MsgApproveAction{
+		Member: member.String(),
+		Id:     id,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgApproveAction) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgApproveAction) Type() string {
+	return sdk.MsgTypeURL(msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgApproveAction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Member); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address: %s", err)
+	}
+	return nil
+}
+
+// NewMsgUpdateDaoMembers creates a new MsgUpdateDaoMembers instance
+func NewMsgUpdateDaoMembers(authority string, daoMembers DaoMembers) *MsgUpdateDaoMembers {
+	return &MsgUpdateDaoMembers{
+		Authority:  authority,
+		DaoMembers: daoMembers,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgUpdateDaoMembers) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgUpdateDaoMembers) Type() string {
+	return sdk.MsgTypeURL(msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgUpdateDaoMembers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if err := msg.DaoMembers.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// NewMsgUpdateDaoBudget creates a new MsgUpdateDaoBudget instance
+func NewMsgUpdateDaoBudget(authority string, daoBudget DaoBudget) *MsgUpdateDaoBudget {
+	return &MsgUpdateDaoBudget{
+		Authority: authority,
+		DaoBudget: daoBudget,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgUpdateDaoBudget) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgUpdateDaoBudget) Type() string {
+	return sdk.MsgTypeURL(msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgUpdateDaoBudget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if err := msg.DaoBudget.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// NewMsgReconfirmDao creates a new MsgReconfirmDao instance
+func NewMsgReconfirmDao(authority string, dao CoreDao) *MsgReconfirmDao {
+	return &MsgReconfirmDao{
+		Authority: authority,
+		Dao:       dao,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgReconfirmDao) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgReconfirmDao) Type() string {
+	return sdk.MsgTypeURL(msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgReconfirmDao) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if msg.Dao != CoreDaoSteering && msg.Dao != CoreDaoOversight {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid dao %s", msg.Dao)
+	}
+	return nil
+}
+
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
 func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
 	return &MsgUpdateParams{
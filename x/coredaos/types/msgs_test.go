@@ -3,11 +3,15 @@ package types_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/atomone-hub/atomone/x/coredaos/types"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 var addrs = []sdk.AccAddress{
@@ -96,3 +100,452 @@ func TestMsgVetoProposal_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgWithdrawVeto_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		vetoer     sdk.AccAddress
+		proposalId uint64
+		expectPass bool
+	}{
+		{sdk.AccAddress{}, 0, false},
+		{addrs[0], 0, true},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgWithdrawVeto(tc.vetoer, tc.proposalId)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgRecuseFromProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name        string
+		recuser     sdk.AccAddress
+		reason      string
+		expectedErr string
+	}{
+		{
+			name:        "invalid recuser",
+			recuser:     sdk.AccAddress{},
+			reason:      "reason",
+			expectedErr: "invalid recuser address: empty address string is not allowed: invalid address",
+		},
+		{
+			name:        "empty reason",
+			recuser:     addrs[0],
+			expectedErr: "recusal reason cannot be empty: invalid request",
+		},
+		{
+			name:        "reason too long",
+			recuser:     addrs[0],
+			reason:      strings.Repeat("a", types.MaxRecusalReasonLength+1),
+			expectedErr: "invalid recusal reason length; got: 1001, max: 1000: invalid request",
+		},
+		{
+			name:    "ok",
+			recuser: addrs[0],
+			reason:  "conflict of interest",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.NewMsgRecuseFromProposal(tt.recuser, 1, tt.reason).ValidateBasic()
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSubmitDaoAction_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name        string
+		msg         *types.MsgSubmitDaoAction
+		expectedErr string
+	}{
+		{
+			name:        "invalid member",
+			msg:         &types.MsgSubmitDaoAction{Dao: types.CoreDaoSteering, Action: types.DaoActionTypeEndorse},
+			expectedErr: "invalid member address: empty address string is not allowed: invalid address",
+		},
+		{
+			name:        "unspecified action",
+			msg:         &types.MsgSubmitDaoAction{Member: addrs[0].String(), Dao: types.CoreDaoSteering},
+			expectedErr: "invalid action DAO_ACTION_TYPE_UNSPECIFIED: invalid request",
+		},
+		{
+			name:        "steering DAO action by oversight DAO",
+			msg:         &types.MsgSubmitDaoAction{Member: addrs[0].String(), Dao: types.CoreDaoOversight, Action: types.DaoActionTypeEndorse},
+			expectedErr: "action DAO_ACTION_TYPE_ENDORSE is only available to the Steering DAO: invalid request",
+		},
+		{
+			name:        "oversight DAO action by steering DAO",
+			msg:         &types.MsgSubmitDaoAction{Member: addrs[0].String(), Dao: types.CoreDaoSteering, Action: types.DaoActionTypeVeto},
+			expectedErr: "action DAO_ACTION_TYPE_VETO is only available to the Oversight DAO: invalid request",
+		},
+		{
+			name:        "unspecified dao",
+			msg:         &types.MsgSubmitDaoAction{Member: addrs[0].String(), Action: types.DaoActionTypeExtendVotingPeriod},
+			expectedErr: "action DAO_ACTION_TYPE_EXTEND_VOTING_PERIOD is only available to the Steering DAO and the Oversight DAO: invalid request",
+		},
+		{
+			name:        "empty annotation",
+			msg:         &types.MsgSubmitDaoAction{Member: addrs[0].String(), Dao: types.CoreDaoSteering, Action: types.DaoActionTypeAnnotate},
+			expectedErr: "annotation cannot be empty: invalid request",
+		},
+		{
+			name: "ok annotation",
+			msg:  &types.MsgSubmitDaoAction{Member: addrs[0].String(), Dao: types.CoreDaoSteering, Action: types.DaoActionTypeAnnotate, Annotation: "annotation"},
+		},
+		{
+			name: "ok extension",
+			msg:  &types.MsgSubmitDaoAction{Member: addrs[0].String(), Dao: types.CoreDaoOversight, Action: types.DaoActionTypeExtendVotingPeriod},
+		},
+		{
+			name:        "empty recusal reason",
+			msg:         &types.MsgSubmitDaoAction{Member: addrs[0].String(), Dao: types.CoreDaoOversight, Action: types.DaoActionTypeRecuse},
+			expectedErr: "recusal reason cannot be empty: invalid request",
+		},
+		{
+			name: "ok recusal",
+			msg:  &types.MsgSubmitDaoAction{Member: addrs[0].String(), Dao: types.CoreDaoSteering, Action: types.DaoActionTypeRecuse, Reason: "conflict of interest"},
+		},
+		{
+			name:        "empty spend amount",
+			msg:         &types.MsgSubmitDaoAction{Member: addrs[0].String(), Dao: types.CoreDaoSteering, Action: types.DaoActionTypeSpend, Recipient: addrs[1].String()},
+			expectedErr: "spend amount cannot be empty: invalid request",
+		},
+		{
+			name: "ok spend",
+			msg: &types.MsgSubmitDaoAction{
+				Member: addrs[0].String(), Dao: types.CoreDaoOversight, Action: types.DaoActionTypeSpend,
+				Recipient: addrs[1].String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("uatone", 1)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDaoSpend_ValidateBasic(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatone", 1))
+	tests := []struct {
+		name        string
+		spender     sdk.AccAddress
+		recipient   sdk.AccAddress
+		amount      sdk.Coins
+		memo        string
+		expectedErr string
+	}{
+		{
+			name:        "invalid spender",
+			spender:     sdk.AccAddress{},
+			recipient:   addrs[1],
+			amount:      amount,
+			expectedErr: "invalid spender address: empty address string is not allowed: invalid address",
+		},
+		{
+			name:        "invalid recipient",
+			spender:     addrs[0],
+			recipient:   sdk.AccAddress{},
+			amount:      amount,
+			expectedErr: "invalid recipient address: empty address string is not allowed: invalid request",
+		},
+		{
+			name:        "empty amount",
+			spender:     addrs[0],
+			recipient:   addrs[1],
+			expectedErr: "spend amount cannot be empty: invalid request",
+		},
+		{
+			name:        "invalid amount",
+			spender:     addrs[0],
+			recipient:   addrs[1],
+			amount:      sdk.Coins{sdk.Coin{Denom: "uatone", Amount: math.ZeroInt()}},
+			expectedErr: "invalid spend amount: coin 0uatone amount is not positive: invalid request",
+		},
+		{
+			name:        "memo too long",
+			spender:     addrs[0],
+			recipient:   addrs[1],
+			amount:      amount,
+			memo:        strings.Repeat("a", types.MaxSpendMemoLength+1),
+			expectedErr: "invalid memo length; got: 257, max: 256: invalid request",
+		},
+		{
+			name:      "ok",
+			spender:   addrs[0],
+			recipient: addrs[1],
+			amount:    amount,
+			memo:      "grant",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.NewMsgDaoSpend(tt.spender, tt.recipient, tt.amount, tt.memo).ValidateBasic()
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateDaoBudget_ValidateBasic(t *testing.T) {
+	authority := addrs[0].String()
+	allowance := sdk.NewCoins(sdk.NewInt64Coin("uatone", 100))
+	tests := []struct {
+		name        string
+		msg         *types.MsgUpdateDaoBudget
+		expectedErr string
+	}{
+		{
+			name:        "invalid authority",
+			msg:         types.NewMsgUpdateDaoBudget("", types.DaoBudget{Dao: types.CoreDaoSteering}),
+			expectedErr: "invalid authority address: empty address string is not allowed: invalid address",
+		},
+		{
+			name:        "unspecified dao",
+			msg:         types.NewMsgUpdateDaoBudget(authority, types.DaoBudget{EpochIdentifier: "week", Allowance: allowance}),
+			expectedErr: "invalid dao CORE_DAO_UNSPECIFIED: invalid request",
+		},
+		{
+			name:        "empty epoch identifier",
+			msg:         types.NewMsgUpdateDaoBudget(authority, types.DaoBudget{Dao: types.CoreDaoSteering, Allowance: allowance}),
+			expectedErr: "epoch identifier cannot be empty: invalid request",
+		},
+		{
+			name: "invalid allowance",
+			msg: types.NewMsgUpdateDaoBudget(authority, types.DaoBudget{
+				Dao: types.CoreDaoSteering, EpochIdentifier: "week", Allowance: sdk.Coins{sdk.Coin{Denom: "uatone", Amount: math.ZeroInt()}},
+			}),
+			expectedErr: "invalid allowance: coin 0uatone amount is not positive: invalid request",
+		},
+		{
+			name: "ok",
+			msg:  types.NewMsgUpdateDaoBudget(authority, types.DaoBudget{Dao: types.CoreDaoOversight, EpochIdentifier: "week", Allowance: allowance}),
+		},
+		{
+			name: "ok remove budget",
+			msg:  types.NewMsgUpdateDaoBudget(authority, types.DaoBudget{Dao: types.CoreDaoOversight}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgReconfirmDao_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name        string
+		msg         *types.MsgReconfirmDao
+		expectedErr string
+	}{
+		{
+			name:        "invalid authority",
+			msg:         types.NewMsgReconfirmDao("", types.CoreDaoSteering),
+			expectedErr: "invalid authority address: empty address string is not allowed: invalid address",
+		},
+		{
+			name:        "unspecified dao",
+			msg:         types.NewMsgReconfirmDao(addrs[0].String(), types.CoreDaoUnspecified),
+			expectedErr: "invalid dao CORE_DAO_UNSPECIFIED: invalid request",
+		},
+		{
+			name: "ok",
+			msg:  types.NewMsgReconfirmDao(addrs[0].String(), types.CoreDaoOversight),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgApproveAction_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		member     sdk.AccAddress
+		id         uint64
+		expectPass bool
+	}{
+		{sdk.AccAddress{}, 0, false},
+		{addrs[0], 0, true},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgApproveAction(tc.member, tc.id)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgUpdateDaoMembers_ValidateBasic(t *testing.T) {
+	authority := addrs[0].String()
+	members := []string{addrs[0].String(), addrs[1].String()}
+	tests := []struct {
+		name        string
+		msg         *types.MsgUpdateDaoMembers
+		expectedErr string
+	}{
+		{
+			name:        "invalid authority",
+			msg:         types.NewMsgUpdateDaoMembers("", types.DaoMembers{Dao: types.CoreDaoSteering}),
+			expectedErr: "invalid authority address: empty address string is not allowed: invalid address",
+		},
+		{
+			name:        "unspecified dao",
+			msg:         types.NewMsgUpdateDaoMembers(authority, types.DaoMembers{}),
+			expectedErr: "invalid dao CORE_DAO_UNSPECIFIED: invalid request",
+		},
+		{
+			name: "invalid member",
+			msg: types.NewMsgUpdateDaoMembers(authority, types.DaoMembers{
+				Dao: types.CoreDaoSteering, Members: []string{"invalid"}, Threshold: 1, ApprovalTimeout: time.Hour,
+			}),
+			expectedErr: "invalid member address invalid: decoding bech32 failed: invalid bech32 string length 7: invalid request",
+		},
+		{
+			name: "duplicate member",
+			msg: types.NewMsgUpdateDaoMembers(authority, types.DaoMembers{
+				Dao: types.CoreDaoSteering, Members: []string{members[0], members[0]}, Threshold: 1, ApprovalTimeout: time.Hour,
+			}),
+			expectedErr: "duplicate member " + members[0] + ": invalid request",
+		},
+		{
+			name: "zero threshold",
+			msg: types.NewMsgUpdateDaoMembers(authority, types.DaoMembers{
+				Dao: types.CoreDaoSteering, Members: members, ApprovalTimeout: time.Hour,
+			}),
+			expectedErr: "threshold must be between 1 and the number of members 2: 0: invalid request",
+		},
+		{
+			name: "threshold above members",
+			msg: types.NewMsgUpdateDaoMembers(authority, types.DaoMembers{
+				Dao: types.CoreDaoSteering, Members: members, Threshold: 3, ApprovalTimeout: time.Hour,
+			}),
+			expectedErr: "threshold must be between 1 and the number of members 2: 3: invalid request",
+		},
+		{
+			name: "zero approval timeout",
+			msg: types.NewMsgUpdateDaoMembers(authority, types.DaoMembers{
+				Dao: types.CoreDaoSteering, Members: members, Threshold: 2,
+			}),
+			expectedErr: "approval timeout must be positive: 0s: invalid request",
+		},
+		{
+			name: "ok",
+			msg: types.NewMsgUpdateDaoMembers(authority, types.DaoMembers{
+				Dao: types.CoreDaoOversight, Members: members, Threshold: 2, ApprovalTimeout: time.Hour,
+			}),
+		},
+		{
+			name: "ok remove members",
+			msg:  types.NewMsgUpdateDaoMembers(authority, types.DaoMembers{Dao: types.CoreDaoOversight}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSubmitSteeringProposal_ValidateBasic(t *testing.T) {
+	sendMsg := banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin("uatone", 1)))
+	invalidMsg := &types.MsgUpdateDaoMembers{}
+	tests := []struct {
+		name        string
+		proposer    sdk.AccAddress
+		msgs        []sdk.Msg
+		title       string
+		summary     string
+		expectedErr string
+	}{
+		{
+			name:        "invalid proposer",
+			proposer:    sdk.AccAddress{},
+			title:       "title",
+			summary:     "summary",
+			expectedErr: "invalid proposer address: empty address string is not allowed: invalid address",
+		},
+		{
+			name:        "empty title",
+			proposer:    addrs[0],
+			summary:     "summary",
+			expectedErr: "proposal title cannot be empty: invalid request",
+		},
+		{
+			name:        "empty summary",
+			proposer:    addrs[0],
+			title:       "title",
+			expectedErr: "proposal summary cannot be empty: invalid request",
+		},
+		{
+			name:        "invalid message",
+			proposer:    addrs[0],
+			msgs:        []sdk.Msg{sendMsg, invalidMsg},
+			title:       "title",
+			summary:     "summary",
+			expectedErr: "proposal message 1: invalid authority address: empty address string is not allowed: invalid address: invalid request",
+		},
+		{
+			name:     "ok text proposal",
+			proposer: addrs[0],
+			title:    "title",
+			summary:  "summary",
+		},
+		{
+			name:     "ok",
+			proposer: addrs[0],
+			msgs:     []sdk.Msg{sendMsg},
+			title:    "title",
+			summary:  "summary",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := types.NewMsgSubmitSteeringProposal(tt.proposer, tt.msgs, "", tt.title, tt.summary)
+			require.NoError(t, err)
+			err = msg.ValidateBasic()
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,18 +2,27 @@ package types
 
 import (
 	fmt "fmt"
+	"slices"
+	"strings"
 	time "time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkgovv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+	govv1beta1 "github.com/atomone-hub/atomone/x/gov/types/v1beta1"
 )
 
 // NewParams creates a new Params instance
-func NewParams(steeringDaoAddress, oversightDaoAddress string, votingPeriodExtensionsLimit uint32, votingPeriodExtensionDuration time.Duration) Params {
+func NewParams(steeringDaoAddress, oversightDaoAddress string, votingPeriodExtensionsLimit uint32, votingPeriodExtensionDuration, vetoDelay time.Duration) Params {
 	return Params{
 		SteeringDaoAddress:            steeringDaoAddress,
 		OversightDaoAddress:           oversightDaoAddress,
 		VotingPeriodExtensionsLimit:   votingPeriodExtensionsLimit,
 		VotingPeriodExtensionDuration: &votingPeriodExtensionDuration,
+		VetoDelay:                     &vetoDelay,
 	}
 }
 
@@ -28,16 +37,34 @@ const (
 	DefaultVotingPeriodExtensionsLimit = 3
 	// DefaultVotingPeriodExtensionDuration is the default duration for voting period extensions
 	DefaultVotingPeriodExtensionDuration = time.Hour * 24 * 7 // 7 days
+	// DefaultVetoDelay is the default delay between the submission of a veto
+	// and its execution
+	DefaultVetoDelay = time.Hour * 24 * 3 // 3 days
+	// DefaultSteeringProposalQuota is the default number of proposals the
+	// Steering DAO can submit without deposit per epoch
+	DefaultSteeringProposalQuota = 1
+	// DefaultSteeringProposalEpochIdentifier is the default epoch of the
+	// Steering DAO proposal quota
+	DefaultSteeringProposalEpochIdentifier = "week"
+	// DefaultMaxInactivity is the default duration after which an inactive
+	// Core DAO is suspended
+	DefaultMaxInactivity = time.Hour * 24 * 90 // 90 days
 )
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultSteeringDaoAddress,
 		DefaultOversightDaoAddress,
 		DefaultVotingPeriodExtensionsLimit,
 		DefaultVotingPeriodExtensionDuration,
+		DefaultVetoDelay,
 	)
+	params.SteeringProposalQuota = DefaultSteeringProposalQuota
+	params.SteeringProposalEpochIdentifier = DefaultSteeringProposalEpochIdentifier
+	maxInactivity := DefaultMaxInactivity
+	params.MaxInactivity = &maxInactivity
+	return params
 }
 
 // Validate validates the set of params
@@ -74,5 +101,146 @@ func (p Params) ValidateBasic() error {
 	if p.VotingPeriodExtensionDuration.Seconds() <= 0 {
 		return fmt.Errorf("voting period extension duration must be positive: %s", p.VotingPeriodExtensionDuration)
 	}
+
+	// VetoDelay can be nil or zero, in which case vetoes are executed at the
+	// end of the block they are submitted in
+	if p.VetoDelay != nil && *p.VetoDelay < 0 {
+		return fmt.Errorf("veto delay cannot be negative: %s", p.VetoDelay)
+	}
+
+	// The Steering DAO proposal quota is counted per epoch
+	if p.SteeringProposalQuota > 0 && p.SteeringProposalEpochIdentifier == "" {
+		return fmt.Errorf("steering proposal epoch identifier must be set when the steering proposal quota is used")
+	}
+
+	// MaxInactivity can be nil or zero, in which case inactive Core DAOs are
+	// not suspended
+	if p.MaxInactivity != nil && *p.MaxInactivity < 0 {
+		return fmt.Errorf("max inactivity cannot be negative: %s", p.MaxInactivity)
+	}
+
+	// Each proposal kind can have at most one extension policy
+	seenKinds := make(map[ProposalKind]bool, len(p.ExtensionPolicies))
+	for _, policy := range p.ExtensionPolicies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+		if seenKinds[policy.ProposalKind] {
+			return fmt.Errorf("duplicate extension policy for proposal kind %s", policy.ProposalKind)
+		}
+		seenKinds[policy.ProposalKind] = true
+	}
 	return nil
 }
+
+// Validate returns an error if the extension policy is invalid.
+func (p ExtensionPolicy) Validate() error {
+	if _, ok := ProposalKind_name[int32(p.ProposalKind)]; !ok || p.ProposalKind == ProposalKindUnspecified {
+		return fmt.Errorf("invalid extension policy proposal kind %s", p.ProposalKind)
+	}
+	if p.ExtensionDuration <= 0 {
+		return fmt.Errorf("extension duration of proposal kind %s must be positive: %s", p.ProposalKind, p.ExtensionDuration)
+	}
+	for _, dao := range []CoreDao{CoreDaoSteering, CoreDaoOversight} {
+		if limit := p.ExtensionsLimit(dao); limit > p.TotalExtensionsLimit {
+			return fmt.Errorf("%s extensions limit of proposal kind %s exceeds its extensions limit: %d > %d",
+				dao.DisplayName(), p.ProposalKind, limit, p.TotalExtensionsLimit)
+		}
+	}
+	return nil
+}
+
+// ExtensionsLimit returns the maximum number of times dao can extend the
+// voting period of a proposal under the policy.
+func (p ExtensionPolicy) ExtensionsLimit(dao CoreDao) uint32 {
+	switch dao {
+	case CoreDaoSteering:
+		return p.SteeringDaoExtensionsLimit
+	case CoreDaoOversight:
+		return p.OversightDaoExtensionsLimit
+	default:
+		return 0
+	}
+}
+
+// SuspendsInactiveDaos returns true if the Core DAOs which have not acted for
+// longer than MaxInactivity are suspended.
+func (p Params) SuspendsInactiveDaos() bool {
+	return p.MaxInactivity != nil && *p.MaxInactivity > 0
+}
+
+// ExtensionsLimits returns how many times the voting period of a proposal of
+// kind can be extended, in total and by dao. The limits of the extension
+// policy of kind replace VotingPeriodExtensionsLimit, which only applies to
+// the kinds without policy.
+func (p Params) ExtensionsLimits(kind ProposalKind, dao CoreDao) (total, daoLimit uint32) {
+	if policy, ok := p.ExtensionPolicyOf(kind); ok {
+		return policy.TotalExtensionsLimit, policy.ExtensionsLimit(dao)
+	}
+	return p.VotingPeriodExtensionsLimit, p.VotingPeriodExtensionsLimit
+}
+
+// ExtensionPolicyOf returns the extension policy of the proposals of kind, and
+// false if kind has no policy.
+func (p Params) ExtensionPolicyOf(kind ProposalKind) (ExtensionPolicy, bool) {
+	for _, policy := range p.ExtensionPolicies {
+		if policy.ProposalKind == kind {
+			return policy, true
+		}
+	}
+	return ExtensionPolicy{}, false
+}
+
+var (
+	// paramChangeProposalTypeURL is the type URL of the legacy param change
+	// proposals.
+	paramChangeProposalTypeURL = sdk.MsgTypeURL(&paramsproposal.ParameterChangeProposal{})
+	// textProposalTypeURLs are the type URLs of the legacy text proposals.
+	textProposalTypeURLs = []string{
+		sdk.MsgTypeURL(&govv1beta1.TextProposal{}),
+		sdk.MsgTypeURL(&sdkgovv1beta1.TextProposal{}),
+	}
+)
+
+// ProposalKindOf returns the kind of a proposal from its x/gov kinds and its
+// messages. A proposal is classified by its most significant message, so a
+// proposal which amends the constitution is a constitution amendment even if
+// it also contains laws or other messages, and a proposal which contains a
+// law is a law even if it also changes params. A proposal without messages,
+// or which only executes legacy text proposals, is a text proposal.
+func ProposalKindOf(kinds govv1.ProposalKinds, msgs []sdk.Msg) ProposalKind {
+	switch {
+	case kinds.HasKindConstitutionAmendment():
+		return ProposalKindConstitutionAmendment
+	case kinds.HasKindLaw():
+		return ProposalKindLaw
+	case slices.ContainsFunc(msgs, isParamChangeMsg):
+		return ProposalKindParamChange
+	case !slices.ContainsFunc(msgs, func(msg sdk.Msg) bool { return !isTextMsg(msg) }):
+		return ProposalKindText
+	default:
+		return ProposalKindAny
+	}
+}
+
+// legacyContentMsg is implemented by the messages which execute a legacy
+// proposal content, i.e. MsgExecLegacyContent.
+type legacyContentMsg interface {
+	GetContent() *codectypes.Any
+}
+
+// isParamChangeMsg returns true if msg changes the params of a module, either
+// with the MsgUpdateParams of the module or with a legacy param change
+// proposal.
+func isParamChangeMsg(msg sdk.Msg) bool {
+	if msg, ok := msg.(legacyContentMsg); ok {
+		return msg.GetContent().GetTypeUrl() == paramChangeProposalTypeURL
+	}
+	return strings.HasSuffix(sdk.MsgTypeURL(msg), ".MsgUpdateParams")
+}
+
+// isTextMsg returns true if msg executes a legacy text proposal.
+func isTextMsg(msg sdk.Msg) bool {
+	contentMsg, ok := msg.(legacyContentMsg)
+	return ok && slices.Contains(textProposalTypeURLs, contentMsg.GetContent().GetTypeUrl())
+}
@@ -0,0 +1,119 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/atomone-hub/atomone/x/coredaos/types"
+	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+	govv1beta1 "github.com/atomone-hub/atomone/x/gov/types/v1beta1"
+)
+
+func TestProposalKindOf(t *testing.T) {
+	legacyContent := func(content govv1beta1.Content) sdk.Msg {
+		msg, err := govv1.NewLegacyContent(content, addrs[0].String())
+		require.NoError(t, err)
+		return msg
+	}
+	var (
+		sendMsg         = banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins())
+		updateParamsMsg = &banktypes.MsgUpdateParams{Authority: addrs[0].String()}
+		paramChangeMsg  = legacyContent(&paramsproposal.ParameterChangeProposal{Title: "title"})
+		textMsg         = legacyContent(govv1beta1.NewTextProposal("title", "description"))
+	)
+	tests := []struct {
+		name     string
+		kinds    govv1.ProposalKinds
+		msgs     []sdk.Msg
+		expected types.ProposalKind
+	}{
+		{
+			name:     "constitution amendment",
+			kinds:    govv1.ProposalKindAny | govv1.ProposalKindLaw | govv1.ProposalKindConstitutionAmendment,
+			msgs:     []sdk.Msg{updateParamsMsg},
+			expected: types.ProposalKindConstitutionAmendment,
+		},
+		{
+			name:     "law",
+			kinds:    govv1.ProposalKindAny | govv1.ProposalKindLaw,
+			msgs:     []sdk.Msg{updateParamsMsg},
+			expected: types.ProposalKindLaw,
+		},
+		{
+			name:     "param change",
+			kinds:    govv1.ProposalKindAny,
+			msgs:     []sdk.Msg{sendMsg, updateParamsMsg},
+			expected: types.ProposalKindParamChange,
+		},
+		{
+			name:     "legacy param change",
+			kinds:    govv1.ProposalKindAny,
+			msgs:     []sdk.Msg{paramChangeMsg},
+			expected: types.ProposalKindParamChange,
+		},
+		{
+			name:     "text without messages",
+			expected: types.ProposalKindText,
+		},
+		{
+			name:     "legacy text",
+			kinds:    govv1.ProposalKindAny,
+			msgs:     []sdk.Msg{textMsg},
+			expected: types.ProposalKindText,
+		},
+		{
+			name:     "any",
+			kinds:    govv1.ProposalKindAny,
+			msgs:     []sdk.Msg{textMsg, sendMsg},
+			expected: types.ProposalKindAny,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, types.ProposalKindOf(tt.kinds, tt.msgs))
+		})
+	}
+}
+
+func TestParamsExtensionsLimits(t *testing.T) {
+	params := types.DefaultParams()
+	params.VotingPeriodExtensionsLimit = 3
+	params.ExtensionPolicies = []types.ExtensionPolicy{{
+		ProposalKind:                types.ProposalKindLaw,
+		ExtensionDuration:           time.Hour,
+		SteeringDaoExtensionsLimit:  2,
+		OversightDaoExtensionsLimit: 4,
+		TotalExtensionsLimit:        5,
+	}}
+	require.NoError(t, params.ValidateBasic())
+
+	tests := []struct {
+		name             string
+		kind             types.ProposalKind
+		dao              types.CoreDao
+		expectedTotal    uint32
+		expectedDaoLimit uint32
+	}{
+		{name: "policy", kind: types.ProposalKindLaw, dao: types.CoreDaoSteering, expectedTotal: 5, expectedDaoLimit: 2},
+		{name: "policy above the voting period extensions limit", kind: types.ProposalKindLaw, dao: types.CoreDaoOversight, expectedTotal: 5, expectedDaoLimit: 4},
+		{name: "no policy", kind: types.ProposalKindText, dao: types.CoreDaoSteering, expectedTotal: 3, expectedDaoLimit: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, daoLimit := params.ExtensionsLimits(tt.kind, tt.dao)
+			require.Equal(t, tt.expectedTotal, total)
+			require.Equal(t, tt.expectedDaoLimit, daoLimit)
+		})
+	}
+
+	// the Core DAO limits of a policy can't exceed its total limit
+	params.ExtensionPolicies[0].OversightDaoExtensionsLimit = 6
+	require.EqualError(t, params.ValidateBasic(),
+		"Oversight DAO extensions limit of proposal kind PROPOSAL_KIND_LAW exceeds its extensions limit: 6 > 5")
+}
@@ -7,25 +7,30 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -116,215 +121,4560 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "atomone.coredaos.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "atomone.coredaos.v1.QueryParamsResponse")
+// QueryProposalActionsRequest is request type for the Query/ProposalActions
+// RPC method.
+type QueryProposalActionsRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("atomone/coredaos/v1/query.proto", fileDescriptor_f9c15ff84e5e7470) }
-
-var fileDescriptor_f9c15ff84e5e7470 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xb1, 0x4b, 0xfb, 0x40,
-	0x14, 0xc7, 0x73, 0x3f, 0x7e, 0x76, 0x88, 0x93, 0x69, 0x07, 0x6d, 0xf5, 0x2a, 0x71, 0xb0, 0x08,
-	0xe6, 0x91, 0x3a, 0xb9, 0x76, 0x73, 0xab, 0x1d, 0x5d, 0xe4, 0x12, 0x8f, 0x34, 0x60, 0xee, 0x5d,
-	0x73, 0x97, 0x62, 0x37, 0x71, 0x74, 0x12, 0xfc, 0xa7, 0x3a, 0x16, 0x5c, 0x9c, 0x44, 0x5a, 0xff,
-	0x10, 0xe9, 0x5d, 0x52, 0x15, 0x03, 0x2e, 0x21, 0x79, 0xef, 0xf3, 0xfd, 0xe6, 0xc3, 0x73, 0xbb,
-	0x4c, 0x63, 0x86, 0x82, 0x43, 0x8c, 0x39, 0xbf, 0x61, 0xa8, 0x60, 0x1a, 0xc2, 0xa4, 0xe0, 0xf9,
-	0x2c, 0x90, 0x39, 0x6a, 0xf4, 0x9a, 0x25, 0x10, 0x54, 0x40, 0x30, 0x0d, 0xdb, 0xad, 0x04, 0x13,
-	0x34, 0x7b, 0x58, 0xbf, 0x59, 0xb4, 0xbd, 0x9f, 0x20, 0x26, 0xb7, 0x1c, 0x98, 0x4c, 0x81, 0x09,
-	0x81, 0x9a, 0xe9, 0x14, 0x85, 0x2a, 0xb7, 0x27, 0x31, 0xaa, 0x0c, 0x15, 0x44, 0x4c, 0x71, 0xfb,
-	0x07, 0x98, 0x86, 0x11, 0xd7, 0x2c, 0x04, 0xc9, 0x92, 0x54, 0x18, 0xb8, 0x64, 0xfd, 0x3a, 0xab,
-	0x8d, 0x80, 0x65, 0xe8, 0xf7, 0xbe, 0xaa, 0x29, 0xc6, 0xb4, 0xea, 0xd8, 0x61, 0x59, 0x2a, 0x10,
-	0xcc, 0xb3, 0x1c, 0xed, 0xd9, 0xc8, 0xb5, 0x35, 0xb7, 0x1f, 0x76, 0xe5, 0xb7, 0x5c, 0xef, 0x72,
-	0xed, 0x34, 0x64, 0x39, 0xcb, 0xd4, 0x88, 0x4f, 0x0a, 0xae, 0xb4, 0x3f, 0x74, 0x9b, 0x3f, 0xa6,
-	0x4a, 0xa2, 0x50, 0xdc, 0x3b, 0x77, 0x1b, 0xd2, 0x4c, 0x76, 0xc9, 0x21, 0xe9, 0x6d, 0xf7, 0x3b,
-	0x41, 0xcd, 0x91, 0x02, 0x1b, 0x1a, 0xfc, 0x9f, 0xbf, 0x75, 0x9d, 0x51, 0x19, 0xe8, 0x3f, 0x12,
-	0x77, 0xcb, 0x54, 0x7a, 0xf7, 0xc4, 0x6d, 0x58, 0xc4, 0x3b, 0xae, 0xcd, 0xff, 0xf6, 0x69, 0xf7,
-	0xfe, 0x06, 0xad, 0xa2, 0x7f, 0xf4, 0xf0, 0xf2, 0xf1, 0xfc, 0xef, 0xc0, 0xeb, 0x40, 0xdd, 0x29,
-	0xad, 0xcc, 0xe0, 0x62, 0xbe, 0xa4, 0x64, 0xb1, 0xa4, 0xe4, 0x7d, 0x49, 0xc9, 0xd3, 0x8a, 0x3a,
-	0x8b, 0x15, 0x75, 0x5e, 0x57, 0xd4, 0xb9, 0x82, 0x24, 0xd5, 0xe3, 0x22, 0x0a, 0x62, 0xcc, 0xaa,
-	0x82, 0xd3, 0x71, 0x11, 0x6d, 0xca, 0xee, 0xbe, 0xea, 0xf4, 0x4c, 0x72, 0x15, 0x35, 0xcc, 0x19,
-	0xcf, 0x3e, 0x03, 0x00, 0x00, 0xff, 0xff, 0xa3, 0xbe, 0x1c, 0x91, 0x50, 0x02, 0x00, 0x00,
+func (m *QueryProposalActionsRequest) Reset()         { *m = QueryProposalActionsRequest{} }
+func (m *QueryProposalActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalActionsRequest) ProtoMessage()    {}
+func (*QueryProposalActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{2}
+}
+func (m *QueryProposalActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalActionsRequest.Merge(m, src)
+}
+func (m *QueryProposalActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalActionsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryProposalActionsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryProposalActionsRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryProposalActionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryProposalActionsResponse is response type for the Query/ProposalActions
+// RPC method.
+type QueryProposalActionsResponse struct {
+	// actions holds the actions taken on the proposal, in order of execution.
+	Actions []DaoAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryProposalActionsResponse) Reset()         { *m = QueryProposalActionsResponse{} }
+func (m *QueryProposalActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalActionsResponse) ProtoMessage()    {}
+func (*QueryProposalActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{3}
+}
+func (m *QueryProposalActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalActionsResponse.Merge(m, src)
+}
+func (m *QueryProposalActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalActionsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProposalActionsResponse proto.InternalMessageInfo
+
+func (m *QueryProposalActionsResponse) GetActions() []DaoAction {
+	if m != nil {
+		return m.Actions
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryProposalActionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryDaoActionsRequest is request type for the Query/DaoActions RPC method.
+type QueryDaoActionsRequest struct {
+	// dao_address is the address of the Core DAO.
+	DaoAddress string `protobuf:"bytes,1,opt,name=dao_address,json=daoAddress,proto3" json:"dao_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryDaoActionsRequest) Reset()         { *m = QueryDaoActionsRequest{} }
+func (m *QueryDaoActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDaoActionsRequest) ProtoMessage()    {}
+func (*QueryDaoActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{4}
+}
+func (m *QueryDaoActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDaoActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDaoActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDaoActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDaoActionsRequest.Merge(m, src)
+}
+func (m *QueryDaoActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDaoActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDaoActionsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryDaoActionsRequest proto.InternalMessageInfo
+
+func (m *QueryDaoActionsRequest) GetDaoAddress() string {
+	if m != nil {
+		return m.DaoAddress
+	}
+	return ""
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryDaoActionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return nil
+}
+
+// QueryDaoActionsResponse is response type for the Query/DaoActions RPC
+// method.
+type QueryDaoActionsResponse struct {
+	// actions holds the actions taken by the Core DAO, in order of execution.
+	Actions []DaoAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDaoActionsResponse) Reset()         { *m = QueryDaoActionsResponse{} }
+func (m *QueryDaoActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDaoActionsResponse) ProtoMessage()    {}
+func (*QueryDaoActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{5}
+}
+func (m *QueryDaoActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDaoActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDaoActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomone.coredaos.v1.Query/Params",
+}
+func (m *QueryDaoActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDaoActionsResponse.Merge(m, src)
+}
+func (m *QueryDaoActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDaoActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDaoActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDaoActionsResponse proto.InternalMessageInfo
+
+func (m *QueryDaoActionsResponse) GetActions() []DaoAction {
+	if m != nil {
+		return m.Actions
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return nil
+}
+
+func (m *QueryDaoActionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "atomone.coredaos.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "atomone/coredaos/v1/query.proto",
+// QueryPendingVetoesRequest is request type for the Query/PendingVetoes RPC
+// method.
+type QueryPendingVetoesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingVetoesRequest) Reset()         { *m = QueryPendingVetoesRequest{} }
+func (m *QueryPendingVetoesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingVetoesRequest) ProtoMessage()    {}
+func (*QueryPendingVetoesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{6}
+}
+func (m *QueryPendingVetoesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingVetoesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingVetoesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPendingVetoesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingVetoesRequest.Merge(m, src)
+}
+func (m *QueryPendingVetoesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingVetoesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingVetoesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPendingVetoesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingVetoesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryPendingVetoesResponse is response type for the Query/PendingVetoes RPC
+// method.
+type QueryPendingVetoesResponse struct {
+	// pending_vetoes holds the vetoes waiting for execution, ordered by
+	// proposal id.
+	PendingVetoes []PendingVeto `protobuf:"bytes,1,rep,name=pending_vetoes,json=pendingVetoes,proto3" json:"pending_vetoes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingVetoesResponse) Reset()         { *m = QueryPendingVetoesResponse{} }
+func (m *QueryPendingVetoesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingVetoesResponse) ProtoMessage()    {}
+func (*QueryPendingVetoesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{7}
+}
+func (m *QueryPendingVetoesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingVetoesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingVetoesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPendingVetoesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingVetoesResponse.Merge(m, src)
+}
+func (m *QueryPendingVetoesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingVetoesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingVetoesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPendingVetoesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingVetoesResponse) GetPendingVetoes() []PendingVeto {
+	if m != nil {
+		return m.PendingVetoes
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPendingVetoesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDaoMembersRequest is request type for the Query/DaoMembers RPC method.
+type QueryDaoMembersRequest struct {
+}
+
+func (m *QueryDaoMembersRequest) Reset()         { *m = QueryDaoMembersRequest{} }
+func (m *QueryDaoMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDaoMembersRequest) ProtoMessage()    {}
+func (*QueryDaoMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{8}
+}
+func (m *QueryDaoMembersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDaoMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDaoMembersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDaoMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDaoMembersRequest.Merge(m, src)
+}
+func (m *QueryDaoMembersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDaoMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDaoMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDaoMembersRequest proto.InternalMessageInfo
+
+// QueryDaoMembersResponse is response type for the Query/DaoMembers RPC
+// method.
+type QueryDaoMembersResponse struct {
+	// dao_members holds the members of the Core DAOs that have a member set.
+	DaoMembers []DaoMembers `protobuf:"bytes,1,rep,name=dao_members,json=daoMembers,proto3" json:"dao_members"`
+}
+
+func (m *QueryDaoMembersResponse) Reset()         { *m = QueryDaoMembersResponse{} }
+func (m *QueryDaoMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDaoMembersResponse) ProtoMessage()    {}
+func (*QueryDaoMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{9}
+}
+func (m *QueryDaoMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDaoMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDaoMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDaoMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDaoMembersResponse.Merge(m, src)
+}
+func (m *QueryDaoMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDaoMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDaoMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDaoMembersResponse proto.InternalMessageInfo
+
+func (m *QueryDaoMembersResponse) GetDaoMembers() []DaoMembers {
+	if m != nil {
+		return m.DaoMembers
+	}
+	return nil
+}
+
+// QueryPendingDaoActionsRequest is request type for the
+// Query/PendingDaoActions RPC method.
+type QueryPendingDaoActionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingDaoActionsRequest) Reset()         { *m = QueryPendingDaoActionsRequest{} }
+func (m *QueryPendingDaoActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDaoActionsRequest) ProtoMessage()    {}
+func (*QueryPendingDaoActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{10}
+}
+func (m *QueryPendingDaoActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDaoActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDaoActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingDaoActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDaoActionsRequest.Merge(m, src)
+}
+func (m *QueryPendingDaoActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDaoActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDaoActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingDaoActionsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingDaoActionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingDaoActionsResponse is response type for the
+// Query/PendingDaoActions RPC method.
+type QueryPendingDaoActionsResponse struct {
+	// pending_dao_actions holds the actions waiting for approval, ordered by
+	// id.
+	PendingDaoActions []PendingDaoAction `protobuf:"bytes,1,rep,name=pending_dao_actions,json=pendingDaoActions,proto3" json:"pending_dao_actions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingDaoActionsResponse) Reset()         { *m = QueryPendingDaoActionsResponse{} }
+func (m *QueryPendingDaoActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDaoActionsResponse) ProtoMessage()    {}
+func (*QueryPendingDaoActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{11}
+}
+func (m *QueryPendingDaoActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDaoActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDaoActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingDaoActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDaoActionsResponse.Merge(m, src)
+}
+func (m *QueryPendingDaoActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDaoActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDaoActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingDaoActionsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingDaoActionsResponse) GetPendingDaoActions() []PendingDaoAction {
+	if m != nil {
+		return m.PendingDaoActions
+	}
+	return nil
+}
+
+func (m *QueryPendingDaoActionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySteeringProposalCountRequest is request type for the
+// Query/SteeringProposalCount RPC method.
+type QuerySteeringProposalCountRequest struct {
+}
+
+func (m *QuerySteeringProposalCountRequest) Reset()         { *m = QuerySteeringProposalCountRequest{} }
+func (m *QuerySteeringProposalCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySteeringProposalCountRequest) ProtoMessage()    {}
+func (*QuerySteeringProposalCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{12}
+}
+func (m *QuerySteeringProposalCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySteeringProposalCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySteeringProposalCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySteeringProposalCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySteeringProposalCountRequest.Merge(m, src)
+}
+func (m *QuerySteeringProposalCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySteeringProposalCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySteeringProposalCountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySteeringProposalCountRequest proto.InternalMessageInfo
+
+// QuerySteeringProposalCountResponse is response type for the
+// Query/SteeringProposalCount RPC method.
+type QuerySteeringProposalCountResponse struct {
+	// count holds the number of proposals submitted by the Steering DAO in
+	// the current epoch.
+	Count SteeringProposalCount `protobuf:"bytes,1,opt,name=count,proto3" json:"count"`
+	// quota is the maximum number of proposals the Steering DAO can submit
+	// per epoch.
+	Quota uint32 `protobuf:"varint,2,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (m *QuerySteeringProposalCountResponse) Reset()         { *m = QuerySteeringProposalCountResponse{} }
+func (m *QuerySteeringProposalCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySteeringProposalCountResponse) ProtoMessage()    {}
+func (*QuerySteeringProposalCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{13}
+}
+func (m *QuerySteeringProposalCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySteeringProposalCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySteeringProposalCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySteeringProposalCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySteeringProposalCountResponse.Merge(m, src)
+}
+func (m *QuerySteeringProposalCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySteeringProposalCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySteeringProposalCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySteeringProposalCountResponse proto.InternalMessageInfo
+
+func (m *QuerySteeringProposalCountResponse) GetCount() SteeringProposalCount {
+	if m != nil {
+		return m.Count
+	}
+	return SteeringProposalCount{}
+}
+
+func (m *QuerySteeringProposalCountResponse) GetQuota() uint32 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
+}
+
+// QueryRecusalsRequest is request type for the Query/Recusals RPC method.
+type QueryRecusalsRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryRecusalsRequest) Reset()         { *m = QueryRecusalsRequest{} }
+func (m *QueryRecusalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecusalsRequest) ProtoMessage()    {}
+func (*QueryRecusalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{14}
+}
+func (m *QueryRecusalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecusalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecusalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecusalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecusalsRequest.Merge(m, src)
+}
+func (m *QueryRecusalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecusalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecusalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecusalsRequest proto.InternalMessageInfo
+
+func (m *QueryRecusalsRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryRecusalsResponse is response type for the Query/Recusals RPC method.
+type QueryRecusalsResponse struct {
+	// recusals holds the recusals of the Core DAOs from the proposal.
+	Recusals []Recusal `protobuf:"bytes,1,rep,name=recusals,proto3" json:"recusals"`
+}
+
+func (m *QueryRecusalsResponse) Reset()         { *m = QueryRecusalsResponse{} }
+func (m *QueryRecusalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecusalsResponse) ProtoMessage()    {}
+func (*QueryRecusalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{15}
+}
+func (m *QueryRecusalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecusalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecusalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecusalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecusalsResponse.Merge(m, src)
+}
+func (m *QueryRecusalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecusalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecusalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecusalsResponse proto.InternalMessageInfo
+
+func (m *QueryRecusalsResponse) GetRecusals() []Recusal {
+	if m != nil {
+		return m.Recusals
+	}
+	return nil
+}
+
+// QueryDaoBudgetsRequest is request type for the Query/DaoBudgets RPC method.
+type QueryDaoBudgetsRequest struct {
+}
+
+func (m *QueryDaoBudgetsRequest) Reset()         { *m = QueryDaoBudgetsRequest{} }
+func (m *QueryDaoBudgetsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDaoBudgetsRequest) ProtoMessage()    {}
+func (*QueryDaoBudgetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{16}
+}
+func (m *QueryDaoBudgetsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDaoBudgetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDaoBudgetsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDaoBudgetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDaoBudgetsRequest.Merge(m, src)
+}
+func (m *QueryDaoBudgetsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDaoBudgetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDaoBudgetsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDaoBudgetsRequest proto.InternalMessageInfo
+
+// QueryDaoBudgetsResponse is response type for the Query/DaoBudgets RPC
+// method.
+type QueryDaoBudgetsResponse struct {
+	// dao_budgets holds the budgets of the Core DAOs.
+	DaoBudgets []DaoBudgetStatus `protobuf:"bytes,1,rep,name=dao_budgets,json=daoBudgets,proto3" json:"dao_budgets"`
+}
+
+func (m *QueryDaoBudgetsResponse) Reset()         { *m = QueryDaoBudgetsResponse{} }
+func (m *QueryDaoBudgetsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDaoBudgetsResponse) ProtoMessage()    {}
+func (*QueryDaoBudgetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{17}
+}
+func (m *QueryDaoBudgetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDaoBudgetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDaoBudgetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDaoBudgetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDaoBudgetsResponse.Merge(m, src)
+}
+func (m *QueryDaoBudgetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDaoBudgetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDaoBudgetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDaoBudgetsResponse proto.InternalMessageInfo
+
+func (m *QueryDaoBudgetsResponse) GetDaoBudgets() []DaoBudgetStatus {
+	if m != nil {
+		return m.DaoBudgets
+	}
+	return nil
+}
+
+// DaoBudgetStatus defines the status of the budget of a Core DAO.
+type DaoBudgetStatus struct {
+	// dao is the Core DAO the budget is granted to.
+	Dao CoreDao `protobuf:"varint,1,opt,name=dao,proto3,enum=atomone.coredaos.v1.CoreDao" json:"dao,omitempty"`
+	// budget is the budget granted to the Core DAO, its allowance is empty if
+	// the budget was removed.
+	Budget DaoBudget `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget"`
+	// account holds the funded and spent amounts of the current epoch.
+	Account DaoBudgetAccount `protobuf:"bytes,3,opt,name=account,proto3" json:"account"`
+	// remaining is the amount the Core DAO can still spend in the current
+	// epoch.
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+}
+
+func (m *DaoBudgetStatus) Reset()         { *m = DaoBudgetStatus{} }
+func (m *DaoBudgetStatus) String() string { return proto.CompactTextString(m) }
+func (*DaoBudgetStatus) ProtoMessage()    {}
+func (*DaoBudgetStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{18}
+}
+func (m *DaoBudgetStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaoBudgetStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DaoBudgetStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DaoBudgetStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaoBudgetStatus.Merge(m, src)
+}
+func (m *DaoBudgetStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaoBudgetStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaoBudgetStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaoBudgetStatus proto.InternalMessageInfo
+
+func (m *DaoBudgetStatus) GetDao() CoreDao {
+	if m != nil {
+		return m.Dao
+	}
+	return CoreDaoUnspecified
+}
+
+func (m *DaoBudgetStatus) GetBudget() DaoBudget {
+	if m != nil {
+		return m.Budget
+	}
+	return DaoBudget{}
+}
+
+func (m *DaoBudgetStatus) GetAccount() DaoBudgetAccount {
+	if m != nil {
+		return m.Account
+	}
+	return DaoBudgetAccount{}
+}
+
+func (m *DaoBudgetStatus) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
+// QueryDaoStatusesRequest is request type for the Query/DaoStatuses RPC
+// method.
+type QueryDaoStatusesRequest struct {
+}
+
+func (m *QueryDaoStatusesRequest) Reset()         { *m = QueryDaoStatusesRequest{} }
+func (m *QueryDaoStatusesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDaoStatusesRequest) ProtoMessage()    {}
+func (*QueryDaoStatusesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{19}
+}
+func (m *QueryDaoStatusesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDaoStatusesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDaoStatusesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDaoStatusesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDaoStatusesRequest.Merge(m, src)
+}
+func (m *QueryDaoStatusesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDaoStatusesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDaoStatusesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDaoStatusesRequest proto.InternalMessageInfo
+
+// QueryDaoStatusesResponse is response type for the Query/DaoStatuses RPC
+// method.
+type QueryDaoStatusesResponse struct {
+	// dao_statuses holds the statuses of the Core DAOs.
+	DaoStatuses []DaoStatus `protobuf:"bytes,1,rep,name=dao_statuses,json=daoStatuses,proto3" json:"dao_statuses"`
+}
+
+func (m *QueryDaoStatusesResponse) Reset()         { *m = QueryDaoStatusesResponse{} }
+func (m *QueryDaoStatusesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDaoStatusesResponse) ProtoMessage()    {}
+func (*QueryDaoStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{20}
+}
+func (m *QueryDaoStatusesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDaoStatusesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDaoStatusesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDaoStatusesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDaoStatusesResponse.Merge(m, src)
+}
+func (m *QueryDaoStatusesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDaoStatusesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDaoStatusesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDaoStatusesResponse proto.InternalMessageInfo
+
+func (m *QueryDaoStatusesResponse) GetDaoStatuses() []DaoStatus {
+	if m != nil {
+		return m.DaoStatuses
+	}
+	return nil
+}
+
+// DaoStatus defines the status of a Core DAO.
+type DaoStatus struct {
+	// dao is the Core DAO.
+	Dao CoreDao `protobuf:"varint,1,opt,name=dao,proto3,enum=atomone.coredaos.v1.CoreDao" json:"dao,omitempty"`
+	// address is the address of the Core DAO, empty if it is disabled.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// state is the state of the Core DAO.
+	State DaoState `protobuf:"varint,3,opt,name=state,proto3,enum=atomone.coredaos.v1.DaoState" json:"state,omitempty"`
+	// liveness holds the activity of the Core DAO.
+	Liveness DaoLiveness `protobuf:"bytes,4,opt,name=liveness,proto3" json:"liveness"`
+	// time_to_suspension is the remaining duration before the Core DAO is
+	// suspended if it doesn't act. It is nil if the Core DAO is not active or
+	// if the suspension of inactive Core DAOs is disabled.
+	TimeToSuspension *time.Duration `protobuf:"bytes,5,opt,name=time_to_suspension,json=timeToSuspension,proto3,stdduration" json:"time_to_suspension,omitempty"`
+}
+
+func (m *DaoStatus) Reset()         { *m = DaoStatus{} }
+func (m *DaoStatus) String() string { return proto.CompactTextString(m) }
+func (*DaoStatus) ProtoMessage()    {}
+func (*DaoStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c15ff84e5e7470, []int{21}
+}
+func (m *DaoStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaoStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DaoStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DaoStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaoStatus.Merge(m, src)
+}
+func (m *DaoStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaoStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaoStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaoStatus proto.InternalMessageInfo
+
+func (m *DaoStatus) GetDao() CoreDao {
+	if m != nil {
+		return m.Dao
+	}
+	return CoreDaoUnspecified
+}
+
+func (m *DaoStatus) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DaoStatus) GetState() DaoState {
+	if m != nil {
+		return m.State
+	}
+	return DaoStateUnspecified
+}
+
+func (m *DaoStatus) GetLiveness() DaoLiveness {
+	if m != nil {
+		return m.Liveness
+	}
+	return DaoLiveness{}
+}
+
+func (m *DaoStatus) GetTimeToSuspension() *time.Duration {
+	if m != nil {
+		return m.TimeToSuspension
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "atomone.coredaos.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "atomone.coredaos.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryProposalActionsRequest)(nil), "atomone.coredaos.v1.QueryProposalActionsRequest")
+	proto.RegisterType((*QueryProposalActionsResponse)(nil), "atomone.coredaos.v1.QueryProposalActionsResponse")
+	proto.RegisterType((*QueryDaoActionsRequest)(nil), "atomone.coredaos.v1.QueryDaoActionsRequest")
+	proto.RegisterType((*QueryDaoActionsResponse)(nil), "atomone.coredaos.v1.QueryDaoActionsResponse")
+	proto.RegisterType((*QueryPendingVetoesRequest)(nil), "atomone.coredaos.v1.QueryPendingVetoesRequest")
+	proto.RegisterType((*QueryPendingVetoesResponse)(nil), "atomone.coredaos.v1.QueryPendingVetoesResponse")
+	proto.RegisterType((*QueryDaoMembersRequest)(nil), "atomone.coredaos.v1.QueryDaoMembersRequest")
+	proto.RegisterType((*QueryDaoMembersResponse)(nil), "atomone.coredaos.v1.QueryDaoMembersResponse")
+	proto.RegisterType((*QueryPendingDaoActionsRequest)(nil), "atomone.coredaos.v1.QueryPendingDaoActionsRequest")
+	proto.RegisterType((*QueryPendingDaoActionsResponse)(nil), "atomone.coredaos.v1.QueryPendingDaoActionsResponse")
+	proto.RegisterType((*QuerySteeringProposalCountRequest)(nil), "atomone.coredaos.v1.QuerySteeringProposalCountRequest")
+	proto.RegisterType((*QuerySteeringProposalCountResponse)(nil), "atomone.coredaos.v1.QuerySteeringProposalCountResponse")
+	proto.RegisterType((*QueryRecusalsRequest)(nil), "atomone.coredaos.v1.QueryRecusalsRequest")
+	proto.RegisterType((*QueryRecusalsResponse)(nil), "atomone.coredaos.v1.QueryRecusalsResponse")
+	proto.RegisterType((*QueryDaoBudgetsRequest)(nil), "atomone.coredaos.v1.QueryDaoBudgetsRequest")
+	proto.RegisterType((*QueryDaoBudgetsResponse)(nil), "atomone.coredaos.v1.QueryDaoBudgetsResponse")
+	proto.RegisterType((*DaoBudgetStatus)(nil), "atomone.coredaos.v1.DaoBudgetStatus")
+	proto.RegisterType((*QueryDaoStatusesRequest)(nil), "atomone.coredaos.v1.QueryDaoStatusesRequest")
+	proto.RegisterType((*QueryDaoStatusesResponse)(nil), "atomone.coredaos.v1.QueryDaoStatusesResponse")
+	proto.RegisterType((*DaoStatus)(nil), "atomone.coredaos.v1.DaoStatus")
+}
+
+func init() { proto.RegisterFile("atomone/coredaos/v1/query.proto", fileDescriptor_f9c15ff84e5e7470) }
+
+var fileDescriptor_f9c15ff84e5e7470 = []byte{
+	// 1321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xa4, 0x49, 0xda, 0xbe, 0xf4, 0x83, 0x4e, 0x53, 0xea, 0x6c, 0x5b, 0xc7, 0xdd, 0x50,
+	0xea, 0xa6, 0xc9, 0x6e, 0xed, 0x7e, 0x44, 0x95, 0x0a, 0x52, 0xdd, 0xd0, 0xaa, 0x82, 0x4a, 0xc5,
+	0x41, 0x20, 0xc1, 0xc1, 0x1a, 0x7b, 0xa7, 0xdb, 0x15, 0xf1, 0xce, 0x76, 0x3f, 0x22, 0xaa, 0x0a,
+	0x09, 0xf5, 0xc0, 0x19, 0x84, 0x90, 0x90, 0x10, 0x52, 0xc5, 0x05, 0xc4, 0x01, 0x71, 0xa8, 0xe0,
+	0xc6, 0x11, 0xf5, 0x58, 0xc1, 0x05, 0x2e, 0x14, 0x35, 0x48, 0xfc, 0x1b, 0x68, 0x67, 0xde, 0xae,
+	0xd7, 0xf6, 0xfa, 0x23, 0x28, 0x07, 0x2e, 0x71, 0x76, 0xe6, 0x7d, 0xfc, 0xde, 0xef, 0xbd, 0x99,
+	0xf9, 0xc1, 0x02, 0x0b, 0x45, 0x5b, 0xb8, 0xdc, 0x6c, 0x09, 0x9f, 0x5b, 0x4c, 0x04, 0xe6, 0x66,
+	0xc5, 0xbc, 0x17, 0x71, 0xff, 0xbe, 0xe1, 0xf9, 0x22, 0x14, 0xf4, 0x30, 0x1a, 0x18, 0x89, 0x81,
+	0xb1, 0x59, 0xd1, 0xe6, 0x6c, 0x61, 0x0b, 0xb9, 0x6f, 0xc6, 0xff, 0x29, 0x53, 0xed, 0xb8, 0x2d,
+	0x84, 0xbd, 0xc1, 0x4d, 0xe6, 0x39, 0x26, 0x73, 0x5d, 0x11, 0xb2, 0xd0, 0x11, 0x6e, 0x80, 0xbb,
+	0x4b, 0x2d, 0x11, 0xb4, 0x45, 0x60, 0x36, 0x59, 0xc0, 0x55, 0x06, 0x73, 0xb3, 0xd2, 0xe4, 0x21,
+	0xab, 0x98, 0x1e, 0xb3, 0x1d, 0x57, 0x1a, 0xa3, 0xad, 0x9e, 0x87, 0x2a, 0x05, 0xa0, 0x6c, 0x8a,
+	0xd9, 0x78, 0x49, 0xa4, 0x96, 0x70, 0x92, 0x18, 0x87, 0x58, 0xdb, 0x71, 0x85, 0x29, 0xff, 0xe2,
+	0xd2, 0xbc, 0x72, 0x69, 0x28, 0xe4, 0xea, 0x23, 0x89, 0x86, 0xd8, 0xe5, 0x57, 0x33, 0xba, 0x63,
+	0x5a, 0x91, 0x9f, 0x41, 0xa4, 0xcf, 0x01, 0x7d, 0x33, 0xc6, 0x7c, 0x9b, 0xf9, 0xac, 0x1d, 0xd4,
+	0xf9, 0xbd, 0x88, 0x07, 0xa1, 0x7e, 0x1b, 0x0e, 0x77, 0xad, 0x06, 0x9e, 0x70, 0x03, 0x4e, 0x2f,
+	0xc3, 0x8c, 0x27, 0x57, 0x0a, 0xa4, 0x44, 0xca, 0xb3, 0xd5, 0x63, 0x46, 0x0e, 0x89, 0x86, 0x72,
+	0xaa, 0x4d, 0x3d, 0xf9, 0x73, 0x61, 0xa2, 0x8e, 0x0e, 0xfa, 0xc7, 0x04, 0x8e, 0xa9, 0x90, 0xbe,
+	0xf0, 0x44, 0xc0, 0x36, 0xae, 0xb6, 0x24, 0x89, 0x98, 0x91, 0x2e, 0xc0, 0xac, 0x87, 0x3b, 0x0d,
+	0xc7, 0x92, 0xf1, 0xa7, 0xea, 0x90, 0x2c, 0xdd, 0xb4, 0xe8, 0x75, 0x80, 0x0e, 0x9d, 0x85, 0x49,
+	0x99, 0xff, 0x65, 0x03, 0x6b, 0x8d, 0xb9, 0x32, 0x54, 0x77, 0x91, 0x31, 0xe3, 0x36, 0xb3, 0x39,
+	0x06, 0xaf, 0x67, 0x3c, 0xf5, 0x6f, 0x08, 0x1c, 0xcf, 0x07, 0x82, 0x45, 0xbe, 0x0a, 0xbb, 0x99,
+	0x5a, 0x2a, 0x90, 0xd2, 0xae, 0xf2, 0x6c, 0xb5, 0x98, 0x5b, 0xe5, 0x1a, 0x13, 0xca, 0x13, 0x0b,
+	0x4d, 0x9c, 0xe8, 0x8d, 0x1c, 0xa0, 0xa7, 0x47, 0x02, 0x55, 0xc9, 0xbb, 0x90, 0x7e, 0x49, 0xe0,
+	0x45, 0x89, 0x34, 0x4d, 0x95, 0xb2, 0x75, 0x19, 0x66, 0x2d, 0x26, 0x1a, 0xcc, 0xb2, 0x7c, 0x1e,
+	0xa8, 0x6e, 0xec, 0xad, 0x15, 0x7e, 0x7d, 0xbc, 0x32, 0x87, 0x79, 0xae, 0xaa, 0x9d, 0xf5, 0xd0,
+	0x77, 0x5c, 0xbb, 0x0e, 0x16, 0x13, 0xb8, 0xb2, 0x63, 0x3c, 0x7e, 0x4d, 0xe0, 0x68, 0x1f, 0xba,
+	0xff, 0x1b, 0x85, 0x2d, 0x98, 0x57, 0xbd, 0xe6, 0xae, 0xe5, 0xb8, 0xf6, 0xdb, 0x3c, 0x14, 0x3c,
+	0x25, 0xb1, 0x9b, 0x09, 0xf2, 0x9f, 0x99, 0x78, 0x4c, 0x40, 0xcb, 0xcb, 0x82, 0x64, 0xdc, 0x82,
+	0x03, 0x9e, 0xda, 0x68, 0x6c, 0xca, 0x1d, 0xe4, 0xa4, 0x94, 0x7f, 0x78, 0x3a, 0x31, 0x90, 0x95,
+	0xfd, 0x5e, 0x36, 0xec, 0xce, 0x71, 0x53, 0xe8, 0x4c, 0xd7, 0x2d, 0xde, 0x6e, 0x72, 0x3f, 0x3d,
+	0xfd, 0x0c, 0x8e, 0xf6, 0xed, 0x60, 0x31, 0xd7, 0xd5, 0xe0, 0xb5, 0xd5, 0x32, 0x56, 0xb2, 0x30,
+	0xa8, 0xbb, 0xe8, 0x8d, 0x85, 0x80, 0x95, 0xae, 0xe8, 0x36, 0x9c, 0xc8, 0x52, 0xd6, 0x3f, 0xe1,
+	0x3b, 0xd5, 0x9c, 0x5f, 0x08, 0x14, 0x07, 0x65, 0xc2, 0x9a, 0xde, 0x83, 0xc3, 0x49, 0x83, 0xe4,
+	0xa1, 0xea, 0x9a, 0xdc, 0x53, 0xc3, 0xba, 0xd4, 0x3b, 0xc0, 0x87, 0xbc, 0xde, 0x24, 0x3b, 0xd7,
+	0xae, 0x45, 0x38, 0x29, 0xeb, 0x58, 0x0f, 0x39, 0x8f, 0x0f, 0x75, 0x72, 0x7d, 0x5d, 0x13, 0x91,
+	0x1b, 0x26, 0x9d, 0x7b, 0x48, 0x40, 0x1f, 0x66, 0x95, 0x76, 0x71, 0xba, 0x15, 0x2f, 0x20, 0xaf,
+	0x4b, 0xb9, 0x35, 0xe6, 0x86, 0xc0, 0x42, 0x95, 0x3b, 0x9d, 0x83, 0xe9, 0x7b, 0x91, 0x08, 0x99,
+	0xac, 0x6b, 0x7f, 0x5d, 0x7d, 0xe8, 0xab, 0x30, 0x27, 0x31, 0xd4, 0x79, 0x2b, 0x0a, 0xd8, 0xc6,
+	0xd8, 0x57, 0xbc, 0xfe, 0x0e, 0x1c, 0xe9, 0x71, 0x4c, 0xef, 0x93, 0x3d, 0x3e, 0xae, 0x61, 0x5b,
+	0x8e, 0xe7, 0x42, 0x46, 0x47, 0x04, 0x99, 0xfa, 0x64, 0x47, 0xbd, 0x16, 0x59, 0x36, 0x0f, 0xd3,
+	0x51, 0xbf, 0x03, 0x47, 0xfb, 0x76, 0x30, 0xe9, 0xeb, 0x6a, 0xd4, 0x9b, 0x6a, 0x19, 0xf3, 0xbe,
+	0x34, 0x68, 0xd4, 0x95, 0xf7, 0x7a, 0xc8, 0xc2, 0x28, 0x3b, 0xef, 0x18, 0x54, 0xff, 0x71, 0x12,
+	0x0e, 0xf6, 0x58, 0x51, 0x03, 0x76, 0x59, 0x4c, 0x48, 0x1e, 0x0e, 0x0c, 0x28, 0xe8, 0x9a, 0xf0,
+	0xf9, 0x1a, 0x13, 0xf5, 0xd8, 0x90, 0x5e, 0x81, 0x19, 0x05, 0x06, 0xc7, 0xa8, 0x38, 0x1c, 0x4b,
+	0xf2, 0x00, 0x2b, 0x1f, 0xfa, 0x5a, 0x7c, 0x27, 0xab, 0xae, 0xef, 0x2a, 0x91, 0x81, 0x93, 0x9d,
+	0xba, 0x5f, 0x55, 0xc6, 0x9d, 0xab, 0x59, 0xb5, 0xdc, 0x85, 0xbd, 0x3e, 0x6f, 0x33, 0xc7, 0x75,
+	0x5c, 0xbb, 0x30, 0x25, 0x39, 0x99, 0xef, 0x1a, 0xe7, 0x64, 0x90, 0xaf, 0x09, 0xc7, 0xad, 0x5d,
+	0x8c, 0x9d, 0xbf, 0x7b, 0xb6, 0x50, 0xb6, 0x9d, 0xf0, 0x6e, 0xd4, 0x34, 0x5a, 0xa2, 0x8d, 0xf2,
+	0x04, 0x7f, 0x56, 0x02, 0xeb, 0x7d, 0x33, 0xbc, 0xef, 0xf1, 0x40, 0x3a, 0x04, 0xdf, 0xfe, 0xf3,
+	0xc3, 0x12, 0xa9, 0x77, 0x52, 0xe8, 0xf3, 0x9d, 0x06, 0x29, 0xda, 0xd2, 0xfb, 0x5b, 0x6f, 0x41,
+	0xa1, 0x7f, 0x0b, 0x9b, 0x77, 0x03, 0xf6, 0xc5, 0xcd, 0x0b, 0x70, 0x7d, 0xd4, 0x33, 0xd4, 0xd5,
+	0xb7, 0x59, 0x2b, 0x59, 0xe0, 0x81, 0xfe, 0xd3, 0x24, 0xec, 0x4d, 0x0d, 0xb6, 0xdd, 0xb2, 0x2a,
+	0xec, 0x4e, 0xde, 0xe8, 0xc9, 0x11, 0x6f, 0x74, 0x62, 0x48, 0xcf, 0xc3, 0x74, 0x0c, 0x9b, 0xcb,
+	0x36, 0x1d, 0xa8, 0x9e, 0x18, 0x86, 0x99, 0xd7, 0x95, 0x2d, 0xad, 0xc1, 0x9e, 0x0d, 0x67, 0x93,
+	0xbb, 0x71, 0xa6, 0xa9, 0x12, 0x19, 0xf8, 0xbc, 0xac, 0x31, 0xf1, 0x06, 0xda, 0x25, 0xa7, 0x24,
+	0xf1, 0xa3, 0xb7, 0x80, 0x86, 0x4e, 0x9b, 0x37, 0x42, 0xd1, 0x08, 0xa2, 0xc0, 0xe3, 0x6e, 0x10,
+	0x5f, 0x59, 0xd3, 0x32, 0xda, 0xbc, 0xa1, 0x74, 0xa4, 0x91, 0xe8, 0x48, 0x63, 0x0d, 0x75, 0x64,
+	0x6d, 0xea, 0x8b, 0x67, 0x0b, 0xa4, 0xfe, 0x42, 0xec, 0xfa, 0x96, 0x58, 0x4f, 0x1d, 0xab, 0x7f,
+	0xec, 0x83, 0x69, 0xd9, 0x1f, 0xfa, 0x11, 0x81, 0x19, 0x25, 0x0a, 0xe9, 0xe9, 0x5c, 0x54, 0xfd,
+	0x0a, 0x54, 0x2b, 0x8f, 0x36, 0x54, 0xad, 0xd6, 0x17, 0x1f, 0xfe, 0xf6, 0xf7, 0x67, 0x93, 0x27,
+	0xe8, 0x31, 0x33, 0x4f, 0x5c, 0x2b, 0xf9, 0x49, 0x1f, 0x13, 0x38, 0xd8, 0x23, 0xf8, 0xe8, 0xb9,
+	0x21, 0x29, 0x72, 0x45, 0xaa, 0x56, 0xd9, 0x86, 0x07, 0xa2, 0xbb, 0x22, 0xd1, 0x5d, 0xa2, 0x17,
+	0xf2, 0xd1, 0xa1, 0x57, 0x60, 0x3e, 0xc8, 0x5c, 0x8d, 0x1f, 0x9a, 0x89, 0x10, 0x7a, 0x44, 0x00,
+	0x32, 0x8f, 0xc9, 0xd9, 0xc1, 0xf9, 0xfb, 0x5e, 0x50, 0x6d, 0x79, 0x3c, 0x63, 0xc4, 0xb9, 0x2a,
+	0x71, 0x56, 0xa8, 0x99, 0x8b, 0x53, 0xfe, 0x3e, 0xc8, 0x48, 0xce, 0x0e, 0xc4, 0xaf, 0x08, 0xec,
+	0xef, 0x12, 0x3e, 0xd4, 0x18, 0xc2, 0x52, 0x8e, 0x0e, 0xd3, 0xcc, 0xb1, 0xed, 0x11, 0xeb, 0x59,
+	0x89, 0xf5, 0x14, 0x5d, 0xcc, 0xe7, 0xb4, 0x4b, 0x6c, 0xd1, 0x4f, 0x15, 0x85, 0x28, 0x3c, 0x46,
+	0x50, 0xd8, 0x2d, 0x84, 0xb4, 0xe5, 0xf1, 0x8c, 0x11, 0x56, 0x59, 0xc2, 0xd2, 0x69, 0x69, 0x10,
+	0x85, 0x89, 0x6c, 0xa2, 0xdf, 0x13, 0x38, 0xd4, 0xa7, 0x47, 0x68, 0x75, 0x24, 0x0f, 0xfd, 0x4d,
+	0x3e, 0xbf, 0x2d, 0x1f, 0x04, 0x7a, 0x4e, 0x02, 0x5d, 0xa2, 0xe5, 0xa1, 0xfc, 0x65, 0xb4, 0x10,
+	0xfd, 0x99, 0xc0, 0x91, 0x5c, 0x3d, 0x40, 0x2f, 0x0d, 0x06, 0x30, 0x4c, 0xa9, 0x68, 0xab, 0xdb,
+	0xf6, 0x43, 0xf0, 0x17, 0x24, 0x78, 0x83, 0x2e, 0xe7, 0x82, 0x0f, 0xd0, 0xb7, 0x91, 0x1e, 0x27,
+	0xf5, 0x6c, 0x3d, 0x22, 0xb0, 0x27, 0x91, 0x15, 0xf4, 0xcc, 0xe0, 0xdc, 0x3d, 0x9a, 0x45, 0x5b,
+	0x1a, 0xc7, 0x14, 0x91, 0xbd, 0x22, 0x91, 0xad, 0xd2, 0x8b, 0xdb, 0x3a, 0xea, 0x89, 0x48, 0x49,
+	0x06, 0x15, 0x15, 0xc3, 0x88, 0x41, 0xed, 0x96, 0x31, 0xda, 0xf2, 0x78, 0xc6, 0x63, 0x0f, 0x2a,
+	0x8a, 0x1e, 0xfa, 0x39, 0x81, 0xd9, 0xcc, 0xf3, 0x4a, 0x87, 0xe7, 0xe9, 0x79, 0xa0, 0xb5, 0x95,
+	0x31, 0xad, 0x11, 0xd6, 0x19, 0x09, 0x6b, 0x91, 0x9e, 0x1c, 0x08, 0x2b, 0x79, 0xce, 0x6b, 0x37,
+	0x9f, 0x3c, 0x2f, 0x92, 0xa7, 0xcf, 0x8b, 0xe4, 0xaf, 0xe7, 0x45, 0xf2, 0xc9, 0x56, 0x71, 0xe2,
+	0xe9, 0x56, 0x71, 0xe2, 0xf7, 0xad, 0xe2, 0xc4, 0xbb, 0x66, 0x46, 0x69, 0x60, 0x98, 0x95, 0xbb,
+	0x51, 0x33, 0x0d, 0xf9, 0x41, 0x27, 0xa8, 0x94, 0x1d, 0xcd, 0x19, 0xf9, 0xa2, 0x9d, 0xff, 0x77,
+	0x00, 0xa7, 0xa6, 0xb4, 0x89, 0x31, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ProposalActions queries the actions taken by the Core DAOs on a
+	// proposal.
+	ProposalActions(ctx context.Context, in *QueryProposalActionsRequest, opts ...grpc.CallOption) (*QueryProposalActionsResponse, error)
+	// DaoActions queries the actions taken by a Core DAO.
+	DaoActions(ctx context.Context, in *QueryDaoActionsRequest, opts ...grpc.CallOption) (*QueryDaoActionsResponse, error)
+	// PendingVetoes queries the vetoes waiting for execution.
+	PendingVetoes(ctx context.Context, in *QueryPendingVetoesRequest, opts ...grpc.CallOption) (*QueryPendingVetoesResponse, error)
+	// DaoMembers queries the members of the Core DAOs.
+	DaoMembers(ctx context.Context, in *QueryDaoMembersRequest, opts ...grpc.CallOption) (*QueryDaoMembersResponse, error)
+	// PendingDaoActions queries the actions waiting for the approval of the
+	// members of the Core DAOs.
+	PendingDaoActions(ctx context.Context, in *QueryPendingDaoActionsRequest, opts ...grpc.CallOption) (*QueryPendingDaoActionsResponse, error)
+	// SteeringProposalCount queries the number of proposals submitted by the
+	// Steering DAO in the current epoch.
+	SteeringProposalCount(ctx context.Context, in *QuerySteeringProposalCountRequest, opts ...grpc.CallOption) (*QuerySteeringProposalCountResponse, error)
+	// Recusals queries the recusals of the Core DAOs from a proposal.
+	Recusals(ctx context.Context, in *QueryRecusalsRequest, opts ...grpc.CallOption) (*QueryRecusalsResponse, error)
+	// DaoBudgets queries the allowance, spent and remaining amounts of the
+	// budgets of the Core DAOs.
+	DaoBudgets(ctx context.Context, in *QueryDaoBudgetsRequest, opts ...grpc.CallOption) (*QueryDaoBudgetsResponse, error)
+	// DaoStatuses queries the state, the last action and the time to
+	// suspension of the Core DAOs.
+	DaoStatuses(ctx context.Context, in *QueryDaoStatusesRequest, opts ...grpc.CallOption) (*QueryDaoStatusesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalActions(ctx context.Context, in *QueryProposalActionsRequest, opts ...grpc.CallOption) (*QueryProposalActionsResponse, error) {
+	out := new(QueryProposalActionsResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Query/ProposalActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DaoActions(ctx context.Context, in *QueryDaoActionsRequest, opts ...grpc.CallOption) (*QueryDaoActionsResponse, error) {
+	out := new(QueryDaoActionsResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Query/DaoActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingVetoes(ctx context.Context, in *QueryPendingVetoesRequest, opts ...grpc.CallOption) (*QueryPendingVetoesResponse, error) {
+	out := new(QueryPendingVetoesResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Query/PendingVetoes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DaoMembers(ctx context.Context, in *QueryDaoMembersRequest, opts ...grpc.CallOption) (*QueryDaoMembersResponse, error) {
+	out := new(QueryDaoMembersResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Query/DaoMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingDaoActions(ctx context.Context, in *QueryPendingDaoActionsRequest, opts ...grpc.CallOption) (*QueryPendingDaoActionsResponse, error) {
+	out := new(QueryPendingDaoActionsResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Query/PendingDaoActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SteeringProposalCount(ctx context.Context, in *QuerySteeringProposalCountRequest, opts ...grpc.CallOption) (*QuerySteeringProposalCountResponse, error) {
+	out := new(QuerySteeringProposalCountResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Query/SteeringProposalCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Recusals(ctx context.Context, in *QueryRecusalsRequest, opts ...grpc.CallOption) (*QueryRecusalsResponse, error) {
+	out := new(QueryRecusalsResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Query/Recusals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DaoBudgets(ctx context.Context, in *QueryDaoBudgetsRequest, opts ...grpc.CallOption) (*QueryDaoBudgetsResponse, error) {
+	out := new(QueryDaoBudgetsResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Query/DaoBudgets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DaoStatuses(ctx context.Context, in *QueryDaoStatusesRequest, opts ...grpc.CallOption) (*QueryDaoStatusesResponse, error) {
+	out := new(QueryDaoStatusesResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Query/DaoStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ProposalActions queries the actions taken by the Core DAOs on a
+	// proposal.
+	ProposalActions(context.Context, *QueryProposalActionsRequest) (*QueryProposalActionsResponse, error)
+	// DaoActions queries the actions taken by a Core DAO.
+	DaoActions(context.Context, *QueryDaoActionsRequest) (*QueryDaoActionsResponse, error)
+	// PendingVetoes queries the vetoes waiting for execution.
+	PendingVetoes(context.Context, *QueryPendingVetoesRequest) (*QueryPendingVetoesResponse, error)
+	// DaoMembers queries the members of the Core DAOs.
+	DaoMembers(context.Context, *QueryDaoMembersRequest) (*QueryDaoMembersResponse, error)
+	// PendingDaoActions queries the actions waiting for the approval of the
+	// members of the Core DAOs.
+	PendingDaoActions(context.Context, *QueryPendingDaoActionsRequest) (*QueryPendingDaoActionsResponse, error)
+	// SteeringProposalCount queries the number of proposals submitted by the
+	// Steering DAO in the current epoch.
+	SteeringProposalCount(context.Context, *QuerySteeringProposalCountRequest) (*QuerySteeringProposalCountResponse, error)
+	// Recusals queries the recusals of the Core DAOs from a proposal.
+	Recusals(context.Context, *QueryRecusalsRequest) (*QueryRecusalsResponse, error)
+	// DaoBudgets queries the allowance, spent and remaining amounts of the
+	// budgets of the Core DAOs.
+	DaoBudgets(context.Context, *QueryDaoBudgetsRequest) (*QueryDaoBudgetsResponse, error)
+	// DaoStatuses queries the state, the last action and the time to
+	// suspension of the Core DAOs.
+	DaoStatuses(context.Context, *QueryDaoStatusesRequest) (*QueryDaoStatusesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ProposalActions(ctx context.Context, req *QueryProposalActionsRequest) (*QueryProposalActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalActions not implemented")
+}
+func (*UnimplementedQueryServer) DaoActions(ctx context.Context, req *QueryDaoActionsRequest) (*QueryDaoActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DaoActions not implemented")
+}
+func (*UnimplementedQueryServer) PendingVetoes(ctx context.Context, req *QueryPendingVetoesRequest) (*QueryPendingVetoesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingVetoes not implemented")
+}
+func (*UnimplementedQueryServer) DaoMembers(ctx context.Context, req *QueryDaoMembersRequest) (*QueryDaoMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DaoMembers not implemented")
+}
+func (*UnimplementedQueryServer) PendingDaoActions(ctx context.Context, req *QueryPendingDaoActionsRequest) (*QueryPendingDaoActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingDaoActions not implemented")
+}
+func (*UnimplementedQueryServer) SteeringProposalCount(ctx context.Context, req *QuerySteeringProposalCountRequest) (*QuerySteeringProposalCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SteeringProposalCount not implemented")
+}
+func (*UnimplementedQueryServer) Recusals(ctx context.Context, req *QueryRecusalsRequest) (*QueryRecusalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recusals not implemented")
+}
+func (*UnimplementedQueryServer) DaoBudgets(ctx context.Context, req *QueryDaoBudgetsRequest) (*QueryDaoBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DaoBudgets not implemented")
+}
+func (*UnimplementedQueryServer) DaoStatuses(ctx context.Context, req *QueryDaoStatusesRequest) (*QueryDaoStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DaoStatuses not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Query/ProposalActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalActions(ctx, req.(*QueryProposalActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DaoActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDaoActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DaoActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Query/DaoActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DaoActions(ctx, req.(*QueryDaoActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingVetoes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingVetoesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingVetoes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Query/PendingVetoes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingVetoes(ctx, req.(*QueryPendingVetoesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DaoMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDaoMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DaoMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Query/DaoMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DaoMembers(ctx, req.(*QueryDaoMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingDaoActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingDaoActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingDaoActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Query/PendingDaoActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingDaoActions(ctx, req.(*QueryPendingDaoActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SteeringProposalCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySteeringProposalCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SteeringProposalCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Query/SteeringProposalCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SteeringProposalCount(ctx, req.(*QuerySteeringProposalCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Recusals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecusalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Recusals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Query/Recusals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Recusals(ctx, req.(*QueryRecusalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DaoBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDaoBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DaoBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Query/DaoBudgets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DaoBudgets(ctx, req.(*QueryDaoBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DaoStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDaoStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DaoStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Query/DaoStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DaoStatuses(ctx, req.(*QueryDaoStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomone.coredaos.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ProposalActions",
+			Handler:    _Query_ProposalActions_Handler,
+		},
+		{
+			MethodName: "DaoActions",
+			Handler:    _Query_DaoActions_Handler,
+		},
+		{
+			MethodName: "PendingVetoes",
+			Handler:    _Query_PendingVetoes_Handler,
+		},
+		{
+			MethodName: "DaoMembers",
+			Handler:    _Query_DaoMembers_Handler,
+		},
+		{
+			MethodName: "PendingDaoActions",
+			Handler:    _Query_PendingDaoActions_Handler,
+		},
+		{
+			MethodName: "SteeringProposalCount",
+			Handler:    _Query_SteeringProposalCount_Handler,
+		},
+		{
+			MethodName: "Recusals",
+			Handler:    _Query_Recusals_Handler,
+		},
+		{
+			MethodName: "DaoBudgets",
+			Handler:    _Query_DaoBudgets_Handler,
+		},
+		{
+			MethodName: "DaoStatuses",
+			Handler:    _Query_DaoStatuses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "atomone/coredaos/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDaoActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDaoActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDaoActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DaoAddress) > 0 {
+		i -= len(m.DaoAddress)
+		copy(dAtA[i:], m.DaoAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DaoAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDaoActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDaoActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDaoActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingVetoesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingVetoesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingVetoesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingVetoesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingVetoesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingVetoesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingVetoes) > 0 {
+		for iNdEx := len(m.PendingVetoes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingVetoes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDaoMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDaoMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDaoMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDaoMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDaoMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDaoMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DaoMembers) > 0 {
+		for iNdEx := len(m.DaoMembers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DaoMembers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDaoActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDaoActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDaoActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDaoActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDaoActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDaoActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingDaoActions) > 0 {
+		for iNdEx := len(m.PendingDaoActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDaoActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySteeringProposalCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySteeringProposalCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySteeringProposalCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySteeringProposalCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySteeringProposalCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySteeringProposalCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quota != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quota))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Count.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecusalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecusalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecusalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecusalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecusalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecusalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recusals) > 0 {
+		for iNdEx := len(m.Recusals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recusals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDaoBudgetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDaoBudgetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDaoBudgetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDaoBudgetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDaoBudgetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDaoBudgetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DaoBudgets) > 0 {
+		for iNdEx := len(m.DaoBudgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DaoBudgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DaoBudgetStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaoBudgetStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaoBudgetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Budget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Dao != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Dao))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDaoStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDaoStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDaoStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDaoStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDaoStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDaoStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DaoStatuses) > 0 {
+		for iNdEx := len(m.DaoStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DaoStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DaoStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaoStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaoStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeToSuspension != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.TimeToSuspension, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.TimeToSuspension):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintQuery(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Liveness.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Dao != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Dao))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProposalActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDaoActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DaoAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDaoActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingVetoesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingVetoesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingVetoes) > 0 {
+		for _, e := range m.PendingVetoes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDaoMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDaoMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DaoMembers) > 0 {
+		for _, e := range m.DaoMembers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingDaoActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingDaoActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingDaoActions) > 0 {
+		for _, e := range m.PendingDaoActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySteeringProposalCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySteeringProposalCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Count.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Quota != 0 {
+		n += 1 + sovQuery(uint64(m.Quota))
+	}
+	return n
+}
+
+func (m *QueryRecusalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryRecusalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recusals) > 0 {
+		for _, e := range m.Recusals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDaoBudgetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDaoBudgetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DaoBudgets) > 0 {
+		for _, e := range m.DaoBudgets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DaoBudgetStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dao != 0 {
+		n += 1 + sovQuery(uint64(m.Dao))
+	}
+	l = m.Budget.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Account.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDaoStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDaoStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DaoStatuses) > 0 {
+		for _, e := range m.DaoStatuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DaoStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dao != 0 {
+		n += 1 + sovQuery(uint64(m.Dao))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	l = m.Liveness.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TimeToSuspension != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.TimeToSuspension)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalActionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalActionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, DaoAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDaoActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDaoActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDaoActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DaoAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDaoActionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDaoActionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDaoActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, DaoAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingVetoesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingVetoesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingVetoesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingVetoesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingVetoesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingVetoesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingVetoes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingVetoes = append(m.PendingVetoes, PendingVeto{})
+			if err := m.PendingVetoes[len(m.PendingVetoes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDaoMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDaoMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDaoMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDaoMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDaoMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDaoMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DaoMembers = append(m.DaoMembers, DaoMembers{})
+			if err := m.DaoMembers[len(m.DaoMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingDaoActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDaoActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDaoActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingDaoActionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDaoActionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDaoActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDaoActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingDaoActions = append(m.PendingDaoActions, PendingDaoAction{})
+			if err := m.PendingDaoActions[len(m.PendingDaoActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySteeringProposalCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySteeringProposalCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySteeringProposalCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySteeringProposalCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySteeringProposalCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySteeringProposalCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			m.Quota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quota |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecusalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecusalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecusalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecusalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecusalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecusalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recusals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recusals = append(m.Recusals, Recusal{})
+			if err := m.Recusals[len(m.Recusals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDaoBudgetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDaoBudgetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDaoBudgetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDaoBudgetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDaoBudgetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDaoBudgetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoBudgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DaoBudgets = append(m.DaoBudgets, DaoBudgetStatus{})
+			if err := m.DaoBudgets[len(m.DaoBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DaoBudgetStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaoBudgetStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaoBudgetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
+			}
+			m.Dao = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dao |= CoreDao(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDaoStatusesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDaoStatusesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDaoStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDaoStatusesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -347,12 +4697,46 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDaoStatusesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDaoStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DaoStatuses = append(m.DaoStatuses, DaoStatus{})
+			if err := m.DaoStatuses[len(m.DaoStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -374,7 +4758,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *DaoStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -397,15 +4781,85 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DaoStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DaoStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
+			}
+			m.Dao = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dao |= CoreDao(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DaoState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liveness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -432,7 +4886,43 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Liveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeToSuspension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeToSuspension == nil {
+				m.TimeToSuspension = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.TimeToSuspension, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
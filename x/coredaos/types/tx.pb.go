@@ -7,23 +7,30 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -394,6 +401,8 @@ func (m *MsgVetoProposal) GetBurnDeposit() bool {
 
 // MsgVetoProposalResponse defines the response for MsgVetoProposal.
 type MsgVetoProposalResponse struct {
+	// execution_time is the time after which the veto is executed.
+	ExecutionTime time.Time `protobuf:"bytes,1,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
 }
 
 func (m *MsgVetoProposalResponse) Reset()         { *m = MsgVetoProposalResponse{} }
@@ -429,29 +438,33 @@ func (m *MsgVetoProposalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVetoProposalResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-type MsgUpdateParams struct {
-	// authority is the address that controls the module (defaults to x/gov unless
-	// overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/coredaos parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+func (m *MsgVetoProposalResponse) GetExecutionTime() time.Time {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return time.Time{}
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+// MsgWithdrawVeto defines a message for withdrawing a pending veto.
+type MsgWithdrawVeto struct {
+	// vetoer is the address of the dao withdrawing the veto.
+	Vetoer string `protobuf:"bytes,1,opt,name=vetoer,proto3" json:"vetoer,omitempty"`
+	// proposal_id is the ID of the proposal whose veto is withdrawn.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgWithdrawVeto) Reset()         { *m = MsgWithdrawVeto{} }
+func (m *MsgWithdrawVeto) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawVeto) ProtoMessage()    {}
+func (*MsgWithdrawVeto) Descriptor() ([]byte, []int) {
 	return fileDescriptor_942eb16dc573b0ab, []int{8}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawVeto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawVeto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawVeto.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -461,49 +474,48 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgWithdrawVeto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawVeto.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgWithdrawVeto) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgWithdrawVeto) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawVeto.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawVeto proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgWithdrawVeto) GetVetoer() string {
 	if m != nil {
-		return m.Authority
+		return m.Vetoer
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgWithdrawVeto) GetProposalId() uint64 {
 	if m != nil {
-		return m.Params
+		return m.ProposalId
 	}
-	return Params{}
+	return 0
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
+// MsgWithdrawVetoResponse defines the response for MsgWithdrawVeto.
+type MsgWithdrawVetoResponse struct {
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgWithdrawVetoResponse) Reset()         { *m = MsgWithdrawVetoResponse{} }
+func (m *MsgWithdrawVetoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawVetoResponse) ProtoMessage()    {}
+func (*MsgWithdrawVetoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_942eb16dc573b0ab, []int{9}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawVetoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawVetoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawVetoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -513,800 +525,5172 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgWithdrawVetoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawVetoResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgWithdrawVetoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgWithdrawVetoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawVetoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawVetoResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAnnotateProposal)(nil), "atomone.coredaos.v1.MsgAnnotateProposal")
-	proto.RegisterType((*MsgAnnotateProposalResponse)(nil), "atomone.coredaos.v1.MsgAnnotateProposalResponse")
-	proto.RegisterType((*MsgEndorseProposal)(nil), "atomone.coredaos.v1.MsgEndorseProposal")
-	proto.RegisterType((*MsgEndorseProposalResponse)(nil), "atomone.coredaos.v1.MsgEndorseProposalResponse")
-	proto.RegisterType((*MsgExtendVotingPeriod)(nil), "atomone.coredaos.v1.MsgExtendVotingPeriod")
-	proto.RegisterType((*MsgExtendVotingPeriodResponse)(nil), "atomone.coredaos.v1.MsgExtendVotingPeriodResponse")
-	proto.RegisterType((*MsgVetoProposal)(nil), "atomone.coredaos.v1.MsgVetoProposal")
-	proto.RegisterType((*MsgVetoProposalResponse)(nil), "atomone.coredaos.v1.MsgVetoProposalResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "atomone.coredaos.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "atomone.coredaos.v1.MsgUpdateParamsResponse")
+// MsgSubmitSteeringProposal defines a message for submitting a governance
+// proposal on behalf of the Steering DAO.
+type MsgSubmitSteeringProposal struct {
+	// proposer is the address of the Steering DAO.
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// messages are the arbitrary messages to be executed if the proposal
+	// passes.
+	Messages []*types.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	// metadata is any arbitrary metadata attached to the proposal. It is
+	// prefixed with the Steering DAO tag in the submitted proposal.
+	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// title is the title of the proposal.
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is the summary of the proposal.
+	Summary string `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
 }
 
-func init() { proto.RegisterFile("atomone/coredaos/v1/tx.proto", fileDescriptor_942eb16dc573b0ab) }
-
-var fileDescriptor_942eb16dc573b0ab = []byte{
-	// 668 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x4f, 0xd4, 0x5e,
-	0x14, 0x9d, 0xfe, 0xe0, 0x47, 0x98, 0x0b, 0x11, 0x2d, 0x28, 0x43, 0x81, 0x82, 0xd5, 0xc8, 0x38,
-	0x91, 0xa9, 0x40, 0x62, 0xcc, 0x98, 0x98, 0x40, 0x74, 0xc1, 0x62, 0x12, 0x52, 0x23, 0x0b, 0x37,
-	0xa4, 0x43, 0x5f, 0x4a, 0xa3, 0xed, 0x6d, 0xde, 0x7b, 0x33, 0xc2, 0xce, 0xb8, 0xd4, 0x8d, 0x2e,
-	0xdd, 0xba, 0xd1, 0x25, 0x0b, 0x3f, 0x04, 0x2b, 0x43, 0x5c, 0xb9, 0x32, 0x06, 0x16, 0x7c, 0x0d,
-	0xd3, 0xbe, 0xd7, 0x3f, 0xcc, 0xb4, 0x61, 0x36, 0x93, 0xde, 0x73, 0x4f, 0xef, 0x39, 0x67, 0x72,
-	0xef, 0x0c, 0x2c, 0xd8, 0x1c, 0x7d, 0x0c, 0x88, 0xb9, 0x8f, 0x94, 0x38, 0x36, 0x32, 0xb3, 0xb7,
-	0x66, 0xf2, 0xc3, 0x66, 0x48, 0x91, 0xa3, 0x3a, 0x2d, 0xbb, 0xcd, 0xa4, 0xdb, 0xec, 0xad, 0x69,
-	0x33, 0x2e, 0xba, 0x18, 0xf7, 0xcd, 0xe8, 0x49, 0x50, 0xb5, 0xb9, 0x7d, 0x64, 0x3e, 0xb2, 0x3d,
-	0xd1, 0x10, 0x85, 0x6c, 0xcd, 0x8a, 0xca, 0xf4, 0x99, 0x1b, 0x4d, 0xf7, 0x99, 0x2b, 0x1b, 0x37,
-	0x6c, 0xdf, 0x0b, 0xd0, 0x8c, 0x3f, 0x25, 0x64, 0x14, 0xf9, 0x49, 0xd5, 0x63, 0x8e, 0xf1, 0x53,
-	0x81, 0xe9, 0x36, 0x73, 0x37, 0x83, 0x00, 0xb9, 0xcd, 0xc9, 0x0e, 0xc5, 0x10, 0x99, 0xfd, 0x46,
-	0x5d, 0x80, 0xaa, 0x2d, 0x30, 0xa4, 0x35, 0x65, 0x59, 0xa9, 0x57, 0xad, 0x0c, 0x50, 0x97, 0x60,
-	0x22, 0x94, 0xcc, 0x3d, 0xcf, 0xa9, 0xfd, 0xb7, 0xac, 0xd4, 0x47, 0x2d, 0x48, 0xa0, 0x6d, 0x47,
-	0xd5, 0x01, 0x24, 0xdb, 0xc3, 0xa0, 0x36, 0x12, 0xbf, 0x9f, 0x43, 0xa2, 0xf1, 0xd8, 0x23, 0xf4,
-	0x2d, 0xf5, 0x38, 0xa9, 0x8d, 0x2e, 0x2b, 0xf5, 0x71, 0x2b, 0x03, 0x5a, 0xad, 0xf7, 0x17, 0xc7,
-	0x8d, 0x4c, 0xee, 0xc3, 0xc5, 0x71, 0x63, 0xa5, 0x28, 0x4b, 0x81, 0x71, 0x63, 0x11, 0xe6, 0x0b,
-	0x60, 0x8b, 0xb0, 0x10, 0x03, 0x46, 0x8c, 0x8f, 0x0a, 0xa8, 0x6d, 0xe6, 0x3e, 0x0f, 0x1c, 0xa4,
-	0x2c, 0x8b, 0xab, 0xc1, 0x38, 0x11, 0x50, 0x92, 0x36, 0xad, 0xaf, 0x0c, 0xdb, 0x7a, 0x1c, 0xd9,
-	0x4d, 0xf9, 0x91, 0xdb, 0x7b, 0x25, 0x6e, 0xfb, 0x64, 0x8d, 0x05, 0xd0, 0x06, 0xd1, 0xd4, 0xeb,
-	0x67, 0x05, 0x6e, 0x46, 0xed, 0x43, 0x4e, 0x02, 0x67, 0x17, 0xb9, 0x17, 0xb8, 0x3b, 0x84, 0x7a,
-	0xe8, 0xc4, 0x76, 0x63, 0x34, 0x67, 0x57, 0xd6, 0x57, 0xdb, 0x7d, 0x22, 0xec, 0x4a, 0x7e, 0x64,
-	0xf7, 0x7e, 0x99, 0xdd, 0x01, 0x65, 0x63, 0x09, 0x16, 0x0b, 0x1b, 0xa9, 0xe9, 0xaf, 0x0a, 0x4c,
-	0xb5, 0x99, 0xbb, 0x4b, 0x38, 0xa6, 0xdf, 0xee, 0x2d, 0x18, 0xeb, 0x11, 0x8e, 0xa9, 0x59, 0x59,
-	0x5d, 0xbd, 0x46, 0xb7, 0x61, 0xb2, 0xd3, 0xa5, 0xc1, 0x9e, 0x43, 0x42, 0x64, 0x1e, 0x8f, 0x17,
-	0x69, 0xdc, 0x9a, 0x88, 0xb0, 0x67, 0x02, 0x6a, 0x6d, 0x44, 0x69, 0xe4, 0xc0, 0x28, 0xcb, 0x9d,
-	0x92, 0x2c, 0x79, 0x43, 0xc6, 0x1c, 0xcc, 0xf6, 0x41, 0xa9, 0xff, 0x2f, 0xc2, 0xff, 0xcb, 0xd0,
-	0x89, 0xd6, 0xc7, 0xa6, 0xb6, 0xcf, 0xd4, 0x47, 0x50, 0xb5, 0xbb, 0xfc, 0x00, 0xa9, 0xc7, 0x8f,
-	0x44, 0x84, 0xad, 0xda, 0xaf, 0x1f, 0xab, 0x33, 0xf2, 0x32, 0x37, 0x1d, 0x87, 0x12, 0xc6, 0x5e,
-	0x70, 0xea, 0x05, 0xae, 0x95, 0x51, 0xd5, 0xa7, 0x30, 0x16, 0xc6, 0x13, 0xe2, 0x68, 0x13, 0xeb,
-	0xf3, 0xcd, 0x82, 0xdf, 0x80, 0xa6, 0x10, 0xd9, 0xaa, 0x9e, 0xfc, 0x59, 0xaa, 0x7c, 0xbf, 0x38,
-	0x6e, 0x28, 0x96, 0x7c, 0xab, 0x75, 0x4d, 0xdc, 0x41, 0x32, 0x4f, 0xda, 0xce, 0x5b, 0x4b, 0x6c,
-	0xaf, 0x7f, 0x1b, 0x85, 0x91, 0x36, 0x73, 0xd5, 0x00, 0xae, 0x0f, 0xdc, 0x72, 0xbd, 0x50, 0xb6,
-	0xe0, 0x4a, 0xb4, 0x87, 0xc3, 0x32, 0x13, 0x5d, 0xf5, 0x35, 0x4c, 0xf5, 0xdf, 0xd2, 0x4a, 0xd9,
-	0x90, 0x3e, 0xa2, 0x66, 0x0e, 0x49, 0x4c, 0xc5, 0x38, 0xa8, 0x05, 0xc7, 0xd0, 0x28, 0x1d, 0x33,
-	0xc0, 0xd5, 0xd6, 0x87, 0xe7, 0xa6, 0xaa, 0x1d, 0x98, 0xbc, 0xb4, 0xcd, 0x77, 0xcb, 0x66, 0xe4,
-	0x59, 0xda, 0x83, 0x61, 0x58, 0x79, 0x8d, 0x4b, 0x1b, 0x57, 0xaa, 0x91, 0x67, 0x95, 0x6b, 0x14,
-	0xad, 0x88, 0xf6, 0xff, 0xbb, 0x68, 0xb9, 0xb6, 0xb6, 0x4f, 0xce, 0x74, 0xe5, 0xf4, 0x4c, 0x57,
-	0xfe, 0x9e, 0xe9, 0xca, 0xa7, 0x73, 0xbd, 0x72, 0x7a, 0xae, 0x57, 0x7e, 0x9f, 0xeb, 0x95, 0x57,
-	0xa6, 0xeb, 0xf1, 0x83, 0x6e, 0xa7, 0xb9, 0x8f, 0xbe, 0x29, 0x07, 0xaf, 0x1e, 0x74, 0x3b, 0xc9,
-	0xb3, 0x79, 0x98, 0xdd, 0x14, 0x3f, 0x0a, 0x09, 0xeb, 0x8c, 0xc5, 0xff, 0x21, 0x1b, 0xff, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x86, 0xe3, 0x2f, 0x93, 0xf9, 0x06, 0x00, 0x00,
+func (m *MsgSubmitSteeringProposal) Reset()         { *m = MsgSubmitSteeringProposal{} }
+func (m *MsgSubmitSteeringProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSteeringProposal) ProtoMessage()    {}
+func (*MsgSubmitSteeringProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{10}
+}
+func (m *MsgSubmitSteeringProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitSteeringProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSteeringProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitSteeringProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSteeringProposal.Merge(m, src)
+}
+func (m *MsgSubmitSteeringProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitSteeringProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSteeringProposal.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSubmitSteeringProposal proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// AnnotateProposal defines a method to annotate a proposal with additional information.
-	// It is only available to the Steering DAO.
-	AnnotateProposal(ctx context.Context, in *MsgAnnotateProposal, opts ...grpc.CallOption) (*MsgAnnotateProposalResponse, error)
-	// EndorseProposal defines a method to endorse a proposal, and for law proposals this also
-	// results in the lowering of the passing threshold to the passing threshold of regular proposals.
-	// It is only available to the Steering DAO.
-	EndorseProposal(ctx context.Context, in *MsgEndorseProposal, opts ...grpc.CallOption) (*MsgEndorseProposalResponse, error)
-	// ExtendVotingPeriod defines a method to extend the voting period of a proposal.
-	// It is available to both the Steering DAO and the Oversight DAO.
-	ExtendVotingPeriod(ctx context.Context, in *MsgExtendVotingPeriod, opts ...grpc.CallOption) (*MsgExtendVotingPeriodResponse, error)
-	// VetoProposal defines a method to veto a proposal.
-	// It is only available to the Oversight DAO.
-	VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error)
-	// UpdateParams defines a governance operation for updating the x/coredaos
-	// module parameters. The authority is defined in the keeper.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgSubmitSteeringProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSubmitSteeringProposal) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSubmitSteeringProposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
 }
 
-func (c *msgClient) AnnotateProposal(ctx context.Context, in *MsgAnnotateProposal, opts ...grpc.CallOption) (*MsgAnnotateProposalResponse, error) {
-	out := new(MsgAnnotateProposalResponse)
-	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/AnnotateProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitSteeringProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) EndorseProposal(ctx context.Context, in *MsgEndorseProposal, opts ...grpc.CallOption) (*MsgEndorseProposalResponse, error) {
-	out := new(MsgEndorseProposalResponse)
-	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/EndorseProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitSteeringProposal) GetSummary() string {
+	if m != nil {
+		return m.Summary
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ExtendVotingPeriod(ctx context.Context, in *MsgExtendVotingPeriod, opts ...grpc.CallOption) (*MsgExtendVotingPeriodResponse, error) {
-	out := new(MsgExtendVotingPeriodResponse)
-	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/ExtendVotingPeriod", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgSubmitSteeringProposalResponse defines the response for
+// MsgSubmitSteeringProposal.
+type MsgSubmitSteeringProposalResponse struct {
+	// proposal_id is the ID of the submitted proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (c *msgClient) VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error) {
-	out := new(MsgVetoProposalResponse)
-	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/VetoProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitSteeringProposalResponse) Reset()         { *m = MsgSubmitSteeringProposalResponse{} }
+func (m *MsgSubmitSteeringProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSteeringProposalResponse) ProtoMessage()    {}
+func (*MsgSubmitSteeringProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{11}
+}
+func (m *MsgSubmitSteeringProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitSteeringProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSteeringProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitSteeringProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSteeringProposalResponse.Merge(m, src)
+}
+func (m *MsgSubmitSteeringProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitSteeringProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSteeringProposalResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitSteeringProposalResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitSteeringProposalResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// AnnotateProposal defines a method to annotate a proposal with additional information.
-	// It is only available to the Steering DAO.
-	AnnotateProposal(context.Context, *MsgAnnotateProposal) (*MsgAnnotateProposalResponse, error)
-	// EndorseProposal defines a method to endorse a proposal, and for law proposals this also
-	// results in the lowering of the passing threshold to the passing threshold of regular proposals.
-	// It is only available to the Steering DAO.
-	EndorseProposal(context.Context, *MsgEndorseProposal) (*MsgEndorseProposalResponse, error)
-	// ExtendVotingPeriod defines a method to extend the voting period of a proposal.
-	// It is available to both the Steering DAO and the Oversight DAO.
-	ExtendVotingPeriod(context.Context, *MsgExtendVotingPeriod) (*MsgExtendVotingPeriodResponse, error)
-	// VetoProposal defines a method to veto a proposal.
-	// It is only available to the Oversight DAO.
-	VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error)
-	// UpdateParams defines a governance operation for updating the x/coredaos
-	// module parameters. The authority is defined in the keeper.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+// MsgRecuseFromProposal defines a message for recusing a Core DAO from a
+// proposal.
+type MsgRecuseFromProposal struct {
+	// recuser is the address of the Core DAO recusing itself.
+	Recuser string `protobuf:"bytes,1,opt,name=recuser,proto3" json:"recuser,omitempty"`
+	// proposal_id is the ID of the proposal the Core DAO recuses itself from.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// reason is the reason of the recusal, such as a conflict of interest.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgRecuseFromProposal) Reset()         { *m = MsgRecuseFromProposal{} }
+func (m *MsgRecuseFromProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgRecuseFromProposal) ProtoMessage()    {}
+func (*MsgRecuseFromProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{12}
 }
-
-func (*UnimplementedMsgServer) AnnotateProposal(ctx context.Context, req *MsgAnnotateProposal) (*MsgAnnotateProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AnnotateProposal not implemented")
+func (m *MsgRecuseFromProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) EndorseProposal(ctx context.Context, req *MsgEndorseProposal) (*MsgEndorseProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EndorseProposal not implemented")
+func (m *MsgRecuseFromProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecuseFromProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ExtendVotingPeriod(ctx context.Context, req *MsgExtendVotingPeriod) (*MsgExtendVotingPeriodResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExtendVotingPeriod not implemented")
+func (m *MsgRecuseFromProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecuseFromProposal.Merge(m, src)
 }
-func (*UnimplementedMsgServer) VetoProposal(ctx context.Context, req *MsgVetoProposal) (*MsgVetoProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VetoProposal not implemented")
+func (m *MsgRecuseFromProposal) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRecuseFromProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecuseFromProposal.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgRecuseFromProposal proto.InternalMessageInfo
 
-func _Msg_AnnotateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAnnotateProposal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AnnotateProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomone.coredaos.v1.Msg/AnnotateProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AnnotateProposal(ctx, req.(*MsgAnnotateProposal))
+func (m *MsgRecuseFromProposal) GetRecuser() string {
+	if m != nil {
+		return m.Recuser
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_EndorseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEndorseProposal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EndorseProposal(ctx, in)
+func (m *MsgRecuseFromProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomone.coredaos.v1.Msg/EndorseProposal",
+	return 0
+}
+
+func (m *MsgRecuseFromProposal) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EndorseProposal(ctx, req.(*MsgEndorseProposal))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ExtendVotingPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExtendVotingPeriod)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExtendVotingPeriod(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomone.coredaos.v1.Msg/ExtendVotingPeriod",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExtendVotingPeriod(ctx, req.(*MsgExtendVotingPeriod))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgRecuseFromProposalResponse defines the response for
+// MsgRecuseFromProposal.
+type MsgRecuseFromProposalResponse struct {
 }
 
-func _Msg_VetoProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVetoProposal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).VetoProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomone.coredaos.v1.Msg/VetoProposal",
+func (m *MsgRecuseFromProposalResponse) Reset()         { *m = MsgRecuseFromProposalResponse{} }
+func (m *MsgRecuseFromProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecuseFromProposalResponse) ProtoMessage()    {}
+func (*MsgRecuseFromProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{13}
+}
+func (m *MsgRecuseFromProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecuseFromProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecuseFromProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VetoProposal(ctx, req.(*MsgVetoProposal))
+}
+func (m *MsgRecuseFromProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecuseFromProposalResponse.Merge(m, src)
+}
+func (m *MsgRecuseFromProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecuseFromProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecuseFromProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecuseFromProposalResponse proto.InternalMessageInfo
+
+// MsgDaoSpend defines a message for spending from the budget of a Core DAO.
+type MsgDaoSpend struct {
+	// spender is the address of the Core DAO spending from its budget.
+	Spender string `protobuf:"bytes,1,opt,name=spender,proto3" json:"spender,omitempty"`
+	// recipient is the address receiving the funds.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount to spend.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// memo describes the purpose of the spend.
+	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *MsgDaoSpend) Reset()         { *m = MsgDaoSpend{} }
+func (m *MsgDaoSpend) String() string { return proto.CompactTextString(m) }
+func (*MsgDaoSpend) ProtoMessage()    {}
+func (*MsgDaoSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{14}
+}
+func (m *MsgDaoSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDaoSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDaoSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDaoSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDaoSpend.Merge(m, src)
+}
+func (m *MsgDaoSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDaoSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDaoSpend.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDaoSpend proto.InternalMessageInfo
+
+func (m *MsgDaoSpend) GetSpender() string {
+	if m != nil {
+		return m.Spender
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return ""
+}
+
+func (m *MsgDaoSpend) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomone.coredaos.v1.Msg/UpdateParams",
+	return ""
+}
+
+func (m *MsgDaoSpend) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return nil
+}
+
+func (m *MsgDaoSpend) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "atomone.coredaos.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AnnotateProposal",
-			Handler:    _Msg_AnnotateProposal_Handler,
-		},
-		{
-			MethodName: "EndorseProposal",
-			Handler:    _Msg_EndorseProposal_Handler,
-		},
-		{
-			MethodName: "ExtendVotingPeriod",
-			Handler:    _Msg_ExtendVotingPeriod_Handler,
-		},
-		{
-			MethodName: "VetoProposal",
-			Handler:    _Msg_VetoProposal_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "atomone/coredaos/v1/tx.proto",
+// MsgDaoSpendResponse defines the response for MsgDaoSpend.
+type MsgDaoSpendResponse struct {
 }
 
-func (m *MsgAnnotateProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDaoSpendResponse) Reset()         { *m = MsgDaoSpendResponse{} }
+func (m *MsgDaoSpendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDaoSpendResponse) ProtoMessage()    {}
+func (*MsgDaoSpendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{15}
+}
+func (m *MsgDaoSpendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDaoSpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDaoSpendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDaoSpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDaoSpendResponse.Merge(m, src)
+}
+func (m *MsgDaoSpendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDaoSpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDaoSpendResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAnnotateProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDaoSpendResponse proto.InternalMessageInfo
+
+// MsgSubmitDaoAction defines a message for submitting an action on behalf of a
+// Core DAO.
+type MsgSubmitDaoAction struct {
+	// member is the address of the member of the Core DAO submitting the
+	// action.
+	Member string `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	// dao is the Core DAO the action is taken on behalf of.
+	Dao CoreDao `protobuf:"varint,2,opt,name=dao,proto3,enum=atomone.coredaos.v1.CoreDao" json:"dao,omitempty"`
+	// action is the type of the action.
+	Action DaoActionType `protobuf:"varint,3,opt,name=action,proto3,enum=atomone.coredaos.v1.DaoActionType" json:"action,omitempty"`
+	// proposal_id is the ID of the proposal the action applies to.
+	ProposalId uint64 `protobuf:"varint,4,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// annotation is the annotation to add to the proposal, for annotations.
+	Annotation string `protobuf:"bytes,5,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	// overwrite indicates whether to overwrite the existing annotation of the
+	// proposal, for annotations.
+	Overwrite bool `protobuf:"varint,6,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	// burn_deposit indicates whether to burn the deposits of the proposal, for
+	// vetoes.
+	BurnDeposit bool `protobuf:"varint,7,opt,name=burn_deposit,json=burnDeposit,proto3" json:"burn_deposit,omitempty"`
+	// reason is the reason of the recusal, for recusals.
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	// recipient is the address receiving the funds, for spends.
+	Recipient string `protobuf:"bytes,9,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount to spend from the budget of the Core DAO, for
+	// spends.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// memo is the memo of the spend, for spends.
+	Memo string `protobuf:"bytes,11,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
-func (m *MsgAnnotateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Overwrite {
-		i--
-		if m.Overwrite {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgSubmitDaoAction) Reset()         { *m = MsgSubmitDaoAction{} }
+func (m *MsgSubmitDaoAction) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitDaoAction) ProtoMessage()    {}
+func (*MsgSubmitDaoAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{16}
+}
+func (m *MsgSubmitDaoAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitDaoAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitDaoAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Annotation) > 0 {
-		i -= len(m.Annotation)
-		copy(dAtA[i:], m.Annotation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Annotation)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.ProposalId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *MsgSubmitDaoAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitDaoAction.Merge(m, src)
+}
+func (m *MsgSubmitDaoAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitDaoAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitDaoAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitDaoAction proto.InternalMessageInfo
+
+func (m *MsgSubmitDaoAction) GetMember() string {
+	if m != nil {
+		return m.Member
 	}
-	if len(m.Annotator) > 0 {
-		i -= len(m.Annotator)
-		copy(dAtA[i:], m.Annotator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Annotator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSubmitDaoAction) GetDao() CoreDao {
+	if m != nil {
+		return m.Dao
 	}
-	return len(dAtA) - i, nil
+	return CoreDaoUnspecified
 }
 
-func (m *MsgAnnotateProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitDaoAction) GetAction() DaoActionType {
+	if m != nil {
+		return m.Action
 	}
-	return dAtA[:n], nil
+	return DaoActionTypeUnspecified
 }
 
-func (m *MsgAnnotateProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitDaoAction) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
 }
 
-func (m *MsgAnnotateProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSubmitDaoAction) GetAnnotation() string {
+	if m != nil {
+		return m.Annotation
+	}
+	return ""
 }
 
-func (m *MsgEndorseProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitDaoAction) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgEndorseProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitDaoAction) GetBurnDeposit() bool {
+	if m != nil {
+		return m.BurnDeposit
+	}
+	return false
 }
 
-func (m *MsgEndorseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgSubmitDaoAction) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(m.Endorser) > 0 {
-		i -= len(m.Endorser)
-		copy(dAtA[i:], m.Endorser)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Endorser)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSubmitDaoAction) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgEndorseProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitDaoAction) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgEndorseProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitDaoAction) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
 }
 
-func (m *MsgEndorseProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSubmitDaoActionResponse defines the response for MsgSubmitDaoAction.
+type MsgSubmitDaoActionResponse struct {
+	// id is the ID of the pending action.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// executed indicates whether the action has been executed, which happens
+	// when the threshold of the Core DAO is one.
+	Executed bool `protobuf:"varint,2,opt,name=executed,proto3" json:"executed,omitempty"`
 }
 
-func (m *MsgExtendVotingPeriod) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitDaoActionResponse) Reset()         { *m = MsgSubmitDaoActionResponse{} }
+func (m *MsgSubmitDaoActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitDaoActionResponse) ProtoMessage()    {}
+func (*MsgSubmitDaoActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{17}
+}
+func (m *MsgSubmitDaoActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitDaoActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitDaoActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgExtendVotingPeriod) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitDaoActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitDaoActionResponse.Merge(m, src)
+}
+func (m *MsgSubmitDaoActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitDaoActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitDaoActionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgExtendVotingPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Extender) > 0 {
-		i -= len(m.Extender)
-		copy(dAtA[i:], m.Extender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Extender)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSubmitDaoActionResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitDaoActionResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgExtendVotingPeriodResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitDaoActionResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgExtendVotingPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgApproveAction defines a message for approving a pending action of a Core
+// DAO.
+type MsgApproveAction struct {
+	// member is the address of the member of the Core DAO approving the
+	// action.
+	Member string `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	// id is the ID of the pending action to approve.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgExtendVotingPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgApproveAction) Reset()         { *m = MsgApproveAction{} }
+func (m *MsgApproveAction) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveAction) ProtoMessage()    {}
+func (*MsgApproveAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{18}
 }
-
-func (m *MsgVetoProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgApproveAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgVetoProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgApproveAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveAction.Merge(m, src)
+}
+func (m *MsgApproveAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveAction.DiscardUnknown(m)
 }
 
-func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BurnDeposit {
-		i--
-		if m.BurnDeposit {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Vetoer) > 0 {
-		i -= len(m.Vetoer)
-		copy(dAtA[i:], m.Vetoer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Vetoer)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgApproveAction proto.InternalMessageInfo
+
+func (m *MsgApproveAction) GetMember() string {
+	if m != nil {
+		return m.Member
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgVetoProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgApproveAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgVetoProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgApproveActionResponse defines the response for MsgApproveAction.
+type MsgApproveActionResponse struct {
+	// executed indicates whether the approval reached the threshold and the
+	// action has been executed.
+	Executed bool `protobuf:"varint,1,opt,name=executed,proto3" json:"executed,omitempty"`
 }
 
-func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgApproveActionResponse) Reset()         { *m = MsgApproveActionResponse{} }
+func (m *MsgApproveActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveActionResponse) ProtoMessage()    {}
+func (*MsgApproveActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{19}
+}
+func (m *MsgApproveActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveActionResponse.Merge(m, src)
+}
+func (m *MsgApproveActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveActionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgApproveActionResponse proto.InternalMessageInfo
+
+func (m *MsgApproveActionResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateDaoMembers is the Msg/UpdateDaoMembers request type.
+type MsgUpdateDaoMembers struct {
+	// authority is the address that controls the module (defaults to x/gov
+	// unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// dao_members defines the new members of the Core DAO. An empty member
+	// list removes the member set, the Core DAO actions are then signed by the
+	// Core DAO address only.
+	DaoMembers DaoMembers `protobuf:"bytes,2,opt,name=dao_members,json=daoMembers,proto3" json:"dao_members"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateDaoMembers) Reset()         { *m = MsgUpdateDaoMembers{} }
+func (m *MsgUpdateDaoMembers) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDaoMembers) ProtoMessage()    {}
+func (*MsgUpdateDaoMembers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{20}
+}
+func (m *MsgUpdateDaoMembers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDaoMembers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDaoMembers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateDaoMembers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDaoMembers.Merge(m, src)
+}
+func (m *MsgUpdateDaoMembers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDaoMembers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDaoMembers.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateDaoMembers proto.InternalMessageInfo
+
+func (m *MsgUpdateDaoMembers) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateDaoMembers) GetDaoMembers() DaoMembers {
+	if m != nil {
+		return m.DaoMembers
+	}
+	return DaoMembers{}
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateDaoMembersResponse defines the response for MsgUpdateDaoMembers.
+type MsgUpdateDaoMembersResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgUpdateDaoMembersResponse) Reset()         { *m = MsgUpdateDaoMembersResponse{} }
+func (m *MsgUpdateDaoMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDaoMembersResponse) ProtoMessage()    {}
+func (*MsgUpdateDaoMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{21}
 }
-func (m *MsgAnnotateProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Annotator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
-	}
-	l = len(m.Annotation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Overwrite {
-		n += 2
+func (m *MsgUpdateDaoMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDaoMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDaoMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUpdateDaoMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDaoMembersResponse.Merge(m, src)
+}
+func (m *MsgUpdateDaoMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDaoMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDaoMembersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAnnotateProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgUpdateDaoMembersResponse proto.InternalMessageInfo
+
+// MsgUpdateDaoBudget is the Msg/UpdateDaoBudget request type.
+type MsgUpdateDaoBudget struct {
+	// authority is the address that controls the module (defaults to x/gov
+	// unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// dao_budget defines the new budget of the Core DAO. An empty allowance
+	// removes the budget. The new budget applies from the start of the next
+	// epoch of the budget.
+	DaoBudget DaoBudget `protobuf:"bytes,2,opt,name=dao_budget,json=daoBudget,proto3" json:"dao_budget"`
 }
 
-func (m *MsgEndorseProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Endorser)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
+func (m *MsgUpdateDaoBudget) Reset()         { *m = MsgUpdateDaoBudget{} }
+func (m *MsgUpdateDaoBudget) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDaoBudget) ProtoMessage()    {}
+func (*MsgUpdateDaoBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{22}
+}
+func (m *MsgUpdateDaoBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDaoBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDaoBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUpdateDaoBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDaoBudget.Merge(m, src)
+}
+func (m *MsgUpdateDaoBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDaoBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDaoBudget.DiscardUnknown(m)
 }
 
-func (m *MsgEndorseProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUpdateDaoBudget proto.InternalMessageInfo
+
+func (m *MsgUpdateDaoBudget) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgExtendVotingPeriod) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Extender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateDaoBudget) GetDaoBudget() DaoBudget {
+	if m != nil {
+		return m.DaoBudget
 	}
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
+	return DaoBudget{}
+}
+
+// MsgUpdateDaoBudgetResponse defines the response for MsgUpdateDaoBudget.
+type MsgUpdateDaoBudgetResponse struct {
+}
+
+func (m *MsgUpdateDaoBudgetResponse) Reset()         { *m = MsgUpdateDaoBudgetResponse{} }
+func (m *MsgUpdateDaoBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDaoBudgetResponse) ProtoMessage()    {}
+func (*MsgUpdateDaoBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{23}
+}
+func (m *MsgUpdateDaoBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDaoBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDaoBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUpdateDaoBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDaoBudgetResponse.Merge(m, src)
+}
+func (m *MsgUpdateDaoBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDaoBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDaoBudgetResponse.DiscardUnknown(m)
 }
 
-func (m *MsgExtendVotingPeriodResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUpdateDaoBudgetResponse proto.InternalMessageInfo
+
+// MsgReconfirmDao is the Msg/ReconfirmDao request type.
+type MsgReconfirmDao struct {
+	// authority is the address that controls the module (defaults to x/gov
+	// unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// dao is the reconfirmed Core DAO.
+	Dao CoreDao `protobuf:"varint,2,opt,name=dao,proto3,enum=atomone.coredaos.v1.CoreDao" json:"dao,omitempty"`
+}
+
+func (m *MsgReconfirmDao) Reset()         { *m = MsgReconfirmDao{} }
+func (m *MsgReconfirmDao) String() string { return proto.CompactTextString(m) }
+func (*MsgReconfirmDao) ProtoMessage()    {}
+func (*MsgReconfirmDao) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{24}
+}
+func (m *MsgReconfirmDao) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReconfirmDao) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReconfirmDao.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgReconfirmDao) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReconfirmDao.Merge(m, src)
+}
+func (m *MsgReconfirmDao) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReconfirmDao) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReconfirmDao.DiscardUnknown(m)
 }
 
-func (m *MsgVetoProposal) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgReconfirmDao proto.InternalMessageInfo
+
+func (m *MsgReconfirmDao) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	l = len(m.Vetoer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgReconfirmDao) GetDao() CoreDao {
+	if m != nil {
+		return m.Dao
 	}
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
+	return CoreDaoUnspecified
+}
+
+// MsgReconfirmDaoResponse defines the response for MsgReconfirmDao.
+type MsgReconfirmDaoResponse struct {
+}
+
+func (m *MsgReconfirmDaoResponse) Reset()         { *m = MsgReconfirmDaoResponse{} }
+func (m *MsgReconfirmDaoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReconfirmDaoResponse) ProtoMessage()    {}
+func (*MsgReconfirmDaoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{25}
+}
+func (m *MsgReconfirmDaoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReconfirmDaoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReconfirmDaoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.BurnDeposit {
-		n += 2
+}
+func (m *MsgReconfirmDaoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReconfirmDaoResponse.Merge(m, src)
+}
+func (m *MsgReconfirmDaoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReconfirmDaoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReconfirmDaoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReconfirmDaoResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/coredaos parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{26}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgVetoProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942eb16dc573b0ab, []int{27}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAnnotateProposal)(nil), "atomone.coredaos.v1.MsgAnnotateProposal")
+	proto.RegisterType((*MsgAnnotateProposalResponse)(nil), "atomone.coredaos.v1.MsgAnnotateProposalResponse")
+	proto.RegisterType((*MsgEndorseProposal)(nil), "atomone.coredaos.v1.MsgEndorseProposal")
+	proto.RegisterType((*MsgEndorseProposalResponse)(nil), "atomone.coredaos.v1.MsgEndorseProposalResponse")
+	proto.RegisterType((*MsgExtendVotingPeriod)(nil), "atomone.coredaos.v1.MsgExtendVotingPeriod")
+	proto.RegisterType((*MsgExtendVotingPeriodResponse)(nil), "atomone.coredaos.v1.MsgExtendVotingPeriodResponse")
+	proto.RegisterType((*MsgVetoProposal)(nil), "atomone.coredaos.v1.MsgVetoProposal")
+	proto.RegisterType((*MsgVetoProposalResponse)(nil), "atomone.coredaos.v1.MsgVetoProposalResponse")
+	proto.RegisterType((*MsgWithdrawVeto)(nil), "atomone.coredaos.v1.MsgWithdrawVeto")
+	proto.RegisterType((*MsgWithdrawVetoResponse)(nil), "atomone.coredaos.v1.MsgWithdrawVetoResponse")
+	proto.RegisterType((*MsgSubmitSteeringProposal)(nil), "atomone.coredaos.v1.MsgSubmitSteeringProposal")
+	proto.RegisterType((*MsgSubmitSteeringProposalResponse)(nil), "atomone.coredaos.v1.MsgSubmitSteeringProposalResponse")
+	proto.RegisterType((*MsgRecuseFromProposal)(nil), "atomone.coredaos.v1.MsgRecuseFromProposal")
+	proto.RegisterType((*MsgRecuseFromProposalResponse)(nil), "atomone.coredaos.v1.MsgRecuseFromProposalResponse")
+	proto.RegisterType((*MsgDaoSpend)(nil), "atomone.coredaos.v1.MsgDaoSpend")
+	proto.RegisterType((*MsgDaoSpendResponse)(nil), "atomone.coredaos.v1.MsgDaoSpendResponse")
+	proto.RegisterType((*MsgSubmitDaoAction)(nil), "atomone.coredaos.v1.MsgSubmitDaoAction")
+	proto.RegisterType((*MsgSubmitDaoActionResponse)(nil), "atomone.coredaos.v1.MsgSubmitDaoActionResponse")
+	proto.RegisterType((*MsgApproveAction)(nil), "atomone.coredaos.v1.MsgApproveAction")
+	proto.RegisterType((*MsgApproveActionResponse)(nil), "atomone.coredaos.v1.MsgApproveActionResponse")
+	proto.RegisterType((*MsgUpdateDaoMembers)(nil), "atomone.coredaos.v1.MsgUpdateDaoMembers")
+	proto.RegisterType((*MsgUpdateDaoMembersResponse)(nil), "atomone.coredaos.v1.MsgUpdateDaoMembersResponse")
+	proto.RegisterType((*MsgUpdateDaoBudget)(nil), "atomone.coredaos.v1.MsgUpdateDaoBudget")
+	proto.RegisterType((*MsgUpdateDaoBudgetResponse)(nil), "atomone.coredaos.v1.MsgUpdateDaoBudgetResponse")
+	proto.RegisterType((*MsgReconfirmDao)(nil), "atomone.coredaos.v1.MsgReconfirmDao")
+	proto.RegisterType((*MsgReconfirmDaoResponse)(nil), "atomone.coredaos.v1.MsgReconfirmDaoResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "atomone.coredaos.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "atomone.coredaos.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("atomone/coredaos/v1/tx.proto", fileDescriptor_942eb16dc573b0ab) }
+
+var fileDescriptor_942eb16dc573b0ab = []byte{
+	// 1540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x6f, 0x14, 0xc7,
+	0x12, 0xf7, 0xac, 0xed, 0x65, 0xdd, 0x0b, 0x86, 0x37, 0x18, 0x58, 0x0f, 0x66, 0xd7, 0xcc, 0xf3,
+	0xc3, 0x7e, 0x96, 0x3d, 0x63, 0x2f, 0x3c, 0xeb, 0x65, 0x23, 0x45, 0xb2, 0x71, 0x22, 0x10, 0xb2,
+	0x84, 0xc6, 0x84, 0x48, 0xb9, 0x58, 0xbd, 0x3b, 0xcd, 0x78, 0x04, 0x33, 0x3d, 0x9a, 0xee, 0x35,
+	0xf6, 0x29, 0x28, 0x97, 0x48, 0xe4, 0x42, 0x8e, 0xb9, 0xe6, 0x94, 0xe4, 0x44, 0xa4, 0x44, 0xf9,
+	0x0a, 0x9c, 0x22, 0x92, 0x43, 0x94, 0x53, 0x88, 0xe0, 0xc0, 0x39, 0xdf, 0x20, 0xea, 0xe9, 0x9e,
+	0xf6, 0xec, 0xfc, 0xd9, 0x1d, 0x7c, 0xb1, 0xb7, 0xab, 0x7e, 0xdd, 0x55, 0xbf, 0xea, 0xea, 0xaa,
+	0x1a, 0x30, 0x07, 0x29, 0xf6, 0xb0, 0x8f, 0xcc, 0x1e, 0x0e, 0x91, 0x0d, 0x31, 0x31, 0x0f, 0xd6,
+	0x4d, 0x7a, 0x68, 0x04, 0x21, 0xa6, 0x58, 0x3d, 0x2f, 0xb4, 0x46, 0xac, 0x35, 0x0e, 0xd6, 0xb5,
+	0x19, 0x07, 0x3b, 0x38, 0xd2, 0x9b, 0xec, 0x17, 0x87, 0x6a, 0xb3, 0x3d, 0x4c, 0x3c, 0x4c, 0xf6,
+	0xb8, 0x82, 0x2f, 0x84, 0xea, 0x12, 0x5f, 0x99, 0x1e, 0x71, 0xd8, 0xe9, 0x1e, 0x71, 0x84, 0xa2,
+	0x29, 0x14, 0x5d, 0x48, 0x90, 0x79, 0xb0, 0xde, 0x45, 0x14, 0xae, 0x9b, 0x3d, 0xec, 0xfa, 0x42,
+	0xff, 0x2f, 0xe8, 0xb9, 0x3e, 0x36, 0xa3, 0xbf, 0xb1, 0x19, 0x07, 0x63, 0xe7, 0x11, 0x32, 0xa3,
+	0x55, 0xb7, 0xff, 0xc0, 0x84, 0xfe, 0x91, 0x50, 0xb5, 0xd2, 0x2a, 0xea, 0x7a, 0x88, 0x50, 0xe8,
+	0x05, 0x02, 0xa0, 0xe7, 0x71, 0x95, 0xcc, 0x22, 0x8c, 0xfe, 0x8b, 0x02, 0xce, 0xef, 0x10, 0x67,
+	0xd3, 0xf7, 0x31, 0x85, 0x14, 0xdd, 0x0d, 0x71, 0x80, 0x09, 0x7c, 0xa4, 0xce, 0x81, 0x29, 0xc8,
+	0x65, 0x38, 0x6c, 0x28, 0xf3, 0xca, 0xd2, 0x94, 0x75, 0x2c, 0x50, 0x5b, 0xa0, 0x1e, 0x08, 0xe4,
+	0x9e, 0x6b, 0x37, 0x2a, 0xf3, 0xca, 0xd2, 0x84, 0x05, 0x62, 0xd1, 0x6d, 0x5b, 0x6d, 0x02, 0x20,
+	0xd0, 0x2e, 0xf6, 0x1b, 0xe3, 0xd1, 0xfe, 0x84, 0x84, 0x1d, 0x8f, 0x0f, 0x50, 0xf8, 0x38, 0x74,
+	0x29, 0x6a, 0x4c, 0xcc, 0x2b, 0x4b, 0x35, 0xeb, 0x58, 0xd0, 0xe9, 0x7c, 0xfe, 0xf6, 0xf9, 0xf2,
+	0xb1, 0xb9, 0xa7, 0x6f, 0x9f, 0x2f, 0x2f, 0xe6, 0x71, 0xc9, 0x71, 0x5c, 0xbf, 0x02, 0x2e, 0xe7,
+	0x88, 0x2d, 0x44, 0x02, 0xec, 0x13, 0xa4, 0x7f, 0xa9, 0x00, 0x75, 0x87, 0x38, 0x1f, 0xfa, 0x36,
+	0x0e, 0xc9, 0x31, 0x5d, 0x0d, 0xd4, 0x10, 0x17, 0xc5, 0x6c, 0xe5, 0x7a, 0x24, 0xd9, 0xce, 0xff,
+	0x99, 0xbb, 0x12, 0xcf, 0xbc, 0xbd, 0x56, 0xe0, 0x6d, 0xca, 0xac, 0x3e, 0x07, 0xb4, 0xac, 0x54,
+	0xfa, 0xfa, 0x95, 0x02, 0x2e, 0x30, 0xf5, 0x21, 0x45, 0xbe, 0x7d, 0x1f, 0x53, 0xd7, 0x77, 0xee,
+	0xa2, 0xd0, 0xc5, 0x76, 0xe4, 0x6e, 0x24, 0x4d, 0xb8, 0x2b, 0xd6, 0xa3, 0xdd, 0x7d, 0x9f, 0xbb,
+	0x2b, 0xf0, 0xcc, 0xdd, 0xff, 0x16, 0xb9, 0x9b, 0xb1, 0xac, 0xb7, 0xc0, 0x95, 0x5c, 0x85, 0x74,
+	0xfa, 0x1b, 0x05, 0x9c, 0xdd, 0x21, 0xce, 0x7d, 0x44, 0xb1, 0x8c, 0xee, 0x45, 0x50, 0x3d, 0x40,
+	0x14, 0x4b, 0x67, 0xc5, 0x6a, 0x74, 0x1a, 0x5d, 0x05, 0xa7, 0xbb, 0xfd, 0xd0, 0xdf, 0xb3, 0x51,
+	0x80, 0x89, 0x4b, 0xa3, 0x44, 0xaa, 0x59, 0x75, 0x26, 0xdb, 0xe6, 0xa2, 0xce, 0x75, 0xc6, 0x46,
+	0x1c, 0xc8, 0xb8, 0xfc, 0xbb, 0x80, 0x4b, 0xd2, 0x21, 0xfd, 0x01, 0xb8, 0x94, 0x12, 0xc5, 0xfe,
+	0xab, 0x77, 0xc0, 0x34, 0x3a, 0x44, 0xbd, 0x3e, 0x4b, 0xd3, 0x3d, 0xf6, 0xa2, 0x22, 0x9f, 0xeb,
+	0x6d, 0xcd, 0xe0, 0xcf, 0xcd, 0x88, 0x9f, 0x9b, 0x71, 0x2f, 0x7e, 0x6e, 0x5b, 0xb5, 0x17, 0x7f,
+	0xb6, 0xc6, 0x9e, 0xbd, 0x6a, 0x29, 0xd6, 0x19, 0xb9, 0x97, 0x69, 0xf5, 0xcf, 0xa2, 0x58, 0x7c,
+	0xe2, 0xd2, 0x7d, 0x3b, 0x84, 0x8f, 0x99, 0xbd, 0x13, 0xc7, 0xa2, 0x34, 0xd1, 0xa4, 0x35, 0x7d,
+	0x16, 0x5c, 0x4a, 0x89, 0xe4, 0x45, 0x7d, 0x51, 0x01, 0xb3, 0x3b, 0xc4, 0xd9, 0xed, 0x77, 0x3d,
+	0x97, 0xee, 0x52, 0x84, 0x42, 0x76, 0x99, 0xf1, 0x95, 0xdd, 0x00, 0x35, 0x6e, 0x3b, 0x76, 0x74,
+	0xab, 0xf1, 0xdb, 0x8f, 0xab, 0x33, 0xa2, 0xce, 0x6d, 0xda, 0x76, 0x88, 0x08, 0xd9, 0xa5, 0x6c,
+	0x8f, 0x25, 0x91, 0xea, 0x1a, 0xa8, 0x79, 0x88, 0x10, 0xe8, 0x20, 0xd2, 0xa8, 0xcc, 0x8f, 0x2f,
+	0xd5, 0xdb, 0x33, 0x99, 0xb0, 0x6d, 0xfa, 0x47, 0x96, 0x44, 0xb1, 0x4c, 0xf6, 0x10, 0x85, 0x36,
+	0xa4, 0x50, 0x94, 0x09, 0xb9, 0x56, 0x67, 0xc0, 0x24, 0x75, 0xe9, 0x23, 0x5e, 0x20, 0xa6, 0x2c,
+	0xbe, 0x50, 0x1b, 0xe0, 0x14, 0xe9, 0x7b, 0x1e, 0x0c, 0x8f, 0x1a, 0x93, 0x91, 0x3c, 0x5e, 0x76,
+	0x36, 0xa2, 0xc4, 0x8e, 0x9d, 0x61, 0x31, 0x5a, 0x88, 0x63, 0xc4, 0x43, 0x93, 0xcf, 0x55, 0xdf,
+	0x06, 0x57, 0x0b, 0x95, 0x32, 0x2f, 0x52, 0xf7, 0xa3, 0xa4, 0xef, 0x47, 0xff, 0x99, 0xbf, 0x56,
+	0x0b, 0xf5, 0xfa, 0x04, 0x7d, 0x14, 0x62, 0x4f, 0xc6, 0xb2, 0x0d, 0x4e, 0x85, 0x91, 0x74, 0x74,
+	0x28, 0x63, 0xe0, 0xe8, 0xa7, 0x71, 0x11, 0x54, 0x43, 0x04, 0x89, 0xac, 0xae, 0x62, 0xd5, 0x59,
+	0x67, 0x41, 0x88, 0x8f, 0x61, 0x31, 0x98, 0x1f, 0x8c, 0x41, 0xd6, 0x3f, 0xf1, 0xa6, 0xb3, 0x0a,
+	0x99, 0x2a, 0xdf, 0x55, 0x40, 0x7d, 0x87, 0x38, 0xdb, 0x10, 0xef, 0x06, 0xc8, 0xb7, 0x19, 0x21,
+	0x12, 0x24, 0xaa, 0xcf, 0x30, 0x42, 0x02, 0xa8, 0x6e, 0x80, 0xa9, 0x10, 0xf5, 0xdc, 0xc0, 0x45,
+	0x3e, 0x6d, 0x54, 0x46, 0xec, 0x3a, 0x86, 0xaa, 0xfb, 0xa0, 0x0a, 0x3d, 0xdc, 0xf7, 0xd9, 0xe3,
+	0x67, 0x09, 0x35, 0x6b, 0x88, 0x1d, 0xac, 0x89, 0x1a, 0xa2, 0x89, 0x1a, 0x37, 0xb1, 0xeb, 0x6f,
+	0xfd, 0x8f, 0x3d, 0xc3, 0xef, 0x5f, 0xb5, 0x96, 0x1c, 0x97, 0xee, 0xf7, 0xbb, 0x46, 0x0f, 0x7b,
+	0xa2, 0x31, 0x8b, 0x7f, 0xab, 0xc4, 0x7e, 0x68, 0xd2, 0xa3, 0x00, 0x91, 0x68, 0x03, 0xf9, 0xf6,
+	0xed, 0xf3, 0x65, 0xc5, 0x12, 0xe7, 0xab, 0x2a, 0x98, 0xf0, 0x90, 0x87, 0x45, 0xb6, 0x45, 0xbf,
+	0x3b, 0x6b, 0x51, 0x34, 0x05, 0x07, 0x16, 0xcd, 0x56, 0xc1, 0xab, 0x8b, 0x63, 0xa3, 0x5f, 0x00,
+	0xe7, 0x13, 0x4b, 0x19, 0xc2, 0x9f, 0x26, 0x80, 0x2a, 0x93, 0x6c, 0x1b, 0xe2, 0xcd, 0x5e, 0xd4,
+	0x07, 0xd7, 0x40, 0xd5, 0x43, 0x5e, 0xb7, 0x44, 0x20, 0x05, 0x4e, 0x35, 0xc0, 0xb8, 0x0d, 0x71,
+	0x14, 0xc1, 0xe9, 0xf6, 0x9c, 0x91, 0x33, 0xb0, 0x18, 0x37, 0x71, 0x88, 0xb6, 0x21, 0xb6, 0x18,
+	0x50, 0xed, 0x80, 0x2a, 0xec, 0xc9, 0x2e, 0x3c, 0xdd, 0xd6, 0x73, 0xb7, 0x48, 0x8f, 0xee, 0x1d,
+	0x05, 0xc8, 0x12, 0x3b, 0xd2, 0x49, 0x38, 0x31, 0xa2, 0xcd, 0x4f, 0x0e, 0x6f, 0xf3, 0xd5, 0x54,
+	0x9b, 0xcf, 0x54, 0xf7, 0x53, 0x99, 0xea, 0x9e, 0xc8, 0xf2, 0x5a, 0x32, 0xcb, 0x07, 0xb3, 0x69,
+	0xaa, 0x7c, 0x36, 0xf5, 0x64, 0x36, 0x81, 0x51, 0xd9, 0xb4, 0xf6, 0xae, 0xd9, 0x94, 0x49, 0xa4,
+	0x7a, 0x22, 0x91, 0xa2, 0xda, 0x24, 0xee, 0x70, 0xd8, 0x84, 0x90, 0x4a, 0x10, 0xfd, 0x16, 0xd0,
+	0xb2, 0x52, 0x59, 0x94, 0xa6, 0x41, 0x45, 0xd6, 0xa2, 0x8a, 0x2b, 0xe6, 0x02, 0xd6, 0x80, 0x10,
+	0x2f, 0x19, 0x35, 0x4b, 0xae, 0xf5, 0xa7, 0x0a, 0x38, 0xc7, 0x26, 0xa3, 0x20, 0x08, 0xf1, 0x01,
+	0x3a, 0x71, 0xfe, 0x71, 0x93, 0x95, 0xd8, 0x64, 0xe7, 0x46, 0x8a, 0xd8, 0x42, 0xd1, 0xa0, 0x96,
+	0xb4, 0xab, 0x6f, 0x80, 0x46, 0x5a, 0x26, 0x49, 0x25, 0x49, 0x28, 0x29, 0x12, 0xbf, 0xf3, 0x71,
+	0xf5, 0xe3, 0xc0, 0x86, 0x94, 0xe5, 0xf8, 0x4e, 0x64, 0x97, 0xb0, 0x7c, 0x80, 0x7d, 0xba, 0x8f,
+	0x43, 0x97, 0x1e, 0x8d, 0xa4, 0x72, 0x0c, 0x55, 0xef, 0x80, 0xba, 0x0d, 0xf1, 0x1e, 0x77, 0x9f,
+	0x44, 0xb4, 0xea, 0xed, 0x56, 0xd1, 0x13, 0x11, 0xd6, 0xb6, 0xa6, 0x58, 0x6a, 0xf0, 0xe2, 0x01,
+	0x6c, 0x29, 0x8e, 0xc7, 0xd6, 0xf8, 0xf0, 0x61, 0x63, 0x6b, 0x9a, 0x80, 0x18, 0x5b, 0xd3, 0x62,
+	0x59, 0x3e, 0x7e, 0xe5, 0x63, 0xab, 0xd4, 0x6f, 0xf5, 0x6d, 0x07, 0xd1, 0x13, 0xd3, 0xbe, 0x05,
+	0x98, 0xdf, 0x7b, 0xdd, 0xe8, 0x14, 0xc1, 0xba, 0x59, 0xc4, 0x9a, 0xdb, 0x4a, 0x92, 0x9e, 0xb2,
+	0x63, 0x69, 0xe7, 0xbd, 0x2c, 0xe7, 0x6b, 0xa3, 0x38, 0xf3, 0xad, 0x62, 0xf8, 0x4d, 0x49, 0x25,
+	0xe3, 0x1f, 0xf8, 0x1c, 0x69, 0xa1, 0x1e, 0xf6, 0x1f, 0xb8, 0xa1, 0xb7, 0x0d, 0xf1, 0x89, 0xe9,
+	0xbe, 0x63, 0xcd, 0xe4, 0x8f, 0x75, 0x90, 0x54, 0xd1, 0xb4, 0x95, 0xf4, 0x4f, 0x4c, 0x5b, 0x49,
+	0x91, 0xa4, 0xf3, 0x35, 0xa7, 0xc3, 0xd9, 0xde, 0x85, 0x21, 0xf4, 0x4e, 0x9e, 0xb4, 0x1f, 0x80,
+	0x6a, 0x10, 0x9d, 0x20, 0x6e, 0xee, 0x72, 0x2e, 0x23, 0x6e, 0x24, 0x79, 0x6d, 0x62, 0x57, 0x67,
+	0x7a, 0x90, 0x9e, 0x70, 0x3b, 0xe9, 0x5a, 0xec, 0x76, 0xfb, 0xef, 0x3a, 0x18, 0xdf, 0x21, 0x8e,
+	0xea, 0x83, 0x73, 0x99, 0x4f, 0xc4, 0xa5, 0x5c, 0xb3, 0x39, 0x1f, 0x5f, 0xda, 0x5a, 0x59, 0xa4,
+	0xac, 0x01, 0x0f, 0xc1, 0xd9, 0xf4, 0x27, 0xda, 0x62, 0xd1, 0x21, 0x29, 0xa0, 0x66, 0x96, 0x04,
+	0x4a, 0x63, 0x14, 0xa8, 0x39, 0xdf, 0x58, 0xcb, 0x85, 0xc7, 0x64, 0xb0, 0x5a, 0xbb, 0x3c, 0x56,
+	0x5a, 0xed, 0x82, 0xd3, 0x03, 0x1f, 0x49, 0x0b, 0x45, 0x67, 0x24, 0x51, 0xda, 0x4a, 0x19, 0x54,
+	0xd2, 0xc6, 0xc0, 0xc7, 0x47, 0xa1, 0x8d, 0x24, 0x4a, 0x5b, 0x29, 0x83, 0x92, 0x36, 0x9e, 0x28,
+	0xe0, 0x62, 0xc1, 0x47, 0x84, 0x51, 0x74, 0x50, 0x3e, 0x5e, 0xdb, 0x78, 0x37, 0x7c, 0xf2, 0x02,
+	0x73, 0xc6, 0xee, 0xc2, 0x0b, 0xcc, 0x62, 0xb5, 0x76, 0x79, 0xac, 0xb4, 0x7a, 0x1f, 0xd4, 0xe4,
+	0x44, 0x3c, 0x5f, 0xb4, 0x3f, 0x46, 0x68, 0x4b, 0xa3, 0x10, 0xc9, 0xdc, 0x4f, 0x8f, 0x89, 0x8b,
+	0xc3, 0x03, 0x23, 0x81, 0x9a, 0x59, 0x12, 0x28, 0x8d, 0x21, 0x70, 0x66, 0x70, 0x22, 0xf8, 0x4f,
+	0xe1, 0x5b, 0x4d, 0xc2, 0xb4, 0xd5, 0x52, 0x30, 0x69, 0xc6, 0x07, 0xe7, 0x32, 0x3d, 0xbb, 0x30,
+	0x22, 0x69, 0xa4, 0xb6, 0x56, 0x16, 0x99, 0x8c, 0x61, 0xba, 0x57, 0x2e, 0x8e, 0x3c, 0x84, 0x03,
+	0x35, 0xb3, 0x24, 0x30, 0xf9, 0xca, 0x06, 0xda, 0xd4, 0xc2, 0x90, 0x64, 0x92, 0x28, 0x6d, 0xa5,
+	0x0c, 0x2a, 0x69, 0x63, 0xa0, 0x77, 0x2c, 0x0c, 0x77, 0x92, 0xa3, 0xb4, 0x95, 0x32, 0xa8, 0xd8,
+	0x86, 0x36, 0xf9, 0x84, 0xb5, 0x89, 0xad, 0xdb, 0x2f, 0x5e, 0x37, 0x95, 0x97, 0xaf, 0x9b, 0xca,
+	0x5f, 0xaf, 0x9b, 0xca, 0xb3, 0x37, 0xcd, 0xb1, 0x97, 0x6f, 0x9a, 0x63, 0x7f, 0xbc, 0x69, 0x8e,
+	0x7d, 0x6a, 0x26, 0x46, 0x61, 0x71, 0xf0, 0xea, 0x7e, 0xbf, 0x1b, 0xff, 0x36, 0x0f, 0x8f, 0xbb,
+	0x63, 0x34, 0x17, 0x77, 0xab, 0xd1, 0x57, 0xff, 0xf5, 0x7f, 0x06, 0x00, 0x9a, 0xd1, 0x2c, 0x1a,
+	0x76, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AnnotateProposal defines a method to annotate a proposal with additional information.
+	// It is only available to the Steering DAO.
+	AnnotateProposal(ctx context.Context, in *MsgAnnotateProposal, opts ...grpc.CallOption) (*MsgAnnotateProposalResponse, error)
+	// EndorseProposal defines a method to endorse a proposal, and for law proposals this also
+	// results in the lowering of the passing threshold to the passing threshold of regular proposals.
+	// It is only available to the Steering DAO.
+	EndorseProposal(ctx context.Context, in *MsgEndorseProposal, opts ...grpc.CallOption) (*MsgEndorseProposalResponse, error)
+	// ExtendVotingPeriod defines a method to extend the voting period of a proposal.
+	// It is available to both the Steering DAO and the Oversight DAO.
+	ExtendVotingPeriod(ctx context.Context, in *MsgExtendVotingPeriod, opts ...grpc.CallOption) (*MsgExtendVotingPeriodResponse, error)
+	// VetoProposal defines a method to veto a proposal.
+	// It is only available to the Oversight DAO.
+	// The veto is executed after the veto delay defined in the module
+	// parameters, and can be withdrawn until then.
+	VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error)
+	// WithdrawVeto defines a method to withdraw a pending veto.
+	// It is only available to the Oversight DAO.
+	WithdrawVeto(ctx context.Context, in *MsgWithdrawVeto, opts ...grpc.CallOption) (*MsgWithdrawVetoResponse, error)
+	// SubmitSteeringProposal defines a method to submit a governance proposal
+	// without deposit, the proposal enters the voting period immediately.
+	// It is only available to the Steering DAO, within the per-epoch quota
+	// defined in the module parameters.
+	SubmitSteeringProposal(ctx context.Context, in *MsgSubmitSteeringProposal, opts ...grpc.CallOption) (*MsgSubmitSteeringProposalResponse, error)
+	// RecuseFromProposal defines a method for a Core DAO to recuse itself from
+	// a proposal, after which it cannot endorse, extend the voting period of,
+	// or veto the proposal.
+	// It is available to both the Steering DAO and the Oversight DAO.
+	RecuseFromProposal(ctx context.Context, in *MsgRecuseFromProposal, opts ...grpc.CallOption) (*MsgRecuseFromProposalResponse, error)
+	// DaoSpend defines a method for a Core DAO to spend from its budget.
+	// It is available to both the Steering DAO and the Oversight DAO.
+	DaoSpend(ctx context.Context, in *MsgDaoSpend, opts ...grpc.CallOption) (*MsgDaoSpendResponse, error)
+	// SubmitDaoAction defines a method for a member of a Core DAO to submit an
+	// action on behalf of the Core DAO. The action is executed once approved by
+	// the threshold of members.
+	SubmitDaoAction(ctx context.Context, in *MsgSubmitDaoAction, opts ...grpc.CallOption) (*MsgSubmitDaoActionResponse, error)
+	// ApproveAction defines a method for a member of a Core DAO to approve a
+	// pending action of the Core DAO.
+	ApproveAction(ctx context.Context, in *MsgApproveAction, opts ...grpc.CallOption) (*MsgApproveActionResponse, error)
+	// UpdateDaoMembers defines a governance operation for updating the members
+	// of a Core DAO. The authority is defined in the keeper.
+	UpdateDaoMembers(ctx context.Context, in *MsgUpdateDaoMembers, opts ...grpc.CallOption) (*MsgUpdateDaoMembersResponse, error)
+	// UpdateDaoBudget defines a governance operation for updating the budget
+	// of a Core DAO. The authority is defined in the keeper.
+	UpdateDaoBudget(ctx context.Context, in *MsgUpdateDaoBudget, opts ...grpc.CallOption) (*MsgUpdateDaoBudgetResponse, error)
+	// ReconfirmDao defines a governance operation for reconfirming a Core DAO,
+	// which lifts its suspension for inactivity. The authority is defined in
+	// the keeper.
+	ReconfirmDao(ctx context.Context, in *MsgReconfirmDao, opts ...grpc.CallOption) (*MsgReconfirmDaoResponse, error)
+	// UpdateParams defines a governance operation for updating the x/coredaos
+	// module parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AnnotateProposal(ctx context.Context, in *MsgAnnotateProposal, opts ...grpc.CallOption) (*MsgAnnotateProposalResponse, error) {
+	out := new(MsgAnnotateProposalResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/AnnotateProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EndorseProposal(ctx context.Context, in *MsgEndorseProposal, opts ...grpc.CallOption) (*MsgEndorseProposalResponse, error) {
+	out := new(MsgEndorseProposalResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/EndorseProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExtendVotingPeriod(ctx context.Context, in *MsgExtendVotingPeriod, opts ...grpc.CallOption) (*MsgExtendVotingPeriodResponse, error) {
+	out := new(MsgExtendVotingPeriodResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/ExtendVotingPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error) {
+	out := new(MsgVetoProposalResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/VetoProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawVeto(ctx context.Context, in *MsgWithdrawVeto, opts ...grpc.CallOption) (*MsgWithdrawVetoResponse, error) {
+	out := new(MsgWithdrawVetoResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/WithdrawVeto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitSteeringProposal(ctx context.Context, in *MsgSubmitSteeringProposal, opts ...grpc.CallOption) (*MsgSubmitSteeringProposalResponse, error) {
+	out := new(MsgSubmitSteeringProposalResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/SubmitSteeringProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecuseFromProposal(ctx context.Context, in *MsgRecuseFromProposal, opts ...grpc.CallOption) (*MsgRecuseFromProposalResponse, error) {
+	out := new(MsgRecuseFromProposalResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/RecuseFromProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DaoSpend(ctx context.Context, in *MsgDaoSpend, opts ...grpc.CallOption) (*MsgDaoSpendResponse, error) {
+	out := new(MsgDaoSpendResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/DaoSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitDaoAction(ctx context.Context, in *MsgSubmitDaoAction, opts ...grpc.CallOption) (*MsgSubmitDaoActionResponse, error) {
+	out := new(MsgSubmitDaoActionResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/SubmitDaoAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveAction(ctx context.Context, in *MsgApproveAction, opts ...grpc.CallOption) (*MsgApproveActionResponse, error) {
+	out := new(MsgApproveActionResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/ApproveAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDaoMembers(ctx context.Context, in *MsgUpdateDaoMembers, opts ...grpc.CallOption) (*MsgUpdateDaoMembersResponse, error) {
+	out := new(MsgUpdateDaoMembersResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/UpdateDaoMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDaoBudget(ctx context.Context, in *MsgUpdateDaoBudget, opts ...grpc.CallOption) (*MsgUpdateDaoBudgetResponse, error) {
+	out := new(MsgUpdateDaoBudgetResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/UpdateDaoBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReconfirmDao(ctx context.Context, in *MsgReconfirmDao, opts ...grpc.CallOption) (*MsgReconfirmDaoResponse, error) {
+	out := new(MsgReconfirmDaoResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/ReconfirmDao", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/atomone.coredaos.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AnnotateProposal defines a method to annotate a proposal with additional information.
+	// It is only available to the Steering DAO.
+	AnnotateProposal(context.Context, *MsgAnnotateProposal) (*MsgAnnotateProposalResponse, error)
+	// EndorseProposal defines a method to endorse a proposal, and for law proposals this also
+	// results in the lowering of the passing threshold to the passing threshold of regular proposals.
+	// It is only available to the Steering DAO.
+	EndorseProposal(context.Context, *MsgEndorseProposal) (*MsgEndorseProposalResponse, error)
+	// ExtendVotingPeriod defines a method to extend the voting period of a proposal.
+	// It is available to both the Steering DAO and the Oversight DAO.
+	ExtendVotingPeriod(context.Context, *MsgExtendVotingPeriod) (*MsgExtendVotingPeriodResponse, error)
+	// VetoProposal defines a method to veto a proposal.
+	// It is only available to the Oversight DAO.
+	// The veto is executed after the veto delay defined in the module
+	// parameters, and can be withdrawn until then.
+	VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error)
+	// WithdrawVeto defines a method to withdraw a pending veto.
+	// It is only available to the Oversight DAO.
+	WithdrawVeto(context.Context, *MsgWithdrawVeto) (*MsgWithdrawVetoResponse, error)
+	// SubmitSteeringProposal defines a method to submit a governance proposal
+	// without deposit, the proposal enters the voting period immediately.
+	// It is only available to the Steering DAO, within the per-epoch quota
+	// defined in the module parameters.
+	SubmitSteeringProposal(context.Context, *MsgSubmitSteeringProposal) (*MsgSubmitSteeringProposalResponse, error)
+	// RecuseFromProposal defines a method for a Core DAO to recuse itself from
+	// a proposal, after which it cannot endorse, extend the voting period of,
+	// or veto the proposal.
+	// It is available to both the Steering DAO and the Oversight DAO.
+	RecuseFromProposal(context.Context, *MsgRecuseFromProposal) (*MsgRecuseFromProposalResponse, error)
+	// DaoSpend defines a method for a Core DAO to spend from its budget.
+	// It is available to both the Steering DAO and the Oversight DAO.
+	DaoSpend(context.Context, *MsgDaoSpend) (*MsgDaoSpendResponse, error)
+	// SubmitDaoAction defines a method for a member of a Core DAO to submit an
+	// action on behalf of the Core DAO. The action is executed once approved by
+	// the threshold of members.
+	SubmitDaoAction(context.Context, *MsgSubmitDaoAction) (*MsgSubmitDaoActionResponse, error)
+	// ApproveAction defines a method for a member of a Core DAO to approve a
+	// pending action of the Core DAO.
+	ApproveAction(context.Context, *MsgApproveAction) (*MsgApproveActionResponse, error)
+	// UpdateDaoMembers defines a governance operation for updating the members
+	// of a Core DAO. The authority is defined in the keeper.
+	UpdateDaoMembers(context.Context, *MsgUpdateDaoMembers) (*MsgUpdateDaoMembersResponse, error)
+	// UpdateDaoBudget defines a governance operation for updating the budget
+	// of a Core DAO. The authority is defined in the keeper.
+	UpdateDaoBudget(context.Context, *MsgUpdateDaoBudget) (*MsgUpdateDaoBudgetResponse, error)
+	// ReconfirmDao defines a governance operation for reconfirming a Core DAO,
+	// which lifts its suspension for inactivity. The authority is defined in
+	// the keeper.
+	ReconfirmDao(context.Context, *MsgReconfirmDao) (*MsgReconfirmDaoResponse, error)
+	// UpdateParams defines a governance operation for updating the x/coredaos
+	// module parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AnnotateProposal(ctx context.Context, req *MsgAnnotateProposal) (*MsgAnnotateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnotateProposal not implemented")
+}
+func (*UnimplementedMsgServer) EndorseProposal(ctx context.Context, req *MsgEndorseProposal) (*MsgEndorseProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndorseProposal not implemented")
+}
+func (*UnimplementedMsgServer) ExtendVotingPeriod(ctx context.Context, req *MsgExtendVotingPeriod) (*MsgExtendVotingPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendVotingPeriod not implemented")
+}
+func (*UnimplementedMsgServer) VetoProposal(ctx context.Context, req *MsgVetoProposal) (*MsgVetoProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoProposal not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawVeto(ctx context.Context, req *MsgWithdrawVeto) (*MsgWithdrawVetoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawVeto not implemented")
+}
+func (*UnimplementedMsgServer) SubmitSteeringProposal(ctx context.Context, req *MsgSubmitSteeringProposal) (*MsgSubmitSteeringProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSteeringProposal not implemented")
+}
+func (*UnimplementedMsgServer) RecuseFromProposal(ctx context.Context, req *MsgRecuseFromProposal) (*MsgRecuseFromProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecuseFromProposal not implemented")
+}
+func (*UnimplementedMsgServer) DaoSpend(ctx context.Context, req *MsgDaoSpend) (*MsgDaoSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DaoSpend not implemented")
+}
+func (*UnimplementedMsgServer) SubmitDaoAction(ctx context.Context, req *MsgSubmitDaoAction) (*MsgSubmitDaoActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDaoAction not implemented")
+}
+func (*UnimplementedMsgServer) ApproveAction(ctx context.Context, req *MsgApproveAction) (*MsgApproveActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAction not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDaoMembers(ctx context.Context, req *MsgUpdateDaoMembers) (*MsgUpdateDaoMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDaoMembers not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDaoBudget(ctx context.Context, req *MsgUpdateDaoBudget) (*MsgUpdateDaoBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDaoBudget not implemented")
+}
+func (*UnimplementedMsgServer) ReconfirmDao(ctx context.Context, req *MsgReconfirmDao) (*MsgReconfirmDaoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconfirmDao not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AnnotateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAnnotateProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AnnotateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Msg/AnnotateProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AnnotateProposal(ctx, req.(*MsgAnnotateProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EndorseProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEndorseProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EndorseProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Msg/EndorseProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EndorseProposal(ctx, req.(*MsgEndorseProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExtendVotingPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendVotingPeriod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendVotingPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Msg/ExtendVotingPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendVotingPeriod(ctx, req.(*MsgExtendVotingPeriod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VetoProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Msg/VetoProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoProposal(ctx, req.(*MsgVetoProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawVeto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawVeto)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawVeto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Msg/WithdrawVeto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawVeto(ctx, req.(*MsgWithdrawVeto))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitSteeringProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSteeringProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSteeringProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Msg/SubmitSteeringProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSteeringProposal(ctx, req.(*MsgSubmitSteeringProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecuseFromProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecuseFromProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecuseFromProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Msg/RecuseFromProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecuseFromProposal(ctx, req.(*MsgRecuseFromProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DaoSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDaoSpend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DaoSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Msg/DaoSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DaoSpend(ctx, req.(*MsgDaoSpend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitDaoAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDaoAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDaoAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Msg/SubmitDaoAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDaoAction(ctx, req.(*MsgSubmitDaoAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Msg/ApproveAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveAction(ctx, req.(*MsgApproveAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDaoMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDaoMembers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDaoMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Msg/UpdateDaoMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDaoMembers(ctx, req.(*MsgUpdateDaoMembers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDaoBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDaoBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDaoBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Msg/UpdateDaoBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDaoBudget(ctx, req.(*MsgUpdateDaoBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReconfirmDao_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReconfirmDao)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReconfirmDao(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Msg/ReconfirmDao",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReconfirmDao(ctx, req.(*MsgReconfirmDao))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.coredaos.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomone.coredaos.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AnnotateProposal",
+			Handler:    _Msg_AnnotateProposal_Handler,
+		},
+		{
+			MethodName: "EndorseProposal",
+			Handler:    _Msg_EndorseProposal_Handler,
+		},
+		{
+			MethodName: "ExtendVotingPeriod",
+			Handler:    _Msg_ExtendVotingPeriod_Handler,
+		},
+		{
+			MethodName: "VetoProposal",
+			Handler:    _Msg_VetoProposal_Handler,
+		},
+		{
+			MethodName: "WithdrawVeto",
+			Handler:    _Msg_WithdrawVeto_Handler,
+		},
+		{
+			MethodName: "SubmitSteeringProposal",
+			Handler:    _Msg_SubmitSteeringProposal_Handler,
+		},
+		{
+			MethodName: "RecuseFromProposal",
+			Handler:    _Msg_RecuseFromProposal_Handler,
+		},
+		{
+			MethodName: "DaoSpend",
+			Handler:    _Msg_DaoSpend_Handler,
+		},
+		{
+			MethodName: "SubmitDaoAction",
+			Handler:    _Msg_SubmitDaoAction_Handler,
+		},
+		{
+			MethodName: "ApproveAction",
+			Handler:    _Msg_ApproveAction_Handler,
+		},
+		{
+			MethodName: "UpdateDaoMembers",
+			Handler:    _Msg_UpdateDaoMembers_Handler,
+		},
+		{
+			MethodName: "UpdateDaoBudget",
+			Handler:    _Msg_UpdateDaoBudget_Handler,
+		},
+		{
+			MethodName: "ReconfirmDao",
+			Handler:    _Msg_ReconfirmDao_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "atomone/coredaos/v1/tx.proto",
+}
+
+func (m *MsgAnnotateProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnnotateProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnnotateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Overwrite {
+		i--
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Annotation) > 0 {
+		i -= len(m.Annotation)
+		copy(dAtA[i:], m.Annotation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Annotation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Annotator) > 0 {
+		i -= len(m.Annotator)
+		copy(dAtA[i:], m.Annotator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Annotator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnnotateProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnnotateProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnnotateProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEndorseProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEndorseProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEndorseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Endorser) > 0 {
+		i -= len(m.Endorser)
+		copy(dAtA[i:], m.Endorser)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Endorser)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEndorseProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEndorseProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEndorseProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendVotingPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendVotingPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendVotingPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Extender) > 0 {
+		i -= len(m.Extender)
+		copy(dAtA[i:], m.Extender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Extender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendVotingPeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendVotingPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendVotingPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BurnDeposit {
+		i--
+		if m.BurnDeposit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Vetoer) > 0 {
+		i -= len(m.Vetoer)
+		copy(dAtA[i:], m.Vetoer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vetoer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawVeto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawVeto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawVeto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Vetoer) > 0 {
+		i -= len(m.Vetoer)
+		copy(dAtA[i:], m.Vetoer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vetoer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawVetoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawVetoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawVetoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSteeringProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSteeringProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSteeringProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSteeringProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSteeringProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSteeringProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecuseFromProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecuseFromProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecuseFromProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recuser) > 0 {
+		i -= len(m.Recuser)
+		copy(dAtA[i:], m.Recuser)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recuser)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecuseFromProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecuseFromProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecuseFromProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDaoSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDaoSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDaoSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDaoSpendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDaoSpendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDaoSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDaoAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDaoAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDaoAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.BurnDeposit {
+		i--
+		if m.BurnDeposit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Overwrite {
+		i--
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Annotation) > 0 {
+		i -= len(m.Annotation)
+		copy(dAtA[i:], m.Annotation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Annotation)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Action != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Dao != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Dao))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDaoActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDaoActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDaoActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDaoMembers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDaoMembers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDaoMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DaoMembers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDaoMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDaoMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDaoMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDaoBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDaoBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDaoBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DaoBudget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDaoBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDaoBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDaoBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReconfirmDao) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReconfirmDao) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReconfirmDao) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Dao != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Dao))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReconfirmDaoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReconfirmDaoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReconfirmDaoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAnnotateProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Annotator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Annotation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Overwrite {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAnnotateProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEndorseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endorser)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgEndorseProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExtendVotingPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Extender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgExtendVotingPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Vetoer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	if m.BurnDeposit {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgVetoProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawVeto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Vetoer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawVetoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitSteeringProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitSteeringProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgRecuseFromProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recuser)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecuseFromProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDaoSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDaoSpendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitDaoAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Dao != 0 {
+		n += 1 + sovTx(uint64(m.Dao))
+	}
+	if m.Action != 0 {
+		n += 1 + sovTx(uint64(m.Action))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Annotation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Overwrite {
+		n += 2
+	}
+	if m.BurnDeposit {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitDaoActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgApproveAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgApproveActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateDaoMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.DaoMembers.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDaoMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDaoBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.DaoBudget.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDaoBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReconfirmDao) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Dao != 0 {
+		n += 1 + sovTx(uint64(m.Dao))
+	}
+	return n
+}
+
+func (m *MsgReconfirmDaoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnnotateProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnnotateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnnotateProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnnotateProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnnotateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEndorseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEndorseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEndorseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endorser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endorser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEndorseProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEndorseProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEndorseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendVotingPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendVotingPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendVotingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendVotingPeriodResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendVotingPeriodResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendVotingPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vetoer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDeposit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnDeposit = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawVeto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawVeto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawVeto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vetoer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawVetoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawVetoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawVetoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSteeringProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSteeringProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSteeringProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSteeringProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSteeringProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSteeringProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecuseFromProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecuseFromProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecuseFromProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recuser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recuser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecuseFromProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecuseFromProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecuseFromProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDaoSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDaoSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDaoSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDaoSpendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDaoSpendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDaoSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDaoAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDaoAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDaoAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
+			}
+			m.Dao = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dao |= CoreDao(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= DaoActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDeposit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnDeposit = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitDaoActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1329,17 +5713,17 @@ func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAnnotateProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitDaoActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAnnotateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitDaoActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1349,29 +5733,16 @@ func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Annotator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
 			}
-			m.ProposalId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1381,14 +5752,65 @@ func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.Executed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1416,13 +5838,13 @@ func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Annotation = string(dAtA[iNdEx:postIndex])
+			m.Member = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1432,12 +5854,11 @@ func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Overwrite = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1459,7 +5880,7 @@ func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAnnotateProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1482,12 +5903,32 @@ func (m *MsgAnnotateProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAnnotateProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAnnotateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1509,7 +5950,7 @@ func (m *MsgAnnotateProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEndorseProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDaoMembers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1532,15 +5973,15 @@ func (m *MsgEndorseProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEndorseProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDaoMembers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEndorseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDaoMembers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endorser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1568,13 +6009,13 @@ func (m *MsgEndorseProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endorser = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoMembers", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1584,11 +6025,25 @@ func (m *MsgEndorseProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DaoMembers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1610,7 +6065,7 @@ func (m *MsgEndorseProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEndorseProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDaoMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1633,10 +6088,10 @@ func (m *MsgEndorseProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEndorseProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDaoMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEndorseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDaoMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1660,7 +6115,7 @@ func (m *MsgEndorseProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendVotingPeriod) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDaoBudget) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1683,15 +6138,15 @@ func (m *MsgExtendVotingPeriod) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendVotingPeriod: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDaoBudget: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendVotingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDaoBudget: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1719,13 +6174,13 @@ func (m *MsgExtendVotingPeriod) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoBudget", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1735,11 +6190,25 @@ func (m *MsgExtendVotingPeriod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DaoBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1761,7 +6230,7 @@ func (m *MsgExtendVotingPeriod) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendVotingPeriodResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDaoBudgetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1784,10 +6253,10 @@ func (m *MsgExtendVotingPeriodResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendVotingPeriodResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDaoBudgetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendVotingPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDaoBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1811,7 +6280,7 @@ func (m *MsgExtendVotingPeriodResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgReconfirmDao) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1834,15 +6303,15 @@ func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReconfirmDao: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReconfirmDao: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vetoer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1870,32 +6339,13 @@ func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vetoer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
 			}
-			var v int
+			m.Dao = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1905,12 +6355,11 @@ func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Dao |= CoreDao(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BurnDeposit = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1932,7 +6381,7 @@ func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReconfirmDaoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1955,10 +6404,10 @@ func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReconfirmDaoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReconfirmDaoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,281 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// GetQueryCmd returns the cli query commands of the AtomOne gov module, which
+// are not already provided by the x/gov module of the SDK.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.AtomOneModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.AtomOneModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetQueryLawsCmd(),
+		GetQueryLawCmd(),
+		GetQueryConstitutionHistoryCmd(),
+		GetQueryConstitutionVersionCmd(),
+		GetQueryConstitutionDiffCmd(),
+		GetQuerySimulateConstitutionAmendmentCmd(),
+		GetQueryCommitRevealVotingCmd(),
+		GetQueryVoteCommitmentsCmd(),
+	)
+	return cmd
+}
+
+func GetQueryLawsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "laws",
+		Short: "shows the laws in force",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+			res, err := queryClient.Laws(cmd.Context(), &v1.QueryLawsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "laws")
+	return cmd
+}
+
+func GetQueryLawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "law [law-id]",
+		Short: "shows a law in force",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			lawID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("law-id %s not a valid uint, please input a valid law-id", args[0])
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+			res, err := queryClient.Law(cmd.Context(), &v1.QueryLawRequest{
+				LawId: lawID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryConstitutionHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "constitution-history",
+		Short: "shows all the versions of the constitution",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+			res, err := queryClient.ConstitutionHistory(cmd.Context(), &v1.QueryConstitutionHistoryRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "constitution versions")
+	return cmd
+}
+
+func GetQueryConstitutionVersionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "constitution-version [version]",
+		Short: "shows a version of the constitution",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			version, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("version %s not a valid uint, please input a valid version", args[0])
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+			res, err := queryClient.ConstitutionVersion(cmd.Context(), &v1.QueryConstitutionVersionRequest{
+				Version: version,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryConstitutionDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "constitution-diff [from-version] [to-version]",
+		Short: "shows the unified diff between two versions of the constitution",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			fromVersion, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("from-version %s not a valid uint, please input a valid from-version", args[0])
+			}
+			toVersion, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("to-version %s not a valid uint, please input a valid to-version", args[1])
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+			res, err := queryClient.ConstitutionDiff(cmd.Context(), &v1.QueryConstitutionDiffRequest{
+				FromVersion: fromVersion,
+				ToVersion:   toVersion,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQuerySimulateConstitutionAmendmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-constitution-amendment [amendment-file]",
+		Short: "shows the constitution resulting from an amendment in unified diff format",
+		Long: `Shows the constitution resulting from the application of the amendment
+in the given file, in unified diff format, to the constitution in force. Fails
+with the hunk that does not apply if the amendment cannot be applied.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			amendment, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateConstitutionAmendment(cmd.Context(), &v1.QuerySimulateConstitutionAmendmentRequest{
+				Amendment: string(amendment),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryCommitRevealVotingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-reveal-voting [proposal-id]",
+		Short: "shows the commit-reveal voting of a proposal",
+		Long: `Shows the commit-reveal voting of a proposal which opted in, and the time
+its reveal window starts once the proposal has entered its voting period.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+			res, err := queryClient.CommitRevealVoting(cmd.Context(), &v1.QueryCommitRevealVotingRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryVoteCommitmentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-commitments [proposal-id]",
+		Short: "shows the vote commitments on a proposal not revealed yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+			res, err := queryClient.VoteCommitments(cmd.Context(), &v1.QueryVoteCommitmentsRequest{
+				ProposalId: proposalID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vote commitments")
+	return cmd
+}
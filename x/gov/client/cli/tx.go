@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+const FlagMetadata = "metadata"
+
+// GetTxCmd returns the transaction commands of the AtomOne gov module, which
+// are not already provided by the x/gov module of the SDK.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.AtomOneModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.AtomOneModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		GetTxSubmitCommitRevealProposalCmd(),
+		GetTxCommitVoteCmd(),
+		GetTxRevealVoteCmd(),
+	)
+	return cmd
+}
+
+// commitRevealProposal defines the proposal submitted with
+// GetTxSubmitCommitRevealProposalCmd.
+type commitRevealProposal struct {
+	// Messages defines the messages of the proposal, in JSON format.
+	Messages []json.RawMessage `json:"messages,omitempty"`
+	Metadata string            `json:"metadata"`
+	Deposit  string            `json:"deposit"`
+	Title    string            `json:"title"`
+	Summary  string            `json:"summary"`
+}
+
+// parseCommitRevealProposal reads and parses the proposal from path.
+func parseCommitRevealProposal(clientCtx client.Context, path string) (commitRevealProposal, []sdk.Msg, sdk.Coins, error) {
+	var proposal commitRevealProposal
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return proposal, nil, nil, err
+	}
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, nil, nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(proposal.Messages))
+	for i, anyJSON := range proposal.Messages {
+		var msg sdk.Msg
+		if err := clientCtx.Codec.UnmarshalInterfaceJSON(anyJSON, &msg); err != nil {
+			return proposal, nil, nil, err
+		}
+		msgs[i] = msg
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+	if err != nil {
+		return proposal, nil, nil, err
+	}
+	return proposal, msgs, deposit, nil
+}
+
+// GetTxSubmitCommitRevealProposalCmd returns the command to submit a proposal
+// using commit-reveal voting
+func GetTxSubmitCommitRevealProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-commit-reveal-proposal [path/to/proposal.json]",
+		Short: "Broadcast a message to submit a proposal using commit-reveal voting.",
+		Long: `Submit a proposal along with some messages, metadata, deposit, title and summary.
+The votes on the proposal are committed to in secret during its voting period,
+and revealed during the reveal window closing it.
+
+Example:
+$ atomoned tx atomone-gov submit-commit-reveal-proposal path/to/proposal.json
+
+Where proposal.json contains:
+
+{
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgSend",
+      "from_address": "atone1...", // The gov module account address
+      "to_address": "atone1...",
+      "amount":[{"denom": "uatone","amount": "10"}]
+    }
+  ],
+  "metadata": "ipfs://CID",
+  "deposit": "10uatone",
+  "title": "My proposal",
+  "summary": "A short summary of my proposal"
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, msgs, deposit, err := parseCommitRevealProposal(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			msg, err := v1.NewMsgSubmitProposal(
+				msgs,
+				deposit,
+				clientCtx.GetFromAddress().String(),
+				proposal.Metadata,
+				proposal.Title,
+				proposal.Summary,
+			)
+			if err != nil {
+				return err
+			}
+			msg.CommitReveal = true
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseVoteReveal parses the proposal id, the weighted vote options and the
+// hex encoded salt of a vote commitment or reveal.
+func parseVoteReveal(args []string) (uint64, v1.WeightedVoteOptions, []byte, error) {
+	proposalID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+	}
+	options, err := v1.WeightedVoteOptionsFromString(args[1])
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	salt, err := hex.DecodeString(args[2])
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("salt %s not a valid hex string: %w", args[2], err)
+	}
+	return proposalID, options, salt, nil
+}
+
+// GetTxCommitVoteCmd returns the command to commit to a secret vote on a
+// proposal using commit-reveal voting
+func GetTxCommitVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-vote [proposal-id] [options] [salt]",
+		Short: "Broadcast a message to commit to a secret vote on a proposal using commit-reveal voting.",
+		Long: fmt.Sprintf(`Commit to a secret vote on a proposal using commit-reveal voting, before
+its reveal window starts. Only the hash of the vote and of the salt is broadcast.
+The salt is a hex encoded secret of at least %d bytes, which must be kept along
+with the vote options to reveal the vote during the reveal window. A new
+commitment replaces the previous one.
+
+Example:
+$ atomoned tx atomone-gov commit-vote 1 VOTE_OPTION_YES=0.6,VOTE_OPTION_NO=0.4 $(openssl rand -hex 32) --from mykey`,
+			v1.MinVoteSaltLength),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalID, options, salt, err := parseVoteReveal(args)
+			if err != nil {
+				return err
+			}
+			// check the vote and the salt before committing to them
+			reveal := v1.NewMsgRevealVote(clientCtx.GetFromAddress(), proposalID, options, "", salt)
+			if err := reveal.ValidateBasic(); err != nil {
+				return err
+			}
+			commitment, err := v1.VoteCommitmentHash(proposalID, clientCtx.GetFromAddress(), options, salt)
+			if err != nil {
+				return err
+			}
+			msg := v1.NewMsgCommitVote(clientCtx.GetFromAddress(), proposalID, commitment)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTxRevealVoteCmd returns the command to reveal a vote committed to on a
+// proposal using commit-reveal voting
+func GetTxRevealVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-vote [proposal-id] [options] [salt]",
+		Short: "Broadcast a message to reveal a vote committed to on a proposal using commit-reveal voting.",
+		Long: `Reveal a vote committed to on a proposal using commit-reveal voting, during
+its reveal window. The vote options and the hex encoded salt must be the ones
+committed to. Once revealed, the vote is tallied like any other vote.
+
+Example:
+$ atomoned tx atomone-gov reveal-vote 1 VOTE_OPTION_YES=0.6,VOTE_OPTION_NO=0.4 <salt> --from mykey`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalID, options, salt, err := parseVoteReveal(args)
+			if err != nil {
+				return err
+			}
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+			msg := v1.NewMsgRevealVote(clientCtx.GetFromAddress(), proposalID, options, metadata, salt)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagMetadata, "", "Specify metadata of the vote")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
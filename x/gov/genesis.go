@@ -0,0 +1,115 @@
+package gov
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/keeper"
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// InitGenesis initializes the state the AtomOne gov module holds on top of the
+// x/gov module of the SDK, i.e. the laws, the constitution history, the
+// commit-reveal votings and the vote commitments, and the
+// ConstitutionAmendmentPatch and CommitReveal params. The rest of the genesis
+// state is initialized by the x/gov module of the SDK.
+// If the constitution history is empty, it starts with the constitution
+// initialized by the x/gov module of the SDK.
+func InitGenesis(ctx sdk.Context, k *keeper.Keeper, data *v1.GenesisState) {
+	// law ids start at 1, a zero law id in MsgProposeLaw proposes a new law.
+	nextLawID := uint64(1)
+	for _, law := range data.Laws {
+		if err := k.Laws.Set(ctx, law.Id, law); err != nil {
+			panic(fmt.Sprintf("%s module law %d has not been set: %s", types.ModuleName, law.Id, err))
+		}
+		if law.Id >= nextLawID {
+			nextLawID = law.Id + 1
+		}
+	}
+	if err := k.LawSequence.Set(ctx, nextLawID); err != nil {
+		panic(fmt.Sprintf("%s module law sequence has not been set", types.ModuleName))
+	}
+
+	for _, v := range data.ConstitutionHistory {
+		if err := k.ConstitutionVersions.Set(ctx, v.Version, v); err != nil {
+			panic(fmt.Sprintf("%s module constitution version %d has not been set: %s", types.ModuleName, v.Version, err))
+		}
+	}
+	if err := k.InitConstitutionHistory(ctx); err != nil {
+		panic(fmt.Sprintf("%s module constitution history has not been initialized: %s", types.ModuleName, err))
+	}
+
+	for _, v := range data.CommitRevealVotings {
+		if err := k.CommitRevealVotings.Set(ctx, v.ProposalId, v); err != nil {
+			panic(fmt.Sprintf("%s module commit-reveal voting of proposal %d has not been set: %s", types.ModuleName, v.ProposalId, err))
+		}
+	}
+	for _, c := range data.VoteCommitments {
+		voter := sdk.MustAccAddressFromBech32(c.Voter)
+		if err := k.VoteCommitments.Set(ctx, collections.Join(c.ProposalId, voter), c.Commitment); err != nil {
+			panic(fmt.Sprintf("%s module vote commitment of %s on proposal %d has not been set: %s", types.ModuleName, c.Voter, c.ProposalId, err))
+		}
+	}
+
+	if data.Params != nil {
+		if err := k.ConstitutionAmendmentPatchParams.Set(ctx, data.Params.ConstitutionAmendmentPatch); err != nil {
+			panic(fmt.Sprintf("%s module constitution amendment patch params have not been set: %s", types.ModuleName, err))
+		}
+		if err := k.CommitRevealParams.Set(ctx, data.Params.CommitReveal); err != nil {
+			panic(fmt.Sprintf("%s module commit-reveal params have not been set: %s", types.ModuleName, err))
+		}
+	}
+}
+
+// ExportGenesis returns the laws, the constitution history, the commit-reveal
+// votings and the vote commitments, and the ConstitutionAmendmentPatch and
+// CommitReveal params in a default gov genesis state. The rest of the genesis
+// state is exported by the x/gov module of the SDK.
+func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
+	genState := v1.DefaultGenesisState()
+	err := k.Laws.Walk(ctx, nil, func(_ uint64, law v1.Law) (bool, error) {
+		genState.Laws = append(genState.Laws, law)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = k.ConstitutionVersions.Walk(ctx, nil, func(_ uint64, v v1.ConstitutionVersion) (bool, error) {
+		genState.ConstitutionHistory = append(genState.ConstitutionHistory, v)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = k.CommitRevealVotings.Walk(ctx, nil, func(_ uint64, v v1.CommitRevealVoting) (bool, error) {
+		genState.CommitRevealVotings = append(genState.CommitRevealVotings, v)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = k.VoteCommitments.Walk(ctx, nil, func(key collections.Pair[uint64, sdk.AccAddress], commitment []byte) (bool, error) {
+		genState.VoteCommitments = append(genState.VoteCommitments, v1.VoteCommitment{
+			ProposalId: key.K1(),
+			Voter:      key.K2().String(),
+			Commitment: commitment,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	genState.Params.ConstitutionAmendmentPatch, err = k.GetConstitutionAmendmentPatchParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genState.Params.CommitReveal, err = k.GetCommitRevealParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return genState
+}
@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkgovtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	sdkv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// GetCommitRevealParams returns the params defining the commit-reveal voting
+// of the proposals. Until they are set, commit-reveal voting is disabled.
+func (keeper *Keeper) GetCommitRevealParams(ctx context.Context) (v1.CommitRevealParams, error) {
+	params, err := keeper.CommitRevealParams.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.CommitRevealParams{}, nil
+	}
+	return params, err
+}
+
+// GetCommitRevealVoting returns the commit-reveal voting of proposalID, and
+// false if the proposal did not opt in.
+func (keeper *Keeper) GetCommitRevealVoting(ctx context.Context, proposalID uint64) (v1.CommitRevealVoting, bool, error) {
+	voting, err := keeper.CommitRevealVotings.Get(ctx, proposalID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.CommitRevealVoting{}, false, nil
+	}
+	return voting, err == nil, err
+}
+
+// revealStartTime returns the time the reveal window of the proposal starts,
+// i.e. the reveal period of its commit-reveal voting before the end of its
+// voting period, or nil if the proposal has not entered its voting period.
+// The reveal window follows the extensions of the voting period, which are
+// rejected once it has started, see CheckVotingPeriodExtension.
+func revealStartTime(proposal sdkv1.Proposal, voting v1.CommitRevealVoting) *time.Time {
+	if proposal.VotingEndTime == nil {
+		return nil
+	}
+	revealStart := proposal.VotingEndTime.Add(-voting.RevealPeriod)
+	return &revealStart
+}
+
+// enableCommitRevealVoting opts proposalID in commit-reveal voting, with the
+// reveal period defined by the params. While the quorum checks are enabled,
+// the opt-in is only accepted if the params allow to exempt the proposal from
+// them, see exemptFromQuorumCheck.
+func (keeper *Keeper) enableCommitRevealVoting(ctx context.Context, proposalID uint64) error {
+	params, err := keeper.GetCommitRevealParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.RevealPeriod == 0 {
+		return types.ErrCommitRevealDisabled.Wrap("the reveal period is zero")
+	}
+	govParams, err := keeper.Keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if govParams.QuorumCheckCount > 0 && !params.QuorumCheckExemption {
+		return types.ErrCommitRevealDisabled.Wrap("the quorum checks are enabled and the proposals can not be exempted from them")
+	}
+	err = keeper.CommitRevealVotings.Set(ctx, proposalID, v1.CommitRevealVoting{
+		ProposalId:   proposalID,
+		RevealPeriod: params.RevealPeriod,
+	})
+	if err != nil {
+		return err
+	}
+	return keeper.exemptFromQuorumCheck(ctx, proposalID)
+}
+
+// exemptFromQuorumCheck removes proposalID from the x/gov quorum check queue
+// if it uses commit-reveal voting and has entered its voting period. The votes
+// are only revealed at the end of the voting period, so the participation can
+// not be checked at the quorum timeout, and the voting period is not extended
+// when the proposal reaches quorum late: a late extension would also move the
+// reveal window after the votes have been revealed. This trade-off is accepted
+// by the quorum_check_exemption param at the submission of the proposal.
+func (keeper *Keeper) exemptFromQuorumCheck(ctx context.Context, proposalID uint64) error {
+	_, found, err := keeper.GetCommitRevealVoting(ctx, proposalID)
+	if err != nil || !found {
+		return err
+	}
+	return keeper.RemoveFromQuorumCheckQueue(ctx, proposalID)
+}
+
+// getCommitRevealProposal returns proposalID and its commit-reveal voting, or
+// an error if the proposal does not exist or does not use commit-reveal
+// voting.
+func (keeper *Keeper) getCommitRevealProposal(ctx context.Context, proposalID uint64) (sdkv1.Proposal, v1.CommitRevealVoting, error) {
+	proposal, err := keeper.Keeper.Proposals.Get(ctx, proposalID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdkv1.Proposal{}, v1.CommitRevealVoting{}, types.ErrUnknownProposal.Wrapf("proposal %d not found", proposalID)
+	}
+	if err != nil {
+		return sdkv1.Proposal{}, v1.CommitRevealVoting{}, err
+	}
+	voting, found, err := keeper.GetCommitRevealVoting(ctx, proposalID)
+	if err != nil {
+		return sdkv1.Proposal{}, v1.CommitRevealVoting{}, err
+	}
+	if !found {
+		return sdkv1.Proposal{}, v1.CommitRevealVoting{}, types.ErrNotCommitRevealProposal.Wrapf("proposal %d", proposalID)
+	}
+	return proposal, voting, nil
+}
+
+// CommitVote records the commitment of voter to a secret vote on proposalID,
+// replacing any previous commitment. The votes can be committed to during the
+// voting period of the proposal, until its reveal window starts.
+func (keeper *Keeper) CommitVote(ctx context.Context, proposalID uint64, voter sdk.AccAddress, commitment []byte) error {
+	proposal, voting, err := keeper.getCommitRevealProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	revealStart := revealStartTime(proposal, voting)
+	if proposal.Status != sdkv1.StatusVotingPeriod || revealStart == nil {
+		return sdkgovtypes.ErrInactiveProposal.Wrapf("%d", proposalID)
+	}
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if !blockTime.Before(*revealStart) {
+		return types.ErrWrongVotingPhase.Wrapf("the votes on proposal %d can no longer be committed to since %s", proposalID, *revealStart)
+	}
+	if err := keeper.VoteCommitments.Set(ctx, collections.Join(proposalID, voter), commitment); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCommitVote,
+		sdk.NewAttribute(sdkgovtypes.AttributeKeyVoter, voter.String()),
+		sdk.NewAttribute(sdkgovtypes.AttributeKeyProposalID, strconv.FormatUint(proposalID, 10)),
+	))
+	return nil
+}
+
+// verifyVoteReveal checks that the vote options and the salt revealed by voter
+// on proposalID match its commitment, during the reveal window of the
+// proposal, and lets the vote be cast. The commitment is removed by
+// removeVoteCommitment once the vote is cast.
+func (keeper *Keeper) verifyVoteReveal(ctx context.Context, proposalID uint64, voter sdk.AccAddress, options v1.WeightedVoteOptions, salt []byte) error {
+	proposal, voting, err := keeper.getCommitRevealProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	revealStart := revealStartTime(proposal, voting)
+	if proposal.Status != sdkv1.StatusVotingPeriod || revealStart == nil {
+		return sdkgovtypes.ErrInactiveProposal.Wrapf("%d", proposalID)
+	}
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if blockTime.Before(*revealStart) {
+		return types.ErrWrongVotingPhase.Wrapf("the votes on proposal %d can only be revealed since %s", proposalID, *revealStart)
+	}
+	key := collections.Join(proposalID, voter)
+	commitment, err := keeper.VoteCommitments.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrUnknownVoteCommitment.Wrapf("no vote commitment of %s on proposal %d", voter, proposalID)
+	}
+	if err != nil {
+		return err
+	}
+	hash, err := v1.VoteCommitmentHash(proposalID, voter, options, salt)
+	if err != nil {
+		return sdkgovtypes.ErrInvalidVote.Wrap(err.Error())
+	}
+	if !bytes.Equal(hash, commitment) {
+		return types.ErrInvalidVoteReveal.Wrapf("vote of %s on proposal %d", voter, proposalID)
+	}
+	return keeper.PendingVoteReveals.Set(ctx, key)
+}
+
+// removeVoteCommitment removes the commitment of voter on proposalID, once the
+// vote has been revealed and cast.
+func (keeper *Keeper) removeVoteCommitment(ctx context.Context, proposalID uint64, voter sdk.AccAddress) error {
+	return keeper.VoteCommitments.Remove(ctx, collections.Join(proposalID, voter))
+}
+
+// checkCommitRevealVote returns an error if the vote of voter on proposalID
+// has not been revealed while the proposal uses commit-reveal voting, so that
+// the votes are kept secret until the reveal window whatever the message used
+// to vote.
+func (keeper *Keeper) checkCommitRevealVote(ctx context.Context, proposalID uint64, voter sdk.AccAddress) error {
+	key := collections.Join(proposalID, voter)
+	revealed, err := keeper.PendingVoteReveals.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if revealed {
+		return keeper.PendingVoteReveals.Remove(ctx, key)
+	}
+	_, found, err := keeper.GetCommitRevealVoting(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	if found {
+		return types.ErrCommitRevealProposal.Wrapf("the votes on proposal %d must be committed to with MsgCommitVote and revealed with MsgRevealVote", proposalID)
+	}
+	return nil
+}
+
+// clearVoteCommitments removes the commitments on proposalID that have not
+// been revealed at the end of its voting period. The voters who did not
+// reveal their vote did not participate in the vote.
+func (keeper *Keeper) clearVoteCommitments(ctx context.Context, proposalID uint64) error {
+	return keeper.VoteCommitments.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID))
+}
+
+// ClearCommitRevealVoting removes the commit-reveal voting of proposalID along
+// with its vote commitments, when the proposal is rejected before the end of
+// its voting period, e.g. by a Core DAO veto.
+func (keeper *Keeper) ClearCommitRevealVoting(ctx context.Context, proposalID uint64) error {
+	if err := keeper.clearVoteCommitments(ctx, proposalID); err != nil {
+		return err
+	}
+	return keeper.CommitRevealVotings.Remove(ctx, proposalID)
+}
+
+// CheckVotingPeriodExtension returns an error if proposalID uses commit-reveal
+// voting and its reveal window has started. Extending the voting period would
+// move the reveal window, and let the votes be committed to again after some
+// of them have been revealed.
+func (keeper *Keeper) CheckVotingPeriodExtension(ctx context.Context, proposalID uint64) error {
+	voting, found, err := keeper.GetCommitRevealVoting(ctx, proposalID)
+	if err != nil || !found {
+		return err
+	}
+	proposal, err := keeper.Keeper.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	revealStart := revealStartTime(proposal, voting)
+	if revealStart != nil && !sdk.UnwrapSDKContext(ctx).BlockTime().Before(*revealStart) {
+		return types.ErrWrongVotingPhase.Wrapf("the voting period of proposal %d can no longer be extended since its reveal window started at %s", proposalID, *revealStart)
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	sdkgovtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	sdkv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	atomoneapp "github.com/atomone-hub/atomone/app"
+	"github.com/atomone-hub/atomone/app/helpers"
+	"github.com/atomone-hub/atomone/x/gov/keeper"
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// submitCommitRevealProposal submits a proposal through the x/gov keeper,
+// which skips the msgServer deposit gate, opts it in commit-reveal voting and
+// activates its voting period.
+func submitCommitRevealProposal(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, revealPeriod time.Duration) sdkv1.Proposal {
+	t.Helper()
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	msgs := []sdk.Msg{banktypes.NewMsgSend(govAddr, govAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1))))}
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", govAddr)
+	require.NoError(t, err)
+	require.NoError(t, app.GovKeeperWrapper.CommitRevealVotings.Set(ctx, proposal.Id, v1.CommitRevealVoting{
+		ProposalId:   proposal.Id,
+		RevealPeriod: revealPeriod,
+	}))
+	require.NoError(t, app.GovKeeper.ActivateVotingPeriod(ctx, proposal))
+	proposal, err = app.GovKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	return proposal
+}
+
+// fundAccount mints coins to addr.
+func fundAccount(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	t.Helper()
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins))
+}
+
+// stake funds addr with amount of the bond denom and delegates it to the
+// validator of the chain.
+func stake(t *testing.T, app *atomoneapp.AtomOneApp, ctx sdk.Context, addr sdk.AccAddress, amount math.Int) {
+	t.Helper()
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	coin := sdk.NewCoin(bondDenom, amount)
+	fundAccount(t, app, ctx, addr, sdk.NewCoins(coin))
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+	_, err = stakingkeeper.NewMsgServerImpl(app.StakingKeeper).Delegate(ctx, stakingtypes.NewMsgDelegate(addr.String(), validators[0].GetOperator(), coin))
+	require.NoError(t, err)
+}
+
+func TestCommitRevealVoting(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	k := app.GovKeeperWrapper
+	ms := keeper.NewMsgServerImpl(k)
+	qs := keeper.NewQueryServer(k)
+	hooks := k.GovHooks()
+	voter := sdk.AccAddress("voter")
+	absentee := sdk.AccAddress("absentee")
+	stranger := sdk.AccAddress("stranger")
+
+	params, err := k.GetCommitRevealParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, v1.DefaultCommitRevealPeriod, params.RevealPeriod)
+
+	proposal := submitCommitRevealProposal(t, app, ctx, params.RevealPeriod)
+	revealStart := proposal.VotingEndTime.Add(-params.RevealPeriod)
+
+	votingRes, err := qs.CommitRevealVoting(ctx, &v1.QueryCommitRevealVotingRequest{ProposalId: proposal.Id})
+	require.NoError(t, err)
+	require.Equal(t, v1.CommitRevealVoting{ProposalId: proposal.Id, RevealPeriod: params.RevealPeriod}, votingRes.CommitRevealVoting)
+	require.Equal(t, revealStart, *votingRes.RevealStartTime)
+
+	// public votes are rejected
+	_, err = ms.Vote(ctx, v1.NewMsgVote(voter, proposal.Id, v1.OptionYes, ""))
+	require.ErrorIs(t, err, types.ErrCommitRevealProposal)
+
+	// commit to the votes
+	options := v1.NewNonSplitVoteOption(v1.OptionYes)
+	salt := []byte("0123456789abcdef")
+	commitment, err := v1.VoteCommitmentHash(proposal.Id, voter, options, salt)
+	require.NoError(t, err)
+	_, err = ms.CommitVote(ctx, v1.NewMsgCommitVote(voter, proposal.Id, commitment))
+	require.NoError(t, err)
+	absenteeCommitment, err := v1.VoteCommitmentHash(proposal.Id, absentee, options, salt)
+	require.NoError(t, err)
+	_, err = ms.CommitVote(ctx, v1.NewMsgCommitVote(absentee, proposal.Id, absenteeCommitment))
+	require.NoError(t, err)
+
+	commitmentsRes, err := qs.VoteCommitments(ctx, &v1.QueryVoteCommitmentsRequest{ProposalId: proposal.Id})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []v1.VoteCommitment{
+		{ProposalId: proposal.Id, Voter: voter.String(), Commitment: commitment},
+		{ProposalId: proposal.Id, Voter: absentee.String(), Commitment: absenteeCommitment},
+	}, commitmentsRes.Commitments)
+
+	// the votes can not be revealed before the reveal window
+	_, err = ms.RevealVote(ctx, v1.NewMsgRevealVote(voter, proposal.Id, options, "", salt))
+	require.EqualError(t, err, fmt.Sprintf("the votes on proposal %d can only be revealed since %s: wrong commit-reveal voting phase", proposal.Id, revealStart))
+
+	// the votes can no longer be committed to during the reveal window
+	ctx = ctx.WithBlockTime(revealStart)
+	_, err = ms.CommitVote(ctx, v1.NewMsgCommitVote(voter, proposal.Id, commitment))
+	require.EqualError(t, err, fmt.Sprintf("the votes on proposal %d can no longer be committed to since %s: wrong commit-reveal voting phase", proposal.Id, revealStart))
+
+	// the reveal must match the commitment
+	_, err = ms.RevealVote(ctx, v1.NewMsgRevealVote(voter, proposal.Id, v1.NewNonSplitVoteOption(v1.OptionNo), "", salt))
+	require.EqualError(t, err, fmt.Sprintf("vote of %s on proposal %d: vote reveal does not match the commitment", voter, proposal.Id))
+	_, err = ms.RevealVote(ctx, v1.NewMsgRevealVote(stranger, proposal.Id, options, "", salt))
+	require.EqualError(t, err, fmt.Sprintf("no vote commitment of %s on proposal %d: unknown vote commitment", stranger, proposal.Id))
+
+	// reveal the vote
+	_, err = ms.RevealVote(ctx, v1.NewMsgRevealVote(voter, proposal.Id, options, "", salt))
+	require.NoError(t, err)
+	voteRes, err := qs.Vote(ctx, &v1.QueryVoteRequest{ProposalId: proposal.Id, Voter: voter.String()})
+	require.NoError(t, err)
+	require.Equal(t, options, v1.WeightedVoteOptions(voteRes.Vote.Options))
+	has, err := k.VoteCommitments.Has(ctx, collections.Join(proposal.Id, voter))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// public votes are still rejected during the reveal window
+	_, err = ms.Vote(ctx, v1.NewMsgVote(stranger, proposal.Id, v1.OptionYes, ""))
+	require.ErrorIs(t, err, types.ErrCommitRevealProposal)
+
+	// the unrevealed commitments are cleared at the end of the voting period
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(ctx, proposal.Id))
+	commitmentsRes, err = qs.VoteCommitments(ctx, &v1.QueryVoteCommitmentsRequest{ProposalId: proposal.Id})
+	require.NoError(t, err)
+	require.Empty(t, commitmentsRes.Commitments)
+}
+
+func TestCommitRevealVotingNotOptedIn(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	k := app.GovKeeperWrapper
+	ms := keeper.NewMsgServerImpl(k)
+	qs := keeper.NewQueryServer(k)
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	voter := sdk.AccAddress("voter")
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, nil, "", "title", "summary", govAddr)
+	require.NoError(t, err)
+	require.NoError(t, app.GovKeeper.ActivateVotingPeriod(ctx, proposal))
+
+	_, err = ms.CommitVote(ctx, v1.NewMsgCommitVote(voter, proposal.Id, make([]byte, 32)))
+	require.EqualError(t, err, fmt.Sprintf("proposal %d: proposal does not use commit-reveal voting", proposal.Id))
+	_, err = ms.CommitVote(ctx, v1.NewMsgCommitVote(voter, 99, make([]byte, 32)))
+	require.EqualError(t, err, "proposal 99 not found: unknown proposal")
+
+	_, err = qs.CommitRevealVoting(ctx, &v1.QueryCommitRevealVotingRequest{ProposalId: 0})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = proposal id can not be 0")
+
+	// public votes are accepted
+	_, err = ms.Vote(ctx, v1.NewMsgVote(voter, proposal.Id, v1.OptionYes, ""))
+	require.NoError(t, err)
+}
+
+func TestCommitRevealVotingQuorumCheckExemption(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	hooks := app.GovKeeperWrapper.GovHooks()
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	tests := []struct {
+		name         string
+		commitReveal bool
+	}{
+		{name: "commit-reveal proposal is exempt", commitReveal: true},
+		{name: "public proposal stays queued", commitReveal: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, nil, "", "title", "summary", govAddr)
+			require.NoError(t, err)
+			if tt.commitReveal {
+				require.NoError(t, app.GovKeeperWrapper.CommitRevealVotings.Set(ctx, proposal.Id, v1.CommitRevealVoting{
+					ProposalId:   proposal.Id,
+					RevealPeriod: time.Hour,
+				}))
+			}
+			quorumTimeout := ctx.BlockTime().Add(time.Hour)
+			queueKey := collections.Join(quorumTimeout, proposal.Id)
+			entry := sdkv1.QuorumCheckQueueEntry{QuorumTimeoutTime: &quorumTimeout, QuorumCheckCount: 1}
+			require.NoError(t, app.GovKeeper.QuorumCheckQueue.Set(ctx, queueKey, entry))
+
+			require.NoError(t, hooks.AfterProposalDeposit(ctx, proposal.Id, govAddr))
+
+			queued, err := app.GovKeeper.QuorumCheckQueue.Has(ctx, queueKey)
+			require.NoError(t, err)
+			require.Equal(t, !tt.commitReveal, queued)
+		})
+	}
+}
+
+func TestCommitRevealVotingQuorumCheckGate(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	k := app.GovKeeperWrapper
+	ms := keeper.NewMsgServerImpl(k)
+	qs := keeper.NewQueryServer(k)
+	proposer := sdk.AccAddress("proposer")
+
+	depositRes, err := qs.MinInitialDeposit(ctx, &v1.QueryMinInitialDepositRequest{})
+	require.NoError(t, err)
+	deposit := sdk.NewCoins(depositRes.MinInitialDeposit...)
+	fundAccount(t, app, ctx, proposer, deposit.MulInt(math.NewInt(3)))
+	submit := func() (uint64, error) {
+		msg, err := v1.NewMsgSubmitProposal(nil, deposit, proposer.String(), "", "title", "summary")
+		require.NoError(t, err)
+		msg.CommitReveal = true
+		res, err := ms.SubmitProposal(ctx, msg)
+		if err != nil {
+			return 0, err
+		}
+		return res.ProposalId, nil
+	}
+
+	// without quorum checks, the proposals can opt in commit-reveal voting
+	params, err := k.GetCommitRevealParams(ctx)
+	require.NoError(t, err)
+	require.False(t, params.QuorumCheckExemption)
+	_, err = submit()
+	require.NoError(t, err)
+
+	// with quorum checks, the opt-in requires the exemption from them
+	govParams, err := app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	govParams.QuorumCheckCount = 1
+	require.NoError(t, app.GovKeeper.Params.Set(ctx, govParams))
+	_, err = submit()
+	require.EqualError(t, err, "the quorum checks are enabled and the proposals can not be exempted from them: commit-reveal voting disabled")
+
+	params.QuorumCheckExemption = true
+	require.NoError(t, k.CommitRevealParams.Set(ctx, params))
+	proposalID, err := submit()
+	require.NoError(t, err)
+	_, found, err := k.GetCommitRevealVoting(ctx, proposalID)
+	require.NoError(t, err)
+	require.True(t, found)
+}
+
+// TestCommitRevealVotingGovernorInheritance checks that a delegator who
+// committed to a vote but did not reveal it did not vote: like any delegator
+// who does not vote, it inherits the vote revealed by its governor.
+func TestCommitRevealVotingGovernorInheritance(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	k := app.GovKeeperWrapper
+	ms := keeper.NewMsgServerImpl(k)
+	qs := keeper.NewQueryServer(k)
+	hooks := k.GovHooks()
+	governor := sdk.AccAddress("governor")
+	delegator := sdk.AccAddress("delegator")
+	governorStake := v1.DefaultMinGovernorSelfDelegation
+	delegatorStake := math.NewInt(1_000_000)
+	salt := []byte("0123456789abcdef")
+
+	stake(t, app, ctx, governor, governorStake)
+	stake(t, app, ctx, delegator, delegatorStake)
+	_, err := ms.CreateGovernor(ctx, v1.NewMsgCreateGovernor(governor, v1.GovernorDescription{Moniker: "governor"}))
+	require.NoError(t, err)
+	_, err = ms.DelegateGovernor(ctx, v1.NewMsgDelegateGovernor(delegator, sdkgovtypes.GovernorAddress(governor)))
+	require.NoError(t, err)
+
+	commit := func(proposalID uint64, voter sdk.AccAddress, options v1.WeightedVoteOptions) {
+		commitment, err := v1.VoteCommitmentHash(proposalID, voter, options, salt)
+		require.NoError(t, err)
+		_, err = ms.CommitVote(ctx, v1.NewMsgCommitVote(voter, proposalID, commitment))
+		require.NoError(t, err)
+	}
+	reveal := func(proposalID uint64, voter sdk.AccAddress, options v1.WeightedVoteOptions) {
+		_, err := ms.RevealVote(ctx, v1.NewMsgRevealVote(voter, proposalID, options, "", salt))
+		require.NoError(t, err)
+	}
+	tally := func(proposalID uint64) *v1.TallyResult {
+		res, err := qs.TallyResult(ctx, &v1.QueryTallyResultRequest{ProposalId: proposalID})
+		require.NoError(t, err)
+		return res.Tally
+	}
+
+	yes := v1.NewNonSplitVoteOption(v1.OptionYes)
+	no := v1.NewNonSplitVoteOption(v1.OptionNo)
+	unrevealed := submitCommitRevealProposal(t, app, ctx, time.Hour)
+	revealed := submitCommitRevealProposal(t, app, ctx, time.Hour)
+	for _, proposal := range []sdkv1.Proposal{unrevealed, revealed} {
+		commit(proposal.Id, governor, yes)
+		commit(proposal.Id, delegator, no)
+	}
+
+	ctx = ctx.WithBlockTime(unrevealed.VotingEndTime.Add(-time.Hour))
+	for _, proposal := range []sdkv1.Proposal{unrevealed, revealed} {
+		reveal(proposal.Id, governor, yes)
+	}
+	reveal(revealed.Id, delegator, no)
+
+	// the delegator who did not reveal inherits the vote of its governor
+	result := tally(unrevealed.Id)
+	require.Equal(t, governorStake.Add(delegatorStake).String(), result.YesCount)
+	require.Equal(t, "0", result.NoCount)
+	// the revealed vote of the delegator overrides the vote of its governor
+	result = tally(revealed.Id)
+	require.Equal(t, governorStake.String(), result.YesCount)
+	require.Equal(t, delegatorStake.String(), result.NoCount)
+
+	// the unrevealed commitment is cleared at the end of the voting period,
+	// and the delegator still inherits the vote of its governor
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(ctx, unrevealed.Id))
+	has, err := k.VoteCommitments.Has(ctx, collections.Join(unrevealed.Id, delegator))
+	require.NoError(t, err)
+	require.False(t, has)
+	result = tally(unrevealed.Id)
+	require.Equal(t, governorStake.Add(delegatorStake).String(), result.YesCount)
+}
@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	sdkv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// GetConstitutionVersion returns the version of the constitution numbered
+// version.
+func (keeper *Keeper) GetConstitutionVersion(ctx context.Context, version uint64) (v1.ConstitutionVersion, error) {
+	constitutionVersion, err := keeper.ConstitutionVersions.Get(ctx, version)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.ConstitutionVersion{}, types.ErrUnknownConstitutionVersion.Wrapf("constitution version %d not found", version)
+	}
+	return constitutionVersion, err
+}
+
+// GetLatestConstitutionVersion returns the latest version of the constitution,
+// and false if the history has not started yet.
+func (keeper *Keeper) GetLatestConstitutionVersion(ctx context.Context) (v1.ConstitutionVersion, bool, error) {
+	iter, err := keeper.ConstitutionVersions.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return v1.ConstitutionVersion{}, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return v1.ConstitutionVersion{}, false, nil
+	}
+	constitutionVersion, err := iter.Value()
+	if err != nil {
+		return v1.ConstitutionVersion{}, false, err
+	}
+	return constitutionVersion, true, nil
+}
+
+// InitConstitutionHistory starts the history of the constitution with the
+// constitution in force, if the history has not started yet.
+func (keeper *Keeper) InitConstitutionHistory(ctx context.Context) error {
+	_, found, err := keeper.GetLatestConstitutionVersion(ctx)
+	if err != nil || found {
+		return err
+	}
+	constitution, err := keeper.getConstitution(ctx)
+	if err != nil {
+		return err
+	}
+	return keeper.ConstitutionVersions.Set(ctx, 1, v1.ConstitutionVersion{
+		Version:       1,
+		Constitution:  constitution,
+		AmendmentTime: sdk.UnwrapSDKContext(ctx).BlockTime(),
+	})
+}
+
+// getConstitution returns the constitution in force.
+func (keeper *Keeper) getConstitution(ctx context.Context) (string, error) {
+	constitution, err := keeper.Keeper.Constitution.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return "", err
+	}
+	return constitution, nil
+}
+
+// GetConstitutionAmendmentPatchParams returns the params defining how the
+// amendments of the constitution are applied. Until they are set, the
+// amendments are applied strictly.
+func (keeper *Keeper) GetConstitutionAmendmentPatchParams(ctx context.Context) (v1.ConstitutionAmendmentPatchParams, error) {
+	params, err := keeper.ConstitutionAmendmentPatchParams.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.ConstitutionAmendmentPatchParams{Strict: true}, nil
+	}
+	return params, err
+}
+
+// SimulateConstitutionAmendment returns the constitution resulting from the
+// application of amendment to the constitution in force, with the offset and
+// fuzz each hunk was applied with, or an ErrInvalidConstitutionAmendment error
+// locating the hunk that does not apply.
+func (keeper *Keeper) SimulateConstitutionAmendment(ctx context.Context, amendment string) (string, []types.AppliedHunk, error) {
+	constitution, err := keeper.getConstitution(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return keeper.applyConstitutionAmendment(ctx, constitution, amendment)
+}
+
+// applyConstitutionAmendment applies amendment to constitution as defined by
+// the ConstitutionAmendmentPatch params. No hunk is reported when the
+// amendments are applied strictly.
+func (keeper *Keeper) applyConstitutionAmendment(ctx context.Context, constitution, amendment string) (string, []types.AppliedHunk, error) {
+	params, err := keeper.GetConstitutionAmendmentPatchParams(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	var (
+		result string
+		hunks  []types.AppliedHunk
+	)
+	if params.Strict {
+		result, err = types.ApplyUnifiedDiff(constitution, amendment)
+	} else {
+		result, hunks, err = types.ApplyUnifiedDiffFuzzy(constitution, amendment, int(params.MaxOffset), int(params.MaxFuzz))
+	}
+	if err != nil {
+		return "", nil, types.ErrInvalidConstitutionAmendment.Wrapf("amendment does not apply to the constitution: %s", err)
+	}
+	return result, hunks, nil
+}
+
+// PatchConstitution returns the constitution resulting from the application
+// of amendment to the constitution in force, with the offset and fuzz each
+// hunk was applied with, without setting it. Since the amendments are checked
+// against the constitution when their proposal is submitted, amendment does
+// not apply when another amendment passed in the meantime and changed the
+// lines it relies on beyond the offset and fuzz tolerated: an
+// ErrConstitutionAmendmentConflict error is returned, and the proposal fails
+// rather than being rebased.
+func (keeper *Keeper) PatchConstitution(ctx context.Context, amendment string) (string, []types.AppliedHunk, error) {
+	result, hunks, err := keeper.SimulateConstitutionAmendment(ctx, amendment)
+	if !errors.Is(err, types.ErrInvalidConstitutionAmendment) {
+		return result, hunks, err
+	}
+	latest, found, lerr := keeper.GetLatestConstitutionVersion(ctx)
+	if lerr != nil {
+		return "", nil, lerr
+	}
+	if !found {
+		return "", nil, types.ErrConstitutionAmendmentConflict.Wrap(err.Error())
+	}
+	return "", nil, types.ErrConstitutionAmendmentConflict.Wrapf("constitution has been amended to version %d since the proposal submission: %s",
+		latest.Version, err)
+}
+
+// validateConstitutionAmendments returns an error if the amendments of the
+// constitution in proposalID do not apply to the constitution in force. The
+// amendments are applied in sequence, as they would be upon execution.
+func (keeper *Keeper) validateConstitutionAmendments(ctx context.Context, proposalID uint64) error {
+	proposal, err := keeper.Keeper.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	amendments, err := keeper.constitutionAmendments(proposal.Messages)
+	if err != nil || len(amendments) == 0 {
+		return err
+	}
+	constitution, err := keeper.getConstitution(ctx)
+	if err != nil {
+		return err
+	}
+	for _, amendment := range amendments {
+		constitution, _, err = keeper.applyConstitutionAmendment(ctx, constitution, amendment)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// constitutionAmendments returns the amendments of the constitution proposed
+// by msgs, including the ones wrapped in authz MsgExec messages, in the order
+// they would be executed.
+func (keeper *Keeper) constitutionAmendments(msgs []*codectypes.Any) ([]string, error) {
+	var amendments []string
+	for _, anyMsg := range msgs {
+		var msg sdk.Msg
+		if err := keeper.cdc.UnpackAny(anyMsg, &msg); err != nil {
+			return nil, err
+		}
+		switch msg := msg.(type) {
+		case *v1.MsgProposeConstitutionAmendment:
+			amendments = append(amendments, msg.Amendment)
+		case *sdkv1.MsgProposeConstitutionAmendment:
+			amendments = append(amendments, msg.Amendment)
+		case *authz.MsgExec:
+			execAmendments, err := keeper.constitutionAmendments(msg.Msgs)
+			if err != nil {
+				return nil, err
+			}
+			amendments = append(amendments, execAmendments...)
+		}
+	}
+	return amendments, nil
+}
+
+// RecordConstitutionVersion records the constitution in force as a new
+// version of the constitution, after it has been amended. The proposal
+// amending the constitution is recorded once its execution is complete, by
+// the AfterProposalVotingPeriodEnded hook.
+func (keeper *Keeper) RecordConstitutionVersion(ctx context.Context) error {
+	latest, _, err := keeper.GetLatestConstitutionVersion(ctx)
+	if err != nil {
+		return err
+	}
+	constitution, err := keeper.Keeper.Constitution.Get(ctx)
+	if err != nil {
+		return err
+	}
+	version := latest.Version + 1
+	err = keeper.ConstitutionVersions.Set(ctx, version, v1.ConstitutionVersion{
+		Version:       version,
+		Constitution:  constitution,
+		AmendmentTime: sdk.UnwrapSDKContext(ctx).BlockTime(),
+	})
+	if err != nil {
+		return err
+	}
+	return keeper.PendingConstitutionVersions.Set(ctx, version)
+}
+
+// GetConstitutionDiff returns the unified diff between the versions of the
+// constitution numbered fromVersion and toVersion.
+func (keeper *Keeper) GetConstitutionDiff(ctx context.Context, fromVersion, toVersion uint64) (string, error) {
+	from, err := keeper.GetConstitutionVersion(ctx, fromVersion)
+	if err != nil {
+		return "", err
+	}
+	to, err := keeper.GetConstitutionVersion(ctx, toVersion)
+	if err != nil {
+		return "", err
+	}
+	return types.GenerateUnifiedDiff(from.Constitution, to.Constitution), nil
+}
+
+// recordConstitutionProposal records proposalID as the proposal amending the
+// constitution to the versions created during its execution.
+func (keeper *Keeper) recordConstitutionProposal(ctx context.Context, proposalID uint64) error {
+	var versions []uint64
+	err := keeper.PendingConstitutionVersions.Walk(ctx, nil, func(version uint64) (bool, error) {
+		versions = append(versions, version)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, version := range versions {
+		constitutionVersion, err := keeper.ConstitutionVersions.Get(ctx, version)
+		if err != nil {
+			return err
+		}
+		constitutionVersion.AmendingProposalId = proposalID
+		if err := keeper.ConstitutionVersions.Set(ctx, version, constitutionVersion); err != nil {
+			return err
+		}
+		if err := keeper.PendingConstitutionVersions.Remove(ctx, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,382 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	sdkv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/atomone-hub/atomone/app/helpers"
+	"github.com/atomone-hub/atomone/x/gov/keeper"
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestConstitutionHistory(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	k := app.GovKeeperWrapper
+	ms := keeper.NewMsgServerImpl(k)
+	qs := keeper.NewQueryServer(k)
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	hooks := k.GovHooks()
+
+	// the history starts with the genesis constitution
+	first, err := k.GetConstitutionVersion(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), first.AmendingProposalId)
+
+	// amend the constitution with proposal 1
+	amendmentTime := ctx.BlockTime().Add(time.Hour)
+	ctx = ctx.WithBlockTime(amendmentTime)
+	constitution := "Line one\nLine two\nLine three"
+	amendment := types.GenerateUnifiedDiff(first.Constitution, constitution)
+	_, err = ms.ProposeConstitutionAmendment(ctx, v1.NewMsgProposeConstitutionAmendment(govAddr, amendment))
+	require.NoError(t, err)
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(ctx, 1))
+
+	second, err := k.GetConstitutionVersion(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, v1.ConstitutionVersion{
+		Version:            2,
+		Constitution:       constitution,
+		AmendingProposalId: 1,
+		AmendmentTime:      amendmentTime,
+	}, second)
+
+	// proposals without amendments leave the history untouched
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(ctx, 2))
+	second, err = k.GetConstitutionVersion(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), second.AmendingProposalId)
+
+	// amend the constitution with proposal 3
+	amendment = "@@ -2 +2 @@\n-Line two\n+Line two amended\n"
+	_, err = ms.ProposeConstitutionAmendment(ctx, v1.NewMsgProposeConstitutionAmendment(govAddr, amendment))
+	require.NoError(t, err)
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(ctx, 3))
+
+	res, err := qs.ConstitutionVersion(ctx, &v1.QueryConstitutionVersionRequest{Version: 3})
+	require.NoError(t, err)
+	require.Equal(t, "Line one\nLine two amended\nLine three", res.ConstitutionVersion.Constitution)
+	require.Equal(t, uint64(3), res.ConstitutionVersion.AmendingProposalId)
+
+	constitutionRes, err := qs.Constitution(ctx, &v1.QueryConstitutionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, res.ConstitutionVersion.Constitution, constitutionRes.Constitution)
+
+	historyRes, err := qs.ConstitutionHistory(ctx, &v1.QueryConstitutionHistoryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []v1.ConstitutionVersion{first, second, res.ConstitutionVersion}, historyRes.ConstitutionVersions)
+
+	// the diff between two versions turns the first one into the second one
+	diffRes, err := qs.ConstitutionDiff(ctx, &v1.QueryConstitutionDiffRequest{FromVersion: 2, ToVersion: 3})
+	require.NoError(t, err)
+	require.Equal(t, `--- src
++++ dst
+@@ -1,3 +1,3 @@
+ Line one
+-Line two
++Line two amended
+ Line three
+`, diffRes.Diff)
+	diffRes, err = qs.ConstitutionDiff(ctx, &v1.QueryConstitutionDiffRequest{FromVersion: 3, ToVersion: 1})
+	require.NoError(t, err)
+	reverted, err := types.ApplyUnifiedDiff(res.ConstitutionVersion.Constitution, diffRes.Diff)
+	require.NoError(t, err)
+	require.Equal(t, first.Constitution, reverted)
+	diffRes, err = qs.ConstitutionDiff(ctx, &v1.QueryConstitutionDiffRequest{FromVersion: 3, ToVersion: 3})
+	require.NoError(t, err)
+	require.Empty(t, diffRes.Diff)
+
+	// unknown versions
+	_, err = qs.ConstitutionVersion(ctx, &v1.QueryConstitutionVersionRequest{Version: 0})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = constitution version can not be 0")
+	_, err = qs.ConstitutionVersion(ctx, &v1.QueryConstitutionVersionRequest{Version: 4})
+	require.EqualError(t, err, "rpc error: code = NotFound desc = constitution version 4 not found: unknown constitution version")
+	_, err = qs.ConstitutionDiff(ctx, &v1.QueryConstitutionDiffRequest{FromVersion: 1, ToVersion: 4})
+	require.EqualError(t, err, "rpc error: code = NotFound desc = constitution version 4 not found: unknown constitution version")
+}
+
+func TestInitConstitutionHistory(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	k := app.GovKeeperWrapper
+
+	// the history is started only once
+	require.NoError(t, k.Keeper.Constitution.Set(ctx, "new constitution"))
+	require.NoError(t, k.InitConstitutionHistory(ctx))
+	latest, found, err := k.GetLatestConstitutionVersion(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(1), latest.Version)
+	require.NotEqual(t, "new constitution", latest.Constitution)
+
+	// an empty history starts with the constitution in force
+	require.NoError(t, k.ConstitutionVersions.Remove(ctx, 1))
+	require.NoError(t, k.InitConstitutionHistory(ctx))
+	latest, found, err = k.GetLatestConstitutionVersion(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, v1.ConstitutionVersion{
+		Version:       1,
+		Constitution:  "new constitution",
+		AmendmentTime: ctx.BlockTime(),
+	}, latest)
+}
+
+func TestSimulateConstitutionAmendment(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	qs := keeper.NewQueryServer(app.GovKeeperWrapper)
+	require.NoError(t, app.GovKeeper.Constitution.Set(ctx, "Line one\nLine two\nLine three"))
+
+	res, err := qs.SimulateConstitutionAmendment(ctx, &v1.QuerySimulateConstitutionAmendmentRequest{
+		Amendment: "@@ -2 +2 @@\n-Line two\n+Line two amended\n",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Line one\nLine two amended\nLine three", res.Constitution)
+	require.Equal(t, []v1.ConstitutionAmendmentHunk{{Offset: 0, Fuzz: 0}}, res.Hunks)
+
+	// the hunk is located in the constitution
+	res, err = qs.SimulateConstitutionAmendment(ctx, &v1.QuerySimulateConstitutionAmendmentRequest{
+		Amendment: "@@ -1 +1 @@\n-Line two\n+Line two amended\n",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Line one\nLine two amended\nLine three", res.Constitution)
+	require.Equal(t, []v1.ConstitutionAmendmentHunk{{Offset: 1, Fuzz: 0}}, res.Hunks)
+
+	_, err = qs.SimulateConstitutionAmendment(ctx, &v1.QuerySimulateConstitutionAmendmentRequest{
+		Amendment: "@@ -2 +2 @@\n-Line 2\n+Line two amended\n",
+	})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = amendment does not apply to the constitution: hunk 1 does not apply within 100 lines of line 2 with a fuzz of 2: invalid constitution amendment")
+
+	// in strict mode, the hunks must apply at the lines given in their header
+	require.NoError(t, app.GovKeeperWrapper.ConstitutionAmendmentPatchParams.Set(ctx, v1.ConstitutionAmendmentPatchParams{Strict: true}))
+	_, err = qs.SimulateConstitutionAmendment(ctx, &v1.QuerySimulateConstitutionAmendmentRequest{
+		Amendment: "@@ -1 +1 @@\n-Line two\n+Line two amended\n",
+	})
+	require.EqualError(t, err, `rpc error: code = InvalidArgument desc = amendment does not apply to the constitution: hunk 1: deletion line mismatch at line 1: expected "Line two", got "Line one": invalid constitution amendment`)
+
+	_, err = qs.SimulateConstitutionAmendment(ctx, &v1.QuerySimulateConstitutionAmendmentRequest{
+		Amendment: "Line two amended",
+	})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = amendment does not apply to the constitution: unexpected content outside of hunks at line 1: invalid constitution amendment")
+
+	_, err = qs.SimulateConstitutionAmendment(ctx, &v1.QuerySimulateConstitutionAmendmentRequest{})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = amendment cannot be empty")
+}
+
+func TestConstitutionAmendmentSubmission(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	constitution := "Line one\nLine two\nLine three"
+
+	tests := []struct {
+		name        string
+		amendments  []string
+		authzExec   bool
+		expectedErr string
+	}{
+		{
+			name:       "amendment applies",
+			amendments: []string{"@@ -2 +2 @@\n-Line two\n+Line two amended\n"},
+		},
+		{
+			name:        "amendment does not apply",
+			amendments:  []string{"@@ -2 +2 @@\n-Line 2\n+Line two amended\n"},
+			expectedErr: "amendment does not apply to the constitution: hunk 1 does not apply within 100 lines of line 2 with a fuzz of 2: invalid constitution amendment",
+		},
+		{
+			name:       "amendment applies with an offset",
+			amendments: []string{"@@ -3 +3 @@\n-Line two\n+Line two amended\n"},
+		},
+		{
+			name: "amendments apply in sequence",
+			amendments: []string{
+				"@@ -2 +2 @@\n-Line two\n+Line two amended\n",
+				"@@ -2 +2 @@\n-Line two amended\n+Line two amended twice\n",
+			},
+		},
+		{
+			name: "second amendment does not apply after the first one",
+			amendments: []string{
+				"@@ -2 +2 @@\n-Line two\n+Line two amended\n",
+				"@@ -2 +2 @@\n-Line two\n+Line two amended twice\n",
+			},
+			expectedErr: "amendment does not apply to the constitution: hunk 1 does not apply within 100 lines of line 2 with a fuzz of 2: invalid constitution amendment",
+		},
+		{
+			name: "amendments wrapped in MsgExec apply in sequence",
+			amendments: []string{
+				"@@ -2 +2 @@\n-Line two\n+Line two amended\n",
+				"@@ -2 +2 @@\n-Line two amended\n+Line two amended twice\n",
+			},
+			authzExec: true,
+		},
+		{
+			name:        "amendment wrapped in MsgExec does not apply",
+			amendments:  []string{"@@ -2 +2 @@\n-Line 2\n+Line two amended\n"},
+			authzExec:   true,
+			expectedErr: "amendment does not apply to the constitution: hunk 1 does not apply within 100 lines of line 2 with a fuzz of 2: invalid constitution amendment",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := helpers.Setup(t)
+			ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+			require.NoError(t, app.GovKeeper.Constitution.Set(ctx, constitution))
+			var msgs []sdk.Msg
+			for _, amendment := range tt.amendments {
+				msgs = append(msgs, v1.NewMsgProposeConstitutionAmendment(govAddr, amendment))
+			}
+			if tt.authzExec {
+				exec := authz.NewMsgExec(govAddr, msgs)
+				msgs = []sdk.Msg{&exec}
+			}
+
+			_, err := app.GovKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", govAddr)
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConstitutionAmendmentConflict(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	k := app.GovKeeperWrapper
+	ms := keeper.NewMsgServerImpl(k)
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	require.NoError(t, app.GovKeeper.Constitution.Set(ctx, "Line one\nLine two\nLine three"))
+
+	// two conflicting amendments are submitted while both apply
+	first := v1.NewMsgProposeConstitutionAmendment(govAddr, "@@ -2 +2 @@\n-Line two\n+Line two amended\n")
+	second := v1.NewMsgProposeConstitutionAmendment(govAddr, "@@ -2 +2 @@\n-Line two\n+Line two amended differently\n")
+	_, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{first}, "", "title", "summary", govAddr)
+	require.NoError(t, err)
+	_, err = app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{second}, "", "title", "summary", govAddr)
+	require.NoError(t, err)
+
+	// the first one to pass is executed
+	_, err = ms.ProposeConstitutionAmendment(ctx, first)
+	require.NoError(t, err)
+	require.NoError(t, k.GovHooks().AfterProposalVotingPeriodEnded(ctx, 1))
+
+	// the later one is rejected
+	_, err = ms.ProposeConstitutionAmendment(ctx, second)
+	require.EqualError(t, err, "constitution has been amended to version 2 since the proposal submission: amendment does not apply to the constitution: hunk 1 does not apply within 100 lines of line 2 with a fuzz of 2: invalid constitution amendment: constitution amendment conflict")
+	constitution, err := app.GovKeeper.Constitution.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "Line one\nLine two amended\nLine three", constitution)
+}
+
+func TestConstitutionAmendmentConflictProposalFails(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+	k := app.GovKeeperWrapper
+	ms := keeper.NewMsgServerImpl(k)
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	voter := sdk.AccAddress("voter")
+	stake(t, app, ctx, voter, math.NewInt(1_000_000_000_000))
+	require.NoError(t, app.GovKeeper.Constitution.Set(ctx, "Line one\nLine two\nLine three"))
+
+	// two conflicting amendments are in voting period at the same time, and
+	// both pass
+	var proposalIDs []uint64
+	for _, amendment := range []string{
+		"@@ -2 +2 @@\n-Line two\n+Line two amended\n",
+		"@@ -2 +2 @@\n-Line two\n+Line two amended differently\n",
+	} {
+		msgs := []sdk.Msg{v1.NewMsgProposeConstitutionAmendment(govAddr, amendment)}
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", govAddr)
+		require.NoError(t, err)
+		require.NoError(t, app.GovKeeper.ActivateVotingPeriod(ctx, proposal))
+		_, err = ms.Vote(ctx, v1.NewMsgVote(voter, proposal.Id, v1.OptionYes, ""))
+		require.NoError(t, err)
+		proposalIDs = append(proposalIDs, proposal.Id)
+	}
+	proposal, err := app.GovKeeper.Proposals.Get(ctx, proposalIDs[0])
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(proposal.VotingEndTime.Add(time.Second))
+	_, err = app.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	// the first one is executed
+	proposal, err = app.GovKeeper.Proposals.Get(ctx, proposalIDs[0])
+	require.NoError(t, err)
+	require.Equal(t, sdkv1.StatusPassed, proposal.Status)
+	latest, _, err := k.GetLatestConstitutionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, proposalIDs[0], latest.AmendingProposalId)
+
+	// the second one fails with the conflict as failed reason, and leaves the
+	// constitution unchanged
+	proposal, err = app.GovKeeper.Proposals.Get(ctx, proposalIDs[1])
+	require.NoError(t, err)
+	require.Equal(t, sdkv1.StatusFailed, proposal.Status)
+	require.Contains(t, proposal.FailedReason, "constitution amendment conflict")
+	constitution, err := app.GovKeeper.Constitution.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "Line one\nLine two amended\nLine three", constitution)
+}
+
+func TestConstitutionAmendmentOffset(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	k := app.GovKeeperWrapper
+	ms := keeper.NewMsgServerImpl(k)
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	require.NoError(t, app.GovKeeper.Constitution.Set(ctx, "Line one\nLine two\nLine three\nLine four\nLine five"))
+
+	// two amendments changing different lines are submitted
+	first := v1.NewMsgProposeConstitutionAmendment(govAddr, "@@ -1,2 +1,4 @@\n Line one\n+Line one bis\n+Line one ter\n Line two\n")
+	second := v1.NewMsgProposeConstitutionAmendment(govAddr, "@@ -3,3 +3,3 @@\n Line three\n-Line four\n+Line four amended\n Line five\n")
+	_, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{first}, "", "title", "summary", govAddr)
+	require.NoError(t, err)
+	_, err = app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{second}, "", "title", "summary", govAddr)
+	require.NoError(t, err)
+
+	// the first one moves the lines the second one relies on
+	_, err = ms.ProposeConstitutionAmendment(ctx, first)
+	require.NoError(t, err)
+	require.NoError(t, k.GovHooks().AfterProposalVotingPeriodEnded(ctx, 1))
+
+	// in strict mode, the second one is rejected
+	require.NoError(t, k.ConstitutionAmendmentPatchParams.Set(ctx, v1.ConstitutionAmendmentPatchParams{Strict: true}))
+	_, err = ms.ProposeConstitutionAmendment(ctx, second)
+	require.EqualError(t, err, `constitution has been amended to version 2 since the proposal submission: amendment does not apply to the constitution: hunk 1: context line mismatch at line 3: expected "Line three", got "Line one ter": invalid constitution amendment: constitution amendment conflict`)
+
+	// otherwise, the second one is applied with an offset
+	require.NoError(t, k.ConstitutionAmendmentPatchParams.Set(ctx, v1.DefaultConstitutionAmendmentPatchParams()))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = ms.ProposeConstitutionAmendment(ctx, second)
+	require.NoError(t, err)
+	require.NoError(t, k.GovHooks().AfterProposalVotingPeriodEnded(ctx, 2))
+
+	constitution, err := app.GovKeeper.Constitution.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "Line one\nLine one bis\nLine one ter\nLine two\nLine three\nLine four amended\nLine five", constitution)
+	latest, _, err := k.GetLatestConstitutionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), latest.Version)
+	require.Equal(t, uint64(2), latest.AmendingProposalId)
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeConstitutionAmendmentHunk,
+		sdk.NewAttribute(types.AttributeKeyHunk, "1"),
+		sdk.NewAttribute(types.AttributeKeyOffset, "2"),
+		sdk.NewAttribute(types.AttributeKeyFuzz, "0"),
+	))
+}
@@ -3,6 +3,13 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	sdkv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	sdkv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -15,11 +22,14 @@ var _ v1.QueryServer = grpcServer{}
 
 type grpcServer struct {
 	sdkv1.QueryServer
+
+	k *Keeper
 }
 
 func NewQueryServer(k *Keeper) v1.QueryServer {
 	return &grpcServer{
 		QueryServer: govkeeper.NewQueryServer(k.Keeper),
+		k:           k,
 	}
 }
 
@@ -102,11 +112,23 @@ func (q grpcServer) Params(ctx context.Context, req *v1.QueryParamsRequest) (*v1
 		return nil, err
 	}
 
+	params := v1.ConvertSDKParamsToAtomOne(result.Params)
+	if params != nil {
+		params.ConstitutionAmendmentPatch, err = q.k.GetConstitutionAmendmentPatchParams(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		params.CommitReveal, err = q.k.GetCommitRevealParams(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	return &v1.QueryParamsResponse{
 		VotingParams:  v1.ConvertSDKVotingParamsToAtomOne(result.VotingParams),   //nolint:staticcheck // backward compat
 		DepositParams: v1.ConvertSDKDepositParamsToAtomOne(result.DepositParams), //nolint:staticcheck // backward compat
 		TallyParams:   v1.ConvertSDKTallyParamsToAtomOne(result.TallyParams),     //nolint:staticcheck // backward compat
-		Params:        v1.ConvertSDKParamsToAtomOne(result.Params),
+		Params:        params,
 	}, nil
 }
 
@@ -139,7 +161,8 @@ func (q grpcServer) Deposits(ctx context.Context, req *v1.QueryDepositsRequest)
 	}, nil
 }
 
-// TallyResult queries the tally of a proposal vote
+// TallyResult queries the tally of a proposal vote, and whether the
+// endorsement of the proposal exempts it from the late quorum extension.
 func (q grpcServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRequest) (*v1.QueryTallyResultResponse, error) {
 	result, err := q.QueryServer.TallyResult(ctx, &sdkv1.QueryTallyResultRequest{
 		ProposalId: req.ProposalId,
@@ -148,7 +171,18 @@ func (q grpcServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultReq
 		return nil, err
 	}
 
-	return &v1.QueryTallyResultResponse{Tally: v1.ConvertSDKTallyResultToAtomOne(result.GetTally())}, nil
+	var exempt bool
+	if q.k.endorsementKeeper != nil {
+		exempt, err = q.k.endorsementKeeper.QuorumExtensionExempt(ctx, req.ProposalId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.QueryTallyResultResponse{
+		Tally:                 v1.ConvertSDKTallyResultToAtomOne(result.GetTally()),
+		QuorumExtensionExempt: exempt,
+	}, nil
 }
 
 // MinDeposit returns the minimum deposit currently required for a proposal to enter voting period
@@ -171,7 +205,8 @@ func (q grpcServer) MinInitialDeposit(ctx context.Context, req *v1.QueryMinIniti
 	return &v1.QueryMinInitialDepositResponse{MinInitialDeposit: result.GetMinInitialDeposit()}, nil
 }
 
-// Quorums returns the current quorums
+// Quorums returns the current quorums, and whether the endorsement of a
+// proposal exempts it from the late quorum extension.
 func (q grpcServer) Quorums(ctx context.Context, _ *v1.QueryQuorumsRequest) (*v1.QueryQuorumsResponse, error) {
 	result, err := q.QueryServer.Quorums(ctx, &sdkv1.QueryQuorumsRequest{})
 	if err != nil {
@@ -179,9 +214,10 @@ func (q grpcServer) Quorums(ctx context.Context, _ *v1.QueryQuorumsRequest) (*v1
 	}
 
 	return &v1.QueryQuorumsResponse{
-		Quorum:                      result.GetQuorum(),
-		ConstitutionAmendmentQuorum: result.GetConstitutionAmendmentQuorum(),
-		LawQuorum:                   result.GetLawQuorum(),
+		Quorum:                            result.GetQuorum(),
+		ConstitutionAmendmentQuorum:       result.GetConstitutionAmendmentQuorum(),
+		LawQuorum:                         result.GetLawQuorum(),
+		EndorsementExemptsQuorumExtension: q.k.endorsementKeeper != nil && q.k.endorsementKeeper.EndorsementExemptsQuorumExtension(ctx),
 	}, nil
 }
 
@@ -408,3 +444,166 @@ func (q legacyQueryServer) TallyResult(c context.Context, req *v1beta1.QueryTall
 	tally := result.GetTally()
 	return &v1beta1.QueryTallyResultResponse{Tally: v1beta1.ConvertSDKTallyResultToAtomOne(&tally)}, nil
 }
+
+// Laws queries the laws in force.
+func (q grpcServer) Laws(ctx context.Context, req *v1.QueryLawsRequest) (*v1.QueryLawsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	laws, pageRes, err := query.CollectionPaginate(ctx, q.k.Laws, req.Pagination,
+		func(_ uint64, law v1.Law) (v1.Law, error) {
+			return law, nil
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryLawsResponse{Laws: laws, Pagination: pageRes}, nil
+}
+
+// Law queries a law in force based on its id.
+func (q grpcServer) Law(ctx context.Context, req *v1.QueryLawRequest) (*v1.QueryLawResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.LawId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "law id can not be 0")
+	}
+
+	law, err := q.k.GetLaw(ctx, req.LawId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &v1.QueryLawResponse{Law: law}, nil
+}
+
+// ConstitutionVersion queries a version of the constitution based on its
+// number.
+func (q grpcServer) ConstitutionVersion(ctx context.Context, req *v1.QueryConstitutionVersionRequest) (*v1.QueryConstitutionVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Version == 0 {
+		return nil, status.Error(codes.InvalidArgument, "constitution version can not be 0")
+	}
+
+	constitutionVersion, err := q.k.GetConstitutionVersion(ctx, req.Version)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &v1.QueryConstitutionVersionResponse{ConstitutionVersion: constitutionVersion}, nil
+}
+
+// ConstitutionHistory queries all the versions of the constitution.
+func (q grpcServer) ConstitutionHistory(ctx context.Context, req *v1.QueryConstitutionHistoryRequest) (*v1.QueryConstitutionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	versions, pageRes, err := query.CollectionPaginate(ctx, q.k.ConstitutionVersions, req.Pagination,
+		func(_ uint64, constitutionVersion v1.ConstitutionVersion) (v1.ConstitutionVersion, error) {
+			return constitutionVersion, nil
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryConstitutionHistoryResponse{ConstitutionVersions: versions, Pagination: pageRes}, nil
+}
+
+// ConstitutionDiff queries the unified diff between two versions of the
+// constitution.
+func (q grpcServer) ConstitutionDiff(ctx context.Context, req *v1.QueryConstitutionDiffRequest) (*v1.QueryConstitutionDiffResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.FromVersion == 0 || req.ToVersion == 0 {
+		return nil, status.Error(codes.InvalidArgument, "constitution version can not be 0")
+	}
+
+	diff, err := q.k.GetConstitutionDiff(ctx, req.FromVersion, req.ToVersion)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &v1.QueryConstitutionDiffResponse{Diff: diff}, nil
+}
+
+// SimulateConstitutionAmendment queries the constitution resulting from the
+// application of an amendment to the constitution in force.
+func (q grpcServer) SimulateConstitutionAmendment(ctx context.Context, req *v1.QuerySimulateConstitutionAmendmentRequest) (*v1.QuerySimulateConstitutionAmendmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Amendment == "" {
+		return nil, status.Error(codes.InvalidArgument, "amendment cannot be empty")
+	}
+
+	constitution, hunks, err := q.k.SimulateConstitutionAmendment(ctx, req.Amendment)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := &v1.QuerySimulateConstitutionAmendmentResponse{Constitution: constitution}
+	for _, hunk := range hunks {
+		res.Hunks = append(res.Hunks, v1.ConstitutionAmendmentHunk{
+			Offset: int64(hunk.Offset),
+			Fuzz:   uint64(hunk.Fuzz),
+		})
+	}
+	return res, nil
+}
+
+// CommitRevealVoting queries the commit-reveal voting of a proposal which
+// opted in, and the time its reveal window starts.
+func (q grpcServer) CommitRevealVoting(ctx context.Context, req *v1.QueryCommitRevealVotingRequest) (*v1.QueryCommitRevealVotingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, voting, err := q.k.getCommitRevealProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &v1.QueryCommitRevealVotingResponse{
+		CommitRevealVoting: voting,
+		RevealStartTime:    revealStartTime(proposal, voting),
+	}, nil
+}
+
+// VoteCommitments queries the vote commitments on a proposal not revealed yet.
+func (q grpcServer) VoteCommitments(ctx context.Context, req *v1.QueryVoteCommitmentsRequest) (*v1.QueryVoteCommitmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	commitments, pageRes, err := query.CollectionPaginate(ctx, q.k.VoteCommitments, req.Pagination,
+		func(key collections.Pair[uint64, sdk.AccAddress], commitment []byte) (v1.VoteCommitment, error) {
+			return v1.VoteCommitment{
+				ProposalId: key.K1(),
+				Voter:      key.K2().String(),
+				Commitment: commitment,
+			}, nil
+		}, query.WithCollectionPaginationPairPrefix[uint64, sdk.AccAddress](req.ProposalId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVoteCommitmentsResponse{VoteCommitments: commitments, Pagination: pageRes}, nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Hooks wrapper struct for the AtomOne gov keeper
+type Hooks struct {
+	k *Keeper
+}
+
+var _ govtypes.GovHooks = Hooks{}
+
+// GovHooks returns the gov hooks maintaining the laws, the constitution
+// history and the commit-reveal votings.
+func (keeper *Keeper) GovHooks() Hooks {
+	return Hooks{keeper}
+}
+
+// AfterProposalSubmission rejects the proposal if its amendments of the
+// constitution do not apply to the constitution in force, rather than letting
+// it fail upon execution.
+func (h Hooks) AfterProposalSubmission(ctx context.Context, proposalID uint64) error {
+	return h.k.validateConstitutionAmendments(ctx, proposalID)
+}
+
+// AfterProposalDeposit exempts the proposal from the quorum check if it uses
+// commit-reveal voting, once the deposit made it enter its voting period.
+func (h Hooks) AfterProposalDeposit(ctx context.Context, proposalID uint64, depositorAddr sdk.AccAddress) error {
+	return h.k.exemptFromQuorumCheck(ctx, proposalID)
+}
+
+// AfterProposalVote rejects the vote if the proposal uses commit-reveal voting
+// and the vote has not been revealed with MsgRevealVote.
+func (h Hooks) AfterProposalVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress) error {
+	return h.k.checkCommitRevealVote(ctx, proposalID, voterAddr)
+}
+
+// AfterProposalFailedMinDeposit removes the commit-reveal voting of the
+// proposal, which never entered its voting period.
+func (h Hooks) AfterProposalFailedMinDeposit(ctx context.Context, proposalID uint64) error {
+	return h.k.CommitRevealVotings.Remove(ctx, proposalID)
+}
+
+// AfterProposalVotingPeriodEnded records the proposal as the proposal enacting
+// or amending the laws enacted or amended by its execution, and as the
+// proposal amending the constitution to the versions created by its execution.
+// The laws and the versions are only pending if the proposal passed and its
+// messages have been executed. The vote commitments on the proposal that have
+// not been revealed are removed.
+func (h Hooks) AfterProposalVotingPeriodEnded(ctx context.Context, proposalID uint64) error {
+	if err := h.k.recordLawProposal(ctx, proposalID); err != nil {
+		return err
+	}
+	if err := h.k.recordConstitutionProposal(ctx, proposalID); err != nil {
+		return err
+	}
+	return h.k.clearVoteCommitments(ctx, proposalID)
+}
@@ -1,26 +1,120 @@
 package keeper
 
 import (
+	"context"
 	"time"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	sdkv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
+	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 )
 
+// EndorsementKeeper defines the expected keeper reporting the effects of the
+// endorsement of the proposals by the Steering DAO.
+type EndorsementKeeper interface {
+	EndorsementExemptsQuorumExtension(ctx context.Context) bool
+	QuorumExtensionExempt(ctx context.Context, proposalID uint64) (bool, error)
+}
+
 // Keeper defines the governance module Keeper
 type Keeper struct {
 	*govkeeper.Keeper
+
+	cdc codec.BinaryCodec
+
+	endorsementKeeper EndorsementKeeper
+
+	// Laws holds the laws in force, by id.
+	Laws collections.Map[uint64, v1.Law]
+	// LawSequence provides the ids of the laws.
+	LawSequence collections.Sequence
+	// PendingLawEnactments holds the ids of the laws enacted or amended by the
+	// proposal being executed, until the proposal is known to the registry.
+	PendingLawEnactments collections.KeySet[uint64]
+	// ConstitutionVersions holds the versions of the constitution, by number.
+	ConstitutionVersions collections.Map[uint64, v1.ConstitutionVersion]
+	// PendingConstitutionVersions holds the numbers of the versions of the
+	// constitution created by the proposal being executed, until the proposal
+	// is known to the history.
+	PendingConstitutionVersions collections.KeySet[uint64]
+	// ConstitutionAmendmentPatchParams holds the params defining how the
+	// amendments of the constitution are applied, which the params of the
+	// x/gov module of the SDK can not hold.
+	ConstitutionAmendmentPatchParams collections.Item[v1.ConstitutionAmendmentPatchParams]
+	// CommitRevealVotings holds the commit-reveal voting of the proposals which
+	// opted in, by proposal id.
+	CommitRevealVotings collections.Map[uint64, v1.CommitRevealVoting]
+	// VoteCommitments holds the vote commitments not revealed yet, by proposal
+	// id and voter.
+	VoteCommitments collections.Map[collections.Pair[uint64, sdk.AccAddress], []byte]
+	// PendingVoteReveals holds the vote revealed by the message being executed,
+	// until the vote is cast. Any other vote on a proposal using commit-reveal
+	// voting is rejected.
+	PendingVoteReveals collections.KeySet[collections.Pair[uint64, sdk.AccAddress]]
+	// CommitRevealParams holds the params defining the commit-reveal voting of
+	// the proposals, which the params of the x/gov module of the SDK can not
+	// hold.
+	CommitRevealParams collections.Item[v1.CommitRevealParams]
 }
 
 // NewKeeper returns a governance keeper. It wraps the original Atom One SDK module for backward compatibility.
-func NewKeeper(k *govkeeper.Keeper) *Keeper {
-	return &Keeper{
-		Keeper: k,
+// The store service holds the state specific to the AtomOne gov module, like
+// the laws, the constitution history and the vote commitments.
+func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, k *govkeeper.Keeper) *Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	keeper := &Keeper{
+		Keeper:      k,
+		cdc:         cdc,
+		Laws:        collections.NewMap(sb, types.LawsKeyPrefix, "laws", collections.Uint64Key, codec.CollValue[v1.Law](cdc)),
+		LawSequence: collections.NewSequence(sb, types.LawSequenceKey, "law_sequence"),
+		PendingLawEnactments: collections.NewKeySet(
+			sb, types.PendingLawEnactmentsKeyPrefix, "pending_law_enactments", collections.Uint64Key,
+		),
+		ConstitutionVersions: collections.NewMap(
+			sb, types.ConstitutionVersionsKeyPrefix, "constitution_versions", collections.Uint64Key,
+			codec.CollValue[v1.ConstitutionVersion](cdc),
+		),
+		PendingConstitutionVersions: collections.NewKeySet(
+			sb, types.PendingConstitutionVersionsKeyPrefix, "pending_constitution_versions", collections.Uint64Key,
+		),
+		ConstitutionAmendmentPatchParams: collections.NewItem(
+			sb, types.ConstitutionAmendmentPatchParamsKey, "constitution_amendment_patch_params",
+			codec.CollValue[v1.ConstitutionAmendmentPatchParams](cdc),
+		),
+		CommitRevealVotings: collections.NewMap(
+			sb, types.CommitRevealVotingsKeyPrefix, "commit_reveal_votings", collections.Uint64Key,
+			codec.CollValue[v1.CommitRevealVoting](cdc),
+		),
+		VoteCommitments: collections.NewMap(
+			sb, types.VoteCommitmentsKeyPrefix, "vote_commitments",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), collections.BytesValue,
+		),
+		PendingVoteReveals: collections.NewKeySet(
+			sb, types.PendingVoteRevealsKeyPrefix, "pending_vote_reveals",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey),
+		),
+		CommitRevealParams: collections.NewItem(
+			sb, types.CommitRevealParamsKey, "commit_reveal_params", codec.CollValue[v1.CommitRevealParams](cdc),
+		),
 	}
+	if _, err := sb.Build(); err != nil {
+		panic(err)
+	}
+	return keeper
+}
+
+// SetEndorsementKeeper sets the keeper reporting the effects of the
+// endorsements in the queries.
+func (keeper *Keeper) SetEndorsementKeeper(ek EndorsementKeeper) *Keeper {
+	keeper.endorsementKeeper = ek
+	return keeper
 }
 
 // DeleteAndBurnDeposits implements GovKeeper.
@@ -98,6 +192,28 @@ func (keeper *Keeper) RemoveFromActiveProposalQueue(ctx sdk.Context, proposalID
 	}
 }
 
+// RemoveFromQuorumCheckQueue removes proposalID from the x/gov quorum check
+// queue, so that its participation is not checked at the quorum timeout and
+// its voting period is not extended when it reaches quorum late.
+func (keeper *Keeper) RemoveFromQuorumCheckQueue(ctx context.Context, proposalID uint64) error {
+	var keys []collections.Pair[time.Time, uint64]
+	err := keeper.Keeper.QuorumCheckQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64], _ sdkv1.QuorumCheckQueueEntry) (bool, error) {
+		if key.K2() == proposalID {
+			keys = append(keys, key)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := keeper.Keeper.QuorumCheckQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SetProposal implements GovKeeper.
 func (keeper *Keeper) SetProposal(ctx sdk.Context, proposal v1.Proposal) {
 	sdkProposal := v1.ConvertAtomOneProposalToSDK(&proposal)
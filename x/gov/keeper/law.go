@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// GetLaw returns the law in force lawID.
+func (keeper *Keeper) GetLaw(ctx context.Context, lawID uint64) (v1.Law, error) {
+	law, err := keeper.Laws.Get(ctx, lawID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.Law{}, types.ErrUnknownLaw.Wrapf("law %d not found", lawID)
+	}
+	return law, err
+}
+
+// EnactLaw enacts the law proposed by msg, or amends the law in force
+// msg.LawId if it is not zero, and returns the id of the law. The proposal
+// enacting or amending the law is recorded once its execution is complete, by
+// the AfterProposalVotingPeriodEnded hook.
+func (keeper *Keeper) EnactLaw(ctx context.Context, msg *v1.MsgProposeLaw) (uint64, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	var law v1.Law
+	if msg.LawId == 0 {
+		lawID, err := keeper.LawSequence.Next(ctx)
+		if err != nil {
+			return 0, err
+		}
+		law = v1.Law{Id: lawID, EnactmentTime: blockTime}
+	} else {
+		var err error
+		law, err = keeper.GetLaw(ctx, msg.LawId)
+		if err != nil {
+			return 0, err
+		}
+		law.LastAmendingProposalId = 0
+		law.LastAmendmentTime = &blockTime
+	}
+	law.Title = msg.Title
+	law.Text = msg.Text
+	law.Uri = msg.Uri
+	law.ContentHash = msg.ContentHash
+	if law.ContentHash == "" {
+		law.ContentHash = v1.LawContentHash(msg.Text)
+	}
+
+	if err := keeper.Laws.Set(ctx, law.Id, law); err != nil {
+		return 0, err
+	}
+	if err := keeper.PendingLawEnactments.Set(ctx, law.Id); err != nil {
+		return 0, err
+	}
+	return law.Id, nil
+}
+
+// RepealLaw removes the law in force lawID from the laws.
+func (keeper *Keeper) RepealLaw(ctx context.Context, lawID uint64) error {
+	has, err := keeper.Laws.Has(ctx, lawID)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return types.ErrUnknownLaw.Wrapf("law %d not found", lawID)
+	}
+	if err := keeper.Laws.Remove(ctx, lawID); err != nil {
+		return err
+	}
+	return keeper.PendingLawEnactments.Remove(ctx, lawID)
+}
+
+// recordLawProposal records proposalID as the proposal enacting or amending
+// the laws enacted or amended during its execution.
+func (keeper *Keeper) recordLawProposal(ctx context.Context, proposalID uint64) error {
+	var lawIDs []uint64
+	err := keeper.PendingLawEnactments.Walk(ctx, nil, func(lawID uint64) (bool, error) {
+		lawIDs = append(lawIDs, lawID)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, lawID := range lawIDs {
+		law, err := keeper.Laws.Get(ctx, lawID)
+		if err != nil {
+			return err
+		}
+		if law.EnactingProposalId == 0 {
+			law.EnactingProposalId = proposalID
+		}
+		if law.LastAmendmentTime != nil && law.LastAmendingProposalId == 0 {
+			law.LastAmendingProposalId = proposalID
+		}
+		if err := keeper.Laws.Set(ctx, lawID, law); err != nil {
+			return err
+		}
+		if err := keeper.PendingLawEnactments.Remove(ctx, lawID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
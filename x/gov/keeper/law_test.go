@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/atomone-hub/atomone/app/helpers"
+	"github.com/atomone-hub/atomone/x/gov/keeper"
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestLaws(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	k := app.GovKeeperWrapper
+	ms := keeper.NewMsgServerImpl(k)
+	qs := keeper.NewQueryServer(k)
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	hooks := k.GovHooks()
+
+	// enact a law with proposal 1
+	res, err := ms.ProposeLaw(ctx, v1.NewMsgProposeLaw(govAddr, "law", "text", "", "", 0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.LawId)
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(ctx, 1))
+
+	law, err := k.GetLaw(ctx, res.LawId)
+	require.NoError(t, err)
+	require.Equal(t, v1.Law{
+		Id:                 1,
+		Title:              "law",
+		Text:               "text",
+		ContentHash:        v1.LawContentHash("text"),
+		EnactingProposalId: 1,
+		EnactmentTime:      ctx.BlockTime(),
+	}, law)
+
+	// proposals without laws leave the laws untouched
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(ctx, 2))
+	law, err = k.GetLaw(ctx, res.LawId)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), law.EnactingProposalId)
+
+	// amend the law with proposal 3
+	amendmentTime := ctx.BlockTime().Add(time.Hour)
+	ctx = ctx.WithBlockTime(amendmentTime)
+	uriHash := v1.LawContentHash("amended text")
+	res, err = ms.ProposeLaw(ctx, v1.NewMsgProposeLaw(govAddr, "amended law", "", "ipfs://law", uriHash, 1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.LawId)
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(ctx, 3))
+
+	law, err = k.GetLaw(ctx, res.LawId)
+	require.NoError(t, err)
+	require.Equal(t, "amended law", law.Title)
+	require.Empty(t, law.Text)
+	require.Equal(t, "ipfs://law", law.Uri)
+	require.Equal(t, uriHash, law.ContentHash)
+	require.Equal(t, uint64(1), law.EnactingProposalId)
+	require.Equal(t, uint64(3), law.LastAmendingProposalId)
+	require.Equal(t, amendmentTime, *law.LastAmendmentTime)
+
+	// amending an unknown law fails
+	_, err = ms.ProposeLaw(ctx, v1.NewMsgProposeLaw(govAddr, "law", "text", "", "", 9))
+	require.EqualError(t, err, "law 9 not found: unknown law")
+
+	// enact a second law
+	res, err = ms.ProposeLaw(ctx, v1.NewMsgProposeLaw(govAddr, "law 2", "text 2", "", "", 0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.LawId)
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(ctx, 4))
+
+	lawsRes, err := qs.Laws(ctx, &v1.QueryLawsRequest{})
+	require.NoError(t, err)
+	require.Len(t, lawsRes.Laws, 2)
+
+	// repeal the first law
+	_, err = ms.RepealLaw(ctx, v1.NewMsgRepealLaw(govAddr, 1))
+	require.NoError(t, err)
+	_, err = qs.Law(ctx, &v1.QueryLawRequest{LawId: 1})
+	require.EqualError(t, err, "rpc error: code = NotFound desc = law 1 not found: unknown law")
+	lawsRes, err = qs.Laws(ctx, &v1.QueryLawsRequest{})
+	require.NoError(t, err)
+	require.Len(t, lawsRes.Laws, 1)
+	require.Equal(t, uint64(2), lawsRes.Laws[0].Id)
+
+	// repealing an unknown law fails
+	_, err = ms.RepealLaw(ctx, v1.NewMsgRepealLaw(govAddr, 1))
+	require.EqualError(t, err, "law 1 not found: unknown law")
+
+	// only the authority can repeal a law
+	_, err = ms.RepealLaw(ctx, v1.NewMsgRepealLaw(authtypes.NewModuleAddress("other"), 2))
+	require.ErrorContains(t, err, "invalid authority")
+}
+
+func TestLawRepealedBeforeProposalCompletes(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.NewUncachedContext(true, tmproto.Header{Time: time.Now()})
+	k := app.GovKeeperWrapper
+	ms := keeper.NewMsgServerImpl(k)
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	// a proposal enacting and repealing the same law leaves no law behind
+	res, err := ms.ProposeLaw(ctx, v1.NewMsgProposeLaw(govAddr, "law", "text", "", "", 0))
+	require.NoError(t, err)
+	_, err = ms.RepealLaw(ctx, v1.NewMsgRepealLaw(govAddr, res.LawId))
+	require.NoError(t, err)
+
+	require.NoError(t, k.GovHooks().AfterProposalVotingPeriodEnded(ctx, 1))
+	has, err := k.Laws.Has(ctx, res.LawId)
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -2,17 +2,24 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	sdkgovtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	sdkv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	sdkv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
+	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
 )
 
 type msgServer struct {
 	sdkv1.MsgServer
+
+	k *Keeper
 }
 
 // NewMsgServerImpl returns an implementation of the gov MsgServer interface
@@ -20,12 +27,13 @@ type msgServer struct {
 // We return an private type, as we do not want to do type casting in module.
 // Making it public adds no benefits.
 func NewMsgServerImpl(k *Keeper) *msgServer {
-	return &msgServer{MsgServer: govkeeper.NewMsgServerImpl(k.Keeper)}
+	return &msgServer{MsgServer: govkeeper.NewMsgServerImpl(k.Keeper), k: k}
 }
 
 var _ v1.MsgServer = msgServer{}
 
 // SubmitProposal implements the MsgServer.SubmitProposal method.
+// If msg.CommitReveal is set, the proposal uses commit-reveal voting.
 func (k msgServer) SubmitProposal(ctx context.Context, msg *v1.MsgSubmitProposal) (*v1.MsgSubmitProposalResponse, error) {
 	result, err := k.MsgServer.SubmitProposal(ctx, &sdkv1.MsgSubmitProposal{
 		Messages:       msg.GetMessages(),
@@ -39,6 +47,12 @@ func (k msgServer) SubmitProposal(ctx context.Context, msg *v1.MsgSubmitProposal
 		return nil, err
 	}
 
+	if msg.GetCommitReveal() {
+		if err := k.k.enableCommitRevealVoting(ctx, result.GetProposalId()); err != nil {
+			return nil, err
+		}
+	}
+
 	return &v1.MsgSubmitProposalResponse{
 		ProposalId: result.GetProposalId(),
 	}, nil
@@ -87,6 +101,50 @@ func (k msgServer) VoteWeighted(ctx context.Context, msg *v1.MsgVoteWeighted) (*
 	return &v1.MsgVoteWeightedResponse{}, nil
 }
 
+// CommitVote implements the MsgServer.CommitVote method.
+func (k msgServer) CommitVote(ctx context.Context, msg *v1.MsgCommitVote) (*v1.MsgCommitVoteResponse, error) {
+	voter, err := sdk.AccAddressFromBech32(msg.GetVoter())
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+
+	if err := k.k.CommitVote(ctx, msg.GetProposalId(), voter, msg.GetCommitment()); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCommitVoteResponse{}, nil
+}
+
+// RevealVote implements the MsgServer.RevealVote method.
+// Once checked against the commitment, the vote is cast as a weighted vote
+// and tallied like any other vote.
+func (k msgServer) RevealVote(ctx context.Context, msg *v1.MsgRevealVote) (*v1.MsgRevealVoteResponse, error) {
+	voter, err := sdk.AccAddressFromBech32(msg.GetVoter())
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+
+	if err := k.k.verifyVoteReveal(ctx, msg.GetProposalId(), voter, msg.GetOptions(), msg.GetSalt()); err != nil {
+		return nil, err
+	}
+
+	_, err = k.MsgServer.VoteWeighted(ctx, &sdkv1.MsgVoteWeighted{
+		ProposalId: msg.GetProposalId(),
+		Voter:      msg.GetVoter(),
+		Options:    v1.ConvertAtomOneWeightedVoteOptionsToSDK(msg.GetOptions()),
+		Metadata:   msg.GetMetadata(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.k.removeVoteCommitment(ctx, msg.GetProposalId(), voter); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgRevealVoteResponse{}, nil
+}
+
 // Deposit implements the MsgServer.Deposit method.
 func (k msgServer) Deposit(ctx context.Context, msg *v1.MsgDeposit) (*v1.MsgDepositResponse, error) {
 	_, err := k.MsgServer.Deposit(ctx, &sdkv1.MsgDeposit{
@@ -111,10 +169,18 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *v1.MsgUpdateParams) (*
 		return nil, err
 	}
 
+	if err := k.k.ConstitutionAmendmentPatchParams.Set(ctx, msg.Params.ConstitutionAmendmentPatch); err != nil {
+		return nil, err
+	}
+	if err := k.k.CommitRevealParams.Set(ctx, msg.Params.CommitReveal); err != nil {
+		return nil, err
+	}
+
 	return &v1.MsgUpdateParamsResponse{}, nil
 }
 
 // ProposeLaw implements the MsgServer.ProposeLaw method.
+// It enacts the law, or amends the law in force msg.LawId.
 func (k msgServer) ProposeLaw(ctx context.Context, msg *v1.MsgProposeLaw) (*v1.MsgProposeLawResponse, error) {
 	_, err := k.MsgServer.ProposeLaw(ctx, &sdkv1.MsgProposeLaw{
 		Authority: msg.GetAuthority(),
@@ -123,22 +189,85 @@ func (k msgServer) ProposeLaw(ctx context.Context, msg *v1.MsgProposeLaw) (*v1.M
 		return nil, err
 	}
 
-	return &v1.MsgProposeLawResponse{}, nil
+	lawID, err := k.k.EnactLaw(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgProposeLawResponse{LawId: lawID}, nil
+}
+
+// RepealLaw implements the MsgServer.RepealLaw method.
+func (k msgServer) RepealLaw(ctx context.Context, msg *v1.MsgRepealLaw) (*v1.MsgRepealLawResponse, error) {
+	if k.k.GetAuthority() != msg.GetAuthority() {
+		return nil, sdkgovtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", k.k.GetAuthority(), msg.GetAuthority())
+	}
+
+	if err := k.k.RepealLaw(ctx, msg.GetLawId()); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgRepealLawResponse{}, nil
 }
 
 // ProposeConstitutionAmendment implements the MsgServer.ProposeConstitutionAmendment method.
+// The amendment is located in the constitution as defined by the
+// ConstitutionAmendmentPatch params, and the x/gov module of the SDK, which
+// applies amendments strictly, is given the exact diff of the result.
 func (k msgServer) ProposeConstitutionAmendment(ctx context.Context, msg *v1.MsgProposeConstitutionAmendment) (*v1.MsgProposeConstitutionAmendmentResponse, error) {
-	_, err := k.MsgServer.ProposeConstitutionAmendment(ctx, &sdkv1.MsgProposeConstitutionAmendment{
-		Authority: msg.GetAuthority(),
-		Amendment: msg.GetAmendment(),
-	})
+	if k.k.GetAuthority() != msg.GetAuthority() {
+		return nil, sdkgovtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", k.k.GetAuthority(), msg.GetAuthority())
+	}
+
+	constitution, err := k.k.getConstitution(ctx)
 	if err != nil {
 		return nil, err
 	}
+	amended, hunks, err := k.k.PatchConstitution(ctx, msg.GetAmendment())
+	if err != nil {
+		return nil, err
+	}
+	emitConstitutionAmendmentHunkEvents(ctx, hunks)
+
+	// an amendment leaving the constitution unchanged has no diff, but still
+	// makes a new version of the constitution
+	if diff := types.GenerateUnifiedDiff(constitution, amended); diff != "" {
+		_, err = k.MsgServer.ProposeConstitutionAmendment(ctx, &sdkv1.MsgProposeConstitutionAmendment{
+			Authority: msg.GetAuthority(),
+			Amendment: diff,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.k.RecordConstitutionVersion(ctx); err != nil {
+		return nil, err
+	}
 
 	return &v1.MsgProposeConstitutionAmendmentResponse{}, nil
 }
 
+// emitConstitutionAmendmentHunkEvents reports the hunks of an amendment of the
+// constitution which were not applied at the lines given in their header, or
+// with some of their context lines ignored.
+func emitConstitutionAmendmentHunkEvents(ctx context.Context, hunks []types.AppliedHunk) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for i, hunk := range hunks {
+		if hunk.Offset == 0 && hunk.Fuzz == 0 {
+			continue
+		}
+		sdkCtx.Logger().Info("constitution amendment hunk applied with offset or fuzz",
+			"hunk", i+1, "offset", hunk.Offset, "fuzz", hunk.Fuzz)
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeConstitutionAmendmentHunk,
+			sdk.NewAttribute(types.AttributeKeyHunk, strconv.Itoa(i+1)),
+			sdk.NewAttribute(types.AttributeKeyOffset, strconv.Itoa(hunk.Offset)),
+			sdk.NewAttribute(types.AttributeKeyFuzz, strconv.Itoa(hunk.Fuzz)),
+		))
+	}
+}
+
 func (k msgServer) CreateGovernor(goCtx context.Context, msg *v1.MsgCreateGovernor) (*v1.MsgCreateGovernorResponse, error) {
 	_, err := k.MsgServer.CreateGovernor(goCtx, &sdkv1.MsgCreateGovernor{
 		Address:     msg.GetAddress(),
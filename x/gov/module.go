@@ -8,9 +8,11 @@ import (
 	"fmt"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -22,6 +24,7 @@ import (
 	sdkgov "github.com/cosmos/cosmos-sdk/x/gov"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 
+	"github.com/atomone-hub/atomone/x/gov/client/cli"
 	"github.com/atomone-hub/atomone/x/gov/keeper"
 	"github.com/atomone-hub/atomone/x/gov/types"
 	modulev1 "github.com/atomone-hub/atomone/x/gov/types/module"
@@ -45,7 +48,7 @@ func NewAppModuleBasic() AppModuleBasic {
 
 // Name returns the gov module's name.
 func (AppModuleBasic) Name() string {
-	return fmt.Sprintf("atomone-%s", types.ModuleName)
+	return types.AtomOneModuleName
 }
 
 // RegisterLegacyAminoCodec registers the gov module's types for the given codec.
@@ -80,6 +83,16 @@ func (a AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux
 	}
 }
 
+// GetTxCmd returns the root tx command of the AtomOne gov module.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd()
+}
+
+// GetQueryCmd returns the root query command of the AtomOne gov module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
 // RegisterInterfaces implements InterfaceModule.RegisterInterfaces
 func (a AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	v1.RegisterInterfaces(registry)
@@ -103,7 +116,10 @@ func NewAppModule(cdc codec.Codec, k *keeper.Keeper, accountKeeper authkeeper.Ac
 	}
 }
 
-var _ appmodule.AppModule = AppModule{}
+var (
+	_ appmodule.AppModule = AppModule{}
+	_ module.HasGenesis   = AppModule{}
+)
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() {}
@@ -120,8 +136,9 @@ func init() {
 type GovInputs struct {
 	depinject.In
 
-	Config *modulev1.Module
-	Cdc    codec.Codec
+	Config       *modulev1.Module
+	Cdc          codec.Codec
+	StoreService store.KVStoreService
 
 	GovKeeper     *govkeeper.Keeper
 	AccountKeeper authkeeper.AccountKeeper
@@ -135,7 +152,7 @@ type GovOutputs struct {
 }
 
 func ProvideModule(in GovInputs) GovOutputs {
-	k := keeper.NewKeeper(in.GovKeeper)
+	k := keeper.NewKeeper(in.Cdc, in.StoreService, in.GovKeeper)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper)
 
 	return GovOutputs{Module: m, Keeper: k}
@@ -159,6 +176,19 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	}
 }
 
+// InitGenesis performs genesis initialization for the laws.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) {
+	var genesisState v1.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, &genesisState)
+}
+
+// ExportGenesis returns the exported genesis state of the laws as raw bytes.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return cdc.MustMarshalJSON(gs)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return sdkgov.ConsensusVersion }
 
@@ -6,5 +6,15 @@ import (
 
 // x/gov module sentinel errors
 var (
-	ErrUnknownProposal = errors.Register(ModuleName, 180, "unknown proposal")
+	ErrUnknownProposal               = errors.Register(ModuleName, 180, "unknown proposal")
+	ErrUnknownLaw                    = errors.Register(ModuleName, 181, "unknown law")
+	ErrUnknownConstitutionVersion    = errors.Register(ModuleName, 182, "unknown constitution version")
+	ErrInvalidConstitutionAmendment  = errors.Register(ModuleName, 183, "invalid constitution amendment")
+	ErrConstitutionAmendmentConflict = errors.Register(ModuleName, 184, "constitution amendment conflict")
+	ErrCommitRevealDisabled          = errors.Register(ModuleName, 185, "commit-reveal voting disabled")
+	ErrNotCommitRevealProposal       = errors.Register(ModuleName, 186, "proposal does not use commit-reveal voting")
+	ErrCommitRevealProposal          = errors.Register(ModuleName, 187, "proposal uses commit-reveal voting")
+	ErrWrongVotingPhase              = errors.Register(ModuleName, 188, "wrong commit-reveal voting phase")
+	ErrUnknownVoteCommitment         = errors.Register(ModuleName, 189, "unknown vote commitment")
+	ErrInvalidVoteReveal             = errors.Register(ModuleName, 190, "vote reveal does not match the commitment")
 )
@@ -0,0 +1,11 @@
+package types
+
+// Event types for the gov module
+const (
+	EventTypeConstitutionAmendmentHunk = "constitution_amendment_hunk"
+	EventTypeCommitVote                = "commit_vote"
+
+	AttributeKeyHunk   = "hunk"
+	AttributeKeyOffset = "offset"
+	AttributeKeyFuzz   = "fuzz"
+)
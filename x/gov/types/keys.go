@@ -1,11 +1,35 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName is the name of the module
 	ModuleName = "gov"
 
+	// AtomOneModuleName is the name of the AtomOne gov module, which wraps the
+	// x/gov module of the SDK.
+	AtomOneModuleName = "atomone-" + ModuleName
+
 	// StoreKey is the store key string for gov
 	StoreKey = ModuleName
 
+	// AtomOneStoreKey is the store key string for the state the AtomOne gov
+	// module holds on top of the x/gov module of the SDK, like the laws and the
+	// constitution history.
+	AtomOneStoreKey = "atomonegov"
+
 	RouterKey = ModuleName
 )
+
+var (
+	LawsKeyPrefix                        = collections.NewPrefix(0)
+	LawSequenceKey                       = collections.NewPrefix(1)
+	PendingLawEnactmentsKeyPrefix        = collections.NewPrefix(2)
+	ConstitutionVersionsKeyPrefix        = collections.NewPrefix(3)
+	PendingConstitutionVersionsKeyPrefix = collections.NewPrefix(4)
+	ConstitutionAmendmentPatchParamsKey  = collections.NewPrefix(5)
+	CommitRevealVotingsKeyPrefix         = collections.NewPrefix(6)
+	VoteCommitmentsKeyPrefix             = collections.NewPrefix(7)
+	PendingVoteRevealsKeyPrefix          = collections.NewPrefix(8)
+	CommitRevealParamsKey                = collections.NewPrefix(9)
+)
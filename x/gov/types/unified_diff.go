@@ -1,7 +1,9 @@
 package types
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -203,16 +205,16 @@ func applyHunks(srcStr string, hunks []Hunk) ([]string, error) {
 	result := make([]string, 0)
 	srcIndex := 0
 
-	for _, hunk := range hunks {
+	for i, hunk := range hunks {
 		// Validate hunk.SrcLine is within bounds
 		if hunk.SrcLine > len(srcLines) {
-			return nil, fmt.Errorf("hunk starts at line %d but source only has %d lines", hunk.SrcLine+1, len(srcLines))
+			return nil, fmt.Errorf("hunk %d starts at line %d but source only has %d lines", i+1, hunk.SrcLine+1, len(srcLines))
 		}
 
 		// Add unchanged lines before the hunk
 		for srcIndex < hunk.SrcLine {
 			if srcIndex >= len(srcLines) {
-				return nil, fmt.Errorf("source index %d exceeds source length %d", srcIndex, len(srcLines))
+				return nil, fmt.Errorf("hunk %d: source index %d exceeds source length %d", i+1, srcIndex, len(srcLines))
 			}
 			result = append(result, srcLines[srcIndex])
 			srcIndex++
@@ -230,27 +232,27 @@ func applyHunks(srcStr string, hunks []Hunk) ([]string, error) {
 			case ' ':
 				// Context line, should match source
 				if srcIndex >= len(srcLines) {
-					return nil, fmt.Errorf("context line at hunk position exceeds source length (srcIndex: %d, srcLines: %d)", srcIndex, len(srcLines))
+					return nil, fmt.Errorf("hunk %d: context line at hunk position exceeds source length (srcIndex: %d, srcLines: %d)", i+1, srcIndex, len(srcLines))
 				}
 				if srcLines[srcIndex] != content {
-					return nil, fmt.Errorf("context line mismatch at line %d", srcIndex+1)
+					return nil, fmt.Errorf("hunk %d: context line mismatch at line %d: expected %q, got %q", i+1, srcIndex+1, content, srcLines[srcIndex])
 				}
 				result = append(result, content)
 				srcIndex++
 			case '-':
 				// Deletion, skip source line
 				if srcIndex >= len(srcLines) {
-					return nil, fmt.Errorf("deletion line at hunk position exceeds source length (srcIndex: %d, srcLines: %d)", srcIndex, len(srcLines))
+					return nil, fmt.Errorf("hunk %d: deletion line at hunk position exceeds source length (srcIndex: %d, srcLines: %d)", i+1, srcIndex, len(srcLines))
 				}
 				if srcLines[srcIndex] != content {
-					return nil, fmt.Errorf("deletion line mismatch at line %d", srcIndex+1)
+					return nil, fmt.Errorf("hunk %d: deletion line mismatch at line %d: expected %q, got %q", i+1, srcIndex+1, content, srcLines[srcIndex])
 				}
 				srcIndex++
 			case '+':
 				// Insertion, add to result
 				result = append(result, content)
 			default:
-				return nil, fmt.Errorf("invalid diff line: %s", line)
+				return nil, fmt.Errorf("hunk %d: invalid diff line: %s", i+1, line)
 			}
 		}
 	}
@@ -281,3 +283,337 @@ func ApplyUnifiedDiff(src, diffStr string) (string, error) {
 
 	return strings.Join(resultLines, "\n"), nil
 }
+
+// AppliedHunk reports where a hunk has been applied by
+// ApplyUnifiedDiffFuzzy.
+type AppliedHunk struct {
+	// Offset is the number of lines between the lines given in the hunk header
+	// and the lines the hunk has been applied to.
+	Offset int
+	// Fuzz is the number of context lines ignored at the start and at the end
+	// of the hunk.
+	Fuzz int
+}
+
+// ApplyUnifiedDiffFuzzy applies a unified diff patch to the src string and
+// returns the result, like ApplyUnifiedDiff, but locates the hunks like GNU
+// patch does. A hunk which does not apply at the lines given in its header,
+// shifted by the offset of the previous hunk, is searched for within
+// maxOffset lines of these lines, first forward then backward. If it is not
+// found, the search is repeated ignoring up to maxFuzz context lines at the
+// start and at the end of the hunk. The offset and fuzz used for each hunk are
+// returned along with the result.
+// Does not make use of any external libraries to ensure deterministic behavior.
+func ApplyUnifiedDiffFuzzy(src, diffStr string, maxOffset, maxFuzz int) (string, []AppliedHunk, error) {
+	hunks, err := ParseUnifiedDiff(diffStr)
+	if err != nil {
+		return "", nil, err
+	}
+
+	resultLines, applied, err := applyHunksFuzzy(src, hunks, maxOffset, maxFuzz)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return strings.Join(resultLines, "\n"), applied, nil
+}
+
+// applyHunksFuzzy applies the parsed hunks to the source lines, locating each
+// hunk within maxOffset lines and maxFuzz context lines.
+func applyHunksFuzzy(srcStr string, hunks []Hunk, maxOffset, maxFuzz int) ([]string, []AppliedHunk, error) {
+	srcLines := strings.Split(srcStr, "\n")
+	result := make([]string, 0, len(srcLines))
+	applied := make([]AppliedHunk, 0, len(hunks))
+	srcIndex := 0
+	offset := 0
+
+	for i, hunk := range hunks {
+		lines := hunkChangeLines(hunk)
+		expected := max(hunk.SrcLine, 0) + offset
+		pos, fuzz, found := locateHunk(srcLines, srcIndex, expected, lines, maxOffset, maxFuzz)
+		if !found {
+			return nil, nil, fmt.Errorf("hunk %d does not apply within %d lines of line %d with a fuzz of %d",
+				i+1, maxOffset, expected+1, maxFuzz)
+		}
+
+		// Add unchanged lines before the hunk
+		result = append(result, srcLines[srcIndex:pos]...)
+		srcIndex = pos
+
+		// Apply hunk lines, the context lines are taken from the source since
+		// the ignored ones may not match
+		lead, trail := fuzzContextLines(lines, fuzz)
+		for _, line := range lines[lead : len(lines)-trail] {
+			switch line[0] {
+			case ' ':
+				result = append(result, srcLines[srcIndex])
+				srcIndex++
+			case '-':
+				srcIndex++
+			case '+':
+				result = append(result, line[1:])
+			}
+		}
+
+		offset = pos - lead - max(hunk.SrcLine, 0)
+		applied = append(applied, AppliedHunk{Offset: offset, Fuzz: fuzz})
+	}
+
+	// Add any remaining lines
+	result = append(result, srcLines[srcIndex:]...)
+
+	return result, applied, nil
+}
+
+// hunkChangeLines returns the lines of the hunk, without the empty lines which
+// do not contribute to the hunk.
+func hunkChangeLines(hunk Hunk) []string {
+	lines := make([]string, 0, len(hunk.Lines))
+	for _, line := range hunk.Lines {
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// fuzzContextLines returns the number of context lines ignored at the start
+// and at the end of the hunk lines with the given fuzz.
+func fuzzContextLines(lines []string, fuzz int) (lead, trail int) {
+	for lead < fuzz && lead < len(lines) && lines[lead][0] == ' ' {
+		lead++
+	}
+	for trail < fuzz && trail < len(lines)-lead && lines[len(lines)-1-trail][0] == ' ' {
+		trail++
+	}
+	return lead, trail
+}
+
+// locateHunk returns the position in the source lines, not before minPos,
+// where the hunk lines apply with the smallest fuzz, and the closest to the
+// expected position for that fuzz.
+func locateHunk(srcLines []string, minPos, expected int, lines []string, maxOffset, maxFuzz int) (pos, fuzz int, found bool) {
+	prevLead, prevTrail := -1, -1
+	for fuzz = 0; fuzz <= maxFuzz; fuzz++ {
+		lead, trail := fuzzContextLines(lines, fuzz)
+		if lead == prevLead && trail == prevTrail {
+			// no more context lines to ignore
+			break
+		}
+		prevLead, prevTrail = lead, trail
+
+		trimmed := lines[lead : len(lines)-trail]
+		first := expected + lead
+		for d := 0; d <= maxOffset; d++ {
+			if first+d > len(srcLines) && first-d < minPos {
+				break
+			}
+			if hunkMatches(srcLines, minPos, first+d, trimmed) {
+				return first + d, fuzz, true
+			}
+			if d > 0 && hunkMatches(srcLines, minPos, first-d, trimmed) {
+				return first - d, fuzz, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// hunkMatches returns true if the context and deletion lines of the hunk lines
+// match the source lines at pos, which must not be before minPos.
+func hunkMatches(srcLines []string, minPos, pos int, lines []string) bool {
+	if pos < minPos || pos > len(srcLines) {
+		return false
+	}
+	srcIndex := pos
+	for _, line := range lines {
+		if line[0] == '+' {
+			continue
+		}
+		if srcIndex >= len(srcLines) || srcLines[srcIndex] != line[1:] {
+			return false
+		}
+		srcIndex++
+	}
+	return true
+}
+
+// diffContextLines is the number of unchanged lines surrounding the changes in
+// the hunks of the diffs generated by GenerateUnifiedDiff.
+const diffContextLines = 3
+
+// GenerateUnifiedDiff returns a unified diff patch turning the src string into
+// the dst string, that ApplyUnifiedDiff accepts. It returns an empty string if
+// src and dst are identical.
+// Does not make use of any external libraries to ensure deterministic behavior.
+func GenerateUnifiedDiff(src, dst string) string {
+	if src == dst {
+		return ""
+	}
+	srcLines := strings.Split(src, "\n")
+	dstLines := strings.Split(dst, "\n")
+	ops := diffLines(srcLines, dstLines)
+
+	var sb strings.Builder
+	sb.WriteString("--- src\n+++ dst\n")
+
+	// srcIndexes[i] and dstIndexes[i] are the indexes in the source and
+	// destination lines of ops[i]
+	srcIndexes := make([]int, len(ops)+1)
+	dstIndexes := make([]int, len(ops)+1)
+	for i, op := range ops {
+		srcIndexes[i+1], dstIndexes[i+1] = srcIndexes[i], dstIndexes[i]
+		if op[0] != '+' {
+			srcIndexes[i+1]++
+		}
+		if op[0] != '-' {
+			dstIndexes[i+1]++
+		}
+	}
+
+	for i := 0; i < len(ops); {
+		if ops[i][0] == ' ' {
+			i++
+			continue
+		}
+		// ops[i] is the first change of a new hunk, extend the hunk while the
+		// next change is close enough for their contexts to overlap
+		start := max(0, i-diffContextLines)
+		end := i
+		for j := i; j < len(ops) && j <= end+2*diffContextLines+1; j++ {
+			if ops[j][0] != ' ' {
+				end = j
+			}
+		}
+		end = min(len(ops), end+1+diffContextLines)
+
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n",
+			srcIndexes[start]+1, srcIndexes[end]-srcIndexes[start],
+			dstIndexes[start]+1, dstIndexes[end]-dstIndexes[start])
+		for _, op := range ops[start:end] {
+			sb.WriteString(op)
+			sb.WriteByte('\n')
+		}
+		i = end
+	}
+	return sb.String()
+}
+
+// diffLines returns the shortest edit script turning the src lines into the
+// dst lines, as diff lines prefixed with ' ', '-' or '+'. Deletions come
+// before insertions in each change.
+func diffLines(src, dst []string) []string {
+	// the common prefix and suffix are unchanged, only the lines in between
+	// need to be compared
+	prefix := 0
+	for prefix < len(src) && prefix < len(dst) && src[prefix] == dst[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(src)-prefix && suffix < len(dst)-prefix &&
+		src[len(src)-1-suffix] == dst[len(dst)-1-suffix] {
+		suffix++
+	}
+	ops := make([]string, 0, len(src)+len(dst))
+	for _, line := range src[:prefix] {
+		ops = append(ops, " "+line)
+	}
+	ops = append(ops, diffChangedLines(src[prefix:len(src)-suffix], dst[prefix:len(dst)-suffix])...)
+	for _, line := range src[len(src)-suffix:] {
+		ops = append(ops, " "+line)
+	}
+	return ops
+}
+
+// diffChangedLines returns the shortest edit script turning the src lines into
+// the dst lines, computed from their longest common subsequence. The
+// subsequence is found with Hirschberg's algorithm, which only needs memory
+// linear in the number of lines, and the deletions are then moved before the
+// insertions in each change.
+func diffChangedLines(src, dst []string) []string {
+	ops := appendLCSOps(make([]string, 0, len(src)+len(dst)), src, dst)
+	for i := 0; i < len(ops); {
+		if ops[i][0] == ' ' {
+			i++
+			continue
+		}
+		end := i
+		for end < len(ops) && ops[end][0] != ' ' {
+			end++
+		}
+		// a sequence of deletions and insertions has the same effect in any
+		// order, as long as the order of the lines of each is kept. The
+		// deletions come first since '-' sorts after '+'.
+		slices.SortStableFunc(ops[i:end], func(a, b string) int {
+			return cmp.Compare(b[0], a[0])
+		})
+		i = end
+	}
+	return ops
+}
+
+// appendLCSOps appends to ops the lines of src and dst, prefixed with ' ' if
+// they belong to a longest common subsequence of src and dst, and with '-' or
+// '+' otherwise.
+func appendLCSOps(ops, src, dst []string) []string {
+	switch {
+	case len(src) == 0:
+		for _, line := range dst {
+			ops = append(ops, "+"+line)
+		}
+		return ops
+	case len(dst) == 0:
+		for _, line := range src {
+			ops = append(ops, "-"+line)
+		}
+		return ops
+	case len(src) == 1:
+		k := slices.Index(dst, src[0])
+		if k < 0 {
+			ops = append(ops, "-"+src[0])
+			return appendLCSOps(ops, nil, dst)
+		}
+		ops = appendLCSOps(ops, nil, dst[:k])
+		ops = append(ops, " "+src[0])
+		return appendLCSOps(ops, nil, dst[k+1:])
+	}
+
+	// split src in halves, and dst where the sum of the longest common
+	// subsequences of the halves with the parts of dst is maximal
+	mid := len(src) / 2
+	head := lcsLengths(src[:mid], dst)
+	tail := lcsLengths(reversed(src[mid:]), reversed(dst))
+	split := 0
+	for k := range head {
+		if head[k]+tail[len(dst)-k] > head[split]+tail[len(dst)-split] {
+			split = k
+		}
+	}
+	ops = appendLCSOps(ops, src[:mid], dst[:split])
+	return appendLCSOps(ops, src[mid:], dst[split:])
+}
+
+// lcsLengths returns the lengths of the longest common subsequences of src
+// and each prefix of dst, indexed by the length of the prefix.
+func lcsLengths(src, dst []string) []int {
+	prev := make([]int, len(dst)+1)
+	cur := make([]int, len(dst)+1)
+	for _, line := range src {
+		for j := range dst {
+			if line == dst[j] {
+				cur[j+1] = prev[j] + 1
+			} else {
+				cur[j+1] = max(prev[j+1], cur[j])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev
+}
+
+// reversed returns a copy of lines in reverse order.
+func reversed(lines []string) []string {
+	lines = slices.Clone(lines)
+	slices.Reverse(lines)
+	return lines
+}
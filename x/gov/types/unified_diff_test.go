@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -344,6 +348,394 @@ func TestParseUnifiedDiff(t *testing.T) {
 	}
 }
 
+func TestApplyUnifiedDiffErrors(t *testing.T) {
+	src := "Line one\nLine two\nLine three\nLine four\nLine five\nLine six\nLine seven\nLine eight"
+	tests := []struct {
+		name     string
+		diffStr  string
+		errorMsg string
+	}{
+		{
+			name: "Context line mismatch in second hunk",
+			diffStr: `@@ -1,2 +1,2 @@
+-Line one
++Line one modified
+ Line two
+@@ -7,2 +7,2 @@
+ Line 7
+-Line eight
++Line eight modified
+`,
+			errorMsg: `hunk 2: context line mismatch at line 7: expected "Line 7", got "Line seven"`,
+		},
+		{
+			name: "Deletion line mismatch",
+			diffStr: `@@ -2,1 +2,1 @@
+-Line 2
++Line two modified
+`,
+			errorMsg: `hunk 1: deletion line mismatch at line 2: expected "Line 2", got "Line two"`,
+		},
+		{
+			name: "Hunk beyond source length",
+			diffStr: `@@ -20,1 +20,1 @@
+ Line twenty
+`,
+			errorMsg: "hunk 1 starts at line 20 but source only has 8 lines",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ApplyUnifiedDiff(src, tt.diffStr)
+			require.EqualError(t, err, tt.errorMsg)
+		})
+	}
+}
+
+func TestApplyUnifiedDiffFuzzy(t *testing.T) {
+	src := "Line one\nLine two\nLine three\nLine four\nLine five\nLine six\nLine seven\nLine eight"
+	tests := []struct {
+		name      string
+		src       string
+		diffStr   string
+		maxOffset int
+		maxFuzz   int
+		expected  string
+		applied   []AppliedHunk
+		errorMsg  string
+	}{
+		{
+			name: "Apply at header lines",
+			src:  src,
+			diffStr: `@@ -3,3 +3,3 @@
+ Line three
+-Line four
++Line four modified
+ Line five
+`,
+			expected: "Line one\nLine two\nLine three\nLine four modified\nLine five\nLine six\nLine seven\nLine eight",
+			applied:  []AppliedHunk{{Offset: 0, Fuzz: 0}},
+		},
+		{
+			name: "Apply with forward offset",
+			src:  "Line zero\nLine zero bis\n" + src,
+			diffStr: `@@ -3,3 +3,3 @@
+ Line three
+-Line four
++Line four modified
+ Line five
+`,
+			maxOffset: 2,
+			expected:  "Line zero\nLine zero bis\nLine one\nLine two\nLine three\nLine four modified\nLine five\nLine six\nLine seven\nLine eight",
+			applied:   []AppliedHunk{{Offset: 2, Fuzz: 0}},
+		},
+		{
+			name: "Apply with backward offset",
+			src:  "Line three\nLine four\nLine five\nLine six\nLine seven\nLine eight",
+			diffStr: `@@ -3,3 +3,3 @@
+ Line three
+-Line four
++Line four modified
+ Line five
+`,
+			maxOffset: 2,
+			expected:  "Line three\nLine four modified\nLine five\nLine six\nLine seven\nLine eight",
+			applied:   []AppliedHunk{{Offset: -2, Fuzz: 0}},
+		},
+		{
+			name: "Offset beyond max offset",
+			src:  "Line zero\nLine zero bis\nLine zero ter\n" + src,
+			diffStr: `@@ -3,3 +3,3 @@
+ Line three
+-Line four
++Line four modified
+ Line five
+`,
+			maxOffset: 2,
+			errorMsg:  "hunk 1 does not apply within 2 lines of line 3 with a fuzz of 0",
+		},
+		{
+			name: "Offset of previous hunk carried to next hunk",
+			src:  "Line zero\n" + src,
+			diffStr: `@@ -1,2 +1,2 @@
+-Line one
++Line one modified
+ Line two
+@@ -6,3 +6,3 @@
+ Line six
+-Line seven
++Line seven modified
+ Line eight
+`,
+			maxOffset: 1,
+			expected:  "Line zero\nLine one modified\nLine two\nLine three\nLine four\nLine five\nLine six\nLine seven modified\nLine eight",
+			applied:   []AppliedHunk{{Offset: 1, Fuzz: 0}, {Offset: 1, Fuzz: 0}},
+		},
+		{
+			name: "Apply with fuzz",
+			src:  "Line one\nLine two\nLine three amended\nLine four\nLine five\nLine six\nLine seven\nLine eight",
+			diffStr: `@@ -3,3 +3,3 @@
+ Line three
+-Line four
++Line four modified
+ Line five
+`,
+			maxFuzz:  1,
+			expected: "Line one\nLine two\nLine three amended\nLine four modified\nLine five\nLine six\nLine seven\nLine eight",
+			applied:  []AppliedHunk{{Offset: 0, Fuzz: 1}},
+		},
+		{
+			name: "Apply with offset and fuzz",
+			src:  "Line zero\nLine one\nLine two\nLine three amended\nLine four\nLine five amended\nLine six",
+			diffStr: `@@ -3,3 +3,3 @@
+ Line three
+-Line four
++Line four modified
+ Line five
+`,
+			maxOffset: 1,
+			maxFuzz:   1,
+			expected:  "Line zero\nLine one\nLine two\nLine three amended\nLine four modified\nLine five amended\nLine six",
+			applied:   []AppliedHunk{{Offset: 1, Fuzz: 1}},
+		},
+		{
+			name: "Fuzz does not ignore deletion lines",
+			src:  src,
+			diffStr: `@@ -3,3 +3,3 @@
+ Line three
+-Line 4
++Line four modified
+ Line five
+`,
+			maxOffset: 10,
+			maxFuzz:   2,
+			errorMsg:  "hunk 1 does not apply within 10 lines of line 3 with a fuzz of 2",
+		},
+		{
+			name: "Smallest fuzz preferred over smallest offset",
+			src:  "Line a\nLine x\nLine c\nLine d\nLine a\nLine b\nLine c",
+			diffStr: `@@ -1,3 +1,3 @@
+ Line a
+-Line b
++Line b modified
+ Line c
+`,
+			maxOffset: 4,
+			maxFuzz:   1,
+			expected:  "Line a\nLine x\nLine c\nLine d\nLine a\nLine b modified\nLine c",
+			applied:   []AppliedHunk{{Offset: 4, Fuzz: 0}},
+		},
+		{
+			name: "Forward offset preferred over backward offset",
+			src:  "Line a\nLine b\nLine x\nLine y\nLine a\nLine b",
+			diffStr: `@@ -3,2 +3,2 @@
+-Line a
++Line a modified
+ Line b
+`,
+			maxOffset: 2,
+			expected:  "Line a\nLine b\nLine x\nLine y\nLine a modified\nLine b",
+			applied:   []AppliedHunk{{Offset: 2, Fuzz: 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, applied, err := ApplyUnifiedDiffFuzzy(tt.src, tt.diffStr, tt.maxOffset, tt.maxFuzz)
+			if tt.errorMsg != "" {
+				require.ErrorContains(t, err, tt.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+			require.Equal(t, tt.applied, applied)
+		})
+	}
+}
+
+// referenceApplyUnifiedDiffFuzzy is a naive implementation of
+// ApplyUnifiedDiffFuzzy: for each hunk, every position of the source is tried
+// and the best match is kept, i.e. the one with the smallest fuzz, then the
+// smallest offset, then the forward one.
+func referenceApplyUnifiedDiffFuzzy(src, diffStr string, maxOffset, maxFuzz int) (string, []AppliedHunk, error) {
+	hunks, err := ParseUnifiedDiff(diffStr)
+	if err != nil {
+		return "", nil, err
+	}
+	srcLines := strings.Split(src, "\n")
+	var (
+		result  []string
+		applied []AppliedHunk
+		srcPos  int
+		offset  int
+	)
+	for i, hunk := range hunks {
+		var lines []string
+		for _, line := range hunk.Lines {
+			if line != "" {
+				lines = append(lines, line)
+			}
+		}
+		start := max(hunk.SrcLine, 0)
+		expected := start + offset
+
+		type match struct{ pos, lead, trail, fuzz, distance int }
+		var best *match
+		var tried [][2]int
+		for fuzz := 0; fuzz <= maxFuzz && best == nil; fuzz++ {
+			lead, trail := 0, 0
+			for lead < fuzz && lead < len(lines) && strings.HasPrefix(lines[lead], " ") {
+				lead++
+			}
+			for trail < fuzz && lead+trail < len(lines) && strings.HasPrefix(lines[len(lines)-1-trail], " ") {
+				trail++
+			}
+			if slices.Contains(tried, [2]int{lead, trail}) {
+				break
+			}
+			tried = append(tried, [2]int{lead, trail})
+
+			var old []string
+			for _, line := range lines[lead : len(lines)-trail] {
+				if !strings.HasPrefix(line, "+") {
+					old = append(old, line[1:])
+				}
+			}
+			for pos := srcPos; pos+len(old) <= len(srcLines); pos++ {
+				distance := pos - (expected + lead)
+				if distance < -maxOffset || distance > maxOffset {
+					continue
+				}
+				if !slices.Equal(srcLines[pos:pos+len(old)], old) {
+					continue
+				}
+				m := match{pos: pos, lead: lead, trail: trail, fuzz: fuzz, distance: distance}
+				if best == nil || abs(m.distance) < abs(best.distance) ||
+					(abs(m.distance) == abs(best.distance) && m.distance > best.distance) {
+					best = &m
+				}
+			}
+		}
+		if best == nil {
+			return "", nil, fmt.Errorf("hunk %d does not apply within %d lines of line %d with a fuzz of %d",
+				i+1, maxOffset, expected+1, maxFuzz)
+		}
+
+		result = append(result, srcLines[srcPos:best.pos]...)
+		srcPos = best.pos
+		for _, line := range lines[best.lead : len(lines)-best.trail] {
+			switch line[0] {
+			case ' ':
+				result = append(result, srcLines[srcPos])
+				srcPos++
+			case '-':
+				srcPos++
+			case '+':
+				result = append(result, line[1:])
+			}
+		}
+		offset = best.pos - best.lead - start
+		applied = append(applied, AppliedHunk{Offset: offset, Fuzz: best.fuzz})
+	}
+	result = append(result, srcLines[srcPos:]...)
+	return strings.Join(result, "\n"), applied, nil
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// checkApplyUnifiedDiffFuzzy checks that ApplyUnifiedDiffFuzzy behaves like
+// the reference implementation when applying the diff of src and dst to
+// target, and that it applies the diff like ApplyUnifiedDiff when target is
+// src.
+func checkApplyUnifiedDiffFuzzy(t *testing.T, src, dst, target string, maxOffset, maxFuzz int) {
+	t.Helper()
+	diffStr := GenerateUnifiedDiff(src, dst)
+	if diffStr == "" {
+		return
+	}
+
+	result, applied, err := ApplyUnifiedDiffFuzzy(target, diffStr, maxOffset, maxFuzz)
+	expected, expectedApplied, expectedErr := referenceApplyUnifiedDiffFuzzy(target, diffStr, maxOffset, maxFuzz)
+	if expectedErr != nil {
+		require.EqualError(t, err, expectedErr.Error(), "src=%q dst=%q target=%q", src, dst, target)
+	} else {
+		require.NoError(t, err, "src=%q dst=%q target=%q", src, dst, target)
+		require.Equal(t, expected, result, "src=%q dst=%q target=%q", src, dst, target)
+		require.Equal(t, expectedApplied, applied, "src=%q dst=%q target=%q", src, dst, target)
+	}
+
+	if target == src {
+		require.NoError(t, err)
+		require.Equal(t, dst, result)
+		for _, a := range applied {
+			require.Equal(t, AppliedHunk{}, a)
+		}
+	}
+}
+
+func TestApplyUnifiedDiffFuzzyReference(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomLines := func(n int) []string {
+		lines := make([]string, n)
+		for i := range lines {
+			// a small alphabet makes the context lines ambiguous
+			lines[i] = fmt.Sprintf("line %d", r.Intn(5))
+		}
+		return lines
+	}
+	for i := 0; i < 5000; i++ {
+		srcLines := randomLines(r.Intn(20))
+		dstLines := slices.Clone(srcLines)
+		for j := r.Intn(4); j > 0; j-- {
+			pos := r.Intn(len(dstLines) + 1)
+			switch r.Intn(3) {
+			case 0:
+				dstLines = slices.Insert(dstLines, pos, randomLines(1+r.Intn(2))...)
+			case 1:
+				if pos < len(dstLines) {
+					dstLines = slices.Delete(dstLines, pos, pos+1)
+				}
+			case 2:
+				if pos < len(dstLines) {
+					dstLines[pos] = "changed"
+				}
+			}
+		}
+		targetLines := slices.Clone(srcLines)
+		for j := r.Intn(3); j > 0; j-- {
+			pos := r.Intn(len(targetLines) + 1)
+			if r.Intn(2) == 0 || pos == len(targetLines) {
+				targetLines = slices.Insert(targetLines, pos, randomLines(1+r.Intn(3))...)
+			} else {
+				targetLines = slices.Delete(targetLines, pos, pos+1)
+			}
+		}
+		checkApplyUnifiedDiffFuzzy(t,
+			strings.Join(srcLines, "\n"), strings.Join(dstLines, "\n"), strings.Join(targetLines, "\n"),
+			r.Intn(6), r.Intn(4))
+		// the diff always applies to its own source
+		checkApplyUnifiedDiffFuzzy(t,
+			strings.Join(srcLines, "\n"), strings.Join(dstLines, "\n"), strings.Join(srcLines, "\n"),
+			r.Intn(6), r.Intn(4))
+	}
+}
+
+func FuzzApplyUnifiedDiffFuzzy(f *testing.F) {
+	f.Add("a\nb\nc\nd", "a\nB\nc\nd", "x\na\nb\nc\nd", uint8(2), uint8(1))
+	f.Add("a\nb\nc\nd\ne", "a\nb\nd\ne", "a\nb\nc\nd\nE", uint8(0), uint8(2))
+	f.Add("a\na\na", "a\nb\na", "a\na\na\na", uint8(3), uint8(3))
+	f.Add("", "a", "b", uint8(1), uint8(0))
+	f.Fuzz(func(t *testing.T, src, dst, target string, maxOffset, maxFuzz uint8) {
+		checkApplyUnifiedDiffFuzzy(t, src, dst, target, int(maxOffset%16), int(maxFuzz%4))
+	})
+}
+
 // TestParseHunkHeader tests the parseHunkHeader function.
 func TestParseHunkHeader(t *testing.T) {
 	tests := []struct {
@@ -411,3 +803,138 @@ func TestParseHunkHeader(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		dst      string
+		expected string
+	}{
+		{
+			name:     "Identical",
+			src:      "Line one\nLine two",
+			dst:      "Line one\nLine two",
+			expected: "",
+		},
+		{
+			name: "Addition",
+			src:  "Line one\nLine two",
+			dst:  "Line one\nLine two\nLine three",
+			expected: `--- src
++++ dst
+@@ -1,2 +1,3 @@
+ Line one
+ Line two
++Line three
+`,
+		},
+		{
+			name: "Modification",
+			src:  "Line one\nLine two\nLine three",
+			dst:  "Line one\nLine two modified\nLine three",
+			expected: `--- src
++++ dst
+@@ -1,3 +1,3 @@
+ Line one
+-Line two
++Line two modified
+ Line three
+`,
+		},
+		{
+			name: "Multiple hunks",
+			src:  "Line one\nLine two\nLine three\nLine four\nLine five\nLine six\nLine seven\nLine eight\nLine nine\nLine ten",
+			dst:  "Line one modified\nLine two\nLine three\nLine four\nLine five\nLine six\nLine seven\nLine eight\nLine nine\nLine ten modified",
+			expected: `--- src
++++ dst
+@@ -1,4 +1,4 @@
+-Line one
++Line one modified
+ Line two
+ Line three
+ Line four
+@@ -7,4 +7,4 @@
+ Line seven
+ Line eight
+ Line nine
+-Line ten
++Line ten modified
+`,
+		},
+		{
+			name: "Close changes in a single hunk",
+			src:  "Line one\nLine two\nLine three\nLine four\nLine five\nLine six\nLine seven\nLine eight",
+			dst:  "Line one modified\nLine two\nLine three\nLine four\nLine five\nLine six\nLine seven\nLine eight modified",
+			expected: `--- src
++++ dst
+@@ -1,8 +1,8 @@
+-Line one
++Line one modified
+ Line two
+ Line three
+ Line four
+ Line five
+ Line six
+ Line seven
+-Line eight
++Line eight modified
+`,
+		},
+		{
+			name: "Empty source",
+			src:  "",
+			dst:  "Line one",
+			expected: `--- src
++++ dst
+@@ -1,1 +1,1 @@
+-
++Line one
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := GenerateUnifiedDiff(tt.src, tt.dst)
+			require.Equal(t, tt.expected, diff)
+			if diff == "" {
+				return
+			}
+			// the generated diff must turn src into dst
+			result, err := ApplyUnifiedDiff(tt.src, diff)
+			require.NoError(t, err)
+			require.Equal(t, tt.dst, result)
+		})
+	}
+}
+
+func TestGenerateUnifiedDiffLarge(t *testing.T) {
+	// the lines are compared in memory linear in their number, so the diff of
+	// large texts doesn't need a matrix of their lines
+	r := rand.New(rand.NewSource(1))
+	randomLines := func(n int) string {
+		lines := make([]string, n)
+		for i := range lines {
+			lines[i] = fmt.Sprintf("Line %d", r.Intn(8))
+		}
+		return strings.Join(lines, "\n")
+	}
+	src, dst := randomLines(3000), randomLines(3000)
+
+	diff := GenerateUnifiedDiff(src, dst)
+
+	result, err := ApplyUnifiedDiff(src, diff)
+	require.NoError(t, err)
+	require.Equal(t, dst, result)
+	// the deletions come before the insertions in each change
+	prev := byte(' ')
+	for _, line := range strings.Split(diff, "\n")[2:] {
+		if line != "" && line[0] == '-' {
+			require.NotEqual(t, byte('+'), prev, "insertion before deletion")
+		}
+		if line != "" {
+			prev = line[0]
+		}
+	}
+}
@@ -15,10 +15,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgDeposit{}, "atomone/v1/MsgDeposit")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "atomone/v1/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "atomone/v1/MsgVoteWeighted")
+	legacy.RegisterAminoMsg(cdc, &MsgCommitVote{}, "atomone/v1/MsgCommitVote")
+	legacy.RegisterAminoMsg(cdc, &MsgRevealVote{}, "atomone/v1/MsgRevealVote")
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "atomone/v1/MsgExecLegacyContent")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "atomone/x/gov/v1/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgProposeConstitutionAmendment{}, "atomone/x/gov/v1/MsgProposeAmendment")
 	legacy.RegisterAminoMsg(cdc, &MsgProposeLaw{}, "atomone/x/gov/v1/MsgProposeLaw")
+	legacy.RegisterAminoMsg(cdc, &MsgRepealLaw{}, "atomone/x/gov/v1/MsgRepealLaw")
 	legacy.RegisterAminoMsg(cdc, &MsgCreateGovernor{}, "atomone/v1/MsgCreateGovernor")
 	legacy.RegisterAminoMsg(cdc, &MsgEditGovernor{}, "atomone/v1/MsgEditGovernor")
 	legacy.RegisterAminoMsg(cdc, &MsgDelegateGovernor{}, "atomone/v1/MsgDelegateGovernor")
@@ -31,11 +34,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgSubmitProposal{},
 		&MsgVote{},
 		&MsgVoteWeighted{},
+		&MsgCommitVote{},
+		&MsgRevealVote{},
 		&MsgDeposit{},
 		&MsgExecLegacyContent{},
 		&MsgUpdateParams{},
 		&MsgProposeConstitutionAmendment{},
 		&MsgProposeLaw{},
+		&MsgRepealLaw{},
 		&MsgCreateGovernor{},
 		&MsgEditGovernor{},
 		&MsgDelegateGovernor{},
@@ -156,6 +156,73 @@ func ValidateGenesis(data *GenesisState) error {
 		return nil
 	})
 
+	// weed out invalid and duplicate laws
+	errGroup.Go(func() error {
+		lawIDs := make(map[uint64]struct{})
+		for _, l := range data.Laws {
+			if err := l.Validate(); err != nil {
+				return err
+			}
+			if _, ok := lawIDs[l.Id]; ok {
+				return fmt.Errorf("duplicate law id: %d", l.Id)
+			}
+
+			lawIDs[l.Id] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// verify the constitution history is ordered and gapless
+	errGroup.Go(func() error {
+		for i, v := range data.ConstitutionHistory {
+			if v.Version != uint64(i+1) {
+				return fmt.Errorf("invalid constitution version %d at position %d: versions must be numbered from 1 without gaps", v.Version, i)
+			}
+		}
+
+		return nil
+	})
+
+	// weed out duplicate commit-reveal votings, and invalid, duplicate and
+	// orphan vote commitments
+	errGroup.Go(func() error {
+		commitRevealProposalIDs := make(map[uint64]struct{})
+		for _, c := range data.CommitRevealVotings {
+			if _, ok := commitRevealProposalIDs[c.ProposalId]; ok {
+				return fmt.Errorf("duplicate commit-reveal voting: proposal %d", c.ProposalId)
+			}
+			if c.RevealPeriod <= 0 {
+				return fmt.Errorf("commit-reveal voting of proposal %d reveal period must be positive: %s", c.ProposalId, c.RevealPeriod)
+			}
+
+			commitRevealProposalIDs[c.ProposalId] = struct{}{}
+		}
+
+		type commitmentKey struct {
+			ProposalId uint64
+			Voter      string
+		}
+		commitmentIDs := make(map[commitmentKey]struct{})
+		for _, c := range data.VoteCommitments {
+			if err := c.Validate(); err != nil {
+				return err
+			}
+			if _, ok := commitRevealProposalIDs[c.ProposalId]; !ok {
+				return fmt.Errorf("vote commitment of %s on proposal %d has no commit-reveal voting", c.Voter, c.ProposalId)
+			}
+
+			ck := commitmentKey{c.ProposalId, c.Voter}
+			if _, ok := commitmentIDs[ck]; ok {
+				return fmt.Errorf("duplicate vote commitment of %s on proposal %d", c.Voter, c.ProposalId)
+			}
+
+			commitmentIDs[ck] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic()
@@ -6,6 +6,7 @@ package v1
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -70,6 +71,16 @@ type GenesisState struct {
 	Governors []*Governor `protobuf:"bytes,15,rep,name=governors,proto3" json:"governors,omitempty"`
 	// governance_delegations defines all the governance delegations present at genesis.
 	GovernanceDelegations []*GovernanceDelegation `protobuf:"bytes,16,rep,name=governance_delegations,json=governanceDelegations,proto3" json:"governance_delegations,omitempty"`
+	// laws defines all the laws in force at genesis.
+	Laws []Law `protobuf:"bytes,17,rep,name=laws,proto3" json:"laws"`
+	// constitution_history defines all the versions of the constitution at
+	// genesis. If empty, the history starts with the genesis constitution.
+	ConstitutionHistory []ConstitutionVersion `protobuf:"bytes,18,rep,name=constitution_history,json=constitutionHistory,proto3" json:"constitution_history"`
+	// commit_reveal_votings defines the commit-reveal voting of the proposals
+	// which opted in.
+	CommitRevealVotings []CommitRevealVoting `protobuf:"bytes,19,rep,name=commit_reveal_votings,json=commitRevealVotings,proto3" json:"commit_reveal_votings"`
+	// vote_commitments defines all the vote commitments not revealed yet.
+	VoteCommitments []VoteCommitment `protobuf:"bytes,20,rep,name=vote_commitments,json=voteCommitments,proto3" json:"vote_commitments"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -220,6 +231,34 @@ func (m *GenesisState) GetGovernanceDelegations() []*GovernanceDelegation {
 	return nil
 }
 
+func (m *GenesisState) GetLaws() []Law {
+	if m != nil {
+		return m.Laws
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConstitutionHistory() []ConstitutionVersion {
+	if m != nil {
+		return m.ConstitutionHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommitRevealVotings() []CommitRevealVoting {
+	if m != nil {
+		return m.CommitRevealVotings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteCommitments() []VoteCommitment {
+	if m != nil {
+		return m.VoteCommitments
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "atomone.gov.v1.GenesisState")
 }
@@ -227,44 +266,51 @@ func init() {
 func init() { proto.RegisterFile("atomone/gov/v1/genesis.proto", fileDescriptor_7737a96fb154b10d) }
 
 var fileDescriptor_7737a96fb154b10d = []byte{
-	// 580 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdd, 0x8a, 0xd3, 0x4e,
-	0x14, 0xc0, 0x9b, 0xfd, 0xe8, 0xbf, 0x9d, 0x7e, 0xfc, 0xeb, 0xb8, 0x5d, 0xc7, 0x75, 0x0d, 0x65,
-	0x51, 0x28, 0x42, 0x13, 0xbb, 0x0b, 0x7b, 0xe3, 0x95, 0xa5, 0x4b, 0x77, 0x41, 0xa1, 0x44, 0x51,
-	0xd0, 0x8b, 0x30, 0x4d, 0x86, 0xec, 0x40, 0x32, 0x13, 0x3a, 0xd3, 0xac, 0xfb, 0x16, 0x3e, 0x84,
-	0x8f, 0xe0, 0x43, 0x78, 0xb9, 0x78, 0xe5, 0xa5, 0xb4, 0x2f, 0x22, 0x99, 0x24, 0x6d, 0x9a, 0x8d,
-	0xe0, 0x5d, 0xcf, 0x39, 0xbf, 0xf3, 0x9b, 0x33, 0xd3, 0x43, 0xc0, 0x31, 0x96, 0x3c, 0xe0, 0x8c,
-	0x98, 0x1e, 0x8f, 0xcc, 0x68, 0x68, 0x7a, 0x84, 0x11, 0x41, 0x85, 0x11, 0xce, 0xb9, 0xe4, 0xb0,
-	0x9d, 0x56, 0x0d, 0x8f, 0x47, 0x46, 0x34, 0x3c, 0x42, 0x45, 0x9a, 0x47, 0x09, 0x79, 0xf4, 0xd8,
-	0xe1, 0x22, 0xe0, 0xc2, 0x56, 0x91, 0x99, 0x04, 0x49, 0xe9, 0xe4, 0x5b, 0x0d, 0x34, 0x27, 0x89,
-	0xf6, 0x9d, 0xc4, 0x92, 0xc0, 0x97, 0xe0, 0x40, 0x48, 0x3c, 0x97, 0x94, 0x79, 0x31, 0x1f, 0x72,
-	0x81, 0x7d, 0x9b, 0xba, 0x48, 0xeb, 0x69, 0xfd, 0x3d, 0x0b, 0x66, 0xb5, 0x69, 0x5a, 0xba, 0x72,
-	0xe1, 0x19, 0xa8, 0xb9, 0x24, 0xe4, 0x82, 0x4a, 0x81, 0x76, 0x7a, 0xbb, 0xfd, 0xc6, 0xe9, 0x23,
-	0x63, 0x7b, 0x34, 0x63, 0x9c, 0xd4, 0xad, 0x35, 0x08, 0x5f, 0x80, 0xfd, 0x88, 0x4b, 0x22, 0xd0,
-	0xae, 0xea, 0x38, 0x28, 0x76, 0x7c, 0xe0, 0x92, 0x58, 0x09, 0x02, 0xcf, 0x41, 0x3d, 0x9b, 0x44,
-	0xa0, 0x3d, 0xc5, 0xa3, 0x22, 0x9f, 0xcd, 0x63, 0x6d, 0x50, 0x78, 0x09, 0xda, 0xe9, 0x79, 0x76,
-	0x88, 0xe7, 0x38, 0x10, 0x68, 0xbf, 0xa7, 0xf5, 0x1b, 0xa7, 0x4f, 0xff, 0x32, 0xde, 0x54, 0x41,
-	0xa3, 0x1d, 0xa4, 0x59, 0x2d, 0x37, 0x9f, 0x82, 0x17, 0xa0, 0x15, 0xf1, 0xe4, 0x49, 0x12, 0x51,
-	0x55, 0x89, 0x8e, 0x4b, 0xa6, 0x8e, 0xdf, 0x66, 0xe3, 0x69, 0x46, 0xb9, 0x0c, 0x1c, 0x81, 0xa6,
-	0xc4, 0xbe, 0x7f, 0x9b, 0x59, 0xfe, 0x53, 0x96, 0x27, 0x45, 0xcb, 0xfb, 0x98, 0xc9, 0x49, 0x1a,
-	0x72, 0x93, 0x80, 0x06, 0xa8, 0xa6, 0xdd, 0x35, 0xd5, 0x7d, 0x78, 0xef, 0x25, 0x54, 0xd5, 0x4a,
-	0x29, 0x78, 0x02, 0x9a, 0x0e, 0x67, 0x42, 0x52, 0xb9, 0x90, 0x94, 0x33, 0x54, 0xef, 0x69, 0xfd,
-	0xba, 0xb5, 0x95, 0x83, 0x97, 0xa0, 0xe3, 0x63, 0x21, 0xed, 0x80, 0x32, 0x3b, 0xbd, 0x38, 0x02,
-	0xca, 0xae, 0x17, 0xed, 0x6f, 0xb0, 0x90, 0x6f, 0x29, 0xcb, 0xfe, 0xd0, 0xb6, 0xbf, 0x15, 0xc3,
-	0x8f, 0x00, 0xad, 0x4d, 0x94, 0x51, 0x49, 0xb1, 0xbf, 0x36, 0x36, 0xfe, 0xc9, 0xd8, 0x4d, 0x8d,
-	0x57, 0x49, 0x77, 0x26, 0x7e, 0x05, 0x1e, 0x84, 0xf1, 0xe6, 0x39, 0x34, 0xc4, 0xf1, 0xcc, 0x36,
-	0x09, 0x30, 0x6a, 0xc6, 0x77, 0x19, 0xb5, 0x7f, 0x7e, 0x1f, 0x80, 0x74, 0xa9, 0xc7, 0xc4, 0xb1,
-	0x3a, 0x5b, 0xe0, 0x45, 0x80, 0xa1, 0x07, 0xfa, 0xf9, 0xfb, 0xda, 0x38, 0x20, 0xcc, 0x0d, 0x08,
-	0x53, 0x7b, 0x51, 0x70, 0xb6, 0x4a, 0x9d, 0xcf, 0xf3, 0xfd, 0xaf, 0xb3, 0xf6, 0x69, 0xf1, 0xa0,
-	0x11, 0xe8, 0xfa, 0xf8, 0xa6, 0xc4, 0xda, 0x2e, 0xb5, 0x3e, 0xf4, 0xf1, 0xcd, 0x3d, 0xc7, 0x39,
-	0xa8, 0x7b, 0x3c, 0x22, 0x73, 0xc6, 0xe7, 0x02, 0xfd, 0x5f, 0xbe, 0xed, 0x93, 0x14, 0xb0, 0x36,
-	0x28, 0xfc, 0x0c, 0x0e, 0x93, 0x00, 0x33, 0x87, 0xd8, 0x2e, 0xf1, 0x89, 0xa7, 0x9c, 0x02, 0x75,
-	0x94, 0xe4, 0x59, 0xb9, 0x24, 0xa6, 0xc7, 0x6b, 0xd8, 0xea, 0x7a, 0x25, 0x59, 0x31, 0x9a, 0xfc,
-	0x58, 0xea, 0xda, 0xdd, 0x52, 0xd7, 0x7e, 0x2f, 0x75, 0xed, 0xeb, 0x4a, 0xaf, 0xdc, 0xad, 0xf4,
-	0xca, 0xaf, 0x95, 0x5e, 0xf9, 0x34, 0xf0, 0xa8, 0xbc, 0x5e, 0xcc, 0x0c, 0x87, 0x07, 0x66, 0x7a,
-	0xc0, 0xe0, 0x7a, 0x31, 0xcb, 0x7e, 0x9b, 0x5f, 0xd4, 0xe7, 0x48, 0xde, 0x86, 0x44, 0x98, 0xd1,
-	0x70, 0x56, 0x55, 0x9f, 0x9d, 0xb3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb9, 0xc2, 0x02, 0x06,
-	0xdb, 0x04, 0x00, 0x00,
+	// 700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdf, 0x4e, 0xdb, 0x3a,
+	0x18, 0xc0, 0x1b, 0x28, 0x1c, 0xea, 0x96, 0x52, 0xdc, 0x96, 0xe3, 0xc3, 0x61, 0x5d, 0xc5, 0x36,
+	0xa9, 0x9a, 0xd4, 0x64, 0x80, 0xc4, 0xcd, 0xae, 0x56, 0x40, 0x80, 0xb4, 0x69, 0x28, 0x9b, 0x98,
+	0xb4, 0x21, 0x45, 0x26, 0xb5, 0x82, 0xa5, 0x24, 0x8e, 0x62, 0x93, 0x8e, 0xb7, 0xd8, 0xc3, 0xec,
+	0x21, 0xb8, 0x44, 0xbb, 0xda, 0xd5, 0x84, 0xe0, 0x45, 0xa6, 0xd8, 0x49, 0x9b, 0xa4, 0x99, 0xb4,
+	0xbb, 0xf8, 0xfb, 0x7e, 0xdf, 0xcf, 0x9f, 0xff, 0xc4, 0x60, 0x0b, 0x0b, 0xe6, 0x31, 0x9f, 0x18,
+	0x0e, 0x8b, 0x8c, 0x68, 0xc7, 0x70, 0x88, 0x4f, 0x38, 0xe5, 0x7a, 0x10, 0x32, 0xc1, 0x60, 0x33,
+	0xc9, 0xea, 0x0e, 0x8b, 0xf4, 0x68, 0x67, 0x13, 0x15, 0x69, 0x16, 0x29, 0x72, 0xf3, 0x3f, 0x9b,
+	0x71, 0x8f, 0x71, 0x4b, 0x8e, 0x0c, 0x35, 0x48, 0x52, 0x1d, 0x87, 0x39, 0x4c, 0xc5, 0xe3, 0x2f,
+	0x15, 0xdd, 0xbe, 0x07, 0xa0, 0x71, 0xac, 0x26, 0xfb, 0x20, 0xb0, 0x20, 0xf0, 0x15, 0xe8, 0x70,
+	0x81, 0x43, 0x41, 0x7d, 0x27, 0xb6, 0x04, 0x8c, 0x63, 0xd7, 0xa2, 0x63, 0xa4, 0xf5, 0xb5, 0x41,
+	0xd5, 0x84, 0x69, 0xee, 0x2c, 0x49, 0x9d, 0x8e, 0xe1, 0x1e, 0x58, 0x19, 0x93, 0x80, 0x71, 0x2a,
+	0x38, 0x5a, 0xe8, 0x2f, 0x0e, 0xea, 0xbb, 0xff, 0xea, 0xf9, 0x86, 0xf5, 0x43, 0x95, 0x37, 0xa7,
+	0x20, 0x7c, 0x09, 0x96, 0x22, 0x26, 0x08, 0x47, 0x8b, 0xb2, 0xa2, 0x53, 0xac, 0x38, 0x67, 0x82,
+	0x98, 0x0a, 0x81, 0xfb, 0xa0, 0x96, 0x76, 0xc2, 0x51, 0x55, 0xf2, 0xa8, 0xc8, 0xa7, 0xfd, 0x98,
+	0x33, 0x14, 0x9e, 0x80, 0x66, 0x32, 0x9f, 0x15, 0xe0, 0x10, 0x7b, 0x1c, 0x2d, 0xf5, 0xb5, 0x41,
+	0x7d, 0xf7, 0xc9, 0x1f, 0xda, 0x3b, 0x93, 0xd0, 0x68, 0x01, 0x69, 0xe6, 0xea, 0x38, 0x1b, 0x82,
+	0x47, 0x60, 0x35, 0x62, 0x6a, 0x4b, 0x94, 0x68, 0x59, 0x8a, 0xb6, 0x4a, 0xba, 0x8e, 0xf7, 0x66,
+	0xe6, 0x69, 0x44, 0x99, 0x08, 0x1c, 0x81, 0x86, 0xc0, 0xae, 0x7b, 0x93, 0x5a, 0xfe, 0x91, 0x96,
+	0xff, 0x8b, 0x96, 0x8f, 0x31, 0x93, 0x91, 0xd4, 0xc5, 0x2c, 0x00, 0x75, 0xb0, 0x9c, 0x54, 0xaf,
+	0xc8, 0xea, 0x8d, 0xb9, 0x9d, 0x90, 0x59, 0x33, 0xa1, 0xe0, 0x36, 0x68, 0xd8, 0xcc, 0xe7, 0x82,
+	0x8a, 0x6b, 0x41, 0x99, 0x8f, 0x6a, 0x7d, 0x6d, 0x50, 0x33, 0x73, 0x31, 0x78, 0x02, 0x5a, 0x2e,
+	0xe6, 0xc2, 0xf2, 0xa8, 0x6f, 0x25, 0x0b, 0x47, 0x40, 0xda, 0x7b, 0x45, 0xfb, 0x5b, 0xcc, 0xc5,
+	0x3b, 0xea, 0xa7, 0x07, 0xda, 0x74, 0x73, 0x63, 0xf8, 0x09, 0xa0, 0xa9, 0x89, 0xfa, 0x54, 0x50,
+	0xec, 0x4e, 0x8d, 0xf5, 0xbf, 0x32, 0x76, 0x13, 0xe3, 0xa9, 0xaa, 0x4e, 0xc5, 0xaf, 0xc1, 0x7a,
+	0x10, 0xdf, 0x3c, 0x9b, 0x06, 0x38, 0xee, 0xd9, 0x22, 0x1e, 0x46, 0x8d, 0x78, 0x2d, 0xa3, 0xe6,
+	0x8f, 0xef, 0x43, 0x90, 0x5c, 0xf5, 0x43, 0x62, 0x9b, 0xad, 0x1c, 0x78, 0xe4, 0x61, 0xe8, 0x80,
+	0x41, 0x76, 0xbd, 0x16, 0xf6, 0x88, 0x3f, 0xf6, 0x88, 0x2f, 0xac, 0x1c, 0x2a, 0x9d, 0xab, 0xa5,
+	0xce, 0x17, 0xd9, 0xfa, 0x37, 0x69, 0xf9, 0x59, 0x71, 0xa2, 0x11, 0xe8, 0xba, 0x78, 0x52, 0x62,
+	0x6d, 0x96, 0x5a, 0xdb, 0x2e, 0x9e, 0xcc, 0x39, 0xf6, 0x41, 0xcd, 0x61, 0x11, 0x09, 0x7d, 0x16,
+	0x72, 0xb4, 0x56, 0x7e, 0xdb, 0x8f, 0x13, 0xc0, 0x9c, 0xa1, 0xf0, 0x0b, 0xd8, 0x50, 0x03, 0xec,
+	0xdb, 0xc4, 0x1a, 0x13, 0x97, 0x38, 0xd2, 0xc9, 0x51, 0x4b, 0x4a, 0x9e, 0x97, 0x4b, 0x62, 0xfa,
+	0x70, 0x0a, 0x9b, 0x5d, 0xa7, 0x24, 0xca, 0xe1, 0x10, 0x54, 0x5d, 0x3c, 0xe1, 0x68, 0x5d, 0xaa,
+	0xda, 0xf3, 0x67, 0x38, 0x19, 0x55, 0x6f, 0x7f, 0x3d, 0xad, 0x98, 0x12, 0x83, 0x17, 0xa0, 0x93,
+	0xdb, 0xf0, 0x2b, 0xca, 0x05, 0x0b, 0x6f, 0x10, 0x94, 0xe5, 0xcf, 0x8a, 0xe5, 0x07, 0x19, 0xf6,
+	0x9c, 0x84, 0x9c, 0x32, 0x3f, 0xd1, 0xb5, 0xb3, 0x9a, 0x13, 0x65, 0x81, 0x17, 0xa0, 0x6b, 0x33,
+	0xcf, 0xa3, 0xc2, 0x0a, 0x49, 0x44, 0xb0, 0x6b, 0xa9, 0x9f, 0x8c, 0xa3, 0xb6, 0xd4, 0x6f, 0xcf,
+	0xeb, 0x63, 0xd8, 0x94, 0xac, 0xfa, 0x43, 0x67, 0xf6, 0x62, 0x86, 0xc3, 0xf7, 0xa0, 0x15, 0x31,
+	0x41, 0x2c, 0x95, 0x8b, 0x8f, 0x99, 0xa3, 0x4e, 0x7f, 0xb1, 0xec, 0xea, 0xc6, 0x8f, 0xd4, 0xc1,
+	0x14, 0x4b, 0xa4, 0x6b, 0x51, 0x2e, 0xca, 0x47, 0xc7, 0xb7, 0x0f, 0x3d, 0xed, 0xee, 0xa1, 0xa7,
+	0xdd, 0x3f, 0xf4, 0xb4, 0x6f, 0x8f, 0xbd, 0xca, 0xdd, 0x63, 0xaf, 0xf2, 0xf3, 0xb1, 0x57, 0xf9,
+	0x3c, 0x74, 0xa8, 0xb8, 0xba, 0xbe, 0xd4, 0x6d, 0xe6, 0x19, 0x89, 0x7a, 0x78, 0x75, 0x7d, 0x99,
+	0x7e, 0x1b, 0x5f, 0xe5, 0x03, 0x2f, 0x6e, 0x02, 0xc2, 0x8d, 0x68, 0xe7, 0x72, 0x59, 0x3e, 0xd9,
+	0x7b, 0xbf, 0x07, 0x00, 0xff, 0x20, 0x16, 0x63, 0x2d, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -287,6 +333,70 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoteCommitments) > 0 {
+		for iNdEx := len(m.VoteCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.CommitRevealVotings) > 0 {
+		for iNdEx := len(m.CommitRevealVotings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitRevealVotings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ConstitutionHistory) > 0 {
+		for iNdEx := len(m.ConstitutionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConstitutionHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Laws) > 0 {
+		for iNdEx := len(m.Laws) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Laws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	if len(m.GovernanceDelegations) > 0 {
 		for iNdEx := len(m.GovernanceDelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -557,6 +667,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Laws) > 0 {
+		for _, e := range m.Laws {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConstitutionHistory) > 0 {
+		for _, e := range m.ConstitutionHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommitRevealVotings) > 0 {
+		for _, e := range m.CommitRevealVotings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteCommitments) > 0 {
+		for _, e := range m.VoteCommitments {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1128,6 +1262,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Laws", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Laws = append(m.Laws, Law{})
+			if err := m.Laws[len(m.Laws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConstitutionHistory = append(m.ConstitutionHistory, ConstitutionVersion{})
+			if err := m.ConstitutionHistory[len(m.ConstitutionHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealVotings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitRevealVotings = append(m.CommitRevealVotings, CommitRevealVoting{})
+			if err := m.CommitRevealVotings[len(m.CommitRevealVotings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteCommitments = append(m.VoteCommitments, VoteCommitment{})
+			if err := m.VoteCommitments[len(m.VoteCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
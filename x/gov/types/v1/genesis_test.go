@@ -393,6 +393,41 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "duplicate deposit: proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "constitution amendment patch max offset too large",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				params.ConstitutionAmendmentPatch.MaxOffset = v1.MaxConstitutionAmendmentPatchMaxOffset + 1
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+			},
+			expErrMsg: "constitution amendment patch max offset must be less than or equal to 10000: 10001",
+		},
+		{
+			name: "constitution amendment patch max fuzz too large",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				params.ConstitutionAmendmentPatch.MaxFuzz = v1.MaxConstitutionAmendmentPatchMaxFuzz + 1
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+			},
+			expErrMsg: "constitution amendment patch max fuzz must be less than or equal to 3: 4",
+		},
+		{
+			name: "commit-reveal reveal period not less than the voting period",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				params.CommitReveal.RevealPeriod = *params.VotingPeriod
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+			},
+			expErrMsg: "commit-reveal reveal period 504h0m0s must be strictly less than the voting period 504h0m0s",
+		},
+		{
+			name: "disabled commit-reveal voting",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				params.CommitReveal.RevealPeriod = 0
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+			},
+		},
 		{
 			name: "invalid participation_ema - not a decimal",
 			genesisState: func() *v1.GenesisState {
@@ -471,6 +506,160 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "valid laws",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+				state.Laws = append(state.Laws,
+					v1.Law{Id: 1, Title: "law", Text: "text", ContentHash: v1.LawContentHash("text"), EnactingProposalId: 1},
+					v1.Law{Id: 2, Title: "law", Uri: "ipfs://law", ContentHash: v1.LawContentHash("text"), EnactingProposalId: 2},
+				)
+
+				return state
+			},
+		},
+		{
+			name: "duplicate law id",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+				law := v1.Law{Id: 1, Title: "law", Text: "text", ContentHash: v1.LawContentHash("text")}
+				state.Laws = append(state.Laws, law, law)
+
+				return state
+			},
+			expErrMsg: "duplicate law id: 1",
+		},
+		{
+			name: "law content hash not matching its text",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+				state.Laws = append(state.Laws,
+					v1.Law{Id: 1, Title: "law", Text: "text", ContentHash: v1.LawContentHash("other text")})
+
+				return state
+			},
+			expErrMsg: "invalid law 1: law content hash",
+		},
+		{
+			name: "law without content hash",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+				state.Laws = append(state.Laws, v1.Law{Id: 1, Title: "law", Text: "text"})
+
+				return state
+			},
+			expErrMsg: "law 1 content hash cannot be empty",
+		},
+		{
+			name: "valid constitution history",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+				state.ConstitutionHistory = append(state.ConstitutionHistory,
+					v1.ConstitutionVersion{Version: 1, Constitution: "constitution"},
+					v1.ConstitutionVersion{Version: 2, Constitution: "amended constitution", AmendingProposalId: 1},
+				)
+
+				return state
+			},
+		},
+		{
+			name: "constitution history not starting at version 1",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+				state.ConstitutionHistory = append(state.ConstitutionHistory,
+					v1.ConstitutionVersion{Version: 2, Constitution: "constitution"})
+
+				return state
+			},
+			expErrMsg: "invalid constitution version 2 at position 0",
+		},
+		{
+			name: "constitution history with a gap",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+				state.ConstitutionHistory = append(state.ConstitutionHistory,
+					v1.ConstitutionVersion{Version: 1, Constitution: "constitution"},
+					v1.ConstitutionVersion{Version: 3, Constitution: "amended constitution", AmendingProposalId: 1},
+				)
+
+				return state
+			},
+			expErrMsg: "invalid constitution version 3 at position 1",
+		},
+		{
+			name: "valid vote commitments",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+				state.CommitRevealVotings = append(state.CommitRevealVotings,
+					v1.CommitRevealVoting{ProposalId: 1, RevealPeriod: time.Hour})
+				state.VoteCommitments = append(state.VoteCommitments,
+					v1.VoteCommitment{ProposalId: 1, Voter: sdk.AccAddress("voter1").String(), Commitment: make([]byte, 32)},
+					v1.VoteCommitment{ProposalId: 1, Voter: sdk.AccAddress("voter2").String(), Commitment: make([]byte, 32)},
+				)
+
+				return state
+			},
+		},
+		{
+			name: "duplicate commit-reveal voting",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+				c := v1.CommitRevealVoting{ProposalId: 1, RevealPeriod: time.Hour}
+				state.CommitRevealVotings = append(state.CommitRevealVotings, c, c)
+
+				return state
+			},
+			expErrMsg: "duplicate commit-reveal voting: proposal 1",
+		},
+		{
+			name: "vote commitment without commit-reveal voting",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+				state.VoteCommitments = append(state.VoteCommitments,
+					v1.VoteCommitment{ProposalId: 1, Voter: sdk.AccAddress("voter1").String(), Commitment: make([]byte, 32)})
+
+				return state
+			},
+			expErrMsg: "has no commit-reveal voting",
+		},
+		{
+			name: "duplicate vote commitment",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+				state.CommitRevealVotings = append(state.CommitRevealVotings,
+					v1.CommitRevealVoting{ProposalId: 1, RevealPeriod: time.Hour})
+				c := v1.VoteCommitment{ProposalId: 1, Voter: sdk.AccAddress("voter1").String(), Commitment: make([]byte, 32)}
+				state.VoteCommitments = append(state.VoteCommitments, c, c)
+
+				return state
+			},
+			expErrMsg: "duplicate vote commitment",
+		},
+		{
+			name: "vote commitment not a SHA-256 hash",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+				state.CommitRevealVotings = append(state.CommitRevealVotings,
+					v1.CommitRevealVoting{ProposalId: 1, RevealPeriod: time.Hour})
+				state.VoteCommitments = append(state.VoteCommitments,
+					v1.VoteCommitment{ProposalId: 1, Voter: sdk.AccAddress("voter1").String(), Commitment: []byte("commitment")})
+
+				return state
+			},
+			expErrMsg: "must be a SHA-256 hash",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1134,12 +1134,12 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid. Default value: 0.25.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid. Default value: 0.25.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"` // Deprecated: Do not use.
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 2/3.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 2/3.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"` // Deprecated: Do not use.
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -1186,6 +1186,14 @@ type Params struct {
 	// must have the governance VP from the base account automatically delegated to them), that a governor
 	// must have to be considered active.
 	MinGovernorSelfDelegation string `protobuf:"bytes,30,opt,name=min_governor_self_delegation,json=minGovernorSelfDelegation,proto3" json:"min_governor_self_delegation,omitempty"`
+	// Defines how the amendments of the constitution are applied. This param is
+	// held by the AtomOne gov module on top of the params of the x/gov module of
+	// the SDK.
+	ConstitutionAmendmentPatch ConstitutionAmendmentPatchParams `protobuf:"bytes,31,opt,name=constitution_amendment_patch,json=constitutionAmendmentPatch,proto3" json:"constitution_amendment_patch"`
+	// Defines the commit-reveal voting the proposals can opt in. This param is
+	// held by the AtomOne gov module on top of the params of the x/gov module of
+	// the SDK.
+	CommitReveal CommitRevealParams `protobuf:"bytes,32,opt,name=commit_reveal,json=commitReveal,proto3" json:"commit_reveal"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1394,6 +1402,152 @@ func (m *Params) GetMinGovernorSelfDelegation() string {
 	return ""
 }
 
+func (m *Params) GetConstitutionAmendmentPatch() ConstitutionAmendmentPatchParams {
+	if m != nil {
+		return m.ConstitutionAmendmentPatch
+	}
+	return ConstitutionAmendmentPatchParams{}
+}
+
+func (m *Params) GetCommitReveal() CommitRevealParams {
+	if m != nil {
+		return m.CommitReveal
+	}
+	return CommitRevealParams{}
+}
+
+// ConstitutionAmendmentPatchParams defines how the hunks of the amendments of
+// the constitution are located in the constitution. Unless strict, a hunk that
+// does not apply at the lines given in its header is searched for within
+// max_offset lines of these lines, then again ignoring up to max_fuzz context
+// lines at its start and end, like GNU patch does.
+type ConstitutionAmendmentPatchParams struct {
+	// strict requires the hunks to apply at the lines given in their header,
+	// regardless of max_offset and max_fuzz.
+	Strict bool `protobuf:"varint,1,opt,name=strict,proto3" json:"strict,omitempty"`
+	// max_offset is the maximum number of lines a hunk can be moved from the
+	// lines given in its header, once the offsets of the previous hunks are
+	// taken into account.
+	MaxOffset uint64 `protobuf:"varint,2,opt,name=max_offset,json=maxOffset,proto3" json:"max_offset,omitempty"`
+	// max_fuzz is the maximum number of context lines that can be ignored at
+	// the start and at the end of a hunk.
+	MaxFuzz uint64 `protobuf:"varint,3,opt,name=max_fuzz,json=maxFuzz,proto3" json:"max_fuzz,omitempty"`
+}
+
+func (m *ConstitutionAmendmentPatchParams) Reset()         { *m = ConstitutionAmendmentPatchParams{} }
+func (m *ConstitutionAmendmentPatchParams) String() string { return proto.CompactTextString(m) }
+func (*ConstitutionAmendmentPatchParams) ProtoMessage()    {}
+func (*ConstitutionAmendmentPatchParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{13}
+}
+func (m *ConstitutionAmendmentPatchParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConstitutionAmendmentPatchParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConstitutionAmendmentPatchParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConstitutionAmendmentPatchParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstitutionAmendmentPatchParams.Merge(m, src)
+}
+func (m *ConstitutionAmendmentPatchParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConstitutionAmendmentPatchParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstitutionAmendmentPatchParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstitutionAmendmentPatchParams proto.InternalMessageInfo
+
+func (m *ConstitutionAmendmentPatchParams) GetStrict() bool {
+	if m != nil {
+		return m.Strict
+	}
+	return false
+}
+
+func (m *ConstitutionAmendmentPatchParams) GetMaxOffset() uint64 {
+	if m != nil {
+		return m.MaxOffset
+	}
+	return 0
+}
+
+func (m *ConstitutionAmendmentPatchParams) GetMaxFuzz() uint64 {
+	if m != nil {
+		return m.MaxFuzz
+	}
+	return 0
+}
+
+type CommitRevealParams struct {
+	// reveal_period is the duration of the reveal window closing the voting
+	// period of the proposals using commit-reveal voting. Zero disables
+	// commit-reveal voting for the proposals submitted afterwards.
+	RevealPeriod time.Duration `protobuf:"bytes,1,opt,name=reveal_period,json=revealPeriod,proto3,stdduration" json:"reveal_period"`
+	// quorum_check_exemption allows the proposals to opt in commit-reveal voting
+	// while the quorum checks are enabled. Their votes are only revealed at the
+	// end of the voting period, so they are exempt from the quorum checks and
+	// their voting period is never extended. Unless set, commit-reveal voting is
+	// only available while quorum_check_count is zero.
+	QuorumCheckExemption bool `protobuf:"varint,2,opt,name=quorum_check_exemption,json=quorumCheckExemption,proto3" json:"quorum_check_exemption,omitempty"`
+}
+
+func (m *CommitRevealParams) Reset()         { *m = CommitRevealParams{} }
+func (m *CommitRevealParams) String() string { return proto.CompactTextString(m) }
+func (*CommitRevealParams) ProtoMessage()    {}
+func (*CommitRevealParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{14}
+}
+func (m *CommitRevealParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitRevealParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitRevealParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitRevealParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitRevealParams.Merge(m, src)
+}
+func (m *CommitRevealParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitRevealParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitRevealParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitRevealParams proto.InternalMessageInfo
+
+func (m *CommitRevealParams) GetRevealPeriod() time.Duration {
+	if m != nil {
+		return m.RevealPeriod
+	}
+	return 0
+}
+
+func (m *CommitRevealParams) GetQuorumCheckExemption() bool {
+	if m != nil {
+		return m.QuorumCheckExemption
+	}
+	return false
+}
+
 type QuorumRange struct {
 	// Maximum achievable quorum
 	Max string `protobuf:"bytes,1,opt,name=max,proto3" json:"max,omitempty"`
@@ -1405,7 +1559,7 @@ func (m *QuorumRange) Reset()         { *m = QuorumRange{} }
 func (m *QuorumRange) String() string { return proto.CompactTextString(m) }
 func (*QuorumRange) ProtoMessage()    {}
 func (*QuorumRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{13}
+	return fileDescriptor_ecf0f9950ff6986c, []int{15}
 }
 func (m *QuorumRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1468,7 +1622,7 @@ func (m *Governor) Reset()         { *m = Governor{} }
 func (m *Governor) String() string { return proto.CompactTextString(m) }
 func (*Governor) ProtoMessage()    {}
 func (*Governor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{14}
+	return fileDescriptor_ecf0f9950ff6986c, []int{16}
 }
 func (m *Governor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1515,7 +1669,7 @@ func (m *GovernorDescription) Reset()         { *m = GovernorDescription{} }
 func (m *GovernorDescription) String() string { return proto.CompactTextString(m) }
 func (*GovernorDescription) ProtoMessage()    {}
 func (*GovernorDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{15}
+	return fileDescriptor_ecf0f9950ff6986c, []int{17}
 }
 func (m *GovernorDescription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1592,7 +1746,7 @@ func (m *GovernorValShares) Reset()         { *m = GovernorValShares{} }
 func (m *GovernorValShares) String() string { return proto.CompactTextString(m) }
 func (*GovernorValShares) ProtoMessage()    {}
 func (*GovernorValShares) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{16}
+	return fileDescriptor_ecf0f9950ff6986c, []int{18}
 }
 func (m *GovernorValShares) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1632,7 +1786,7 @@ func (m *GovernanceDelegation) Reset()         { *m = GovernanceDelegation{} }
 func (m *GovernanceDelegation) String() string { return proto.CompactTextString(m) }
 func (*GovernanceDelegation) ProtoMessage()    {}
 func (*GovernanceDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{17}
+	return fileDescriptor_ecf0f9950ff6986c, []int{19}
 }
 func (m *GovernanceDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1661,280 +1815,631 @@ func (m *GovernanceDelegation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GovernanceDelegation proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("atomone.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
-	proto.RegisterEnum("atomone.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
-	proto.RegisterEnum("atomone.gov.v1.GovernorStatus", GovernorStatus_name, GovernorStatus_value)
-	proto.RegisterType((*WeightedVoteOption)(nil), "atomone.gov.v1.WeightedVoteOption")
-	proto.RegisterType((*Deposit)(nil), "atomone.gov.v1.Deposit")
-	proto.RegisterType((*LastMinDeposit)(nil), "atomone.gov.v1.LastMinDeposit")
-	proto.RegisterType((*Proposal)(nil), "atomone.gov.v1.Proposal")
-	proto.RegisterType((*TallyResult)(nil), "atomone.gov.v1.TallyResult")
-	proto.RegisterType((*Vote)(nil), "atomone.gov.v1.Vote")
-	proto.RegisterType((*QuorumCheckQueueEntry)(nil), "atomone.gov.v1.QuorumCheckQueueEntry")
-	proto.RegisterType((*DepositParams)(nil), "atomone.gov.v1.DepositParams")
-	proto.RegisterType((*VotingParams)(nil), "atomone.gov.v1.VotingParams")
-	proto.RegisterType((*TallyParams)(nil), "atomone.gov.v1.TallyParams")
-	proto.RegisterType((*MinDepositThrottler)(nil), "atomone.gov.v1.MinDepositThrottler")
-	proto.RegisterType((*MinInitialDepositThrottler)(nil), "atomone.gov.v1.MinInitialDepositThrottler")
-	proto.RegisterType((*Params)(nil), "atomone.gov.v1.Params")
-	proto.RegisterType((*QuorumRange)(nil), "atomone.gov.v1.QuorumRange")
-	proto.RegisterType((*Governor)(nil), "atomone.gov.v1.Governor")
-	proto.RegisterType((*GovernorDescription)(nil), "atomone.gov.v1.GovernorDescription")
-	proto.RegisterType((*GovernorValShares)(nil), "atomone.gov.v1.GovernorValShares")
-	proto.RegisterType((*GovernanceDelegation)(nil), "atomone.gov.v1.GovernanceDelegation")
+// Law defines a law in force, enacted by a law proposal that passed.
+type Law struct {
+	// id is the unique id of the law.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// title is the title of the law.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// text is the full text of the law. It may be empty if the text is
+	// published at uri.
+	Text string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// uri is the URI where the text of the law is published. It may be empty if
+	// the full text is stored on chain.
+	Uri string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	// content_hash is the hex encoded SHA-256 hash of the text of the law.
+	ContentHash string `protobuf:"bytes,5,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// enacting_proposal_id is the id of the proposal that enacted the law.
+	EnactingProposalId uint64 `protobuf:"varint,6,opt,name=enacting_proposal_id,json=enactingProposalId,proto3" json:"enacting_proposal_id,omitempty"`
+	// enactment_time is the time the law has been enacted.
+	EnactmentTime time.Time `protobuf:"bytes,7,opt,name=enactment_time,json=enactmentTime,proto3,stdtime" json:"enactment_time"`
+	// last_amending_proposal_id is the id of the proposal that last amended the
+	// law, zero if the law has never been amended.
+	LastAmendingProposalId uint64 `protobuf:"varint,8,opt,name=last_amending_proposal_id,json=lastAmendingProposalId,proto3" json:"last_amending_proposal_id,omitempty"`
+	// last_amendment_time is the time the law has last been amended, nil if the
+	// law has never been amended.
+	LastAmendmentTime *time.Time `protobuf:"bytes,9,opt,name=last_amendment_time,json=lastAmendmentTime,proto3,stdtime" json:"last_amendment_time,omitempty"`
+}
+
+func (m *Law) Reset()         { *m = Law{} }
+func (m *Law) String() string { return proto.CompactTextString(m) }
+func (*Law) ProtoMessage()    {}
+func (*Law) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{20}
+}
+func (m *Law) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Law) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Law.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Law) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Law.Merge(m, src)
+}
+func (m *Law) XXX_Size() int {
+	return m.Size()
+}
+func (m *Law) XXX_DiscardUnknown() {
+	xxx_messageInfo_Law.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("atomone/gov/v1/gov.proto", fileDescriptor_ecf0f9950ff6986c) }
+var xxx_messageInfo_Law proto.InternalMessageInfo
 
-var fileDescriptor_ecf0f9950ff6986c = []byte{
-	// 2326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xbd, 0x6f, 0x23, 0xc7,
-	0xd9, 0xd7, 0x92, 0x14, 0x25, 0x3d, 0x14, 0xa9, 0xd5, 0x48, 0x77, 0xb7, 0xa2, 0x4e, 0x94, 0x4c,
-	0x1b, 0x86, 0x7c, 0xaf, 0x8f, 0xb4, 0xce, 0x7e, 0x5d, 0x1c, 0x0c, 0x04, 0x94, 0x48, 0x2b, 0x74,
-	0xce, 0x22, 0xbd, 0xe4, 0xc9, 0x71, 0x8a, 0x2c, 0x46, 0xdc, 0x11, 0xb5, 0x38, 0xee, 0x0e, 0xbd,
-	0x3b, 0xe4, 0x89, 0x29, 0x53, 0x19, 0x4e, 0x63, 0x20, 0x4d, 0x12, 0xc4, 0xc0, 0x01, 0x69, 0x52,
-	0xa4, 0x70, 0x61, 0x20, 0x4d, 0x8a, 0x34, 0x01, 0x5c, 0x1a, 0xae, 0x92, 0x14, 0x97, 0xc0, 0x2e,
-	0x62, 0xf8, 0x5f, 0x08, 0x02, 0x04, 0xf3, 0xb1, 0xfc, 0xd2, 0xd2, 0x92, 0x0e, 0x0e, 0x10, 0xa4,
-	0xb9, 0xe3, 0xce, 0xf3, 0x7b, 0x3e, 0xe6, 0xf9, 0x9a, 0x67, 0x46, 0x60, 0x60, 0x46, 0x5d, 0xea,
-	0x91, 0x62, 0x9b, 0xf6, 0x8b, 0xfd, 0x3d, 0xfe, 0x5f, 0xa1, 0xeb, 0x53, 0x46, 0x51, 0x46, 0x51,
-	0x0a, 0x7c, 0xa9, 0xbf, 0x97, 0xcd, 0xb5, 0x68, 0xe0, 0xd2, 0xa0, 0x78, 0x82, 0x03, 0x52, 0xec,
-	0xef, 0x9d, 0x10, 0x86, 0xf7, 0x8a, 0x2d, 0xea, 0x78, 0x12, 0x9f, 0x5d, 0x6f, 0xd3, 0x36, 0x15,
-	0x3f, 0x8b, 0xfc, 0x97, 0x5a, 0xdd, 0x6e, 0x53, 0xda, 0xee, 0x90, 0xa2, 0xf8, 0x3a, 0xe9, 0x9d,
-	0x16, 0x99, 0xe3, 0x92, 0x80, 0x61, 0xb7, 0xab, 0x00, 0x1b, 0xd3, 0x00, 0xec, 0x0d, 0x14, 0x29,
-	0x37, 0x4d, 0xb2, 0x7b, 0x3e, 0x66, 0x0e, 0x0d, 0x35, 0x6e, 0x48, 0x8b, 0x2c, 0xa9, 0x54, 0x7e,
-	0x28, 0xd2, 0x2a, 0x76, 0x1d, 0x8f, 0x16, 0xc5, 0xbf, 0x72, 0x29, 0xdf, 0x05, 0xf4, 0x2e, 0x71,
-	0xda, 0x67, 0x8c, 0xd8, 0xc7, 0x94, 0x91, 0x5a, 0x97, 0x4b, 0x42, 0xf7, 0x20, 0x49, 0xc5, 0x2f,
-	0x43, 0xdb, 0xd1, 0x76, 0x33, 0xf7, 0xb2, 0x85, 0xc9, 0x6d, 0x17, 0x46, 0x58, 0x53, 0x21, 0xd1,
-	0x8b, 0x90, 0x7c, 0x2c, 0x24, 0x19, 0xb1, 0x1d, 0x6d, 0x77, 0x69, 0x3f, 0xf3, 0xc5, 0xa7, 0x77,
-	0x41, 0xa9, 0x2f, 0x93, 0x96, 0xa9, 0xa8, 0xf9, 0x27, 0x1a, 0x2c, 0x94, 0x49, 0x97, 0x06, 0x0e,
-	0x43, 0xdb, 0x90, 0xea, 0xfa, 0xb4, 0x4b, 0x03, 0xdc, 0xb1, 0x1c, 0x5b, 0x28, 0x4b, 0x98, 0x10,
-	0x2e, 0x55, 0x6d, 0xf4, 0x3a, 0x2c, 0xd9, 0x12, 0x4b, 0x7d, 0x25, 0xd7, 0xf8, 0xe2, 0xd3, 0xbb,
-	0xeb, 0x4a, 0x6e, 0xc9, 0xb6, 0x7d, 0x12, 0x04, 0x0d, 0xe6, 0x3b, 0x5e, 0xdb, 0x1c, 0x41, 0xd1,
-	0x1b, 0x90, 0xc4, 0x2e, 0xed, 0x79, 0xcc, 0x88, 0xef, 0xc4, 0x77, 0x53, 0xf7, 0x36, 0x0a, 0x8a,
-	0x83, 0xc7, 0xa9, 0xa0, 0xe2, 0x54, 0x38, 0xa0, 0x8e, 0xb7, 0xbf, 0xf4, 0xd9, 0xd3, 0xed, 0xb9,
-	0xdf, 0xfe, 0xe3, 0x93, 0x3b, 0x9a, 0xa9, 0x78, 0xf2, 0x3f, 0xd5, 0x20, 0xf3, 0x00, 0x07, 0xec,
-	0x6d, 0xc7, 0x0b, 0x2d, 0xbd, 0x0f, 0xf3, 0x7d, 0xdc, 0xe9, 0x11, 0x43, 0xbb, 0x86, 0x3c, 0xc9,
-	0x82, 0x5e, 0x83, 0x04, 0x8f, 0xaf, 0xb0, 0x3f, 0x75, 0x2f, 0x5b, 0x90, 0x01, 0x2c, 0x84, 0x01,
-	0x2c, 0x34, 0xc3, 0xe0, 0xef, 0x27, 0x3e, 0xfa, 0xdb, 0xb6, 0x66, 0x0a, 0x74, 0xfe, 0x8f, 0x49,
-	0x58, 0xac, 0x2b, 0x4f, 0xa0, 0x0c, 0xc4, 0x86, 0xfe, 0x89, 0x39, 0x36, 0x7a, 0x05, 0x16, 0x5d,
-	0x12, 0x04, 0xb8, 0x4d, 0x02, 0x23, 0x26, 0x2c, 0x5a, 0xbf, 0x20, 0xb6, 0xe4, 0x0d, 0xcc, 0x21,
-	0x0a, 0xbd, 0x0e, 0xc9, 0x80, 0x61, 0xd6, 0x0b, 0x8c, 0xb8, 0x08, 0x69, 0x6e, 0x3a, 0xa4, 0xa1,
-	0xae, 0x86, 0x40, 0x99, 0x0a, 0x8d, 0xaa, 0x80, 0x4e, 0x1d, 0x0f, 0x77, 0x2c, 0x86, 0x3b, 0x9d,
-	0x81, 0xe5, 0x93, 0xa0, 0xd7, 0x61, 0x46, 0x42, 0x6c, 0x65, 0x73, 0x5a, 0x46, 0x93, 0x63, 0x4c,
-	0x01, 0x31, 0x75, 0xc1, 0x36, 0xb6, 0x82, 0x4a, 0x90, 0x0a, 0x7a, 0x27, 0xae, 0xc3, 0x2c, 0xe1,
-	0x8e, 0xf9, 0x2b, 0xba, 0x03, 0x24, 0x13, 0x5f, 0x46, 0x6f, 0x81, 0xae, 0x82, 0x6c, 0x11, 0xcf,
-	0x96, 0x72, 0x92, 0x57, 0x94, 0x93, 0x51, 0x9c, 0x15, 0xcf, 0x16, 0xb2, 0xaa, 0x90, 0x66, 0x94,
-	0xe1, 0x8e, 0xa5, 0xd6, 0x8d, 0x85, 0x6b, 0x84, 0x76, 0x59, 0xb0, 0x86, 0xd9, 0xf1, 0x00, 0x56,
-	0xfb, 0x94, 0x39, 0x5e, 0xdb, 0x0a, 0x18, 0xf6, 0xd5, 0xfe, 0x16, 0xaf, 0x68, 0xd7, 0x8a, 0x64,
-	0x6d, 0x70, 0x4e, 0x61, 0xd8, 0xf7, 0x41, 0x2d, 0x8d, 0xf6, 0xb8, 0x74, 0x45, 0x59, 0x69, 0xc9,
-	0x18, 0x6e, 0x31, 0xcb, 0xd3, 0x84, 0x61, 0x1b, 0x33, 0x6c, 0x00, 0xaf, 0x1e, 0x73, 0xf8, 0x8d,
-	0xd6, 0x61, 0x9e, 0x39, 0xac, 0x43, 0x8c, 0x94, 0x20, 0xc8, 0x0f, 0x64, 0xc0, 0x42, 0xd0, 0x73,
-	0x5d, 0xec, 0x0f, 0x8c, 0x65, 0xb1, 0x1e, 0x7e, 0xa2, 0xd7, 0x60, 0x51, 0x16, 0x26, 0xf1, 0x8d,
-	0xf4, 0x25, 0x95, 0x38, 0x44, 0x72, 0x0b, 0x88, 0x67, 0x53, 0x3f, 0x20, 0xb6, 0x91, 0xd9, 0xd1,
-	0x76, 0x17, 0xcd, 0xe1, 0x37, 0xca, 0x01, 0x60, 0xcf, 0xa3, 0x4c, 0x74, 0x2f, 0x63, 0x45, 0xa8,
-	0x1b, 0x5b, 0x41, 0xdf, 0x83, 0xdb, 0xa2, 0x2f, 0x5a, 0xca, 0x1b, 0x5d, 0xe2, 0x3b, 0xd4, 0xb6,
-	0xc8, 0x39, 0x23, 0x9e, 0x4d, 0x6c, 0x43, 0xdf, 0xd1, 0x76, 0xd3, 0xe6, 0x86, 0xc0, 0x1c, 0x0b,
-	0x48, 0x5d, 0x20, 0x2a, 0x0a, 0x90, 0xff, 0x95, 0x06, 0xa9, 0xf1, 0x04, 0xfc, 0x3f, 0x58, 0x1a,
-	0x90, 0xc0, 0x6a, 0x89, 0xc6, 0xa0, 0x5d, 0xe8, 0x52, 0x55, 0x8f, 0x99, 0x8b, 0x03, 0x12, 0x1c,
-	0x70, 0x3a, 0x7a, 0x15, 0xd2, 0xf8, 0x24, 0x60, 0xd8, 0xf1, 0x14, 0x43, 0x2c, 0x92, 0x61, 0x59,
-	0x81, 0x24, 0xd3, 0x4b, 0xb0, 0xe8, 0x51, 0x85, 0x8f, 0x47, 0xe2, 0x17, 0x3c, 0x2a, 0xa0, 0xf9,
-	0xdf, 0x6b, 0x90, 0xe0, 0x6d, 0xf4, 0xf2, 0x26, 0x58, 0x80, 0xf9, 0x3e, 0x65, 0xe4, 0xf2, 0x06,
-	0x28, 0x61, 0xe8, 0x0d, 0x58, 0x90, 0x3d, 0x39, 0x30, 0x12, 0x22, 0xa5, 0xf3, 0xd3, 0x75, 0x7a,
-	0xb1, 0xe5, 0x9b, 0x21, 0xcb, 0x44, 0xce, 0xcc, 0x4f, 0xe6, 0xcc, 0x5b, 0x89, 0xc5, 0xb8, 0x9e,
-	0xc8, 0xff, 0x49, 0x83, 0x1b, 0xef, 0xf4, 0xa8, 0xdf, 0x73, 0x0f, 0xce, 0x48, 0xeb, 0xd1, 0x3b,
-	0x3d, 0xd2, 0x23, 0x15, 0x8f, 0xf9, 0x03, 0x54, 0x87, 0xb5, 0xf7, 0x05, 0x41, 0x64, 0x2d, 0xed,
-	0xa9, 0x4a, 0xd0, 0xae, 0x98, 0xbd, 0xab, 0x92, 0xb9, 0x29, 0x79, 0x45, 0x06, 0xbf, 0x0c, 0x48,
-	0x49, 0x6c, 0x71, 0x5d, 0x63, 0xa1, 0x48, 0x98, 0xfa, 0xfb, 0x23, 0x23, 0xa4, 0xfb, 0xa7, 0xd0,
-	0x81, 0x65, 0x53, 0x8f, 0x88, 0x40, 0x4c, 0xa2, 0x83, 0x32, 0xf5, 0x48, 0xfe, 0x2f, 0x1a, 0xa4,
-	0x55, 0x05, 0xd7, 0xb1, 0x8f, 0xdd, 0x00, 0xbd, 0x07, 0x29, 0xd7, 0xf1, 0x86, 0x0d, 0xe1, 0xd2,
-	0x5e, 0xbf, 0xc5, 0x1b, 0xc2, 0x37, 0x4f, 0xb7, 0x6f, 0x8c, 0x71, 0xbd, 0x4c, 0x5d, 0x87, 0x11,
-	0xb7, 0xcb, 0x06, 0x26, 0xb8, 0xa3, 0x03, 0xc4, 0x05, 0xe4, 0xe2, 0xf3, 0x10, 0xa4, 0x72, 0x59,
-	0x1d, 0x09, 0x1b, 0x17, 0x3c, 0x53, 0x56, 0x67, 0xfa, 0xfe, 0x0b, 0xdf, 0x3c, 0xdd, 0xbe, 0x7d,
-	0x91, 0x71, 0xa4, 0xe4, 0x17, 0xdc, 0x71, 0xba, 0x8b, 0xcf, 0xc3, 0x9d, 0x08, 0x7a, 0xbe, 0x09,
-	0xcb, 0xaa, 0x24, 0xe4, 0xce, 0xca, 0x90, 0x9e, 0xa8, 0x22, 0x15, 0x93, 0x6f, 0xd1, 0x9c, 0x10,
-	0x92, 0x97, 0xfb, 0x63, 0x85, 0x95, 0xff, 0x67, 0x4c, 0x15, 0x94, 0x92, 0xba, 0x0b, 0x49, 0xe9,
-	0x55, 0x55, 0x4d, 0xfa, 0xe4, 0x99, 0x6f, 0x68, 0xa6, 0xa2, 0xa3, 0x97, 0x61, 0x89, 0x9d, 0xf9,
-	0x24, 0x38, 0xa3, 0x1d, 0x7b, 0xc6, 0x80, 0x30, 0x02, 0xa0, 0x26, 0x6c, 0xb5, 0xa8, 0x17, 0x30,
-	0x87, 0xf5, 0xb8, 0x2d, 0x16, 0x76, 0x89, 0x67, 0xbb, 0xc4, 0x63, 0x96, 0x52, 0x17, 0x9f, 0xa1,
-	0x6e, 0x73, 0x9c, 0xad, 0x14, 0x72, 0xc9, 0x64, 0x45, 0x3f, 0x84, 0x9d, 0x19, 0x52, 0x47, 0xa6,
-	0x25, 0x22, 0x4d, 0xcb, 0x45, 0x8a, 0x6d, 0x0e, 0xed, 0x2d, 0x02, 0x74, 0xf0, 0xe3, 0xd0, 0xb8,
-	0xf9, 0x19, 0xc6, 0x2d, 0x75, 0xf0, 0x63, 0x65, 0xca, 0xab, 0x90, 0xe6, 0x0c, 0x23, 0xbd, 0xc9,
-	0x48, 0xbd, 0xcb, 0x1d, 0xfc, 0x78, 0xa8, 0x25, 0xff, 0xcb, 0x38, 0xac, 0x8d, 0x46, 0x92, 0xe6,
-	0x99, 0x4f, 0x19, 0xeb, 0x10, 0x1f, 0x55, 0x20, 0x75, 0xda, 0xa1, 0xd4, 0xb7, 0xae, 0x3f, 0xa1,
-	0x80, 0x60, 0x3c, 0x16, 0x63, 0x4a, 0x19, 0xd2, 0xbd, 0xae, 0x8d, 0x19, 0xb9, 0x72, 0x72, 0xaa,
-	0x14, 0x91, 0x5c, 0x32, 0x45, 0xd0, 0xeb, 0x70, 0x8b, 0x61, 0xbf, 0x4d, 0x98, 0x85, 0x5b, 0xcc,
-	0xe9, 0x13, 0x2b, 0x6c, 0x64, 0x81, 0xaa, 0xc3, 0x1b, 0x92, 0x5c, 0x12, 0xd4, 0x70, 0xe8, 0x08,
-	0xd0, 0xff, 0x43, 0xc6, 0xf1, 0x5a, 0x3e, 0xc1, 0x01, 0xb1, 0x84, 0xf8, 0x19, 0xa1, 0x48, 0x87,
-	0x28, 0x93, 0x83, 0x38, 0x9b, 0x4d, 0x26, 0xd8, 0xe6, 0xa3, 0xd9, 0x42, 0x94, 0x64, 0xab, 0xc1,
-	0x0b, 0x43, 0xb6, 0x80, 0x78, 0x81, 0xc3, 0x9c, 0xbe, 0xc3, 0x06, 0x96, 0x32, 0xdd, 0x76, 0x02,
-	0x86, 0xbd, 0x96, 0x9c, 0x2d, 0x12, 0xe6, 0x73, 0x21, 0xb6, 0x31, 0x82, 0x36, 0x05, 0xb2, 0xac,
-	0x80, 0xf9, 0x9f, 0xc7, 0x21, 0xfb, 0xb6, 0xe3, 0x55, 0x3d, 0x87, 0x39, 0xc3, 0xb9, 0xe0, 0xbf,
-	0x34, 0x44, 0x2f, 0x81, 0xae, 0xf6, 0x39, 0x1d, 0x9b, 0x15, 0xb9, 0xfe, 0x3f, 0x13, 0x95, 0x9f,
-	0x65, 0x20, 0xa9, 0x5a, 0xd5, 0xe1, 0x35, 0x5b, 0x7b, 0x6a, 0x18, 0x01, 0x43, 0x9b, 0x68, 0xe4,
-	0x6f, 0x3f, 0x5b, 0x23, 0x4f, 0x44, 0x37, 0xea, 0x8b, 0x8d, 0x39, 0xfe, 0x0c, 0x8d, 0x79, 0xac,
-	0x11, 0x27, 0xae, 0xd3, 0x88, 0xe7, 0x2f, 0x6b, 0xc4, 0x3f, 0x80, 0x0d, 0xee, 0x35, 0x47, 0xa6,
-	0xf5, 0x70, 0xd3, 0x32, 0xa6, 0x0b, 0x33, 0x54, 0xdd, 0x74, 0xa7, 0x0b, 0x41, 0x86, 0x77, 0x17,
-	0xf4, 0x93, 0x9e, 0xef, 0xf1, 0x71, 0x8e, 0x84, 0xbd, 0x32, 0x2d, 0x66, 0xc2, 0x0c, 0x5f, 0xe7,
-	0xc3, 0x88, 0x6a, 0x8f, 0x25, 0xd8, 0x12, 0xc8, 0xe1, 0x5c, 0x34, 0xf4, 0xb6, 0x4f, 0x38, 0xb7,
-	0x1a, 0x25, 0xb3, 0x1c, 0x14, 0x26, 0x6b, 0xe8, 0x56, 0x89, 0x40, 0xf7, 0x61, 0x75, 0x2c, 0xde,
-	0xca, 0xe2, 0x95, 0xc8, 0xfd, 0xae, 0x8c, 0xa2, 0x2b, 0x0d, 0xbd, 0xf4, 0xf8, 0xd1, 0xff, 0x53,
-	0xc7, 0xcf, 0xea, 0x77, 0x70, 0xfc, 0xa0, 0x67, 0x38, 0x7e, 0xd6, 0x2e, 0x3f, 0x7e, 0xd0, 0x9b,
-	0x90, 0x99, 0x1c, 0xee, 0x8c, 0xf5, 0xab, 0xa5, 0x6a, 0x7a, 0x62, 0xac, 0x43, 0x3f, 0x86, 0x4d,
-	0x5e, 0x40, 0x11, 0x43, 0x7d, 0xc0, 0xef, 0x01, 0x37, 0xae, 0x26, 0xd4, 0x70, 0xf1, 0xf9, 0x85,
-	0xa1, 0x9f, 0x0b, 0x98, 0x31, 0x32, 0xde, 0x9c, 0x31, 0x32, 0xbe, 0x0b, 0xe3, 0xc3, 0x1b, 0x77,
-	0x89, 0x6c, 0xd9, 0xc6, 0x2d, 0x61, 0xc7, 0xf3, 0xd3, 0xa3, 0x73, 0xc4, 0x01, 0x6c, 0xae, 0xb9,
-	0x11, 0xa7, 0xb2, 0x0b, 0x5b, 0x51, 0xa5, 0x33, 0x52, 0x60, 0x08, 0x05, 0x77, 0x22, 0x14, 0xcc,
-	0x38, 0x45, 0xcc, 0xac, 0x3b, 0xfb, 0x84, 0xa9, 0xc2, 0x86, 0x28, 0x99, 0x50, 0x8f, 0x47, 0xc7,
-	0xc2, 0xbb, 0x11, 0x19, 0xde, 0x9b, 0x9c, 0x41, 0x09, 0x3a, 0xa2, 0xa3, 0x40, 0x1f, 0xc1, 0xb2,
-	0x72, 0xa0, 0x8f, 0xbd, 0x36, 0x31, 0xb2, 0xd1, 0x97, 0x7d, 0x99, 0x4b, 0x26, 0x87, 0x5c, 0x10,
-	0x9d, 0x7a, 0x7f, 0x44, 0x44, 0x3f, 0x81, 0xe7, 0xbf, 0xb5, 0x9c, 0x94, 0x9a, 0xcd, 0xeb, 0xab,
-	0xd9, 0xf9, 0x96, 0x7a, 0x93, 0xba, 0x1f, 0x82, 0x3e, 0x2a, 0x0d, 0xa5, 0xe8, 0xf6, 0xf5, 0x15,
-	0x65, 0x86, 0xb5, 0x23, 0xc5, 0x9e, 0xc0, 0x56, 0x9b, 0xf6, 0x89, 0xef, 0x51, 0xdf, 0x92, 0x0f,
-	0x25, 0x56, 0xeb, 0x8c, 0x53, 0xc2, 0x2e, 0xbe, 0x75, 0xb5, 0x2c, 0xce, 0x86, 0x52, 0xe4, 0xab,
-	0xcb, 0x81, 0x90, 0xa1, 0x7a, 0x7a, 0x0d, 0x6e, 0xf3, 0x04, 0x1a, 0xe9, 0x21, 0x9d, 0x53, 0xcb,
-	0x26, 0x1d, 0xd2, 0x96, 0x17, 0xe6, 0x5c, 0xe4, 0xfd, 0x92, 0xf7, 0xeb, 0xc3, 0x50, 0x28, 0xe9,
-	0x9c, 0x96, 0x87, 0x0c, 0xf9, 0x77, 0x20, 0x35, 0xbe, 0x87, 0x1d, 0x88, 0xbb, 0xf8, 0x3c, 0xe2,
-	0x1e, 0xcc, 0x37, 0xcc, 0x49, 0x02, 0xe1, 0x78, 0x33, 0xc6, 0x75, 0x4e, 0xca, 0xff, 0x21, 0x06,
-	0x8b, 0xa1, 0x36, 0x74, 0x00, 0xfa, 0xd0, 0x58, 0x2c, 0x2f, 0xa6, 0x4a, 0xfa, 0xec, 0x2b, 0xeb,
-	0x4a, 0xc8, 0xa1, 0x96, 0xc7, 0xde, 0xa9, 0x62, 0xd1, 0xef, 0x54, 0x87, 0x13, 0x1e, 0x1b, 0xbe,
-	0x53, 0xd5, 0x21, 0x65, 0x93, 0xa0, 0xe5, 0x3b, 0xf2, 0xdd, 0x32, 0x1e, 0x5d, 0xbd, 0x21, 0x73,
-	0x79, 0x04, 0x1d, 0x9f, 0xb5, 0xc6, 0x45, 0xa0, 0x77, 0xe1, 0x56, 0x07, 0x07, 0x6c, 0x2a, 0xbe,
-	0xe2, 0x42, 0x9b, 0xb8, 0xe2, 0x85, 0x76, 0x9d, 0x0b, 0x18, 0x0f, 0x2d, 0x07, 0xdc, 0x5f, 0xfc,
-	0xe0, 0xc9, 0xf6, 0xdc, 0xd7, 0x4f, 0xb6, 0xe7, 0xf2, 0x9f, 0x68, 0xb0, 0x16, 0x61, 0x12, 0x32,
-	0x60, 0xc1, 0xa5, 0x9e, 0xf3, 0x88, 0xf8, 0xd2, 0x81, 0x66, 0xf8, 0xc9, 0x6f, 0xe7, 0x8e, 0x4d,
-	0x3c, 0xe6, 0xb0, 0x81, 0x8c, 0x8b, 0x39, 0xfc, 0xe6, 0x5c, 0x8f, 0xc9, 0x49, 0xe0, 0x30, 0x79,
-	0xe5, 0x5d, 0x32, 0xc3, 0x4f, 0x3e, 0xf1, 0x05, 0xa4, 0xd5, 0xf3, 0xf9, 0x30, 0xd5, 0xa2, 0x1e,
-	0xc3, 0x2d, 0xf9, 0x84, 0xb7, 0x64, 0xae, 0x84, 0xeb, 0x07, 0x72, 0x99, 0x0b, 0xb1, 0x09, 0xc3,
-	0x4e, 0x27, 0x50, 0xb7, 0xff, 0xf0, 0xf3, 0x7e, 0xe2, 0xeb, 0x27, 0xdb, 0x5a, 0xfe, 0x5f, 0x1a,
-	0xac, 0x86, 0x26, 0x1f, 0xe3, 0x4e, 0xe3, 0x0c, 0xfb, 0x24, 0xf8, 0x6e, 0x42, 0x7f, 0x04, 0xab,
-	0x7d, 0xdc, 0x71, 0x6c, 0xcc, 0xc6, 0xa4, 0xc8, 0xe4, 0x7b, 0xee, 0x8b, 0x4f, 0xef, 0x6e, 0x29,
-	0x29, 0xc7, 0x21, 0x66, 0x52, 0x9c, 0xde, 0x9f, 0x5a, 0x47, 0x55, 0x48, 0x06, 0xc2, 0x3c, 0x75,
-	0x5d, 0xdc, 0xe3, 0x81, 0xfe, 0xeb, 0xd3, 0xed, 0x4d, 0x29, 0x28, 0xb0, 0x1f, 0x15, 0x1c, 0x5a,
-	0x74, 0x31, 0x3b, 0x2b, 0x3c, 0x20, 0x6d, 0xdc, 0x1a, 0x94, 0x49, 0x6b, 0xfa, 0xd1, 0x5a, 0x0a,
-	0x18, 0x0b, 0xd9, 0xef, 0x34, 0x58, 0x97, 0xfb, 0xe7, 0x13, 0xe6, 0xa8, 0xba, 0x50, 0x05, 0x56,
-	0x55, 0x71, 0x5e, 0xc3, 0x07, 0xfa, 0x90, 0x25, 0x34, 0x3a, 0xca, 0x93, 0xb1, 0x6b, 0x7a, 0x72,
-	0x64, 0xee, 0x9d, 0x47, 0x00, 0x63, 0xef, 0xfa, 0x9b, 0x70, 0xeb, 0xb8, 0xd6, 0xac, 0x58, 0xb5,
-	0x7a, 0xb3, 0x5a, 0x3b, 0xb2, 0x1e, 0x1e, 0x35, 0xea, 0x95, 0x83, 0xea, 0x9b, 0xd5, 0x4a, 0x59,
-	0x9f, 0x43, 0x6b, 0xb0, 0x32, 0x4e, 0x7c, 0xaf, 0xd2, 0xd0, 0x35, 0x74, 0x0b, 0xd6, 0xc6, 0x17,
-	0x4b, 0xfb, 0x8d, 0x66, 0xa9, 0x7a, 0xa4, 0xc7, 0x10, 0x82, 0xcc, 0x38, 0xe1, 0xa8, 0xa6, 0xc7,
-	0xef, 0x7c, 0xa3, 0x41, 0x66, 0xf2, 0x19, 0x19, 0x6d, 0xc3, 0x66, 0xdd, 0xac, 0xd5, 0x6b, 0x8d,
-	0xd2, 0x03, 0xab, 0xd1, 0x2c, 0x35, 0x1f, 0x36, 0xa6, 0xb4, 0xe6, 0x21, 0x37, 0x0d, 0x28, 0x57,
-	0xea, 0xb5, 0x46, 0xb5, 0x69, 0xd5, 0x2b, 0x66, 0xb5, 0x56, 0xd6, 0x35, 0xf4, 0x1c, 0x6c, 0x4d,
-	0x63, 0x8e, 0x6b, 0xcd, 0xea, 0xd1, 0x61, 0x08, 0x89, 0xa1, 0x2c, 0xdc, 0x9c, 0x86, 0xd4, 0x4b,
-	0x8d, 0x46, 0xa5, 0xac, 0xc7, 0xd1, 0x6d, 0x30, 0xa6, 0x69, 0x66, 0xe5, 0xad, 0xca, 0x41, 0xb3,
-	0x52, 0xd6, 0x13, 0x51, 0x9c, 0x6f, 0x96, 0xaa, 0x0f, 0x2a, 0x65, 0x7d, 0x3e, 0x8a, 0x76, 0x5c,
-	0x69, 0xd6, 0x2a, 0x65, 0x3d, 0x79, 0xe7, 0xd7, 0x1a, 0x64, 0x26, 0x7b, 0x11, 0x7a, 0x05, 0x36,
-	0x0f, 0x6b, 0xc7, 0x15, 0xf3, 0xa8, 0x66, 0x46, 0x6e, 0x36, 0xbb, 0xf2, 0xe1, 0xc7, 0x3b, 0xa9,
-	0x87, 0x5e, 0xd0, 0x25, 0x2d, 0xe7, 0xd4, 0x21, 0x36, 0x7a, 0x11, 0x6e, 0x4e, 0x73, 0x94, 0x0e,
-	0x9a, 0xd5, 0xe3, 0x8a, 0xae, 0x65, 0xe1, 0xc3, 0x8f, 0x77, 0x92, 0xf2, 0x9a, 0x8c, 0xee, 0x80,
-	0x31, 0x8d, 0xab, 0x1e, 0x29, 0x64, 0x2c, 0xbb, 0xfc, 0xe1, 0xc7, 0x3b, 0x8b, 0x55, 0x4f, 0x5e,
-	0xb8, 0xb3, 0x89, 0x0f, 0x7e, 0x93, 0x9b, 0xdb, 0x3f, 0xfc, 0xec, 0xcb, 0x9c, 0xf6, 0xf9, 0x97,
-	0x39, 0xed, 0xef, 0x5f, 0xe6, 0xb4, 0x8f, 0xbe, 0xca, 0xcd, 0x7d, 0xfe, 0x55, 0x6e, 0xee, 0xcf,
-	0x5f, 0xe5, 0xe6, 0x7e, 0x74, 0xb7, 0xed, 0xb0, 0xb3, 0xde, 0x49, 0xa1, 0x45, 0xdd, 0xa2, 0x6a,
-	0x8f, 0x77, 0xcf, 0x7a, 0x27, 0xe1, 0xef, 0xe2, 0xb9, 0xf8, 0xab, 0x17, 0x1b, 0x74, 0x49, 0x50,
-	0xec, 0xef, 0x9d, 0x24, 0x45, 0x77, 0x7b, 0xf5, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x51, 0xc9,
-	0x30, 0x4f, 0x14, 0x1b, 0x00, 0x00,
+func (m *Law) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (this *GovernorDescription) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Law) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
+	return ""
+}
 
-	that1, ok := that.(*GovernorDescription)
-	if !ok {
-		that2, ok := that.(GovernorDescription)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Law) GetText() string {
+	if m != nil {
+		return m.Text
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *Law) GetUri() string {
+	if m != nil {
+		return m.Uri
 	}
-	if this.Moniker != that1.Moniker {
-		return false
+	return ""
+}
+
+func (m *Law) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
 	}
-	if this.Identity != that1.Identity {
-		return false
+	return ""
+}
+
+func (m *Law) GetEnactingProposalId() uint64 {
+	if m != nil {
+		return m.EnactingProposalId
 	}
-	if this.Website != that1.Website {
-		return false
+	return 0
+}
+
+func (m *Law) GetEnactmentTime() time.Time {
+	if m != nil {
+		return m.EnactmentTime
 	}
-	if this.SecurityContact != that1.SecurityContact {
-		return false
+	return time.Time{}
+}
+
+func (m *Law) GetLastAmendingProposalId() uint64 {
+	if m != nil {
+		return m.LastAmendingProposalId
 	}
-	if this.Details != that1.Details {
-		return false
+	return 0
+}
+
+func (m *Law) GetLastAmendmentTime() *time.Time {
+	if m != nil {
+		return m.LastAmendmentTime
 	}
-	return true
+	return nil
 }
-func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+// ConstitutionVersion defines a version of the constitution.
+type ConstitutionVersion struct {
+	// version is the number of the version, starting at 1 for the constitution
+	// in force when the history started.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// constitution is the full text of the constitution at this version.
+	Constitution string `protobuf:"bytes,2,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// amending_proposal_id is the id of the proposal that amended the
+	// constitution to this version, zero for the first version.
+	AmendingProposalId uint64 `protobuf:"varint,3,opt,name=amending_proposal_id,json=amendingProposalId,proto3" json:"amending_proposal_id,omitempty"`
+	// amendment_time is the time the constitution has been amended to this
+	// version, or the time the history started for the first version.
+	AmendmentTime time.Time `protobuf:"bytes,4,opt,name=amendment_time,json=amendmentTime,proto3,stdtime" json:"amendment_time"`
+}
+
+func (m *ConstitutionVersion) Reset()         { *m = ConstitutionVersion{} }
+func (m *ConstitutionVersion) String() string { return proto.CompactTextString(m) }
+func (*ConstitutionVersion) ProtoMessage()    {}
+func (*ConstitutionVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{21}
+}
+func (m *ConstitutionVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConstitutionVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConstitutionVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ConstitutionVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstitutionVersion.Merge(m, src)
+}
+func (m *ConstitutionVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConstitutionVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstitutionVersion.DiscardUnknown(m)
 }
 
-func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ConstitutionVersion proto.InternalMessageInfo
+
+func (m *ConstitutionVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Weight) > 0 {
-		i -= len(m.Weight)
-		copy(dAtA[i:], m.Weight)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
-		i--
-		dAtA[i] = 0x12
+func (m *ConstitutionVersion) GetConstitution() string {
+	if m != nil {
+		return m.Constitution
 	}
-	if m.Option != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Option))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *ConstitutionVersion) GetAmendingProposalId() uint64 {
+	if m != nil {
+		return m.AmendingProposalId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConstitutionVersion) GetAmendmentTime() time.Time {
+	if m != nil {
+		return m.AmendmentTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CommitRevealVoting defines the commit-reveal voting of a proposal which
+// opted in at submission.
+type CommitRevealVoting struct {
+	// proposal_id is the id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// reveal_period is the duration of the reveal window closing the voting
+	// period of the proposal, as defined by the params at submission. The votes
+	// are committed to before the reveal window, and revealed during it.
+	RevealPeriod time.Duration `protobuf:"bytes,2,opt,name=reveal_period,json=revealPeriod,proto3,stdduration" json:"reveal_period"`
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
+func (m *CommitRevealVoting) Reset()         { *m = CommitRevealVoting{} }
+func (m *CommitRevealVoting) String() string { return proto.CompactTextString(m) }
+func (*CommitRevealVoting) ProtoMessage()    {}
+func (*CommitRevealVoting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{22}
+}
+func (m *CommitRevealVoting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitRevealVoting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitRevealVoting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitRevealVoting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitRevealVoting.Merge(m, src)
+}
+func (m *CommitRevealVoting) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitRevealVoting) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitRevealVoting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitRevealVoting proto.InternalMessageInfo
+
+func (m *CommitRevealVoting) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *CommitRevealVoting) GetRevealPeriod() time.Duration {
+	if m != nil {
+		return m.RevealPeriod
+	}
+	return 0
+}
+
+// VoteCommitment defines the commitment of a voter to a secret vote on a
+// proposal using commit-reveal voting, until the vote is revealed.
+type VoteCommitment struct {
+	// proposal_id is the id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the address of the voter.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// commitment is the SHA-256 hash of the vote and of a secret salt.
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *VoteCommitment) Reset()         { *m = VoteCommitment{} }
+func (m *VoteCommitment) String() string { return proto.CompactTextString(m) }
+func (*VoteCommitment) ProtoMessage()    {}
+func (*VoteCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{23}
+}
+func (m *VoteCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteCommitment.Merge(m, src)
+}
+func (m *VoteCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteCommitment proto.InternalMessageInfo
+
+func (m *VoteCommitment) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VoteCommitment) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *VoteCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("atomone.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("atomone.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterEnum("atomone.gov.v1.GovernorStatus", GovernorStatus_name, GovernorStatus_value)
+	proto.RegisterType((*WeightedVoteOption)(nil), "atomone.gov.v1.WeightedVoteOption")
+	proto.RegisterType((*Deposit)(nil), "atomone.gov.v1.Deposit")
+	proto.RegisterType((*LastMinDeposit)(nil), "atomone.gov.v1.LastMinDeposit")
+	proto.RegisterType((*Proposal)(nil), "atomone.gov.v1.Proposal")
+	proto.RegisterType((*TallyResult)(nil), "atomone.gov.v1.TallyResult")
+	proto.RegisterType((*Vote)(nil), "atomone.gov.v1.Vote")
+	proto.RegisterType((*QuorumCheckQueueEntry)(nil), "atomone.gov.v1.QuorumCheckQueueEntry")
+	proto.RegisterType((*DepositParams)(nil), "atomone.gov.v1.DepositParams")
+	proto.RegisterType((*VotingParams)(nil), "atomone.gov.v1.VotingParams")
+	proto.RegisterType((*TallyParams)(nil), "atomone.gov.v1.TallyParams")
+	proto.RegisterType((*MinDepositThrottler)(nil), "atomone.gov.v1.MinDepositThrottler")
+	proto.RegisterType((*MinInitialDepositThrottler)(nil), "atomone.gov.v1.MinInitialDepositThrottler")
+	proto.RegisterType((*Params)(nil), "atomone.gov.v1.Params")
+	proto.RegisterType((*ConstitutionAmendmentPatchParams)(nil), "atomone.gov.v1.ConstitutionAmendmentPatchParams")
+	proto.RegisterType((*CommitRevealParams)(nil), "atomone.gov.v1.CommitRevealParams")
+	proto.RegisterType((*QuorumRange)(nil), "atomone.gov.v1.QuorumRange")
+	proto.RegisterType((*Governor)(nil), "atomone.gov.v1.Governor")
+	proto.RegisterType((*GovernorDescription)(nil), "atomone.gov.v1.GovernorDescription")
+	proto.RegisterType((*GovernorValShares)(nil), "atomone.gov.v1.GovernorValShares")
+	proto.RegisterType((*GovernanceDelegation)(nil), "atomone.gov.v1.GovernanceDelegation")
+	proto.RegisterType((*Law)(nil), "atomone.gov.v1.Law")
+	proto.RegisterType((*ConstitutionVersion)(nil), "atomone.gov.v1.ConstitutionVersion")
+	proto.RegisterType((*CommitRevealVoting)(nil), "atomone.gov.v1.CommitRevealVoting")
+	proto.RegisterType((*VoteCommitment)(nil), "atomone.gov.v1.VoteCommitment")
+}
+
+func init() { proto.RegisterFile("atomone/gov/v1/gov.proto", fileDescriptor_ecf0f9950ff6986c) }
+
+var fileDescriptor_ecf0f9950ff6986c = []byte{
+	// 2709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcb, 0x6f, 0x1b, 0xd7,
+	0xd5, 0xd7, 0x90, 0x94, 0x44, 0x1d, 0x8a, 0x14, 0x75, 0x25, 0xcb, 0x23, 0xca, 0xa2, 0x68, 0x26,
+	0x08, 0x14, 0x7f, 0x31, 0x65, 0x3b, 0xf9, 0x02, 0x7c, 0x46, 0x80, 0x0f, 0xb4, 0x48, 0x3b, 0x4c,
+	0x6d, 0x91, 0x19, 0xd2, 0x4a, 0xd3, 0x45, 0x07, 0x57, 0x33, 0x57, 0xe4, 0xc0, 0x9c, 0x19, 0x66,
+	0xe6, 0x92, 0x96, 0x0c, 0x74, 0xd1, 0xa2, 0x05, 0x82, 0xac, 0x02, 0x74, 0xd3, 0x14, 0x0d, 0x60,
+	0xa0, 0x9b, 0x2e, 0xba, 0xc8, 0x22, 0x40, 0x37, 0x5d, 0x74, 0x53, 0x20, 0xcb, 0x20, 0x9b, 0x3e,
+	0x16, 0x6e, 0x91, 0x2c, 0x1a, 0xe4, 0x5f, 0x28, 0x0a, 0x14, 0xf7, 0x31, 0xe4, 0x90, 0x1c, 0x46,
+	0x52, 0x9a, 0x02, 0x45, 0x37, 0xf6, 0xcc, 0x3d, 0xbf, 0xf3, 0xb8, 0xf7, 0x3c, 0xee, 0x99, 0x43,
+	0x81, 0x8a, 0xa9, 0x6b, 0xbb, 0x0e, 0xd9, 0x6b, 0xbb, 0x83, 0xbd, 0xc1, 0x4d, 0xf6, 0x5f, 0xa9,
+	0xe7, 0xb9, 0xd4, 0x45, 0x19, 0x49, 0x29, 0xb1, 0xa5, 0xc1, 0xcd, 0x5c, 0xde, 0x70, 0x7d, 0xdb,
+	0xf5, 0xf7, 0x8e, 0xb0, 0x4f, 0xf6, 0x06, 0x37, 0x8f, 0x08, 0xc5, 0x37, 0xf7, 0x0c, 0xd7, 0x72,
+	0x04, 0x3e, 0xb7, 0xde, 0x76, 0xdb, 0x2e, 0x7f, 0xdc, 0x63, 0x4f, 0x72, 0x75, 0xa7, 0xed, 0xba,
+	0xed, 0x2e, 0xd9, 0xe3, 0x6f, 0x47, 0xfd, 0xe3, 0x3d, 0x6a, 0xd9, 0xc4, 0xa7, 0xd8, 0xee, 0x49,
+	0xc0, 0xe6, 0x24, 0x00, 0x3b, 0xa7, 0x92, 0x94, 0x9f, 0x24, 0x99, 0x7d, 0x0f, 0x53, 0xcb, 0x0d,
+	0x34, 0x6e, 0x0a, 0x8b, 0x74, 0xa1, 0x54, 0xbc, 0x48, 0xd2, 0x2a, 0xb6, 0x2d, 0xc7, 0xdd, 0xe3,
+	0xff, 0x8a, 0xa5, 0x62, 0x0f, 0xd0, 0x5b, 0xc4, 0x6a, 0x77, 0x28, 0x31, 0x0f, 0x5d, 0x4a, 0xea,
+	0x3d, 0x26, 0x09, 0xdd, 0x82, 0x05, 0x97, 0x3f, 0xa9, 0x4a, 0x41, 0xd9, 0xcd, 0xdc, 0xca, 0x95,
+	0xc6, 0xb7, 0x5d, 0x1a, 0x61, 0x35, 0x89, 0x44, 0x2f, 0xc0, 0xc2, 0x63, 0x2e, 0x49, 0x8d, 0x15,
+	0x94, 0xdd, 0xa5, 0x3b, 0x99, 0xcf, 0x3e, 0xbe, 0x0e, 0x52, 0x7d, 0x85, 0x18, 0x9a, 0xa4, 0x16,
+	0x9f, 0x2a, 0xb0, 0x58, 0x21, 0x3d, 0xd7, 0xb7, 0x28, 0xda, 0x81, 0x54, 0xcf, 0x73, 0x7b, 0xae,
+	0x8f, 0xbb, 0xba, 0x65, 0x72, 0x65, 0x09, 0x0d, 0x82, 0xa5, 0x9a, 0x89, 0x5e, 0x85, 0x25, 0x53,
+	0x60, 0x5d, 0x4f, 0xca, 0x55, 0x3f, 0xfb, 0xf8, 0xfa, 0xba, 0x94, 0x5b, 0x36, 0x4d, 0x8f, 0xf8,
+	0x7e, 0x93, 0x7a, 0x96, 0xd3, 0xd6, 0x46, 0x50, 0xf4, 0x1a, 0x2c, 0x60, 0xdb, 0xed, 0x3b, 0x54,
+	0x8d, 0x17, 0xe2, 0xbb, 0xa9, 0x5b, 0x9b, 0x25, 0xc9, 0xc1, 0xfc, 0x54, 0x92, 0x7e, 0x2a, 0xed,
+	0xbb, 0x96, 0x73, 0x67, 0xe9, 0x93, 0x67, 0x3b, 0x73, 0xbf, 0xfa, 0xdb, 0x47, 0xd7, 0x14, 0x4d,
+	0xf2, 0x14, 0x7f, 0xa4, 0x40, 0xe6, 0x3e, 0xf6, 0xe9, 0x03, 0xcb, 0x09, 0x2c, 0xbd, 0x0d, 0xf3,
+	0x03, 0xdc, 0xed, 0x13, 0x55, 0xb9, 0x80, 0x3c, 0xc1, 0x82, 0x5e, 0x81, 0x04, 0xf3, 0x2f, 0xb7,
+	0x3f, 0x75, 0x2b, 0x57, 0x12, 0x0e, 0x2c, 0x05, 0x0e, 0x2c, 0xb5, 0x02, 0xe7, 0xdf, 0x49, 0xbc,
+	0xff, 0x97, 0x1d, 0x45, 0xe3, 0xe8, 0xe2, 0xef, 0x16, 0x20, 0xd9, 0x90, 0x27, 0x81, 0x32, 0x10,
+	0x1b, 0x9e, 0x4f, 0xcc, 0x32, 0xd1, 0x0d, 0x48, 0xda, 0xc4, 0xf7, 0x71, 0x9b, 0xf8, 0x6a, 0x8c,
+	0x5b, 0xb4, 0x3e, 0x25, 0xb6, 0xec, 0x9c, 0x6a, 0x43, 0x14, 0x7a, 0x15, 0x16, 0x7c, 0x8a, 0x69,
+	0xdf, 0x57, 0xe3, 0xdc, 0xa5, 0xf9, 0x49, 0x97, 0x06, 0xba, 0x9a, 0x1c, 0xa5, 0x49, 0x34, 0xaa,
+	0x01, 0x3a, 0xb6, 0x1c, 0xdc, 0xd5, 0x29, 0xee, 0x76, 0x4f, 0x75, 0x8f, 0xf8, 0xfd, 0x2e, 0x55,
+	0x13, 0x7c, 0x2b, 0x5b, 0x93, 0x32, 0x5a, 0x0c, 0xa3, 0x71, 0x88, 0x96, 0xe5, 0x6c, 0xa1, 0x15,
+	0x54, 0x86, 0x94, 0xdf, 0x3f, 0xb2, 0x2d, 0xaa, 0xf3, 0xe3, 0x98, 0x3f, 0xe7, 0x71, 0x80, 0x60,
+	0x62, 0xcb, 0xe8, 0x0d, 0xc8, 0x4a, 0x27, 0xeb, 0xc4, 0x31, 0x85, 0x9c, 0x85, 0x73, 0xca, 0xc9,
+	0x48, 0xce, 0xaa, 0x63, 0x72, 0x59, 0x35, 0x48, 0x53, 0x97, 0xe2, 0xae, 0x2e, 0xd7, 0xd5, 0xc5,
+	0x0b, 0xb8, 0x76, 0x99, 0xb3, 0x06, 0xd1, 0x71, 0x1f, 0x56, 0x07, 0x2e, 0xb5, 0x9c, 0xb6, 0xee,
+	0x53, 0xec, 0xc9, 0xfd, 0x25, 0xcf, 0x69, 0xd7, 0x8a, 0x60, 0x6d, 0x32, 0x4e, 0x6e, 0xd8, 0xeb,
+	0x20, 0x97, 0x46, 0x7b, 0x5c, 0x3a, 0xa7, 0xac, 0xb4, 0x60, 0x0c, 0xb6, 0x98, 0x63, 0x61, 0x42,
+	0xb1, 0x89, 0x29, 0x56, 0x81, 0x65, 0x8f, 0x36, 0x7c, 0x47, 0xeb, 0x30, 0x4f, 0x2d, 0xda, 0x25,
+	0x6a, 0x8a, 0x13, 0xc4, 0x0b, 0x52, 0x61, 0xd1, 0xef, 0xdb, 0x36, 0xf6, 0x4e, 0xd5, 0x65, 0xbe,
+	0x1e, 0xbc, 0xa2, 0x57, 0x20, 0x29, 0x12, 0x93, 0x78, 0x6a, 0xfa, 0x8c, 0x4c, 0x1c, 0x22, 0x99,
+	0x05, 0xc4, 0x31, 0x5d, 0xcf, 0x27, 0xa6, 0x9a, 0x29, 0x28, 0xbb, 0x49, 0x6d, 0xf8, 0x8e, 0xf2,
+	0x00, 0xd8, 0x71, 0x5c, 0xca, 0xab, 0x97, 0xba, 0xc2, 0xd5, 0x85, 0x56, 0xd0, 0xff, 0xc3, 0x15,
+	0x5e, 0x17, 0x75, 0x79, 0x1a, 0x3d, 0xe2, 0x59, 0xae, 0xa9, 0x93, 0x13, 0x4a, 0x1c, 0x93, 0x98,
+	0x6a, 0xb6, 0xa0, 0xec, 0xa6, 0xb5, 0x4d, 0x8e, 0x39, 0xe4, 0x90, 0x06, 0x47, 0x54, 0x25, 0xa0,
+	0xf8, 0x73, 0x05, 0x52, 0xe1, 0x00, 0xfc, 0x1f, 0x58, 0x3a, 0x25, 0xbe, 0x6e, 0xf0, 0xc2, 0xa0,
+	0x4c, 0x55, 0xa9, 0x9a, 0x43, 0xb5, 0xe4, 0x29, 0xf1, 0xf7, 0x19, 0x1d, 0xbd, 0x0c, 0x69, 0x7c,
+	0xe4, 0x53, 0x6c, 0x39, 0x92, 0x21, 0x16, 0xc9, 0xb0, 0x2c, 0x41, 0x82, 0xe9, 0x45, 0x48, 0x3a,
+	0xae, 0xc4, 0xc7, 0x23, 0xf1, 0x8b, 0x8e, 0xcb, 0xa1, 0xc5, 0xdf, 0x28, 0x90, 0x60, 0x65, 0xf4,
+	0xec, 0x22, 0x58, 0x82, 0xf9, 0x81, 0x4b, 0xc9, 0xd9, 0x05, 0x50, 0xc0, 0xd0, 0x6b, 0xb0, 0x28,
+	0x6a, 0xb2, 0xaf, 0x26, 0x78, 0x48, 0x17, 0x27, 0xf3, 0x74, 0xba, 0xe4, 0x6b, 0x01, 0xcb, 0x58,
+	0xcc, 0xcc, 0x8f, 0xc7, 0xcc, 0x1b, 0x89, 0x64, 0x3c, 0x9b, 0x28, 0xfe, 0x5e, 0x81, 0x4b, 0x6f,
+	0xf6, 0x5d, 0xaf, 0x6f, 0xef, 0x77, 0x88, 0xf1, 0xe8, 0xcd, 0x3e, 0xe9, 0x93, 0xaa, 0x43, 0xbd,
+	0x53, 0xd4, 0x80, 0xb5, 0x77, 0x38, 0x81, 0x47, 0xad, 0xdb, 0x97, 0x99, 0xa0, 0x9c, 0x33, 0x7a,
+	0x57, 0x05, 0x73, 0x4b, 0xf0, 0xb2, 0xff, 0xd0, 0x4b, 0x80, 0xa4, 0x44, 0x83, 0xe9, 0x0a, 0xb9,
+	0x22, 0xa1, 0x65, 0xdf, 0x19, 0x19, 0x21, 0x8e, 0x7f, 0x02, 0xed, 0xeb, 0xa6, 0xeb, 0x10, 0x35,
+	0x3e, 0x85, 0xf6, 0x2b, 0xae, 0x43, 0x8a, 0x7f, 0x52, 0x20, 0x2d, 0x33, 0xb8, 0x81, 0x3d, 0x6c,
+	0xfb, 0xe8, 0x6d, 0x48, 0xd9, 0x96, 0x33, 0x2c, 0x08, 0x67, 0xd6, 0xfa, 0x6d, 0x56, 0x10, 0xbe,
+	0x7a, 0xb6, 0x73, 0x29, 0xc4, 0xf5, 0x92, 0x6b, 0x5b, 0x94, 0xd8, 0x3d, 0x7a, 0xaa, 0x81, 0x3d,
+	0xba, 0x40, 0x6c, 0x40, 0x36, 0x3e, 0x09, 0x40, 0x32, 0x96, 0xe5, 0x95, 0xb0, 0x39, 0x75, 0x32,
+	0x15, 0x79, 0xa7, 0xdf, 0x79, 0xfe, 0xab, 0x67, 0x3b, 0x57, 0xa6, 0x19, 0x47, 0x4a, 0x7e, 0xc6,
+	0x0e, 0x2e, 0x6b, 0xe3, 0x93, 0x60, 0x27, 0x9c, 0x5e, 0x6c, 0xc1, 0xb2, 0x4c, 0x09, 0xb1, 0xb3,
+	0x0a, 0xa4, 0xc7, 0xb2, 0x48, 0x55, 0xce, 0xd2, 0x9c, 0xe0, 0x92, 0x97, 0x07, 0xa1, 0xc4, 0x2a,
+	0xfe, 0x3d, 0x26, 0x13, 0x4a, 0x4a, 0xdd, 0x85, 0x05, 0x71, 0xaa, 0x32, 0x9b, 0xb2, 0xe3, 0x77,
+	0xbe, 0xaa, 0x68, 0x92, 0x8e, 0x5e, 0x82, 0x25, 0xda, 0xf1, 0x88, 0xdf, 0x71, 0xbb, 0xe6, 0x8c,
+	0x06, 0x61, 0x04, 0x40, 0x2d, 0xd8, 0x36, 0x5c, 0xc7, 0xa7, 0x16, 0xed, 0x33, 0x5b, 0x74, 0x6c,
+	0x13, 0xc7, 0xb4, 0x89, 0x43, 0x75, 0xa9, 0x2e, 0x3e, 0x43, 0xdd, 0x56, 0x98, 0xad, 0x1c, 0x70,
+	0x89, 0x60, 0x45, 0xdf, 0x85, 0xc2, 0x0c, 0xa9, 0x23, 0xd3, 0x12, 0x91, 0xa6, 0xe5, 0x23, 0xc5,
+	0xb6, 0x86, 0xf6, 0xee, 0x01, 0x74, 0xf1, 0xe3, 0xc0, 0xb8, 0xf9, 0x19, 0xc6, 0x2d, 0x75, 0xf1,
+	0x63, 0x69, 0xca, 0xcb, 0x90, 0x66, 0x0c, 0x23, 0xbd, 0x0b, 0x91, 0x7a, 0x97, 0xbb, 0xf8, 0xf1,
+	0x50, 0x4b, 0xf1, 0x83, 0x38, 0xac, 0x8d, 0x5a, 0x92, 0x56, 0xc7, 0x73, 0x29, 0xed, 0x12, 0x0f,
+	0x55, 0x21, 0x75, 0xdc, 0x75, 0x5d, 0x4f, 0xbf, 0x78, 0x87, 0x02, 0x9c, 0xf1, 0x90, 0xf1, 0xb1,
+	0x10, 0xe9, 0xf7, 0x4c, 0x4c, 0xc9, 0xb9, 0x83, 0x53, 0x86, 0x88, 0xe0, 0x12, 0x21, 0x82, 0x5e,
+	0x85, 0xcb, 0x14, 0x7b, 0x6d, 0x42, 0x75, 0x6c, 0x50, 0x6b, 0x40, 0xf4, 0xa0, 0x90, 0xf9, 0x32,
+	0x0f, 0x2f, 0x09, 0x72, 0x99, 0x53, 0x83, 0xa6, 0xc3, 0x47, 0xff, 0x0b, 0x19, 0xcb, 0x31, 0x3c,
+	0x82, 0x7d, 0xa2, 0x73, 0xf1, 0x33, 0x5c, 0x91, 0x0e, 0x50, 0x1a, 0x03, 0x31, 0x36, 0x93, 0x8c,
+	0xb1, 0xcd, 0x47, 0xb3, 0x99, 0x24, 0xcc, 0x56, 0x87, 0xe7, 0x87, 0x6c, 0x3e, 0x71, 0x7c, 0x8b,
+	0x5a, 0x03, 0x8b, 0x9e, 0xea, 0xd2, 0x74, 0xd3, 0xf2, 0x29, 0x76, 0x0c, 0xd1, 0x5b, 0x24, 0xb4,
+	0xab, 0x01, 0xb6, 0x39, 0x82, 0xb6, 0x38, 0xb2, 0x22, 0x81, 0xc5, 0x9f, 0xc6, 0x21, 0xf7, 0xc0,
+	0x72, 0x6a, 0x8e, 0x45, 0x2d, 0xdc, 0xfd, 0xcf, 0x76, 0xd1, 0x8b, 0x90, 0x95, 0xfb, 0x9c, 0xf4,
+	0xcd, 0x8a, 0x58, 0xff, 0xaf, 0xf1, 0xca, 0xb3, 0x15, 0x58, 0x90, 0xa5, 0xea, 0xde, 0x05, 0x4b,
+	0x7b, 0x6a, 0xe8, 0x01, 0x55, 0x19, 0x2b, 0xe4, 0x0f, 0xbe, 0x59, 0x21, 0x4f, 0x44, 0x17, 0xea,
+	0xe9, 0xc2, 0x1c, 0xff, 0x06, 0x85, 0x39, 0x54, 0x88, 0x13, 0x17, 0x29, 0xc4, 0xf3, 0x67, 0x15,
+	0xe2, 0xef, 0xc0, 0x26, 0x3b, 0x35, 0x4b, 0x84, 0xf5, 0x70, 0xd3, 0xc2, 0xa7, 0x8b, 0x33, 0x54,
+	0x6d, 0xd8, 0x93, 0x89, 0x20, 0xdc, 0xbb, 0x0b, 0xd9, 0xa3, 0xbe, 0xe7, 0xb0, 0x76, 0x8e, 0x04,
+	0xb5, 0x32, 0xcd, 0x7b, 0xc2, 0x0c, 0x5b, 0x67, 0xcd, 0x88, 0x2c, 0x8f, 0x65, 0xd8, 0xe6, 0xc8,
+	0x61, 0x5f, 0x34, 0x3c, 0x6d, 0x8f, 0x30, 0x6e, 0xd9, 0x4a, 0xe6, 0x18, 0x28, 0x08, 0xd6, 0xe0,
+	0x58, 0x05, 0x02, 0xdd, 0x86, 0xd5, 0x90, 0xbf, 0xa5, 0xc5, 0x2b, 0x91, 0xfb, 0x5d, 0x19, 0x79,
+	0x57, 0x18, 0x7a, 0xe6, 0xf5, 0x93, 0xfd, 0x77, 0x5d, 0x3f, 0xab, 0xdf, 0xc2, 0xf5, 0x83, 0xbe,
+	0xc1, 0xf5, 0xb3, 0x76, 0xf6, 0xf5, 0x83, 0xee, 0x42, 0x66, 0xbc, 0xb9, 0x53, 0xd7, 0xcf, 0x17,
+	0xaa, 0xe9, 0xb1, 0xb6, 0x0e, 0x7d, 0x1f, 0xb6, 0x58, 0x02, 0x45, 0x34, 0xf5, 0x3e, 0xfb, 0x0e,
+	0xb8, 0x74, 0x3e, 0xa1, 0xaa, 0x8d, 0x4f, 0xa6, 0x9a, 0x7e, 0x26, 0x60, 0x46, 0xcb, 0xb8, 0x31,
+	0xa3, 0x65, 0x7c, 0x0b, 0xc2, 0xcd, 0x9b, 0x4e, 0x83, 0x92, 0xad, 0x5e, 0xe6, 0x76, 0x3c, 0x37,
+	0xd9, 0x3a, 0x47, 0x5c, 0xc0, 0xda, 0x9a, 0x3d, 0xbd, 0x88, 0x6c, 0xd8, 0x8e, 0x4a, 0x9d, 0x91,
+	0x02, 0x95, 0x2b, 0xb8, 0x16, 0xa1, 0x60, 0xc6, 0x2d, 0xa2, 0xe5, 0xec, 0x99, 0x34, 0x54, 0x83,
+	0x4d, 0x9e, 0x32, 0x81, 0x1e, 0xc7, 0x0d, 0xb9, 0x77, 0x33, 0xd2, 0xbd, 0x1b, 0x8c, 0x41, 0x0a,
+	0x3a, 0x70, 0x47, 0x8e, 0x3e, 0x80, 0x65, 0x79, 0x80, 0x1e, 0x76, 0xda, 0x44, 0xcd, 0x45, 0x7f,
+	0xec, 0x8b, 0x58, 0xd2, 0x18, 0x64, 0x4a, 0x74, 0xea, 0x9d, 0x11, 0x11, 0x3d, 0x81, 0xe7, 0xbe,
+	0x36, 0x9d, 0xa4, 0x9a, 0xad, 0x8b, 0xab, 0x29, 0x7c, 0x4d, 0xbe, 0x09, 0xdd, 0x0f, 0x21, 0x3b,
+	0x4a, 0x0d, 0xa9, 0xe8, 0xca, 0xc5, 0x15, 0x65, 0x86, 0xb9, 0x23, 0xc4, 0x1e, 0xc1, 0x76, 0xdb,
+	0x1d, 0x10, 0xcf, 0x71, 0x3d, 0x5d, 0x0c, 0x4a, 0x74, 0xa3, 0xc3, 0x28, 0x41, 0x15, 0xdf, 0x3e,
+	0x5f, 0x14, 0xe7, 0x02, 0x29, 0x62, 0xea, 0xb2, 0xcf, 0x65, 0xc8, 0x9a, 0x5e, 0x87, 0x2b, 0x2c,
+	0x80, 0x46, 0x7a, 0x48, 0xf7, 0x58, 0x37, 0x49, 0x97, 0xb4, 0xc5, 0x07, 0x73, 0x3e, 0xf2, 0xfb,
+	0x92, 0xd5, 0xeb, 0x7b, 0x81, 0x50, 0xd2, 0x3d, 0xae, 0x0c, 0x19, 0xd0, 0x0f, 0xe0, 0xca, 0x0c,
+	0x3f, 0xf4, 0x30, 0x35, 0x3a, 0xea, 0x0e, 0xb7, 0xf9, 0xc6, 0xe4, 0xb9, 0xec, 0x47, 0x9d, 0x71,
+	0x83, 0x71, 0x88, 0xab, 0x35, 0xdc, 0xac, 0xe4, 0x8c, 0x99, 0x60, 0xa4, 0x41, 0xda, 0x70, 0x6d,
+	0x36, 0xfe, 0xf1, 0xc8, 0x80, 0xe0, 0xae, 0x5a, 0x28, 0x28, 0x51, 0x1f, 0xa7, 0xfb, 0x1c, 0xa4,
+	0x71, 0xcc, 0xb4, 0x86, 0x65, 0x23, 0x44, 0x2e, 0x52, 0x28, 0x9c, 0x65, 0x1e, 0xda, 0x60, 0x93,
+	0x2f, 0xcf, 0x32, 0xc4, 0x27, 0x7f, 0x52, 0x93, 0x6f, 0x68, 0x1b, 0x80, 0xd5, 0x21, 0xf7, 0xf8,
+	0xd8, 0x27, 0xc1, 0x27, 0xe5, 0x92, 0x8d, 0x4f, 0xea, 0x7c, 0x01, 0x6d, 0x42, 0x92, 0x91, 0x8f,
+	0xfb, 0x4f, 0x9e, 0xc8, 0xee, 0x68, 0xd1, 0xc6, 0x27, 0x77, 0xfb, 0x4f, 0x9e, 0x14, 0x3f, 0x50,
+	0x00, 0x4d, 0x5b, 0x89, 0x1e, 0x40, 0x5a, 0xec, 0xec, 0xdc, 0xdf, 0x58, 0x69, 0xb6, 0x2f, 0x16,
+	0x08, 0x72, 0x6f, 0x82, 0x5d, 0xfa, 0xff, 0x15, 0xd8, 0x18, 0xab, 0x63, 0xe4, 0x84, 0x7d, 0xf0,
+	0x31, 0xcf, 0xc7, 0xf8, 0x3e, 0xd6, 0x43, 0xb5, 0xac, 0x1a, 0xd0, 0x8a, 0x6f, 0x42, 0x2a, 0x1c,
+	0xa8, 0x05, 0x88, 0xdb, 0xf8, 0x24, 0x62, 0xd8, 0xc1, 0xa2, 0x9a, 0x91, 0x38, 0xc2, 0x72, 0x66,
+	0x7c, 0x93, 0x31, 0x52, 0xf1, 0xb7, 0x31, 0x48, 0x06, 0x21, 0x85, 0xf6, 0x21, 0x3b, 0x8c, 0x48,
+	0x2c, 0xa6, 0x0f, 0xaa, 0x72, 0xc6, 0x5c, 0x62, 0x25, 0xe0, 0x90, 0xcb, 0xa1, 0x61, 0x64, 0x2c,
+	0x7a, 0x18, 0x79, 0x6f, 0x2c, 0x2d, 0x86, 0xc3, 0xc8, 0x06, 0xa4, 0x4c, 0xe2, 0x1b, 0x9e, 0x25,
+	0xce, 0x21, 0x1e, 0x5d, 0xa2, 0x03, 0xe6, 0xca, 0x08, 0x1a, 0x8e, 0xa0, 0xb0, 0x08, 0xf4, 0x16,
+	0x5c, 0xee, 0x62, 0x9f, 0x4e, 0x24, 0x31, 0x9f, 0x5a, 0x24, 0xce, 0x39, 0xb5, 0x58, 0x67, 0x02,
+	0xc2, 0xf9, 0xcb, 0x00, 0xb7, 0x93, 0xef, 0x3e, 0xdd, 0x99, 0xfb, 0xf2, 0xe9, 0xce, 0x5c, 0xf1,
+	0x23, 0x05, 0xd6, 0x22, 0x4c, 0x62, 0xa3, 0x36, 0xdb, 0x75, 0xac, 0x47, 0xc4, 0x13, 0x07, 0xa8,
+	0x05, 0xaf, 0x6c, 0x04, 0x63, 0x99, 0xc4, 0xa1, 0x16, 0x3d, 0x15, 0x7e, 0xd1, 0x86, 0xef, 0x8c,
+	0xeb, 0x31, 0x39, 0xf2, 0x2d, 0x2a, 0xe6, 0x1a, 0x4b, 0x5a, 0xf0, 0xca, 0xda, 0x7a, 0x9f, 0x18,
+	0x7d, 0x8f, 0x75, 0xcc, 0x86, 0xeb, 0x50, 0x6c, 0x88, 0x39, 0xed, 0x92, 0xb6, 0x12, 0xac, 0xef,
+	0x8b, 0x65, 0x26, 0xc4, 0x24, 0x14, 0x5b, 0x5d, 0x5f, 0x8e, 0x78, 0x82, 0xd7, 0xdb, 0x89, 0x2f,
+	0x9f, 0xee, 0x28, 0xc5, 0x7f, 0x28, 0xb0, 0x1a, 0x98, 0x7c, 0x88, 0xbb, 0xcd, 0x0e, 0xf6, 0x88,
+	0xff, 0xed, 0xb8, 0xfe, 0x00, 0x56, 0x07, 0xb8, 0x6b, 0x99, 0x98, 0x86, 0xa4, 0x88, 0xe0, 0xbb,
+	0xfa, 0xd9, 0xc7, 0xd7, 0xb7, 0xa5, 0x94, 0xc3, 0x00, 0x33, 0x2e, 0x2e, 0x3b, 0x98, 0x58, 0x47,
+	0x35, 0x58, 0xf0, 0xb9, 0x79, 0x72, 0x26, 0x70, 0x93, 0x39, 0xfa, 0xcf, 0xcf, 0x76, 0xb6, 0x84,
+	0x20, 0xdf, 0x7c, 0x54, 0xb2, 0xdc, 0x3d, 0x1b, 0xd3, 0x4e, 0xe9, 0x3e, 0x69, 0x63, 0xe3, 0xb4,
+	0x42, 0x8c, 0xc9, 0x5f, 0x26, 0x84, 0x80, 0x90, 0xcb, 0x7e, 0xad, 0xc0, 0xba, 0xd8, 0x3f, 0xfb,
+	0x8c, 0x08, 0x95, 0xd0, 0x2a, 0xac, 0xca, 0x0a, 0x7c, 0x81, 0x33, 0xc8, 0x0e, 0x59, 0x02, 0xa3,
+	0xa3, 0x4e, 0x32, 0x76, 0xc1, 0x93, 0x0c, 0x99, 0xfb, 0x93, 0x38, 0xc4, 0xef, 0xe3, 0xc7, 0x53,
+	0xbf, 0x12, 0x0c, 0x47, 0xbc, 0xb1, 0xf0, 0x88, 0x17, 0x41, 0x82, 0x92, 0x13, 0x39, 0x9f, 0xd4,
+	0xf8, 0x33, 0xca, 0x42, 0xbc, 0xef, 0x59, 0x32, 0x5c, 0xd8, 0x23, 0xba, 0x0a, 0xcb, 0x2c, 0x88,
+	0xd8, 0xed, 0xd0, 0xc1, 0x7e, 0x47, 0xc6, 0x49, 0x4a, 0xae, 0xbd, 0x8e, 0xfd, 0x0e, 0xba, 0x01,
+	0xeb, 0xc4, 0xc1, 0x86, 0x68, 0xe3, 0x42, 0x13, 0x4c, 0xf1, 0x79, 0x86, 0x02, 0x5a, 0x63, 0x34,
+	0xc9, 0x6c, 0x40, 0x86, 0xaf, 0x8a, 0xae, 0x97, 0x25, 0xd9, 0xe2, 0x99, 0x49, 0xc6, 0x6b, 0xe4,
+	0xfb, 0xc3, 0x1a, 0x99, 0x1e, 0x0a, 0x60, 0x10, 0xf4, 0x7f, 0xb0, 0xc9, 0xf3, 0x97, 0xdf, 0x65,
+	0x93, 0x86, 0x24, 0xb9, 0x21, 0x1b, 0x0c, 0x50, 0x96, 0xf4, 0x31, 0x63, 0xd6, 0x46, 0xac, 0x23,
+	0x8b, 0xce, 0x3b, 0x6a, 0x5f, 0x1d, 0x8a, 0x0d, 0x8c, 0x29, 0xfe, 0x41, 0x81, 0xb5, 0xf0, 0x75,
+	0x74, 0x48, 0x3c, 0x5f, 0x66, 0xfa, 0x40, 0x3c, 0x4a, 0xe7, 0x04, 0xaf, 0xa8, 0xc8, 0x4f, 0x79,
+	0xc8, 0x20, 0x1d, 0x35, 0xb6, 0xc6, 0x8e, 0x39, 0x72, 0x77, 0xe2, 0x52, 0x42, 0x38, 0x6a, 0x67,
+	0x99, 0x89, 0x4d, 0x25, 0x2e, 0x7c, 0xcc, 0x78, 0x6c, 0x67, 0x3f, 0x9e, 0xb8, 0xf1, 0x44, 0xe7,
+	0x7d, 0xf6, 0xe8, 0x7a, 0xea, 0x4a, 0x8c, 0xfd, 0x2b, 0x57, 0x62, 0xf1, 0x87, 0x0a, 0x64, 0xd8,
+	0x67, 0xa2, 0x30, 0x85, 0x59, 0xf7, 0xed, 0x4f, 0xcf, 0xf3, 0x00, 0xc6, 0x50, 0x3c, 0x3f, 0xe4,
+	0x65, 0x2d, 0xb4, 0x72, 0xed, 0x11, 0x40, 0xe8, 0x97, 0xd2, 0x2d, 0xb8, 0x7c, 0x58, 0x6f, 0x55,
+	0xf5, 0x7a, 0xa3, 0x55, 0xab, 0x1f, 0xe8, 0x0f, 0x0f, 0x9a, 0x8d, 0xea, 0x7e, 0xed, 0x6e, 0xad,
+	0x5a, 0xc9, 0xce, 0xa1, 0x35, 0x58, 0x09, 0x13, 0xdf, 0xae, 0x36, 0xb3, 0x0a, 0xba, 0x0c, 0x6b,
+	0xe1, 0xc5, 0xf2, 0x9d, 0x66, 0xab, 0x5c, 0x3b, 0xc8, 0xc6, 0x10, 0x82, 0x4c, 0x98, 0x70, 0x50,
+	0xcf, 0xc6, 0xaf, 0x7d, 0xa5, 0x40, 0x66, 0xfc, 0x87, 0x39, 0xb4, 0x03, 0x5b, 0x0d, 0xad, 0xde,
+	0xa8, 0x37, 0xcb, 0xf7, 0xf5, 0x66, 0xab, 0xdc, 0x7a, 0xd8, 0x9c, 0xd0, 0x5a, 0x84, 0xfc, 0x24,
+	0xa0, 0x52, 0x6d, 0xd4, 0x9b, 0xb5, 0x96, 0xde, 0xa8, 0x6a, 0xb5, 0x7a, 0x25, 0xab, 0xa0, 0xab,
+	0xb0, 0x3d, 0x89, 0x39, 0xac, 0xb7, 0x6a, 0x07, 0xf7, 0x02, 0x48, 0x0c, 0xe5, 0x60, 0x63, 0x12,
+	0xd2, 0x28, 0x37, 0x9b, 0xd5, 0x4a, 0x36, 0x8e, 0xae, 0x80, 0x3a, 0x49, 0xd3, 0xaa, 0x6f, 0x54,
+	0xf7, 0x5b, 0xd5, 0x4a, 0x36, 0x11, 0xc5, 0x79, 0xb7, 0x5c, 0xbb, 0x5f, 0xad, 0x64, 0xe7, 0xa3,
+	0x68, 0x87, 0xd5, 0x56, 0xbd, 0x5a, 0xc9, 0x2e, 0x5c, 0xfb, 0x85, 0x02, 0x99, 0xf1, 0x8b, 0x1f,
+	0xdd, 0x80, 0xad, 0x7b, 0xf5, 0xc3, 0xaa, 0x76, 0x50, 0xd7, 0x22, 0x37, 0x9b, 0x5b, 0x79, 0xef,
+	0xc3, 0x42, 0xea, 0xa1, 0xe3, 0xf7, 0x88, 0x61, 0x1d, 0x5b, 0xc4, 0x44, 0x2f, 0xc0, 0xc6, 0x24,
+	0x47, 0x79, 0xbf, 0x55, 0x3b, 0xac, 0x66, 0x95, 0x1c, 0xbc, 0xf7, 0x61, 0x61, 0x41, 0x0c, 0x1e,
+	0xd1, 0x35, 0x50, 0x27, 0x71, 0xb5, 0x03, 0x89, 0x8c, 0xe5, 0x96, 0xdf, 0xfb, 0xb0, 0x90, 0xac,
+	0x39, 0x62, 0x84, 0x99, 0x4b, 0xbc, 0xfb, 0xcb, 0xfc, 0xdc, 0x9d, 0x7b, 0x9f, 0x7c, 0x9e, 0x57,
+	0x3e, 0xfd, 0x3c, 0xaf, 0xfc, 0xf5, 0xf3, 0xbc, 0xf2, 0xfe, 0x17, 0xf9, 0xb9, 0x4f, 0xbf, 0xc8,
+	0xcf, 0xfd, 0xf1, 0x8b, 0xfc, 0xdc, 0xf7, 0xae, 0xb7, 0x2d, 0xda, 0xe9, 0x1f, 0x95, 0x0c, 0xd7,
+	0xde, 0x93, 0xbd, 0xc8, 0xf5, 0x4e, 0xff, 0x28, 0x78, 0xde, 0x3b, 0xe1, 0x7f, 0x47, 0x40, 0x4f,
+	0x7b, 0xc4, 0x67, 0x7f, 0x23, 0xb0, 0xc0, 0xa3, 0xfe, 0xe5, 0x7f, 0x0e, 0x00, 0x3f, 0x49, 0x75,
+	0xfc, 0x66, 0x20, 0x00, 0x00,
+}
+
+func (this *GovernorDescription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GovernorDescription)
+	if !ok {
+		that2, ok := that.(GovernorDescription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Moniker != that1.Moniker {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.Website != that1.Website {
+		return false
+	}
+	if this.SecurityContact != that1.SecurityContact {
+		return false
+	}
+	if this.Details != that1.Details {
+		return false
+	}
+	return true
+}
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
 				i -= size
 				i = encodeVarintGov(dAtA, i, uint64(size))
 			}
@@ -2619,6 +3124,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.CommitReveal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x82
+	{
+		size, err := m.ConstitutionAmendmentPatch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
 	if len(m.MinGovernorSelfDelegation) > 0 {
 		i -= len(m.MinGovernorSelfDelegation)
 		copy(dAtA[i:], m.MinGovernorSelfDelegation)
@@ -2629,12 +3158,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xf2
 	}
 	if m.GovernorStatusChangePeriod != nil {
-		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.GovernorStatusChangePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.GovernorStatusChangePeriod):])
-		if err12 != nil {
-			return 0, err12
+		n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.GovernorStatusChangePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.GovernorStatusChangePeriod):])
+		if err14 != nil {
+			return 0, err14
 		}
-		i -= n12
-		i = encodeVarintGov(dAtA, i, uint64(n12))
+		i -= n14
+		i = encodeVarintGov(dAtA, i, uint64(n14))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -2727,24 +3256,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.MaxVotingPeriodExtension != nil {
-		n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxVotingPeriodExtension, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxVotingPeriodExtension):])
-		if err18 != nil {
-			return 0, err18
+		n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxVotingPeriodExtension, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxVotingPeriodExtension):])
+		if err20 != nil {
+			return 0, err20
 		}
-		i -= n18
-		i = encodeVarintGov(dAtA, i, uint64(n18))
+		i -= n20
+		i = encodeVarintGov(dAtA, i, uint64(n20))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.QuorumTimeout != nil {
-		n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.QuorumTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.QuorumTimeout):])
-		if err19 != nil {
-			return 0, err19
+		n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.QuorumTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.QuorumTimeout):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n19
-		i = encodeVarintGov(dAtA, i, uint64(n19))
+		i -= n21
+		i = encodeVarintGov(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -2835,22 +3364,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err20 != nil {
-			return 0, err20
+		n22, err22 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err22 != nil {
+			return 0, err22
 		}
-		i -= n20
-		i = encodeVarintGov(dAtA, i, uint64(n20))
+		i -= n22
+		i = encodeVarintGov(dAtA, i, uint64(n22))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err21 != nil {
-			return 0, err21
+		n23, err23 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err23 != nil {
+			return 0, err23
 		}
-		i -= n21
-		i = encodeVarintGov(dAtA, i, uint64(n21))
+		i -= n23
+		i = encodeVarintGov(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2871,7 +3400,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QuorumRange) Marshal() (dAtA []byte, err error) {
+func (m *ConstitutionAmendmentPatchParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2881,36 +3410,120 @@ func (m *QuorumRange) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuorumRange) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConstitutionAmendmentPatchParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuorumRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConstitutionAmendmentPatchParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Min) > 0 {
-		i -= len(m.Min)
-		copy(dAtA[i:], m.Min)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Min)))
+	if m.MaxFuzz != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxFuzz))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.Max) > 0 {
-		i -= len(m.Max)
-		copy(dAtA[i:], m.Max)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Max)))
+	if m.MaxOffset != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxOffset))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Governor) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+	if m.Strict {
+		i--
+		if m.Strict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitRevealParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitRevealParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitRevealParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuorumCheckExemption {
+		i--
+		if m.QuorumCheckExemption {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	n24, err24 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RevealPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RevealPeriod):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintGov(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuorumRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuorumRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Governor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -2929,12 +3542,12 @@ func (m *Governor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.LastStatusChangeTime != nil {
-		n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastStatusChangeTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastStatusChangeTime):])
-		if err22 != nil {
-			return 0, err22
+		n25, err25 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastStatusChangeTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastStatusChangeTime):])
+		if err25 != nil {
+			return 0, err25
 		}
-		i -= n22
-		i = encodeVarintGov(dAtA, i, uint64(n22))
+		i -= n25
+		i = encodeVarintGov(dAtA, i, uint64(n25))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -3105,6 +3718,216 @@ func (m *GovernanceDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Law) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Law) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Law) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastAmendmentTime != nil {
+		n27, err27 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastAmendmentTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastAmendmentTime):])
+		if err27 != nil {
+			return 0, err27
+		}
+		i -= n27
+		i = encodeVarintGov(dAtA, i, uint64(n27))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LastAmendingProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.LastAmendingProposalId))
+		i--
+		dAtA[i] = 0x40
+	}
+	n28, err28 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EnactmentTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EnactmentTime):])
+	if err28 != nil {
+		return 0, err28
+	}
+	i -= n28
+	i = encodeVarintGov(dAtA, i, uint64(n28))
+	i--
+	dAtA[i] = 0x3a
+	if m.EnactingProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.EnactingProposalId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConstitutionVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConstitutionVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstitutionVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AmendmentTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AmendmentTime):])
+	if err29 != nil {
+		return 0, err29
+	}
+	i -= n29
+	i = encodeVarintGov(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0x22
+	if m.AmendingProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.AmendingProposalId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Constitution) > 0 {
+		i -= len(m.Constitution)
+		copy(dAtA[i:], m.Constitution)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Constitution)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitRevealVoting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitRevealVoting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitRevealVoting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n30, err30 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RevealPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RevealPeriod):])
+	if err30 != nil {
+		return 0, err30
+	}
+	i -= n30
+	i = encodeVarintGov(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -3544,6 +4367,42 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
 	}
+	l = m.ConstitutionAmendmentPatch.Size()
+	n += 2 + l + sovGov(uint64(l))
+	l = m.CommitReveal.Size()
+	n += 2 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *ConstitutionAmendmentPatchParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Strict {
+		n += 2
+	}
+	if m.MaxOffset != 0 {
+		n += 1 + sovGov(uint64(m.MaxOffset))
+	}
+	if m.MaxFuzz != 0 {
+		n += 1 + sovGov(uint64(m.MaxFuzz))
+	}
+	return n
+}
+
+func (m *CommitRevealParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RevealPeriod)
+	n += 1 + l + sovGov(uint64(l))
+	if m.QuorumCheckExemption {
+		n += 2
+	}
 	return n
 }
 
@@ -3651,9 +4510,104 @@ func (m *GovernanceDelegation) Size() (n int) {
 	return n
 }
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *Law) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.EnactingProposalId != 0 {
+		n += 1 + sovGov(uint64(m.EnactingProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EnactmentTime)
+	n += 1 + l + sovGov(uint64(l))
+	if m.LastAmendingProposalId != 0 {
+		n += 1 + sovGov(uint64(m.LastAmendingProposalId))
+	}
+	if m.LastAmendmentTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastAmendmentTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ConstitutionVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovGov(uint64(m.Version))
+	}
+	l = len(m.Constitution)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.AmendingProposalId != 0 {
+		n += 1 + sovGov(uint64(m.AmendingProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AmendmentTime)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *CommitRevealVoting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RevealPeriod)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *VoteCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozGov(x uint64) (n int) {
 	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -6679,61 +7633,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.MinGovernorSelfDelegation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuorumRange) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuorumRange: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuorumRange: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentPatch", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -6743,29 +7647,30 @@ func (m *QuorumRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Max = string(dAtA[iNdEx:postIndex])
+			if err := m.ConstitutionAmendmentPatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitReveal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -6775,23 +7680,24 @@ func (m *QuorumRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Min = string(dAtA[iNdEx:postIndex])
+			if err := m.CommitReveal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6814,7 +7720,7 @@ func (m *QuorumRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Governor) Unmarshal(dAtA []byte) error {
+func (m *ConstitutionAmendmentPatchParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6837,17 +7743,17 @@ func (m *Governor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Governor: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConstitutionAmendmentPatchParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Governor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConstitutionAmendmentPatchParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strict", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -6857,29 +7763,17 @@ func (m *Governor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Strict = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOffset", wireType)
 			}
-			m.Status = 0
+			m.MaxOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -6889,49 +7783,16 @@ func (m *Governor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= GovernorStatus(b&0x7F) << shift
+				m.MaxOffset |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastStatusChangeTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFuzz", wireType)
 			}
-			var msglen int
+			m.MaxFuzz = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -6941,28 +7802,11 @@ func (m *Governor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxFuzz |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastStatusChangeTime == nil {
-				m.LastStatusChangeTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastStatusChangeTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -6984,7 +7828,7 @@ func (m *Governor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GovernorDescription) Unmarshal(dAtA []byte) error {
+func (m *CommitRevealParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7007,17 +7851,17 @@ func (m *GovernorDescription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GovernorDescription: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitRevealParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GovernorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitRevealParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -7027,29 +7871,907 @@ func (m *GovernorDescription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RevealPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumCheckExemption", wireType)
 			}
-			var stringLen uint64
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuorumCheckExemption = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuorumRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuorumRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuorumRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Min = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Governor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Governor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Governor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= GovernorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStatusChangeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastStatusChangeTime == nil {
+				m.LastStatusChangeTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastStatusChangeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernorDescription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernorDescription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernorValShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernorValShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernorValShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Law) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Law: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Law: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -7075,11 +8797,11 @@ func (m *GovernorDescription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.Text = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7107,11 +8829,11 @@ func (m *GovernorDescription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			m.Uri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7139,13 +8861,32 @@ func (m *GovernorDescription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnactingProposalId", wireType)
+			}
+			m.EnactingProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnactingProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnactmentTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -7155,23 +8896,79 @@ func (m *GovernorDescription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EnactmentTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAmendingProposalId", wireType)
+			}
+			m.LastAmendingProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAmendingProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAmendmentTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastAmendmentTime == nil {
+				m.LastAmendmentTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastAmendmentTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7194,7 +8991,7 @@ func (m *GovernorDescription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GovernorValShares) Unmarshal(dAtA []byte) error {
+func (m *ConstitutionVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7217,15 +9014,34 @@ func (m *GovernorValShares) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GovernorValShares: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConstitutionVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GovernorValShares: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConstitutionVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constitution", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7253,13 +9069,32 @@ func (m *GovernorValShares) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmendingProposalId", wireType)
+			}
+			m.AmendingProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmendingProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmendmentTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -7269,29 +9104,99 @@ func (m *GovernorValShares) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AmendmentTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitRevealVoting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitRevealVoting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitRevealVoting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -7301,23 +9206,22 @@ func (m *GovernorValShares) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RevealPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7342,7 +9246,7 @@ func (m *GovernorValShares) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
+func (m *VoteCommitment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7365,15 +9269,34 @@ func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GovernanceDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteCommitment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GovernanceDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7401,13 +9324,13 @@ func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -7417,23 +9340,25 @@ func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
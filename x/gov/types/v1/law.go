@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// LawContentHash returns the hex encoded SHA-256 hash of the text of a law.
+func LawContentHash(text string) string {
+	hash := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(hash[:])
+}
+
+// validateLawContent checks that a law has a title and a content, given
+// either by its full text or by the URI where it is published along with the
+// hash of its text. If both the text and the hash are given, the hash must
+// match the text.
+func validateLawContent(title, text, uri, contentHash string) error {
+	if title == "" {
+		return errors.New("law title cannot be empty")
+	}
+	if text == "" && uri == "" {
+		return errors.New("law text and uri cannot be both empty")
+	}
+	if text == "" && contentHash == "" {
+		return errors.New("law content hash cannot be empty when the text is only published at uri")
+	}
+	if contentHash != "" {
+		bz, err := hex.DecodeString(contentHash)
+		if err != nil || len(bz) != sha256.Size {
+			return fmt.Errorf("invalid law content hash %q: must be a hex encoded SHA-256 hash", contentHash)
+		}
+		if text != "" && contentHash != LawContentHash(text) {
+			return fmt.Errorf("law content hash %s does not match the text of the law", contentHash)
+		}
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the law.
+func (l Law) Validate() error {
+	if l.Id == 0 {
+		return errors.New("law id must be greater than 0")
+	}
+	if l.ContentHash == "" {
+		return fmt.Errorf("law %d content hash cannot be empty", l.Id)
+	}
+	if err := validateLawContent(l.Title, l.Text, l.Uri, l.ContentHash); err != nil {
+		return fmt.Errorf("invalid law %d: %w", l.Id, err)
+	}
+	return nil
+}
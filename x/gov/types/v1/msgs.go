@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"crypto/sha256"
 	"fmt"
 
 	"cosmossdk.io/math"
@@ -16,8 +17,8 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgProposeConstitutionAmendment{}, &MsgProposeLaw{}, &MsgCreateGovernor{}, &MsgEditGovernor{}, &MsgDelegateGovernor{}, &MsgUndelegateGovernor{}, &MsgUpdateGovernorStatus{}
-	_, _                                  codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgCommitVote{}, &MsgRevealVote{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgProposeConstitutionAmendment{}, &MsgProposeLaw{}, &MsgRepealLaw{}, &MsgCreateGovernor{}, &MsgEditGovernor{}, &MsgDelegateGovernor{}, &MsgUndelegateGovernor{}, &MsgUpdateGovernorStatus{}
+	_, _                                           codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -167,13 +168,19 @@ func (msg MsgVoteWeighted) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
 	}
-	if len(msg.Options) == 0 {
-		return sdkerrors.ErrInvalidRequest.Wrap(WeightedVoteOptions(msg.Options).String())
+	return validateWeightedVoteOptions(msg.Options)
+}
+
+// validateWeightedVoteOptions checks that the options are valid and distinct,
+// and that their weights sum to 1.
+func validateWeightedVoteOptions(options []*WeightedVoteOption) error {
+	if len(options) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap(WeightedVoteOptions(options).String())
 	}
 
 	totalWeight := math.LegacyNewDec(0)
 	usedOptions := make(map[VoteOption]bool)
-	for _, option := range msg.Options {
+	for _, option := range options {
 		if !option.IsValid() {
 			return sdkgovtypes.ErrInvalidVote.Wrap(option.String())
 		}
@@ -199,6 +206,46 @@ func (msg MsgVoteWeighted) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgCommitVote creates a message to commit to a secret vote on a proposal
+// using commit-reveal voting
+//
+//nolint:interfacer
+func NewMsgCommitVote(voter sdk.AccAddress, proposalID uint64, commitment []byte) *MsgCommitVote {
+	return &MsgCommitVote{proposalID, voter.String(), commitment}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCommitVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+	if len(msg.Commitment) != sha256.Size {
+		return sdkgovtypes.ErrInvalidVote.Wrapf("commitment must be %d bytes long: %d", sha256.Size, len(msg.Commitment))
+	}
+
+	return nil
+}
+
+// NewMsgRevealVote creates a message to reveal a vote committed to on a
+// proposal using commit-reveal voting
+//
+//nolint:interfacer
+func NewMsgRevealVote(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions, metadata string, salt []byte) *MsgRevealVote {
+	return &MsgRevealVote{proposalID, voter.String(), options, metadata, salt}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevealVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+	if len(msg.Salt) < MinVoteSaltLength {
+		return sdkgovtypes.ErrInvalidVote.Wrapf("salt must be at least %d bytes long: %d", MinVoteSaltLength, len(msg.Salt))
+	}
+
+	return validateWeightedVoteOptions(msg.Options)
+}
+
 // NewMsgExecLegacyContent creates a new MsgExecLegacyContent instance
 //
 //nolint:interfacer
@@ -259,12 +306,50 @@ func (msg MsgProposeConstitutionAmendment) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgProposeLaw creates a new MsgProposeLaw enacting a new law, or amending
+// the law in force lawID if it is not zero.
+func NewMsgProposeLaw(authority sdk.AccAddress, title, text, uri, contentHash string, lawID uint64) *MsgProposeLaw {
+	return &MsgProposeLaw{
+		Authority:   authority.String(),
+		Title:       title,
+		Text:        text,
+		Uri:         uri,
+		ContentHash: contentHash,
+		LawId:       lawID,
+	}
+}
+
 // ValidateBasic implements the sdk.Msg interface.
 func (msg MsgProposeLaw) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
 	}
 
+	if err := validateLawContent(msg.Title, msg.Text, msg.Uri, msg.ContentHash); err != nil {
+		return sdkgovtypes.ErrInvalidProposalContent.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgRepealLaw creates a new MsgRepealLaw.
+func NewMsgRepealLaw(authority sdk.AccAddress, lawID uint64) *MsgRepealLaw {
+	return &MsgRepealLaw{
+		Authority: authority.String(),
+		LawId:     lawID,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRepealLaw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if msg.LawId == 0 {
+		return sdkgovtypes.ErrInvalidProposalContent.Wrap("law id cannot be zero")
+	}
+
 	return nil
 }
 
@@ -272,6 +357,8 @@ func (msg MsgProposeConstitutionAmendment) IsProposalKindConstitutionAmendment()
 
 func (msg MsgProposeLaw) IsProposalKindLaw() {}
 
+func (msg MsgRepealLaw) IsProposalKindLaw() {}
+
 // NewMsgCreateGovernor creates a new MsgCreateGovernor instance
 func NewMsgCreateGovernor(address sdk.AccAddress, description GovernorDescription) *MsgCreateGovernor {
 	return &MsgCreateGovernor{Address: address.String(), Description: description}
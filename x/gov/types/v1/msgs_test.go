@@ -237,3 +237,157 @@ func TestMsgProposeConstitutionAmendment_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgProposeLaw_ValidateBasic(t *testing.T) {
+	text := "Law text"
+	tests := []struct {
+		name        string
+		authority   string
+		title       string
+		text        string
+		uri         string
+		contentHash string
+		expErr      bool
+	}{
+		{"invalid authority", "", "title", text, "", "", true},
+		{"empty title", addrs[0].String(), "", text, "", "", true},
+		{"empty text and uri", addrs[0].String(), "title", "", "", "", true},
+		{"uri without content hash", addrs[0].String(), "title", "", "ipfs://law", "", true},
+		{"invalid content hash", addrs[0].String(), "title", "", "ipfs://law", "hash", true},
+		{"content hash not matching text", addrs[0].String(), "title", text, "", v1.LawContentHash("other text"), true},
+		{"valid text", addrs[0].String(), "title", text, "", "", false},
+		{"valid text with content hash", addrs[0].String(), "title", text, "ipfs://law", v1.LawContentHash(text), false},
+		{"valid uri", addrs[0].String(), "title", "", "ipfs://law", v1.LawContentHash(text), false},
+	}
+
+	for _, tc := range tests {
+		msg := v1.MsgProposeLaw{
+			Authority:   tc.authority,
+			Title:       tc.title,
+			Text:        tc.text,
+			Uri:         tc.uri,
+			ContentHash: tc.contentHash,
+		}
+		if tc.expErr {
+			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), "test: %s", tc.name)
+		}
+	}
+}
+
+func TestMsgRepealLaw_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		authority string
+		lawID     uint64
+		expErr    bool
+	}{
+		{"invalid authority", "", 1, true},
+		{"zero law id", addrs[0].String(), 0, true},
+		{"valid", addrs[0].String(), 1, false},
+	}
+
+	for _, tc := range tests {
+		msg := v1.MsgRepealLaw{
+			Authority: tc.authority,
+			LawId:     tc.lawID,
+		}
+		if tc.expErr {
+			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), "test: %s", tc.name)
+		}
+	}
+}
+
+func TestMsgCommitVote_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		voterAddr  sdk.AccAddress
+		commitment []byte
+		expErr     string
+	}{
+		{"valid", addrs[0], make([]byte, 32), ""},
+		{"invalid voter", sdk.AccAddress{}, make([]byte, 32), "invalid voter address: empty address string is not allowed: invalid address"},
+		{"empty commitment", addrs[0], nil, "commitment must be 32 bytes long: 0: invalid vote option"},
+		{"commitment too long", addrs[0], make([]byte, 33), "commitment must be 32 bytes long: 33: invalid vote option"},
+	}
+
+	for _, tc := range tests {
+		err := v1.NewMsgCommitVote(tc.voterAddr, 1, tc.commitment).ValidateBasic()
+		if tc.expErr != "" {
+			require.EqualError(t, err, tc.expErr, "test: %s", tc.name)
+		} else {
+			require.NoError(t, err, "test: %s", tc.name)
+		}
+	}
+}
+
+func TestMsgRevealVote_ValidateBasic(t *testing.T) {
+	salt := make([]byte, v1.MinVoteSaltLength)
+	tests := []struct {
+		name      string
+		voterAddr sdk.AccAddress
+		options   v1.WeightedVoteOptions
+		salt      []byte
+		expErr    string
+	}{
+		{"valid", addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), salt, ""},
+		{"invalid voter", sdk.AccAddress{}, v1.NewNonSplitVoteOption(v1.OptionYes), salt, "invalid voter address: empty address string is not allowed: invalid address"},
+		{"salt too short", addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), salt[1:], "salt must be at least 16 bytes long: 15: invalid vote option"},
+		{"weight sum < 1", addrs[0], v1.WeightedVoteOptions{
+			v1.NewWeightedVoteOption(v1.OptionYes, math.LegacyNewDecWithPrec(5, 1)),
+		}, salt, "Total weight lower than 1.00: invalid vote option"},
+	}
+
+	for _, tc := range tests {
+		err := v1.NewMsgRevealVote(tc.voterAddr, 1, tc.options, "", tc.salt).ValidateBasic()
+		if tc.expErr != "" {
+			require.EqualError(t, err, tc.expErr, "test: %s", tc.name)
+		} else {
+			require.NoError(t, err, "test: %s", tc.name)
+		}
+	}
+}
+
+func TestVoteCommitmentHash(t *testing.T) {
+	salt := []byte("0123456789abcdef")
+	options := v1.WeightedVoteOptions{
+		v1.NewWeightedVoteOption(v1.OptionYes, math.LegacyNewDecWithPrec(7, 1)),
+		{Option: v1.OptionNo, Weight: "0.3"},
+	}
+	hash, err := v1.VoteCommitmentHash(1, addrs[0], options, salt)
+	require.NoError(t, err)
+	require.Len(t, hash, 32)
+
+	// the weights are hashed in canonical form
+	canonical, err := v1.VoteCommitmentHash(1, addrs[0], v1.WeightedVoteOptions{
+		{Option: v1.OptionYes, Weight: "0.7"},
+		v1.NewWeightedVoteOption(v1.OptionNo, math.LegacyNewDecWithPrec(3, 1)),
+	}, salt)
+	require.NoError(t, err)
+	require.Equal(t, hash, canonical)
+
+	// any other proposal, voter, vote or salt gives another commitment
+	others := []struct {
+		proposalID uint64
+		voter      sdk.AccAddress
+		options    v1.WeightedVoteOptions
+		salt       []byte
+	}{
+		{2, addrs[0], options, salt},
+		{1, addrs[1], options, salt},
+		{1, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), salt},
+		{1, addrs[0], v1.WeightedVoteOptions{options[1], options[0]}, salt},
+		{1, addrs[0], options, []byte("fedcba9876543210")},
+	}
+	for i, o := range others {
+		other, err := v1.VoteCommitmentHash(o.proposalID, o.voter, o.options, o.salt)
+		require.NoError(t, err)
+		require.NotEqual(t, hash, other, "test: %d", i)
+	}
+
+	_, err = v1.VoteCommitmentHash(1, addrs[0], v1.WeightedVoteOptions{{Option: v1.OptionYes, Weight: "one"}}, salt)
+	require.ErrorContains(t, err, `invalid weight "one"`)
+}
@@ -25,6 +25,18 @@ const (
 	// DefaultGovernorStatusChangePeriod is the default period that has to pass
 	// before a governor can change their status (e.g. from active to inactive).
 	DefaultGovernorStatusChangePeriod time.Duration = time.Hour * 24 * 28 // 28 days
+
+	// MaxConstitutionAmendmentPatchMaxOffset is the maximum value that can be
+	// set for the number of lines a hunk of an amendment of the constitution
+	// can be moved from the lines given in its header. Amendments moved further
+	// than that are better rewritten against the constitution in force.
+	MaxConstitutionAmendmentPatchMaxOffset = 10000
+
+	// MaxConstitutionAmendmentPatchMaxFuzz is the maximum value that can be
+	// set for the number of context lines ignored at the start and at the end
+	// of a hunk of an amendment of the constitution. Ignoring more context
+	// lines than the 3 lines of a unified diff has no effect.
+	MaxConstitutionAmendmentPatchMaxFuzz = 3
 )
 
 // MinVotingPeriod is set in stone by the constitution at 21 days, but it can
@@ -74,6 +86,11 @@ var (
 	DefaultBurnDepositNoThreshold                                           = math.LegacyNewDecWithPrec(80, 2)
 	DefaultMaxGovernors                                       uint64        = 100
 	DefaultMinGovernorSelfDelegation                                        = math.NewInt(1000_000000)
+	DefaultConstitutionAmendmentPatchStrict                                 = false
+	DefaultConstitutionAmendmentPatchMaxOffset                uint64        = 100
+	DefaultConstitutionAmendmentPatchMaxFuzz                  uint64        = 2
+	DefaultCommitRevealPeriod                                 time.Duration = time.Hour * 24 * 3
+	DefaultCommitRevealQuorumCheckExemption                                 = false
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -174,7 +191,7 @@ func NewParams(
 
 // DefaultParams returns the default governance params
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		// sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultDepositPeriod,
 		DefaultVotingPeriod,
@@ -210,6 +227,28 @@ func DefaultParams() Params {
 		DefaultGovernorStatusChangePeriod,
 		DefaultMinGovernorSelfDelegation.String(),
 	)
+	params.ConstitutionAmendmentPatch = DefaultConstitutionAmendmentPatchParams()
+	params.CommitReveal = DefaultCommitRevealParams()
+	return params
+}
+
+// DefaultConstitutionAmendmentPatchParams returns the default params defining
+// how the amendments of the constitution are applied.
+func DefaultConstitutionAmendmentPatchParams() ConstitutionAmendmentPatchParams {
+	return ConstitutionAmendmentPatchParams{
+		Strict:    DefaultConstitutionAmendmentPatchStrict,
+		MaxOffset: DefaultConstitutionAmendmentPatchMaxOffset,
+		MaxFuzz:   DefaultConstitutionAmendmentPatchMaxFuzz,
+	}
+}
+
+// DefaultCommitRevealParams returns the default params defining the
+// commit-reveal voting of the proposals.
+func DefaultCommitRevealParams() CommitRevealParams {
+	return CommitRevealParams{
+		RevealPeriod:         DefaultCommitRevealPeriod,
+		QuorumCheckExemption: DefaultCommitRevealQuorumCheckExemption,
+	}
 }
 
 // ValidateBasic performs basic validation on governance parameters.
@@ -504,6 +543,23 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("minimum governor self delegation must be positive: %s", minGovernorSelfDelegation)
 	}
 
+	if p.ConstitutionAmendmentPatch.MaxOffset > MaxConstitutionAmendmentPatchMaxOffset {
+		return fmt.Errorf("constitution amendment patch max offset must be less than or equal to %d: %d",
+			MaxConstitutionAmendmentPatchMaxOffset, p.ConstitutionAmendmentPatch.MaxOffset)
+	}
+
+	if p.ConstitutionAmendmentPatch.MaxFuzz > MaxConstitutionAmendmentPatchMaxFuzz {
+		return fmt.Errorf("constitution amendment patch max fuzz must be less than or equal to %d: %d",
+			MaxConstitutionAmendmentPatchMaxFuzz, p.ConstitutionAmendmentPatch.MaxFuzz)
+	}
+
+	if p.CommitReveal.RevealPeriod < 0 {
+		return fmt.Errorf("commit-reveal reveal period must not be negative: %s", p.CommitReveal.RevealPeriod)
+	}
+	if p.CommitReveal.RevealPeriod >= *p.VotingPeriod {
+		return fmt.Errorf("commit-reveal reveal period %s must be strictly less than the voting period %s", p.CommitReveal.RevealPeriod, p.VotingPeriod)
+	}
+
 	return nil
 }
 
@@ -53,6 +53,10 @@ type MsgSubmitProposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Summary string `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	// commit_reveal opts the proposal in commit-reveal voting: the votes are
+	// committed to in secret during the voting period, and revealed during its
+	// last reveal period.
+	CommitReveal bool `protobuf:"varint,7,opt,name=commit_reveal,json=commitReveal,proto3" json:"commit_reveal,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -130,6 +134,13 @@ func (m *MsgSubmitProposal) GetSummary() string {
 	return ""
 }
 
+func (m *MsgSubmitProposal) GetCommitReveal() bool {
+	if m != nil {
+		return m.CommitReveal
+	}
+	return false
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	// proposal_id defines the unique id of the proposal.
@@ -489,6 +500,230 @@ func (m *MsgVoteWeightedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteWeightedResponse proto.InternalMessageInfo
 
+// MsgCommitVote defines a message to commit to a secret vote on a proposal
+// using commit-reveal voting.
+type MsgCommitVote struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"`
+	// voter is the voter address for the proposal.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// commitment is the SHA-256 hash of the vote and of a secret salt, as
+	// computed by VoteCommitmentHash.
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *MsgCommitVote) Reset()         { *m = MsgCommitVote{} }
+func (m *MsgCommitVote) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitVote) ProtoMessage()    {}
+func (*MsgCommitVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6c84786701fca8d, []int{8}
+}
+func (m *MsgCommitVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitVote.Merge(m, src)
+}
+func (m *MsgCommitVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitVote proto.InternalMessageInfo
+
+func (m *MsgCommitVote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgCommitVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *MsgCommitVote) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+// MsgCommitVoteResponse defines the Msg/CommitVote response type.
+type MsgCommitVoteResponse struct {
+}
+
+func (m *MsgCommitVoteResponse) Reset()         { *m = MsgCommitVoteResponse{} }
+func (m *MsgCommitVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitVoteResponse) ProtoMessage()    {}
+func (*MsgCommitVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6c84786701fca8d, []int{9}
+}
+func (m *MsgCommitVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitVoteResponse.Merge(m, src)
+}
+func (m *MsgCommitVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitVoteResponse proto.InternalMessageInfo
+
+// MsgRevealVote defines a message to reveal a vote committed to on a proposal
+// using commit-reveal voting.
+type MsgRevealVote struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"`
+	// voter is the voter address for the proposal.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// options defines the weighted vote options committed to.
+	Options []*WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	// metadata is any arbitrary metadata attached to the vote. It is not part
+	// of the commitment.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// salt is the secret salt committed to with the vote.
+	Salt []byte `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (m *MsgRevealVote) Reset()         { *m = MsgRevealVote{} }
+func (m *MsgRevealVote) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealVote) ProtoMessage()    {}
+func (*MsgRevealVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6c84786701fca8d, []int{10}
+}
+func (m *MsgRevealVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealVote.Merge(m, src)
+}
+func (m *MsgRevealVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealVote proto.InternalMessageInfo
+
+func (m *MsgRevealVote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgRevealVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *MsgRevealVote) GetOptions() []*WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *MsgRevealVote) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *MsgRevealVote) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+// MsgRevealVoteResponse defines the Msg/RevealVote response type.
+type MsgRevealVoteResponse struct {
+}
+
+func (m *MsgRevealVoteResponse) Reset()         { *m = MsgRevealVoteResponse{} }
+func (m *MsgRevealVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealVoteResponse) ProtoMessage()    {}
+func (*MsgRevealVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6c84786701fca8d, []int{11}
+}
+func (m *MsgRevealVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealVoteResponse.Merge(m, src)
+}
+func (m *MsgRevealVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealVoteResponse proto.InternalMessageInfo
+
 // MsgDeposit defines a message to submit a deposit to an existing proposal.
 type MsgDeposit struct {
 	// proposal_id defines the unique id of the proposal.
@@ -503,7 +738,7 @@ func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
 func (m *MsgDeposit) String() string { return proto.CompactTextString(m) }
 func (*MsgDeposit) ProtoMessage()    {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{8}
+	return fileDescriptor_f6c84786701fca8d, []int{12}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +796,7 @@ func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
 func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositResponse) ProtoMessage()    {}
 func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{9}
+	return fileDescriptor_f6c84786701fca8d, []int{13}
 }
 func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,7 +842,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{10}
+	return fileDescriptor_f6c84786701fca8d, []int{14}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -661,7 +896,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{11}
+	return fileDescriptor_f6c84786701fca8d, []int{15}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,13 +930,27 @@ type MsgProposeLaw struct {
 	// authority is the address that controls the module (defaults to x/gov unless
 	// overwritten).
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// title is the title of the law.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// text is the full text of the law. Either text or uri must be set.
+	Text string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// uri is the URI where the text of the law is published. Either text or uri
+	// must be set.
+	Uri string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	// content_hash is the hex encoded SHA-256 hash of the text of the law. It is
+	// required if the text is only published at uri, and computed from text
+	// otherwise.
+	ContentHash string `protobuf:"bytes,5,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// law_id is the id of the law in force amended by this law, zero for a new
+	// law. An amendment replaces the title and the content of the law.
+	LawId uint64 `protobuf:"varint,6,opt,name=law_id,json=lawId,proto3" json:"law_id,omitempty"`
 }
 
 func (m *MsgProposeLaw) Reset()         { *m = MsgProposeLaw{} }
 func (m *MsgProposeLaw) String() string { return proto.CompactTextString(m) }
 func (*MsgProposeLaw) ProtoMessage()    {}
 func (*MsgProposeLaw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{12}
+	return fileDescriptor_f6c84786701fca8d, []int{16}
 }
 func (m *MsgProposeLaw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,16 +986,53 @@ func (m *MsgProposeLaw) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgProposeLaw) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *MsgProposeLaw) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *MsgProposeLaw) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *MsgProposeLaw) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *MsgProposeLaw) GetLawId() uint64 {
+	if m != nil {
+		return m.LawId
+	}
+	return 0
+}
+
 // MsgProposeLawResponse defines the response structure for executing a
 // MsgProposeLaw message.
 type MsgProposeLawResponse struct {
+	// law_id is the id of the enacted or amended law.
+	LawId uint64 `protobuf:"varint,1,opt,name=law_id,json=lawId,proto3" json:"law_id,omitempty"`
 }
 
 func (m *MsgProposeLawResponse) Reset()         { *m = MsgProposeLawResponse{} }
 func (m *MsgProposeLawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProposeLawResponse) ProtoMessage()    {}
 func (*MsgProposeLawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{13}
+	return fileDescriptor_f6c84786701fca8d, []int{17}
 }
 func (m *MsgProposeLawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,6 +1061,107 @@ func (m *MsgProposeLawResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgProposeLawResponse proto.InternalMessageInfo
 
+func (m *MsgProposeLawResponse) GetLawId() uint64 {
+	if m != nil {
+		return m.LawId
+	}
+	return 0
+}
+
+// MsgRepealLaw is the Msg/RepealLaw request type.
+type MsgRepealLaw struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// law_id is the id of the law in force to repeal.
+	LawId uint64 `protobuf:"varint,2,opt,name=law_id,json=lawId,proto3" json:"law_id,omitempty"`
+}
+
+func (m *MsgRepealLaw) Reset()         { *m = MsgRepealLaw{} }
+func (m *MsgRepealLaw) String() string { return proto.CompactTextString(m) }
+func (*MsgRepealLaw) ProtoMessage()    {}
+func (*MsgRepealLaw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6c84786701fca8d, []int{18}
+}
+func (m *MsgRepealLaw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRepealLaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRepealLaw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRepealLaw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepealLaw.Merge(m, src)
+}
+func (m *MsgRepealLaw) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRepealLaw) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepealLaw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRepealLaw proto.InternalMessageInfo
+
+func (m *MsgRepealLaw) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRepealLaw) GetLawId() uint64 {
+	if m != nil {
+		return m.LawId
+	}
+	return 0
+}
+
+// MsgRepealLawResponse defines the response structure for executing a
+// MsgRepealLaw message.
+type MsgRepealLawResponse struct {
+}
+
+func (m *MsgRepealLawResponse) Reset()         { *m = MsgRepealLawResponse{} }
+func (m *MsgRepealLawResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRepealLawResponse) ProtoMessage()    {}
+func (*MsgRepealLawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6c84786701fca8d, []int{19}
+}
+func (m *MsgRepealLawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRepealLawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRepealLawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRepealLawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepealLawResponse.Merge(m, src)
+}
+func (m *MsgRepealLawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRepealLawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepealLawResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRepealLawResponse proto.InternalMessageInfo
+
 // MsgConstitutionAmendment is the Msg/ProposeConstitutionAmendment request type.
 type MsgProposeConstitutionAmendment struct {
 	// authority is the address that controls the module (defaults to x/gov unless
@@ -788,7 +1175,7 @@ func (m *MsgProposeConstitutionAmendment) Reset()         { *m = MsgProposeConst
 func (m *MsgProposeConstitutionAmendment) String() string { return proto.CompactTextString(m) }
 func (*MsgProposeConstitutionAmendment) ProtoMessage()    {}
 func (*MsgProposeConstitutionAmendment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{14}
+	return fileDescriptor_f6c84786701fca8d, []int{20}
 }
 func (m *MsgProposeConstitutionAmendment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -842,7 +1229,7 @@ func (m *MsgProposeConstitutionAmendmentResponse) Reset() {
 func (m *MsgProposeConstitutionAmendmentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgProposeConstitutionAmendmentResponse) ProtoMessage()    {}
 func (*MsgProposeConstitutionAmendmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{15}
+	return fileDescriptor_f6c84786701fca8d, []int{21}
 }
 func (m *MsgProposeConstitutionAmendmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -882,7 +1269,7 @@ func (m *MsgCreateGovernor) Reset()         { *m = MsgCreateGovernor{} }
 func (m *MsgCreateGovernor) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateGovernor) ProtoMessage()    {}
 func (*MsgCreateGovernor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{16}
+	return fileDescriptor_f6c84786701fca8d, []int{22}
 }
 func (m *MsgCreateGovernor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1320,7 @@ func (m *MsgCreateGovernorResponse) Reset()         { *m = MsgCreateGovernorResp
 func (m *MsgCreateGovernorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateGovernorResponse) ProtoMessage()    {}
 func (*MsgCreateGovernorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{17}
+	return fileDescriptor_f6c84786701fca8d, []int{23}
 }
 func (m *MsgCreateGovernorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,7 +1360,7 @@ func (m *MsgEditGovernor) Reset()         { *m = MsgEditGovernor{} }
 func (m *MsgEditGovernor) String() string { return proto.CompactTextString(m) }
 func (*MsgEditGovernor) ProtoMessage()    {}
 func (*MsgEditGovernor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{18}
+	return fileDescriptor_f6c84786701fca8d, []int{24}
 }
 func (m *MsgEditGovernor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1411,7 @@ func (m *MsgEditGovernorResponse) Reset()         { *m = MsgEditGovernorResponse
 func (m *MsgEditGovernorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEditGovernorResponse) ProtoMessage()    {}
 func (*MsgEditGovernorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{19}
+	return fileDescriptor_f6c84786701fca8d, []int{25}
 }
 func (m *MsgEditGovernorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1064,7 +1451,7 @@ func (m *MsgUpdateGovernorStatus) Reset()         { *m = MsgUpdateGovernorStatus
 func (m *MsgUpdateGovernorStatus) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateGovernorStatus) ProtoMessage()    {}
 func (*MsgUpdateGovernorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{20}
+	return fileDescriptor_f6c84786701fca8d, []int{26}
 }
 func (m *MsgUpdateGovernorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,7 +1502,7 @@ func (m *MsgUpdateGovernorStatusResponse) Reset()         { *m = MsgUpdateGovern
 func (m *MsgUpdateGovernorStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateGovernorStatusResponse) ProtoMessage()    {}
 func (*MsgUpdateGovernorStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{21}
+	return fileDescriptor_f6c84786701fca8d, []int{27}
 }
 func (m *MsgUpdateGovernorStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1155,7 +1542,7 @@ func (m *MsgDelegateGovernor) Reset()         { *m = MsgDelegateGovernor{} }
 func (m *MsgDelegateGovernor) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateGovernor) ProtoMessage()    {}
 func (*MsgDelegateGovernor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{22}
+	return fileDescriptor_f6c84786701fca8d, []int{28}
 }
 func (m *MsgDelegateGovernor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1206,7 +1593,7 @@ func (m *MsgDelegateGovernorResponse) Reset()         { *m = MsgDelegateGovernor
 func (m *MsgDelegateGovernorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateGovernorResponse) ProtoMessage()    {}
 func (*MsgDelegateGovernorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{23}
+	return fileDescriptor_f6c84786701fca8d, []int{29}
 }
 func (m *MsgDelegateGovernorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1244,7 +1631,7 @@ func (m *MsgUndelegateGovernor) Reset()         { *m = MsgUndelegateGovernor{} }
 func (m *MsgUndelegateGovernor) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegateGovernor) ProtoMessage()    {}
 func (*MsgUndelegateGovernor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{24}
+	return fileDescriptor_f6c84786701fca8d, []int{30}
 }
 func (m *MsgUndelegateGovernor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1288,7 +1675,7 @@ func (m *MsgUndelegateGovernorResponse) Reset()         { *m = MsgUndelegateGove
 func (m *MsgUndelegateGovernorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegateGovernorResponse) ProtoMessage()    {}
 func (*MsgUndelegateGovernorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f6c84786701fca8d, []int{25}
+	return fileDescriptor_f6c84786701fca8d, []int{31}
 }
 func (m *MsgUndelegateGovernorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1326,12 +1713,18 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "atomone.gov.v1.MsgVoteResponse")
 	proto.RegisterType((*MsgVoteWeighted)(nil), "atomone.gov.v1.MsgVoteWeighted")
 	proto.RegisterType((*MsgVoteWeightedResponse)(nil), "atomone.gov.v1.MsgVoteWeightedResponse")
+	proto.RegisterType((*MsgCommitVote)(nil), "atomone.gov.v1.MsgCommitVote")
+	proto.RegisterType((*MsgCommitVoteResponse)(nil), "atomone.gov.v1.MsgCommitVoteResponse")
+	proto.RegisterType((*MsgRevealVote)(nil), "atomone.gov.v1.MsgRevealVote")
+	proto.RegisterType((*MsgRevealVoteResponse)(nil), "atomone.gov.v1.MsgRevealVoteResponse")
 	proto.RegisterType((*MsgDeposit)(nil), "atomone.gov.v1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "atomone.gov.v1.MsgDepositResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "atomone.gov.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "atomone.gov.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgProposeLaw)(nil), "atomone.gov.v1.MsgProposeLaw")
 	proto.RegisterType((*MsgProposeLawResponse)(nil), "atomone.gov.v1.MsgProposeLawResponse")
+	proto.RegisterType((*MsgRepealLaw)(nil), "atomone.gov.v1.MsgRepealLaw")
+	proto.RegisterType((*MsgRepealLawResponse)(nil), "atomone.gov.v1.MsgRepealLawResponse")
 	proto.RegisterType((*MsgProposeConstitutionAmendment)(nil), "atomone.gov.v1.MsgProposeConstitutionAmendment")
 	proto.RegisterType((*MsgProposeConstitutionAmendmentResponse)(nil), "atomone.gov.v1.MsgProposeConstitutionAmendmentResponse")
 	proto.RegisterType((*MsgCreateGovernor)(nil), "atomone.gov.v1.MsgCreateGovernor")
@@ -1349,91 +1742,107 @@ func init() {
 func init() { proto.RegisterFile("atomone/gov/v1/tx.proto", fileDescriptor_f6c84786701fca8d) }
 
 var fileDescriptor_f6c84786701fca8d = []byte{
-	// 1344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0xdc, 0xc4,
-	0x17, 0x8f, 0x9b, 0x26, 0x69, 0x5e, 0xbe, 0xdf, 0xb4, 0x31, 0x5b, 0xe2, 0xb8, 0xe9, 0x6e, 0xea,
-	0x16, 0x35, 0x2d, 0xc4, 0x66, 0xb7, 0xd0, 0x8a, 0x55, 0x11, 0x34, 0x69, 0x55, 0x55, 0xea, 0xaa,
-	0xd5, 0x56, 0x05, 0xc4, 0xa1, 0x91, 0x77, 0x3d, 0x38, 0x46, 0xb1, 0x67, 0xe5, 0x99, 0x5d, 0x1a,
-	0x4e, 0x88, 0x13, 0xe2, 0xc4, 0x7f, 0x00, 0x47, 0x8e, 0x39, 0xf4, 0xd2, 0x0b, 0x47, 0x54, 0x21,
-	0x0e, 0x15, 0x27, 0x4e, 0x55, 0xd5, 0x1c, 0x8a, 0xb8, 0x72, 0x46, 0x42, 0x9e, 0xf1, 0xcc, 0xda,
-	0xeb, 0xd9, 0x1f, 0x44, 0x02, 0x71, 0x59, 0x79, 0xde, 0xaf, 0x79, 0x9f, 0xcf, 0xbc, 0x79, 0x6f,
-	0x16, 0x96, 0x5d, 0x8a, 0x43, 0x1c, 0x21, 0xc7, 0xc7, 0x3d, 0xa7, 0x57, 0x75, 0xe8, 0x43, 0xbb,
-	0x13, 0x63, 0x8a, 0xf5, 0xc5, 0x54, 0x61, 0xfb, 0xb8, 0x67, 0xf7, 0xaa, 0x66, 0xb9, 0x8d, 0x49,
-	0x88, 0x89, 0xd3, 0x72, 0x09, 0x72, 0x7a, 0xd5, 0x16, 0xa2, 0x6e, 0xd5, 0x69, 0xe3, 0x20, 0xe2,
-	0xf6, 0xa6, 0x31, 0x10, 0x28, 0x71, 0xe3, 0x9a, 0x92, 0x8f, 0x7d, 0xcc, 0x3e, 0x9d, 0xe4, 0x2b,
-	0x95, 0xae, 0xf0, 0x78, 0xdb, 0x5c, 0xc1, 0x17, 0x42, 0xe5, 0x63, 0xec, 0xef, 0x22, 0x87, 0xad,
-	0x5a, 0xdd, 0x4f, 0x1c, 0x37, 0xda, 0x4b, 0x55, 0xcb, 0x69, 0x16, 0x21, 0xf1, 0x93, 0x4d, 0x42,
-	0xe2, 0xa7, 0x8a, 0x25, 0x37, 0x0c, 0x22, 0xec, 0xb0, 0x5f, 0x2e, 0xb2, 0x7e, 0x3c, 0x02, 0x4b,
-	0x0d, 0xe2, 0xdf, 0xeb, 0xb6, 0xc2, 0x80, 0xde, 0x8d, 0x71, 0x07, 0x13, 0x77, 0x57, 0x7f, 0x13,
-	0x8e, 0x85, 0x88, 0x10, 0xd7, 0x47, 0xc4, 0xd0, 0xd6, 0xa6, 0xd7, 0x17, 0x6a, 0x25, 0x9b, 0xef,
-	0x67, 0x8b, 0xfd, 0xec, 0x6b, 0xd1, 0x5e, 0x53, 0x5a, 0xe9, 0x0d, 0x38, 0x1e, 0x44, 0x01, 0x0d,
-	0xdc, 0xdd, 0x6d, 0x0f, 0x75, 0x30, 0x09, 0xa8, 0x71, 0x84, 0x39, 0xae, 0xd8, 0x69, 0xda, 0x09,
-	0x27, 0x76, 0xca, 0x89, 0xbd, 0x85, 0x83, 0x68, 0x73, 0xfe, 0xc9, 0xb3, 0xca, 0xd4, 0xf7, 0x2f,
-	0xf7, 0x2f, 0x6a, 0xcd, 0xc5, 0xd4, 0xf9, 0x3a, 0xf7, 0xd5, 0xdf, 0x82, 0x63, 0x1d, 0x96, 0x0c,
-	0x8a, 0x8d, 0xe9, 0x35, 0x6d, 0x7d, 0x7e, 0xd3, 0xf8, 0xe5, 0xd1, 0x46, 0x29, 0x0d, 0x75, 0xcd,
-	0xf3, 0x62, 0x44, 0xc8, 0x3d, 0x1a, 0x07, 0x91, 0xdf, 0x94, 0x96, 0xba, 0x99, 0xa4, 0x4d, 0x5d,
-	0xcf, 0xa5, 0xae, 0x71, 0x34, 0xf1, 0x6a, 0xca, 0xb5, 0x5e, 0x82, 0x19, 0x1a, 0xd0, 0x5d, 0x64,
-	0xcc, 0x30, 0x05, 0x5f, 0xe8, 0x06, 0xcc, 0x91, 0x6e, 0x18, 0xba, 0xf1, 0x9e, 0x31, 0xcb, 0xe4,
-	0x62, 0x59, 0xb7, 0xbf, 0x7c, 0xb9, 0x7f, 0x51, 0x86, 0xfe, 0xfa, 0xe5, 0xfe, 0xc5, 0x55, 0x71,
-	0x78, 0xbd, 0xaa, 0x53, 0xa0, 0xcc, 0xba, 0x0a, 0x2b, 0x05, 0x61, 0x13, 0x91, 0x0e, 0x8e, 0x08,
-	0xd2, 0x2b, 0xb0, 0xd0, 0x49, 0x65, 0xdb, 0x81, 0x67, 0x68, 0x6b, 0xda, 0xfa, 0xd1, 0x26, 0x08,
-	0xd1, 0x2d, 0xcf, 0x7a, 0xac, 0x41, 0xa9, 0x41, 0xfc, 0x1b, 0x0f, 0x51, 0xfb, 0x36, 0xf2, 0xdd,
-	0xf6, 0xde, 0x16, 0x8e, 0x28, 0x8a, 0xa8, 0x7e, 0x07, 0xe6, 0xda, 0xfc, 0x93, 0x79, 0x0d, 0x39,
-	0x88, 0xcd, 0xca, 0x4f, 0x8f, 0x36, 0x4e, 0xe5, 0x8b, 0x51, 0x10, 0xcd, 0x9c, 0x9b, 0x22, 0x8a,
-	0xbe, 0x0a, 0xf3, 0x6e, 0x97, 0xee, 0xe0, 0x38, 0xa0, 0x7b, 0xc6, 0x11, 0x86, 0xb9, 0x2f, 0xa8,
-	0xd7, 0x12, 0xd4, 0xfd, 0x75, 0x02, 0xbb, 0x92, 0x87, 0x5d, 0x48, 0xd1, 0x2a, 0xc3, 0xaa, 0x4a,
-	0x2e, 0xc0, 0x5b, 0x07, 0x1a, 0xcc, 0x35, 0x88, 0xff, 0x01, 0xa6, 0x48, 0x7f, 0x5b, 0x41, 0xc4,
-	0x66, 0xe9, 0xf7, 0x67, 0x95, 0xac, 0x98, 0x97, 0x44, 0x86, 0x1e, 0xdd, 0x86, 0x99, 0x1e, 0xa6,
-	0x28, 0xe6, 0x09, 0x8f, 0xa8, 0x05, 0x6e, 0xa6, 0xd7, 0x60, 0x16, 0x77, 0x68, 0x80, 0x23, 0x56,
-	0x3c, 0x8b, 0x35, 0xd3, 0xce, 0x73, 0x63, 0x27, 0xc9, 0xdc, 0x61, 0x16, 0xcd, 0xd4, 0x72, 0x54,
-	0xf1, 0xd4, 0xcf, 0x24, 0xb4, 0xf0, 0xd8, 0x09, 0x25, 0x7a, 0x9e, 0x92, 0x24, 0x98, 0xb5, 0x04,
-	0xc7, 0xd3, 0x4f, 0x09, 0xfc, 0x4f, 0x4d, 0xca, 0x3e, 0x44, 0x81, 0xbf, 0x43, 0x91, 0xf7, 0x6f,
-	0x11, 0x70, 0x15, 0xe6, 0x38, 0x2c, 0x62, 0x4c, 0xb3, 0x6b, 0x68, 0x0d, 0x32, 0x20, 0x32, 0xca,
-	0x30, 0x21, 0x5c, 0x46, 0x52, 0x71, 0x21, 0x4f, 0x85, 0x59, 0xa4, 0x42, 0x44, 0xb6, 0x56, 0x60,
-	0x79, 0x40, 0x94, 0xad, 0x09, 0x68, 0x10, 0x5f, 0x5c, 0xf7, 0x43, 0xb2, 0x72, 0x19, 0xe6, 0xd3,
-	0x66, 0x83, 0xc7, 0x33, 0xd3, 0x37, 0xd5, 0xaf, 0xc2, 0xac, 0x1b, 0xe2, 0x6e, 0x44, 0x53, 0x72,
-	0x26, 0xeb, 0x51, 0xa9, 0x4f, 0x7d, 0x9d, 0xdd, 0x11, 0x19, 0x2d, 0x61, 0xe1, 0x64, 0x9e, 0x85,
-	0x14, 0x96, 0x55, 0x02, 0xbd, 0xbf, 0x92, 0xd8, 0x1f, 0xf3, 0xb2, 0xb8, 0xdf, 0xf1, 0x5c, 0x8a,
-	0xee, 0xba, 0xb1, 0x1b, 0x92, 0x04, 0x49, 0xff, 0x56, 0x6a, 0xe3, 0x90, 0x48, 0x53, 0xfd, 0x1d,
-	0x98, 0xed, 0xb0, 0x08, 0x0c, 0xfe, 0x42, 0xed, 0xd5, 0xc1, 0x63, 0xe6, 0xf1, 0x73, 0x30, 0xb8,
-	0x43, 0xfd, 0x52, 0xf1, 0xaa, 0xaf, 0x09, 0x18, 0x0f, 0xc5, 0x80, 0x1a, 0xc8, 0x33, 0x3d, 0xd2,
-	0xac, 0x48, 0xc2, 0xfa, 0x1c, 0xfe, 0xdf, 0x20, 0x3e, 0x6f, 0x7d, 0xe8, 0xb6, 0xfb, 0xd9, 0x61,
-	0x31, 0xd5, 0xab, 0xc5, 0xc4, 0xca, 0xaa, 0xc4, 0xfa, 0x5b, 0x59, 0xcb, 0x70, 0x32, 0x27, 0x90,
-	0x49, 0xed, 0x6b, 0x50, 0xe9, 0x6b, 0xb6, 0x70, 0x44, 0x68, 0x40, 0xbb, 0x49, 0x95, 0x5f, 0x0b,
-	0x51, 0xe4, 0x85, 0x49, 0x47, 0x3c, 0x2c, 0xf7, 0x49, 0x27, 0x15, 0x41, 0x64, 0x27, 0x15, 0x82,
-	0xfa, 0x95, 0x22, 0x8a, 0x73, 0x23, 0x50, 0xc8, 0x74, 0xac, 0x0b, 0x70, 0x7e, 0x4c, 0xc6, 0x12,
-	0xdd, 0xcf, 0x1a, 0x1b, 0xde, 0x5b, 0x31, 0x72, 0x29, 0xba, 0x89, 0x7b, 0x28, 0x8e, 0x70, 0xd2,
-	0xfc, 0xe6, 0x5c, 0x9e, 0xf3, 0x58, 0x34, 0xc2, 0x50, 0xbf, 0x0b, 0x0b, 0x1e, 0x22, 0xed, 0x38,
-	0xe0, 0x5d, 0x93, 0x17, 0xd3, 0xd9, 0xc1, 0x62, 0x12, 0x5b, 0x5c, 0xef, 0x9b, 0x66, 0x2b, 0x2b,
-	0x1b, 0xa2, 0x5e, 0xfd, 0xed, 0xbb, 0xca, 0x54, 0xc2, 0x81, 0xd8, 0x43, 0x31, 0x42, 0xf3, 0x89,
-	0x5b, 0xa7, 0xd8, 0x08, 0xcd, 0x0b, 0x25, 0xd6, 0x27, 0xfc, 0xd6, 0xdc, 0xf0, 0x02, 0xfa, 0x1f,
-	0x43, 0xea, 0xa8, 0x90, 0x0e, 0xf4, 0xc5, 0x6c, 0xda, 0xe9, 0x25, 0xca, 0x8a, 0x24, 0xca, 0x1f,
-	0xb4, 0xcc, 0x05, 0x13, 0xda, 0x7b, 0xd4, 0xa5, 0x5d, 0x72, 0x28, 0xb4, 0x97, 0x61, 0x96, 0x30,
-	0x6f, 0x06, 0x74, 0xb1, 0x56, 0x1e, 0x06, 0x94, 0xef, 0xd1, 0x4c, 0xad, 0xeb, 0x57, 0x54, 0x98,
-	0xac, 0x3c, 0x26, 0x55, 0x92, 0xd6, 0x19, 0x76, 0xdf, 0x54, 0x2a, 0x89, 0xf1, 0xb9, 0x06, 0xaf,
-	0xb0, 0xb6, 0xb8, 0x8b, 0xfc, 0x6c, 0xdd, 0xde, 0x80, 0x25, 0x8f, 0xcb, 0x70, 0xbc, 0x3d, 0x29,
-	0xd2, 0x13, 0xd2, 0x25, 0x95, 0xeb, 0x5b, 0x70, 0xc2, 0x4f, 0x43, 0xca, 0x28, 0xe3, 0x66, 0xc3,
-	0x71, 0xe1, 0x91, 0x8a, 0xeb, 0xef, 0x0a, 0xfc, 0xc5, 0x94, 0x72, 0xfd, 0x48, 0xf4, 0xfb, 0x3c,
-	0x14, 0xeb, 0x34, 0x9c, 0x52, 0x88, 0x25, 0x03, 0xdf, 0x6a, 0xac, 0x5f, 0xdd, 0x8f, 0xbc, 0x7f,
-	0x86, 0x83, 0xfa, 0x7b, 0xa3, 0xd3, 0x5f, 0x1b, 0x38, 0xc8, 0x42, 0x1e, 0x56, 0x05, 0x4e, 0x2b,
-	0x15, 0x02, 0x42, 0xed, 0x8f, 0x79, 0x98, 0x6e, 0x10, 0x5f, 0x7f, 0x00, 0x8b, 0x03, 0xff, 0x1d,
-	0xce, 0x0c, 0x96, 0x58, 0xe1, 0x59, 0x6c, 0x5e, 0x18, 0x6b, 0x22, 0x5f, 0xce, 0x3e, 0x2c, 0x15,
-	0x1f, 0xc5, 0xe7, 0x14, 0xfe, 0x05, 0x2b, 0xf3, 0x8d, 0x49, 0xac, 0xe4, 0x46, 0xef, 0xc3, 0x51,
-	0xf6, 0x42, 0x5d, 0x56, 0x78, 0x25, 0x0a, 0xb3, 0x32, 0x44, 0x21, 0x23, 0x7c, 0x04, 0xff, 0xcb,
-	0x3d, 0xf5, 0x86, 0x39, 0x08, 0x03, 0xf3, 0xfc, 0x18, 0x03, 0x19, 0xf9, 0x16, 0xcc, 0x89, 0x97,
-	0x92, 0xa9, 0xf0, 0x49, 0x75, 0xa6, 0x35, 0x5c, 0x97, 0x4d, 0x32, 0xf7, 0xf0, 0x50, 0x25, 0x99,
-	0x35, 0x50, 0x26, 0xa9, 0x9a, 0xff, 0x7a, 0x13, 0x20, 0x33, 0xfc, 0x4f, 0x2b, 0xdc, 0xfa, 0x6a,
-	0xf3, 0xb5, 0x91, 0x6a, 0x19, 0xf3, 0x2b, 0x0d, 0x56, 0x47, 0xce, 0x6e, 0x67, 0x78, 0x1c, 0xa5,
-	0x83, 0x79, 0xe5, 0x6f, 0x3a, 0xc8, 0x54, 0x1e, 0xc0, 0xe2, 0xc0, 0x9c, 0x55, 0x15, 0x7a, 0xde,
-	0x44, 0x59, 0xe8, 0xea, 0xf9, 0x96, 0x1c, 0x4c, 0x6e, 0xb6, 0xa9, 0x0e, 0x26, 0x6b, 0xa0, 0x3c,
-	0x18, 0xd5, 0x4c, 0xd1, 0x3b, 0x50, 0x52, 0xce, 0x93, 0xe1, 0x27, 0x9b, 0x37, 0x34, 0x9d, 0x09,
-	0x0d, 0xe5, 0x8e, 0x1e, 0x9c, 0x28, 0x74, 0xf7, 0xb3, 0xca, 0xe2, 0xcc, 0x1b, 0x99, 0xaf, 0x4f,
-	0x60, 0x24, 0x77, 0xf9, 0x14, 0x74, 0x45, 0x07, 0x55, 0x55, 0x56, 0xd1, 0xcc, 0xdc, 0x98, 0xc8,
-	0x4c, 0xec, 0x65, 0xce, 0x7c, 0x91, 0xcc, 0xfd, 0xcd, 0x9b, 0x4f, 0x5e, 0x94, 0xb5, 0xa7, 0x2f,
-	0xca, 0xda, 0xf3, 0x17, 0x65, 0xed, 0x9b, 0x83, 0xf2, 0xd4, 0xd3, 0x83, 0xf2, 0xd4, 0xaf, 0x07,
-	0xe5, 0xa9, 0x8f, 0x37, 0xfc, 0x80, 0xee, 0x74, 0x5b, 0x76, 0x1b, 0x87, 0x4e, 0x1a, 0x79, 0x63,
-	0xa7, 0xdb, 0x72, 0xf2, 0x4f, 0x3e, 0xba, 0xd7, 0x41, 0xc4, 0xe9, 0x55, 0x5b, 0xb3, 0xec, 0xdf,
-	0xfb, 0xa5, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x03, 0x52, 0x7b, 0x47, 0x5a, 0x12, 0x00, 0x00,
+	// 1588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0xdb, 0x46,
+	0x16, 0x37, 0xfd, 0x21, 0xc7, 0xcf, 0x8e, 0x63, 0x73, 0xe5, 0x98, 0x66, 0x6c, 0xc9, 0x66, 0x12,
+	0xd8, 0xce, 0xae, 0xc9, 0xd8, 0xd9, 0x4d, 0xb0, 0x42, 0x16, 0xbb, 0xb1, 0x13, 0x64, 0x03, 0xc4,
+	0x48, 0xc0, 0x20, 0xbb, 0x45, 0x0f, 0x31, 0x68, 0x71, 0x4a, 0xb3, 0x10, 0x39, 0x02, 0x67, 0xa4,
+	0xd8, 0xb7, 0xa2, 0xa7, 0xa2, 0x40, 0xd1, 0xfe, 0x07, 0xed, 0xb1, 0x47, 0x1f, 0x72, 0xc9, 0xa5,
+	0xbd, 0x06, 0x45, 0x0f, 0x41, 0x4f, 0x3d, 0x05, 0x41, 0x7c, 0x48, 0xd1, 0xf6, 0xd0, 0x7f, 0xa0,
+	0x40, 0xc1, 0xe1, 0x70, 0x48, 0x8a, 0x94, 0xe4, 0xba, 0x48, 0xd0, 0x8b, 0x30, 0xf3, 0xbe, 0xe6,
+	0xfd, 0x7e, 0xf3, 0xf1, 0x1e, 0x05, 0xb3, 0x16, 0xc5, 0x1e, 0xf6, 0x91, 0xe1, 0xe0, 0xb6, 0xd1,
+	0x5e, 0x37, 0xe8, 0xbe, 0xde, 0x0c, 0x30, 0xc5, 0xf2, 0x24, 0x57, 0xe8, 0x0e, 0x6e, 0xeb, 0xed,
+	0x75, 0xb5, 0x52, 0xc7, 0xc4, 0xc3, 0xc4, 0xd8, 0xb5, 0x08, 0x32, 0xda, 0xeb, 0xbb, 0x88, 0x5a,
+	0xeb, 0x46, 0x1d, 0xbb, 0x7e, 0x64, 0xaf, 0x2a, 0x1d, 0x81, 0x42, 0xb7, 0x48, 0x53, 0x76, 0xb0,
+	0x83, 0xd9, 0xd0, 0x08, 0x47, 0x5c, 0x3a, 0x17, 0xc5, 0xdb, 0x89, 0x14, 0xd1, 0x24, 0x56, 0x39,
+	0x18, 0x3b, 0x0d, 0x64, 0xb0, 0xd9, 0x6e, 0xeb, 0x3d, 0xc3, 0xf2, 0x0f, 0xb8, 0x6a, 0x96, 0x67,
+	0xe1, 0x11, 0x27, 0x5c, 0xc4, 0x23, 0x0e, 0x57, 0x4c, 0x5b, 0x9e, 0xeb, 0x63, 0x83, 0xfd, 0x46,
+	0x22, 0xed, 0xa7, 0x41, 0x98, 0xde, 0x26, 0xce, 0x83, 0xd6, 0xae, 0xe7, 0xd2, 0xfb, 0x01, 0x6e,
+	0x62, 0x62, 0x35, 0xe4, 0xcb, 0x70, 0xca, 0x43, 0x84, 0x58, 0x0e, 0x22, 0x8a, 0xb4, 0x38, 0xb4,
+	0x32, 0xbe, 0x51, 0xd6, 0xa3, 0xf5, 0xf4, 0x78, 0x3d, 0xfd, 0x86, 0x7f, 0x60, 0x0a, 0x2b, 0x79,
+	0x1b, 0xce, 0xb8, 0xbe, 0x4b, 0x5d, 0xab, 0xb1, 0x63, 0xa3, 0x26, 0x26, 0x2e, 0x55, 0x06, 0x99,
+	0xe3, 0x9c, 0xce, 0xd3, 0x0e, 0x39, 0xd1, 0x39, 0x27, 0xfa, 0x16, 0x76, 0xfd, 0xcd, 0xb1, 0x67,
+	0x2f, 0xaa, 0x03, 0x5f, 0xbe, 0x3e, 0xbc, 0x24, 0x99, 0x93, 0xdc, 0xf9, 0x66, 0xe4, 0x2b, 0xff,
+	0x1d, 0x4e, 0x35, 0x59, 0x32, 0x28, 0x50, 0x86, 0x16, 0xa5, 0x95, 0xb1, 0x4d, 0xe5, 0xbb, 0x27,
+	0x6b, 0x65, 0x1e, 0xea, 0x86, 0x6d, 0x07, 0x88, 0x90, 0x07, 0x34, 0x70, 0x7d, 0xc7, 0x14, 0x96,
+	0xb2, 0x1a, 0xa6, 0x4d, 0x2d, 0xdb, 0xa2, 0x96, 0x32, 0x1c, 0x7a, 0x99, 0x62, 0x2e, 0x97, 0x61,
+	0x84, 0xba, 0xb4, 0x81, 0x94, 0x11, 0xa6, 0x88, 0x26, 0xb2, 0x02, 0xa3, 0xa4, 0xe5, 0x79, 0x56,
+	0x70, 0xa0, 0x94, 0x98, 0x3c, 0x9e, 0xca, 0xe7, 0xe1, 0x74, 0x1d, 0x7b, 0x9e, 0x4b, 0x77, 0x02,
+	0xd4, 0x46, 0x56, 0x43, 0x19, 0x5d, 0x94, 0x56, 0x4e, 0x99, 0x13, 0x91, 0xd0, 0x64, 0xb2, 0x9a,
+	0xfe, 0xe1, 0xeb, 0xc3, 0x4b, 0x62, 0xfd, 0x8f, 0x5f, 0x1f, 0x5e, 0x9a, 0x8f, 0x77, 0xb8, 0xbd,
+	0x6e, 0xe4, 0x78, 0xd5, 0xae, 0xc3, 0x5c, 0x4e, 0x68, 0x22, 0xd2, 0xc4, 0x3e, 0x41, 0x72, 0x15,
+	0xc6, 0x9b, 0x5c, 0xb6, 0xe3, 0xda, 0x8a, 0xb4, 0x28, 0xad, 0x0c, 0x9b, 0x10, 0x8b, 0xee, 0xd8,
+	0xda, 0x53, 0x09, 0xca, 0xdb, 0xc4, 0xb9, 0xb5, 0x8f, 0xea, 0x77, 0x91, 0x63, 0xd5, 0x0f, 0xb6,
+	0xb0, 0x4f, 0x91, 0x4f, 0xe5, 0x7b, 0x30, 0x5a, 0x8f, 0x86, 0xcc, 0xab, 0xcb, 0x6e, 0x6d, 0x56,
+	0xbf, 0x79, 0xb2, 0x76, 0x2e, 0x7b, 0x62, 0xe3, 0xdd, 0x60, 0xce, 0x66, 0x1c, 0x45, 0x9e, 0x87,
+	0x31, 0xab, 0x45, 0xf7, 0x70, 0xe0, 0xd2, 0x03, 0x65, 0x90, 0x11, 0x93, 0x08, 0x6a, 0x1b, 0x21,
+	0xea, 0x64, 0x1e, 0xc2, 0xae, 0x66, 0x61, 0xe7, 0x52, 0xd4, 0x2a, 0x30, 0x5f, 0x24, 0x8f, 0xc1,
+	0x6b, 0x47, 0x12, 0x8c, 0x6e, 0x13, 0xe7, 0x7f, 0x98, 0x22, 0xf9, 0x1f, 0x05, 0x44, 0x6c, 0x96,
+	0x7f, 0x7c, 0x51, 0x4d, 0x8b, 0xa3, 0x73, 0x93, 0xa2, 0x47, 0xd6, 0x61, 0xa4, 0x8d, 0x29, 0x0a,
+	0x94, 0xc1, 0x3e, 0x07, 0x26, 0x32, 0x93, 0x37, 0xa0, 0x84, 0x9b, 0xd4, 0xc5, 0x3e, 0x3b, 0x61,
+	0x93, 0x1b, 0xaa, 0x9e, 0xe5, 0x46, 0x0f, 0x93, 0xb9, 0xc7, 0x2c, 0x4c, 0x6e, 0xd9, 0xeb, 0x84,
+	0xd5, 0x96, 0x42, 0x5a, 0xa2, 0xd8, 0x21, 0x25, 0x72, 0x96, 0x92, 0x30, 0x98, 0x36, 0x0d, 0x67,
+	0xf8, 0x50, 0x00, 0xff, 0x55, 0x12, 0xb2, 0xff, 0x23, 0xd7, 0xd9, 0xa3, 0xc8, 0x7e, 0x5b, 0x04,
+	0x5c, 0x87, 0xd1, 0x08, 0x16, 0x51, 0x86, 0xd8, 0x5d, 0xd5, 0x3a, 0x19, 0x88, 0x33, 0x4a, 0x31,
+	0x11, 0xbb, 0xf4, 0xa4, 0x62, 0x35, 0x4b, 0x85, 0x9a, 0xa7, 0x22, 0x8e, 0xac, 0xcd, 0xc1, 0x6c,
+	0x87, 0x48, 0x50, 0xf3, 0xb5, 0x04, 0xa7, 0xb7, 0x89, 0xb3, 0xc5, 0x6e, 0xdc, 0xdb, 0x3c, 0x19,
+	0x15, 0x80, 0xe8, 0x9a, 0x7b, 0xe1, 0x95, 0x0a, 0x4f, 0xc7, 0x84, 0x99, 0x92, 0xd4, 0x96, 0xb3,
+	0xf0, 0x94, 0x2c, 0xbc, 0x24, 0x5f, 0x6d, 0x16, 0x66, 0x32, 0x02, 0x01, 0xed, 0x93, 0x41, 0x06,
+	0x2d, 0x7a, 0x46, 0xde, 0x26, 0xb4, 0x37, 0xb6, 0xe7, 0xb2, 0x0c, 0xc3, 0xc4, 0x6a, 0x50, 0xf6,
+	0xbe, 0x4e, 0x98, 0x6c, 0xdc, 0x87, 0xa8, 0x04, 0x3d, 0x27, 0x2a, 0x11, 0xa4, 0xdf, 0x05, 0xd8,
+	0x26, 0x4e, 0x5c, 0x17, 0x4e, 0xc8, 0xd2, 0x55, 0x18, 0xe3, 0x55, 0x09, 0xf7, 0x67, 0x2a, 0x31,
+	0x95, 0xaf, 0x43, 0xc9, 0xf2, 0x70, 0xcb, 0xa7, 0x9c, 0xac, 0xe3, 0x15, 0x33, 0xee, 0x53, 0x5b,
+	0x61, 0xef, 0xa4, 0x88, 0x16, 0x32, 0x30, 0x93, 0x65, 0x80, 0xc3, 0xd2, 0xca, 0x20, 0x27, 0x33,
+	0x81, 0xfd, 0x69, 0xf4, 0x34, 0x3c, 0x6c, 0xda, 0x16, 0x45, 0xf7, 0xad, 0xc0, 0xf2, 0x48, 0x88,
+	0x24, 0x79, 0x99, 0xa5, 0x7e, 0x48, 0x84, 0xa9, 0xfc, 0x4f, 0x28, 0x35, 0x59, 0x04, 0x06, 0x7f,
+	0x7c, 0xe3, 0x6c, 0xe7, 0xb6, 0x47, 0xf1, 0x33, 0x30, 0x22, 0x87, 0xda, 0x95, 0xfc, 0x73, 0xbf,
+	0x18, 0xc3, 0xd8, 0x8f, 0x3b, 0x99, 0x8e, 0x3c, 0xf9, 0xb5, 0x4e, 0x8b, 0x04, 0xac, 0x5f, 0xa2,
+	0x6b, 0x1d, 0xd5, 0x3f, 0x74, 0xd7, 0x7a, 0x7c, 0x62, 0x50, 0xa2, 0xa6, 0x0f, 0xa6, 0x6b, 0xba,
+	0x0c, 0xc3, 0x14, 0xed, 0x47, 0xf7, 0x76, 0xcc, 0x64, 0x63, 0x79, 0x0a, 0x86, 0x5a, 0x81, 0xcb,
+	0xcf, 0x6c, 0x38, 0x94, 0x97, 0x60, 0x82, 0x57, 0xbb, 0x9d, 0x3d, 0x8b, 0xec, 0xf1, 0xb6, 0x60,
+	0x9c, 0xcb, 0xfe, 0x6b, 0x91, 0x3d, 0x79, 0x06, 0x4a, 0x0d, 0xeb, 0x71, 0x78, 0xce, 0x4a, 0xac,
+	0x16, 0x8f, 0x34, 0xac, 0xc7, 0x77, 0xec, 0xda, 0x7a, 0x9e, 0x8f, 0x4a, 0x11, 0x1f, 0x09, 0x40,
+	0x4d, 0x87, 0x99, 0x8c, 0x40, 0xd4, 0xfc, 0x64, 0x09, 0x29, 0xb5, 0x84, 0xf6, 0xa9, 0x04, 0x13,
+	0xec, 0x3e, 0x34, 0x91, 0xd5, 0xf8, 0x23, 0x0c, 0x25, 0xf1, 0x07, 0xd3, 0x10, 0x2e, 0xe7, 0x21,
+	0x2c, 0x14, 0x41, 0x10, 0x09, 0x68, 0x67, 0xa1, 0x9c, 0x9e, 0x8b, 0xcd, 0x3c, 0x94, 0xa0, 0x9a,
+	0x40, 0xdb, 0xc2, 0x3e, 0xa1, 0x2e, 0x6d, 0x85, 0xaf, 0xc5, 0x0d, 0x0f, 0xf9, 0x76, 0xf8, 0x5c,
+	0x9e, 0x38, 0xf9, 0xb0, 0x0b, 0x89, 0x83, 0x88, 0x2e, 0x24, 0x16, 0xd4, 0xae, 0xe5, 0x31, 0x5c,
+	0xe8, 0xb1, 0x0d, 0x22, 0x1d, 0x6d, 0x15, 0x96, 0xfb, 0x64, 0x2c, 0xd0, 0x7d, 0x2b, 0xb1, 0xee,
+	0x78, 0x2b, 0x40, 0x16, 0x45, 0xb7, 0x71, 0x1b, 0x05, 0x3e, 0x0e, 0x1b, 0x87, 0x51, 0x2b, 0xca,
+	0xb9, 0x2f, 0x9a, 0xd8, 0x50, 0xbe, 0x0f, 0xe3, 0x36, 0x22, 0xf5, 0xc0, 0x8d, 0x3a, 0x8e, 0xe8,
+	0x12, 0x9e, 0xef, 0xbc, 0x84, 0xf1, 0x12, 0x37, 0x13, 0xd3, 0xf4, 0x8d, 0x4c, 0x87, 0xa8, 0xad,
+	0xff, 0xf0, 0x45, 0x75, 0x20, 0xe4, 0x20, 0x5e, 0xa3, 0xa0, 0xfd, 0xcc, 0x26, 0xae, 0x9d, 0x83,
+	0xb9, 0x9c, 0x50, 0x60, 0x7d, 0x16, 0xbd, 0x36, 0xb7, 0x6c, 0x97, 0xfe, 0xc9, 0x90, 0x1a, 0x45,
+	0x48, 0x3b, 0x7a, 0x8a, 0x74, 0xda, 0xfc, 0xf1, 0x49, 0x8b, 0x04, 0xca, 0xaf, 0xa4, 0xd4, 0xc3,
+	0x14, 0x6b, 0x1f, 0x50, 0x8b, 0xb6, 0xc8, 0x89, 0xd0, 0x5e, 0x85, 0x12, 0x61, 0xde, 0x0c, 0xe8,
+	0xe4, 0x46, 0xa5, 0x1b, 0xd0, 0x68, 0x0d, 0x93, 0x5b, 0xd7, 0xae, 0x15, 0x61, 0xd2, 0xb2, 0x98,
+	0x8a, 0x92, 0xd4, 0x96, 0xa0, 0xda, 0x45, 0x25, 0x30, 0xbe, 0x94, 0xe0, 0x2f, 0xac, 0x9c, 0x34,
+	0x90, 0x93, 0x3e, 0xb7, 0xb7, 0x60, 0xda, 0x8e, 0x64, 0x38, 0xd8, 0x39, 0x2e, 0xd2, 0x29, 0xe1,
+	0xc2, 0xe5, 0xf2, 0x16, 0x4c, 0x39, 0x3c, 0xa4, 0x88, 0xd2, 0xaf, 0xa6, 0x9e, 0x89, 0x3d, 0xb8,
+	0xb8, 0xf6, 0xaf, 0x18, 0x7f, 0x3e, 0xa5, 0xcc, 0x83, 0x1a, 0xd7, 0xc9, 0x2c, 0x14, 0x6d, 0x01,
+	0xce, 0x15, 0x88, 0x05, 0x03, 0x9f, 0x4b, 0xec, 0xc1, 0x7d, 0xe8, 0xdb, 0x6f, 0x86, 0x83, 0xda,
+	0xbf, 0x7b, 0xa7, 0xbf, 0xd8, 0xb1, 0x91, 0xb9, 0x3c, 0xb4, 0x2a, 0x2c, 0x14, 0x2a, 0x62, 0x08,
+	0x1b, 0x3f, 0x8f, 0xc3, 0xd0, 0x36, 0x71, 0xe4, 0x47, 0x30, 0xd9, 0xf1, 0x71, 0xbe, 0xd4, 0x79,
+	0xc4, 0x72, 0x9f, 0x94, 0xea, 0x6a, 0x5f, 0x13, 0x51, 0x81, 0x1c, 0x98, 0xce, 0x7f, 0x50, 0x5e,
+	0x28, 0xf0, 0xcf, 0x59, 0xa9, 0x7f, 0x3b, 0x8e, 0x95, 0x58, 0xe8, 0x3f, 0x30, 0xcc, 0x1a, 0xdd,
+	0xd9, 0x02, 0xaf, 0x50, 0xa1, 0x56, 0xbb, 0x28, 0x44, 0x84, 0x77, 0x60, 0x22, 0xf3, 0x99, 0xd4,
+	0xcd, 0x21, 0x36, 0x50, 0x97, 0xfb, 0x18, 0x88, 0xc8, 0x26, 0x40, 0xea, 0x2b, 0x63, 0xa1, 0xc0,
+	0x2d, 0x51, 0xab, 0x17, 0x7b, 0xaa, 0xd3, 0x31, 0x53, 0xed, 0x7d, 0x51, 0xcc, 0x44, 0xad, 0x5e,
+	0xec, 0xa9, 0x16, 0x31, 0xef, 0xc0, 0x68, 0xdc, 0x09, 0xab, 0x05, 0x1e, 0x5c, 0xa7, 0x6a, 0xdd,
+	0x75, 0x69, 0x32, 0x33, 0x8d, 0x65, 0x11, 0x99, 0x69, 0x03, 0x75, 0xb9, 0x8f, 0x41, 0x1a, 0x78,
+	0xaa, 0xb7, 0x2b, 0x02, 0x9e, 0xa8, 0xd5, 0x8b, 0x3d, 0xd5, 0x22, 0xe6, 0x3d, 0x18, 0x4b, 0x9a,
+	0xa1, 0xf9, 0x42, 0xb2, 0xb8, 0x56, 0xbd, 0xd0, 0x4b, 0x2b, 0x02, 0x7e, 0x24, 0xc1, 0x7c, 0xcf,
+	0xa6, 0xc5, 0xe8, 0x9e, 0x58, 0xa1, 0x83, 0x7a, 0xed, 0x77, 0x3a, 0x88, 0x54, 0x1e, 0xc1, 0x64,
+	0x47, 0x83, 0x51, 0x74, 0xc3, 0xb3, 0x26, 0xea, 0x6a, 0x5f, 0x93, 0xf4, 0x4e, 0x67, 0x8a, 0x7a,
+	0xd1, 0x4e, 0xa7, 0x0d, 0xd4, 0xe5, 0x3e, 0x06, 0x22, 0x72, 0x13, 0xca, 0x85, 0x85, 0xb4, 0xfb,
+	0x51, 0xc9, 0x1a, 0xaa, 0xc6, 0x31, 0x0d, 0xc5, 0x8a, 0x36, 0x4c, 0xe5, 0xca, 0xda, 0xf9, 0xc2,
+	0xd3, 0x9e, 0x35, 0x52, 0xff, 0x7a, 0x0c, 0x23, 0xb1, 0xca, 0xfb, 0x20, 0x17, 0x94, 0x8e, 0xa2,
+	0xa3, 0x9a, 0x37, 0x53, 0xd7, 0x8e, 0x65, 0x16, 0xaf, 0xa5, 0x8e, 0x7c, 0x10, 0x36, 0x3c, 0x9b,
+	0xb7, 0x9f, 0xbd, 0xaa, 0x48, 0xcf, 0x5f, 0x55, 0xa4, 0x97, 0xaf, 0x2a, 0xd2, 0x67, 0x47, 0x95,
+	0x81, 0xe7, 0x47, 0x95, 0x81, 0xef, 0x8f, 0x2a, 0x03, 0xef, 0xae, 0x39, 0x2e, 0xdd, 0x6b, 0xed,
+	0xea, 0x75, 0xec, 0x19, 0x3c, 0xf2, 0xda, 0x5e, 0x6b, 0xd7, 0xc8, 0xf6, 0xba, 0xf4, 0xa0, 0x89,
+	0x48, 0xf8, 0x97, 0x73, 0x89, 0xfd, 0xe5, 0x77, 0xe5, 0xb7, 0x01, 0x00, 0x2d, 0x87, 0x25, 0xc6,
+	0xb4, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1458,6 +1867,12 @@ type MsgClient interface {
 	// VoteWeighted defines a method to add a weighted vote on a specific
 	// proposal.
 	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
+	// CommitVote defines a method to commit to a secret vote on a proposal using
+	// commit-reveal voting.
+	CommitVote(ctx context.Context, in *MsgCommitVote, opts ...grpc.CallOption) (*MsgCommitVoteResponse, error)
+	// RevealVote defines a method to reveal a vote committed to on a proposal
+	// using commit-reveal voting.
+	RevealVote(ctx context.Context, in *MsgRevealVote, opts ...grpc.CallOption) (*MsgRevealVoteResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
 	// UpdateParams defines a governance operation for updating the x/gov module
@@ -1468,6 +1883,9 @@ type MsgClient interface {
 	// ProposeLaw defines a governance operation for proposing a new law.
 	// The authority is defined in the keeper.
 	ProposeLaw(ctx context.Context, in *MsgProposeLaw, opts ...grpc.CallOption) (*MsgProposeLawResponse, error)
+	// RepealLaw defines a governance operation for repealing a law in force.
+	// The authority is defined in the keeper.
+	RepealLaw(ctx context.Context, in *MsgRepealLaw, opts ...grpc.CallOption) (*MsgRepealLawResponse, error)
 	// ProposeConstitutionAmendment defines a governance operation for proposing a
 	// new constitution amendment. The authority is defined in the keeper.
 	ProposeConstitutionAmendment(ctx context.Context, in *MsgProposeConstitutionAmendment, opts ...grpc.CallOption) (*MsgProposeConstitutionAmendmentResponse, error)
@@ -1529,6 +1947,24 @@ func (c *msgClient) VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts
 	return out, nil
 }
 
+func (c *msgClient) CommitVote(ctx context.Context, in *MsgCommitVote, opts ...grpc.CallOption) (*MsgCommitVoteResponse, error) {
+	out := new(MsgCommitVoteResponse)
+	err := c.cc.Invoke(ctx, "/atomone.gov.v1.Msg/CommitVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealVote(ctx context.Context, in *MsgRevealVote, opts ...grpc.CallOption) (*MsgRevealVoteResponse, error) {
+	out := new(MsgRevealVoteResponse)
+	err := c.cc.Invoke(ctx, "/atomone.gov.v1.Msg/RevealVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
 	out := new(MsgDepositResponse)
 	err := c.cc.Invoke(ctx, "/atomone.gov.v1.Msg/Deposit", in, out, opts...)
@@ -1556,6 +1992,15 @@ func (c *msgClient) ProposeLaw(ctx context.Context, in *MsgProposeLaw, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) RepealLaw(ctx context.Context, in *MsgRepealLaw, opts ...grpc.CallOption) (*MsgRepealLawResponse, error) {
+	out := new(MsgRepealLawResponse)
+	err := c.cc.Invoke(ctx, "/atomone.gov.v1.Msg/RepealLaw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ProposeConstitutionAmendment(ctx context.Context, in *MsgProposeConstitutionAmendment, opts ...grpc.CallOption) (*MsgProposeConstitutionAmendmentResponse, error) {
 	out := new(MsgProposeConstitutionAmendmentResponse)
 	err := c.cc.Invoke(ctx, "/atomone.gov.v1.Msg/ProposeConstitutionAmendment", in, out, opts...)
@@ -1622,6 +2067,12 @@ type MsgServer interface {
 	// VoteWeighted defines a method to add a weighted vote on a specific
 	// proposal.
 	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
+	// CommitVote defines a method to commit to a secret vote on a proposal using
+	// commit-reveal voting.
+	CommitVote(context.Context, *MsgCommitVote) (*MsgCommitVoteResponse, error)
+	// RevealVote defines a method to reveal a vote committed to on a proposal
+	// using commit-reveal voting.
+	RevealVote(context.Context, *MsgRevealVote) (*MsgRevealVoteResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
 	// UpdateParams defines a governance operation for updating the x/gov module
@@ -1632,6 +2083,9 @@ type MsgServer interface {
 	// ProposeLaw defines a governance operation for proposing a new law.
 	// The authority is defined in the keeper.
 	ProposeLaw(context.Context, *MsgProposeLaw) (*MsgProposeLawResponse, error)
+	// RepealLaw defines a governance operation for repealing a law in force.
+	// The authority is defined in the keeper.
+	RepealLaw(context.Context, *MsgRepealLaw) (*MsgRepealLawResponse, error)
 	// ProposeConstitutionAmendment defines a governance operation for proposing a
 	// new constitution amendment. The authority is defined in the keeper.
 	ProposeConstitutionAmendment(context.Context, *MsgProposeConstitutionAmendment) (*MsgProposeConstitutionAmendmentResponse, error)
@@ -1665,6 +2119,12 @@ func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVote
 func (*UnimplementedMsgServer) VoteWeighted(ctx context.Context, req *MsgVoteWeighted) (*MsgVoteWeightedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteWeighted not implemented")
 }
+func (*UnimplementedMsgServer) CommitVote(ctx context.Context, req *MsgCommitVote) (*MsgCommitVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitVote not implemented")
+}
+func (*UnimplementedMsgServer) RevealVote(ctx context.Context, req *MsgRevealVote) (*MsgRevealVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealVote not implemented")
+}
 func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -1674,6 +2134,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) ProposeLaw(ctx context.Context, req *MsgProposeLaw) (*MsgProposeLawResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProposeLaw not implemented")
 }
+func (*UnimplementedMsgServer) RepealLaw(ctx context.Context, req *MsgRepealLaw) (*MsgRepealLawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepealLaw not implemented")
+}
 func (*UnimplementedMsgServer) ProposeConstitutionAmendment(ctx context.Context, req *MsgProposeConstitutionAmendment) (*MsgProposeConstitutionAmendmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProposeConstitutionAmendment not implemented")
 }
@@ -1769,6 +2232,42 @@ func _Msg_VoteWeighted_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CommitVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.gov.v1.Msg/CommitVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitVote(ctx, req.(*MsgCommitVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.gov.v1.Msg/RevealVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealVote(ctx, req.(*MsgRevealVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDeposit)
 	if err := dec(in); err != nil {
@@ -1823,6 +2322,24 @@ func _Msg_ProposeLaw_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RepealLaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepealLaw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepealLaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.gov.v1.Msg/RepealLaw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepealLaw(ctx, req.(*MsgRepealLaw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ProposeConstitutionAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgProposeConstitutionAmendment)
 	if err := dec(in); err != nil {
@@ -1952,6 +2469,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VoteWeighted",
 			Handler:    _Msg_VoteWeighted_Handler,
 		},
+		{
+			MethodName: "CommitVote",
+			Handler:    _Msg_CommitVote_Handler,
+		},
+		{
+			MethodName: "RevealVote",
+			Handler:    _Msg_RevealVote_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
@@ -1964,6 +2489,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProposeLaw",
 			Handler:    _Msg_ProposeLaw_Handler,
 		},
+		{
+			MethodName: "RepealLaw",
+			Handler:    _Msg_RepealLaw_Handler,
+		},
 		{
 			MethodName: "ProposeConstitutionAmendment",
 			Handler:    _Msg_ProposeConstitutionAmendment_Handler,
@@ -2013,6 +2542,16 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommitReveal {
+		i--
+		if m.CommitReveal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -2314,6 +2853,157 @@ func (m *MsgVoteWeightedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCommitVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2469,6 +3159,39 @@ func (m *MsgProposeLaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LawId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LawId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -2499,10 +3222,15 @@ func (m *MsgProposeLawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LawId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LawId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgProposeConstitutionAmendment) Marshal() (dAtA []byte, err error) {
+func (m *MsgRepealLaw) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2512,22 +3240,20 @@ func (m *MsgProposeConstitutionAmendment) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgProposeConstitutionAmendment) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRepealLaw) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgProposeConstitutionAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRepealLaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amendment) > 0 {
-		i -= len(m.Amendment)
-		copy(dAtA[i:], m.Amendment)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amendment)))
+	if m.LawId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LawId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
@@ -2539,7 +3265,7 @@ func (m *MsgProposeConstitutionAmendment) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgProposeConstitutionAmendmentResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRepealLawResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2549,12 +3275,12 @@ func (m *MsgProposeConstitutionAmendmentResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *MsgProposeConstitutionAmendmentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRepealLawResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgProposeConstitutionAmendmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRepealLawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2562,7 +3288,7 @@ func (m *MsgProposeConstitutionAmendmentResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateGovernor) Marshal() (dAtA []byte, err error) {
+func (m *MsgProposeConstitutionAmendment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2572,19 +3298,79 @@ func (m *MsgCreateGovernor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateGovernor) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgProposeConstitutionAmendment) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateGovernor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgProposeConstitutionAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+	if len(m.Amendment) > 0 {
+		i -= len(m.Amendment)
+		copy(dAtA[i:], m.Amendment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amendment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeConstitutionAmendmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeConstitutionAmendmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeConstitutionAmendmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGovernor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGovernor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGovernor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
 		i -= size
@@ -2904,6 +3690,9 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.CommitReveal {
+		n += 2
+	}
 	return n
 }
 
@@ -3012,6 +3801,74 @@ func (m *MsgVoteWeightedResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCommitVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDeposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3077,10 +3934,57 @@ func (m *MsgProposeLaw) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LawId != 0 {
+		n += 1 + sovTx(uint64(m.LawId))
+	}
 	return n
 }
 
 func (m *MsgProposeLawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LawId != 0 {
+		n += 1 + sovTx(uint64(m.LawId))
+	}
+	return n
+}
+
+func (m *MsgRepealLaw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LawId != 0 {
+		n += 1 + sovTx(uint64(m.LawId))
+	}
+	return n
+}
+
+func (m *MsgRepealLawResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3467,6 +4371,26 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitReveal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitReveal = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4144,7 +5068,7 @@ func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4167,10 +5091,10 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4194,7 +5118,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4222,13 +5146,13 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4238,24 +5162,24 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4279,7 +5203,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4302,10 +5226,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4329,7 +5253,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4352,15 +5276,34 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4388,11 +5331,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4419,21 +5362,717 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeLaw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeLaw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeLaw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LawId", wireType)
+			}
+			m.LawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -4444,7 +6083,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeLawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4467,12 +6106,31 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeLawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeLawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LawId", wireType)
+			}
+			m.LawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4494,7 +6152,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposeLaw) Unmarshal(dAtA []byte) error {
+func (m *MsgRepealLaw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4517,10 +6175,10 @@ func (m *MsgProposeLaw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposeLaw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepealLaw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposeLaw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepealLaw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4555,6 +6213,25 @@ func (m *MsgProposeLaw) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LawId", wireType)
+			}
+			m.LawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4576,7 +6253,7 @@ func (m *MsgProposeLaw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposeLawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRepealLawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4599,10 +6276,10 @@ func (m *MsgProposeLawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposeLawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepealLawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposeLawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepealLawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// MinVoteSaltLength is the minimum length in bytes of the secret salt of a
+// vote commitment, so that the vote can not be guessed by hashing all the
+// possible votes.
+const MinVoteSaltLength = 16
+
+// VoteCommitmentHash returns the commitment of voter to the weighted vote
+// options on the proposal, salted with salt: the SHA-256 hash of the proposal
+// id, the voter address, the options in the order given, with their weights
+// in canonical form, and the salt. The proposal id and the voter are part of
+// the hash so that a commitment can not be copied by another voter, or
+// replayed on another proposal.
+func VoteCommitmentHash(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions, salt []byte) ([]byte, error) {
+	h := sha256.New()
+	h.Write(sdk.Uint64ToBigEndian(proposalID))
+	h.Write(address.MustLengthPrefix(voter))
+	h.Write(binary.BigEndian.AppendUint32(nil, uint32(len(options))))
+	for _, option := range options {
+		weight, err := math.LegacyNewDecFromStr(option.Weight)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight %q: %w", option.Weight, err)
+		}
+		h.Write(binary.BigEndian.AppendUint32(nil, uint32(option.Option)))
+		h.Write([]byte(weight.String()))
+		h.Write([]byte{'|'})
+	}
+	h.Write(salt)
+	return h.Sum(nil), nil
+}
+
+// Validate performs a basic validation of the vote commitment.
+func (c VoteCommitment) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.Voter); err != nil {
+		return fmt.Errorf("invalid vote commitment voter address: %w", err)
+	}
+	if len(c.Commitment) != sha256.Size {
+		return fmt.Errorf("invalid vote commitment of %s on proposal %d: must be a SHA-256 hash", c.Voter, c.ProposalId)
+	}
+	return nil
+}
@@ -125,7 +125,9 @@ func ConvertSDKTallyResultToAtomOne(sdkTally *sdkv1.TallyResult) *TallyResult {
 	}
 }
 
-// ConvertSDKParamsToAtomOne converts SDK params to AtomOne params
+// ConvertSDKParamsToAtomOne converts SDK params to AtomOne params.
+// ConstitutionAmendmentPatch and CommitReveal are held by the AtomOne gov
+// module and are left empty.
 func ConvertSDKParamsToAtomOne(sdkParams *sdkv1.Params) *Params {
 	if sdkParams == nil {
 		return nil
@@ -418,7 +420,9 @@ func ConvertAtomOneDepositToSDK(atomoneDeposit *Deposit) *sdkv1.Deposit {
 	}
 }
 
-// ConvertAtomOneParamsToSDK converts AtomOne params to SDK params
+// ConvertAtomOneParamsToSDK converts AtomOne params to SDK params.
+// ConstitutionAmendmentPatch and CommitReveal are held by the AtomOne gov
+// module and are dropped.
 func ConvertAtomOneParamsToSDK(atomoneParams *Params) *sdkv1.Params {
 	if atomoneParams == nil {
 		return nil
@@ -692,3 +696,31 @@ func ConvertAtomOneTallyParamsToSDK(atomoneParams *TallyParams) *sdkv1.TallyPara
 		LawThreshold:                   atomoneParams.LawThreshold,
 	}
 }
+
+// ConvertAtomOneGenesisStateToSDK converts an AtomOne v1 GenesisState to a
+// Cosmos SDK v1 GenesisState. The laws and the constitution history are left
+// out, since they are not part of the state of the x/gov module of the SDK.
+func ConvertAtomOneGenesisStateToSDK(atomoneGenState *GenesisState) *sdkv1.GenesisState {
+	if atomoneGenState == nil {
+		return nil
+	}
+
+	return &sdkv1.GenesisState{
+		StartingProposalId:                    atomoneGenState.StartingProposalId,
+		Deposits:                              ConvertAtomOneDepositsToSDK(atomoneGenState.Deposits),
+		Votes:                                 ConvertAtomOneVotesToSDK(atomoneGenState.Votes),
+		Proposals:                             ConvertAtomOneProposalsToSDK(atomoneGenState.Proposals),
+		DepositParams:                         ConvertAtomOneDepositParamsToSDK(atomoneGenState.DepositParams), //nolint:staticcheck // SA1019
+		VotingParams:                          ConvertAtomOneVotingParamsToSDK(atomoneGenState.VotingParams),   //nolint:staticcheck // SA1019
+		TallyParams:                           ConvertAtomOneTallyParamsToSDK(atomoneGenState.TallyParams),     //nolint:staticcheck // SA1019
+		Params:                                ConvertAtomOneParamsToSDK(atomoneGenState.Params),
+		Constitution:                          atomoneGenState.Constitution,
+		LastMinDeposit:                        ConvertAtomOneLastMinDepositToSDK(atomoneGenState.LastMinDeposit),
+		LastMinInitialDeposit:                 ConvertAtomOneLastMinDepositToSDK(atomoneGenState.LastMinInitialDeposit),
+		ParticipationEma:                      atomoneGenState.ParticipationEma,
+		ConstitutionAmendmentParticipationEma: atomoneGenState.ConstitutionAmendmentParticipationEma,
+		LawParticipationEma:                   atomoneGenState.LawParticipationEma,
+		Governors:                             ConvertAtomOneGovernorsToSDK(atomoneGenState.Governors),
+		GovernanceDelegations:                 ConvertAtomOneGovernanceDelegationsToSDK(atomoneGenState.GovernanceDelegations),
+	}
+}
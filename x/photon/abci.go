@@ -0,0 +1,12 @@
+package photon
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/keeper"
+)
+
+// EndBlocker is called at the end of every block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	return k.SnapshotConversionRate(ctx)
+}
@@ -2,12 +2,16 @@ package ante
 
 import (
 	"slices"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
+	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	"github.com/atomone-hub/atomone/x/photon/types"
 )
 
@@ -26,6 +30,13 @@ func NewValidateFeeDecorator(k PhotonKeeper) ValidateFeeDecorator {
 //   - tx has no fees or 0 fees.
 //   - tx messages' type URLs match the `TxFeeExceptions` field of the
 //     [types.Params].
+//   - tx messages are matched by the `TxFeeExceptionRules` field of the
+//     [types.Params].
+//   - tx only contains IBC relayer messages, and the `RelayerFeePolicy` field
+//     of the [types.Params] allows any fee denom.
+//   - tx fee denom is listed in the `AcceptedFeeDenoms` field of the
+//     [types.Params]. The fee amount is then priced in photon by the dynamicfee
+//     module, using the photon keeper as denom resolver.
 func (vfd ValidateFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -37,20 +48,47 @@ func (vfd ValidateFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return next(ctx, tx, simulate)
 	}
 
-	if AllowsAnyTxFee(tx, vfd.k.GetParams(ctx).TxFeeExceptions) {
+	params := vfd.k.GetParams(ctx)
+	if AllowsAnyTxFee(tx, params.TxFeeExceptions) {
 		// Skip if tx is declared in TxFeeExceptions (any fee coins are allowed).
 		return next(ctx, tx, simulate)
 	}
+	if params.RelayerFeePolicy == types.RelayerFeePolicyAnyDenom && IsRelayerTx(tx) {
+		// Skip if tx is a relayer tx and the relayer fee policy allows any fee
+		// coins.
+		return next(ctx, tx, simulate)
+	}
+	allowed, err := AllowsTxFeeByRules(tx, feeCoins, params.TxFeeExceptionRules)
+	if err != nil {
+		return ctx, err
+	}
+	if allowed {
+		// Skip if tx is matched by TxFeeExceptionRules (any fee coins within the
+		// rules caps are allowed).
+		return next(ctx, tx, simulate)
+	}
 
 	if len(feeCoins) > 1 {
 		return ctx, types.ErrTooManyFeeCoins
 	}
-	if feeDenom := feeCoins[0].Denom; feeDenom != types.Denom {
-		// feeDenom not allowed
+	feeDenom := feeCoins[0].Denom
+	if feeDenom == types.Denom {
+		// feeDenom photon is allowed
+		return next(ctx, tx, simulate)
+	}
+	if _, ok := params.AcceptedFeeDenom(feeDenom); ok {
+		// feeDenom is an accepted fee denom
+		return next(ctx, tx, simulate)
+	}
+	// feeDenom not allowed
+	if len(params.AcceptedFeeDenoms) == 0 {
 		return ctx, errorsmod.Wrapf(types.ErrInvalidFeeToken, "fee denom %s not allowed; only fee denom %s is allowed", feeDenom, types.Denom)
 	}
-	// feeDenom photon is allowed
-	return next(ctx, tx, simulate)
+	allowedDenoms := []string{types.Denom}
+	for _, d := range params.AcceptedFeeDenoms {
+		allowedDenoms = append(allowedDenoms, d.Denom)
+	}
+	return ctx, errorsmod.Wrapf(types.ErrInvalidFeeToken, "fee denom %s not allowed; only fee denoms %s are allowed", feeDenom, strings.Join(allowedDenoms, ", "))
 }
 
 // AllowsAnyTxFee returns true if all tx messages type URL are presents in
@@ -69,3 +107,107 @@ func AllowsAnyTxFee(tx sdk.Tx, txFeeExceptions []string) bool {
 	}
 	return anyTxFeeMsgCount == len(tx.GetMsgs())
 }
+
+// maxWrappedMsgDepth is the maximum nesting depth of wrapped messages
+// inspected by AllowsTxFeeByRules.
+const maxWrappedMsgDepth = 8
+
+// AllowsTxFeeByRules returns true if all tx messages are matched by one of
+// rules, given the tx signers and fee. Messages wrapped in an authz MsgExec or
+// a gov MsgSubmitProposal are matched by the rules having MatchWrapped set.
+func AllowsTxFeeByRules(tx sdk.Tx, fee sdk.Coins, rules []types.TxFeeExceptionRule) (bool, error) {
+	if len(rules) == 0 {
+		return false, nil
+	}
+	m := ruleMatcher{rules: rules}
+	for _, coin := range fee {
+		if coin.Denom != types.Denom {
+			m.nonPhotonFee = append(m.nonPhotonFee, coin)
+		}
+	}
+	if slices.ContainsFunc(rules, func(r types.TxFeeExceptionRule) bool { return len(r.Signers) > 0 }) {
+		sigTx, ok := tx.(authsigning.SigVerifiableTx)
+		if !ok {
+			return false, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a SigVerifiableTx")
+		}
+		signers, err := sigTx.GetSigners()
+		if err != nil {
+			return false, err
+		}
+		for _, signer := range signers {
+			m.signers = append(m.signers, sdk.AccAddress(signer).String())
+		}
+	}
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false, nil
+	}
+	for _, msg := range msgs {
+		if !m.matchMsg(msg, 0) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+type ruleMatcher struct {
+	rules        []types.TxFeeExceptionRule
+	signers      []string
+	nonPhotonFee sdk.Coins
+}
+
+// matchMsg returns true if msg is matched by one of the rules, or if msg
+// wraps messages that are all matched by one of the rules.
+func (m ruleMatcher) matchMsg(msg sdk.Msg, depth int) bool {
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	for _, rule := range m.rules {
+		if m.matchRule(rule, msgTypeURL, depth > 0) {
+			return true
+		}
+	}
+	if depth >= maxWrappedMsgDepth {
+		return false
+	}
+	var (
+		inner []sdk.Msg
+		err   error
+	)
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		inner, err = msg.GetMessages()
+	case *govv1.MsgSubmitProposal:
+		inner, err = msg.GetMsgs()
+	default:
+		return false
+	}
+	if err != nil || len(inner) == 0 {
+		return false
+	}
+	for _, innerMsg := range inner {
+		if !m.matchMsg(innerMsg, depth+1) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchRule returns true if rule matches a message of type msgTypeURL.
+func (m ruleMatcher) matchRule(rule types.TxFeeExceptionRule, msgTypeURL string, wrapped bool) bool {
+	if wrapped && !rule.MatchWrapped {
+		return false
+	}
+	if rule.MsgTypeUrl != "*" && rule.MsgTypeUrl != msgTypeURL {
+		return false
+	}
+	if len(rule.Signers) > 0 {
+		for _, signer := range m.signers {
+			if !slices.Contains(rule.Signers, signer) {
+				return false
+			}
+		}
+	}
+	if len(rule.MaxFee) > 0 && !m.nonPhotonFee.IsAllLTE(rule.MaxFee) {
+		return false
+	}
+	return true
+}
@@ -11,28 +11,36 @@ import (
 	"cosmossdk.io/log"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	appparams "github.com/atomone-hub/atomone/app/params"
+	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	"github.com/atomone-hub/atomone/x/photon/ante"
 	"github.com/atomone-hub/atomone/x/photon/types"
 )
 
 type mocks struct {
-	ctx          sdk.Context
-	PhotonKeeper *MockPhotonKeeper
+	ctx           sdk.Context
+	PhotonKeeper  *MockPhotonKeeper
+	ChannelKeeper *MockChannelKeeper
 }
 
 func setupMocks(t *testing.T) mocks {
 	t.Helper()
 	ctrl := gomock.NewController(t)
 	return mocks{
-		ctx:          sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()),
-		PhotonKeeper: NewMockPhotonKeeper(ctrl),
+		ctx:           sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()),
+		PhotonKeeper:  NewMockPhotonKeeper(ctrl),
+		ChannelKeeper: NewMockChannelKeeper(ctrl),
 	}
 }
 
@@ -41,6 +49,11 @@ func TestValidateFeeDecorator(t *testing.T) {
 		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
 		[]signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT},
 	)
+	ibcPhotonDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	paramsWithAcceptedFeeDenom := types.DefaultParams()
+	paramsWithAcceptedFeeDenom.AcceptedFeeDenoms = []types.AcceptedFeeDenom{
+		{Denom: ibcPhotonDenom, Conversion: types.FeeDenomConversionPegged},
+	}
 
 	tests := []struct {
 		name          string
@@ -121,6 +134,38 @@ func TestValidateFeeDecorator(t *testing.T) {
 				types.Denom,
 			),
 		},
+		{
+			name: "ok: MsgUpdateParams fee in accepted fee denom",
+			tx: func() sdk.Tx {
+				txBuilder := txConfig.NewTxBuilder()
+				txBuilder.SetMsgs(&types.MsgUpdateParams{})
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(ibcPhotonDenom, 1)))
+				return txBuilder.GetTx()
+			},
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).
+					Return(paramsWithAcceptedFeeDenom)
+			},
+		},
+		{
+			name: "fail: MsgUpdateParams fee xxx with accepted fee denoms",
+			tx: func() sdk.Tx {
+				txBuilder := txConfig.NewTxBuilder()
+				txBuilder.SetMsgs(&types.MsgUpdateParams{})
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("xxx", 1)))
+				return txBuilder.GetTx()
+			},
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).
+					Return(paramsWithAcceptedFeeDenom)
+			},
+			expectedError: fmt.Sprintf(
+				"fee denom %s not allowed; only fee denoms %s, %s are allowed: invalid fee token",
+				"xxx",
+				types.Denom,
+				ibcPhotonDenom,
+			),
+		},
 		{
 			name: "fail: MsgUpdateParams multiple fee denom",
 			tx: func() sdk.Tx {
@@ -138,6 +183,38 @@ func TestValidateFeeDecorator(t *testing.T) {
 			},
 			expectedError: "too many fee coins, only accepts fees in one denom",
 		},
+		{
+			name: "ok: relayer tx fee uatone with any denom relayer fee policy",
+			tx: func() sdk.Tx {
+				txBuilder := txConfig.NewTxBuilder()
+				txBuilder.SetMsgs(&clienttypes.MsgUpdateClient{}, &channeltypes.MsgRecvPacket{})
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 1)))
+				return txBuilder.GetTx()
+			},
+			setup: func(m mocks) {
+				params := types.DefaultParams()
+				params.RelayerFeePolicy = types.RelayerFeePolicyAnyDenom
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).Return(params)
+			},
+		},
+		{
+			name: "fail: relayer tx fee uatone with photon only relayer fee policy",
+			tx: func() sdk.Tx {
+				txBuilder := txConfig.NewTxBuilder()
+				txBuilder.SetMsgs(&clienttypes.MsgUpdateClient{}, &channeltypes.MsgRecvPacket{})
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 1)))
+				return txBuilder.GetTx()
+			},
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).
+					Return(types.DefaultParams())
+			},
+			expectedError: fmt.Sprintf(
+				"fee denom %s not allowed; only fee denom %s is allowed: invalid fee token",
+				"uatone",
+				types.Denom,
+			),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -250,3 +327,146 @@ func TestAllowsAnyTxFee(t *testing.T) {
 		})
 	}
 }
+
+func TestAllowsTxFeeByRules(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	authz.RegisterInterfaces(encCfg.InterfaceRegistry)
+	govv1.RegisterInterfaces(encCfg.InterfaceRegistry)
+	var (
+		addr1       = sdk.AccAddress("addr1")
+		addr2       = sdk.AccAddress("addr2")
+		mintMsg     = &types.MsgMintPhoton{ToAddress: addr1.String()}
+		mintTypeURL = sdk.MsgTypeURL(&types.MsgMintPhoton{})
+		atoneFee    = sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 100))
+	)
+	newTx := func(msgs ...sdk.Msg) func() sdk.Tx {
+		return func() sdk.Tx {
+			txBuilder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(msgs...))
+			return txBuilder.GetTx()
+		}
+	}
+	execMsg := authz.NewMsgExec(addr2, []sdk.Msg{mintMsg})
+	proposalMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{mintMsg}, nil, addr2.String(), "", "title", "summary")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		tx          func() sdk.Tx
+		fee         sdk.Coins
+		rules       []types.TxFeeExceptionRule
+		expectedRes bool
+	}{
+		{
+			name:        "no rules",
+			tx:          newTx(mintMsg),
+			fee:         atoneFee,
+			expectedRes: false,
+		},
+		{
+			name:        "rule matches msg type",
+			tx:          newTx(mintMsg),
+			fee:         atoneFee,
+			rules:       []types.TxFeeExceptionRule{{MsgTypeUrl: mintTypeURL}},
+			expectedRes: true,
+		},
+		{
+			name:        "rule doesn't match msg type",
+			tx:          newTx(mintMsg),
+			fee:         atoneFee,
+			rules:       []types.TxFeeExceptionRule{{MsgTypeUrl: sdk.MsgTypeURL(&types.MsgUpdateParams{})}},
+			expectedRes: false,
+		},
+		{
+			name:        "wildcard rule",
+			tx:          newTx(mintMsg),
+			fee:         atoneFee,
+			rules:       []types.TxFeeExceptionRule{{MsgTypeUrl: "*"}},
+			expectedRes: true,
+		},
+		{
+			name: "rule matches signer",
+			tx:   newTx(mintMsg),
+			fee:  atoneFee,
+			rules: []types.TxFeeExceptionRule{
+				{MsgTypeUrl: mintTypeURL, Signers: []string{addr2.String(), addr1.String()}},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "rule doesn't match signer",
+			tx:   newTx(mintMsg),
+			fee:  atoneFee,
+			rules: []types.TxFeeExceptionRule{
+				{MsgTypeUrl: mintTypeURL, Signers: []string{addr2.String()}},
+			},
+			expectedRes: false,
+		},
+		{
+			name: "fee within rule cap",
+			tx:   newTx(mintMsg),
+			fee:  atoneFee.Add(sdk.NewInt64Coin(types.Denom, 1_000)),
+			rules: []types.TxFeeExceptionRule{
+				{MsgTypeUrl: mintTypeURL, MaxFee: atoneFee},
+			},
+			expectedRes: true,
+		},
+		{
+			name: "fee exceeds rule cap",
+			tx:   newTx(mintMsg),
+			fee:  atoneFee,
+			rules: []types.TxFeeExceptionRule{
+				{MsgTypeUrl: mintTypeURL, MaxFee: sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 99))},
+			},
+			expectedRes: false,
+		},
+		{
+			name: "fee denom not in rule cap",
+			tx:   newTx(mintMsg),
+			fee:  sdk.NewCoins(sdk.NewInt64Coin("xxx", 1)),
+			rules: []types.TxFeeExceptionRule{
+				{MsgTypeUrl: mintTypeURL, MaxFee: atoneFee},
+			},
+			expectedRes: false,
+		},
+		{
+			name: "only one msg matches",
+			tx:   newTx(mintMsg, &types.MsgUpdateParams{Authority: addr1.String()}),
+			fee:  atoneFee,
+			rules: []types.TxFeeExceptionRule{
+				{MsgTypeUrl: mintTypeURL},
+			},
+			expectedRes: false,
+		},
+		{
+			name:        "authz exec not matched by rule without match_wrapped",
+			tx:          newTx(&execMsg),
+			fee:         atoneFee,
+			rules:       []types.TxFeeExceptionRule{{MsgTypeUrl: mintTypeURL}},
+			expectedRes: false,
+		},
+		{
+			name:        "authz exec matched by rule with match_wrapped",
+			tx:          newTx(&execMsg),
+			fee:         atoneFee,
+			rules:       []types.TxFeeExceptionRule{{MsgTypeUrl: mintTypeURL, MatchWrapped: true}},
+			expectedRes: true,
+		},
+		{
+			name:        "submit proposal matched by rule with match_wrapped",
+			tx:          newTx(proposalMsg),
+			fee:         atoneFee,
+			rules:       []types.TxFeeExceptionRule{{MsgTypeUrl: mintTypeURL, MatchWrapped: true}},
+			expectedRes: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := ante.AllowsTxFeeByRules(tt.tx(), tt.fee, tt.rules)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRes, res)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package ante
 
 import (
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	photontypes "github.com/atomone-hub/atomone/x/photon/types"
@@ -9,4 +11,14 @@ import (
 // PhotonKeeper defines the expected photon keeper.
 type PhotonKeeper interface {
 	GetParams(ctx sdk.Context) photontypes.Params
+	MintPhotonForFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coin) (sdk.Coin, error)
+	TrackZeroFeeRelayerGas(ctx sdk.Context, params photontypes.Params, gas uint64) error
+}
+
+// ChannelKeeper defines the expected IBC channel keeper.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+	GetNextSequenceRecv(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetPacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) (string, bool)
+	GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte
 }
@@ -9,6 +9,7 @@ import (
 
 	types "github.com/atomone-hub/atomone/x/photon/types"
 	types0 "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -48,3 +49,114 @@ func (mr *MockPhotonKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockPhotonKeeper)(nil).GetParams), ctx)
 }
+
+// MintPhotonForFee mocks base method.
+func (m *MockPhotonKeeper) MintPhotonForFee(ctx types0.Context, payer types0.AccAddress, fee types0.Coin) (types0.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MintPhotonForFee", ctx, payer, fee)
+	ret0, _ := ret[0].(types0.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MintPhotonForFee indicates an expected call of MintPhotonForFee.
+func (mr *MockPhotonKeeperMockRecorder) MintPhotonForFee(ctx, payer, fee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintPhotonForFee", reflect.TypeOf((*MockPhotonKeeper)(nil).MintPhotonForFee), ctx, payer, fee)
+}
+
+// TrackZeroFeeRelayerGas mocks base method.
+func (m *MockPhotonKeeper) TrackZeroFeeRelayerGas(ctx types0.Context, params types.Params, gas uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrackZeroFeeRelayerGas", ctx, params, gas)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrackZeroFeeRelayerGas indicates an expected call of TrackZeroFeeRelayerGas.
+func (mr *MockPhotonKeeperMockRecorder) TrackZeroFeeRelayerGas(ctx, params, gas interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackZeroFeeRelayerGas", reflect.TypeOf((*MockPhotonKeeper)(nil).TrackZeroFeeRelayerGas), ctx, params, gas)
+}
+
+// MockChannelKeeper is a mock of ChannelKeeper interface.
+type MockChannelKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockChannelKeeperMockRecorder
+}
+
+// MockChannelKeeperMockRecorder is the mock recorder for MockChannelKeeper.
+type MockChannelKeeperMockRecorder struct {
+	mock *MockChannelKeeper
+}
+
+// NewMockChannelKeeper creates a new mock instance.
+func NewMockChannelKeeper(ctrl *gomock.Controller) *MockChannelKeeper {
+	mock := &MockChannelKeeper{ctrl: ctrl}
+	mock.recorder = &MockChannelKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChannelKeeper) EXPECT() *MockChannelKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetChannel mocks base method.
+func (m *MockChannelKeeper) GetChannel(ctx types0.Context, portID, channelID string) (types1.Channel, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannel", ctx, portID, channelID)
+	ret0, _ := ret[0].(types1.Channel)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetChannel indicates an expected call of GetChannel.
+func (mr *MockChannelKeeperMockRecorder) GetChannel(ctx, portID, channelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannel", reflect.TypeOf((*MockChannelKeeper)(nil).GetChannel), ctx, portID, channelID)
+}
+
+// GetNextSequenceRecv mocks base method.
+func (m *MockChannelKeeper) GetNextSequenceRecv(ctx types0.Context, portID, channelID string) (uint64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextSequenceRecv", ctx, portID, channelID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetNextSequenceRecv indicates an expected call of GetNextSequenceRecv.
+func (mr *MockChannelKeeperMockRecorder) GetNextSequenceRecv(ctx, portID, channelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextSequenceRecv", reflect.TypeOf((*MockChannelKeeper)(nil).GetNextSequenceRecv), ctx, portID, channelID)
+}
+
+// GetPacketCommitment mocks base method.
+func (m *MockChannelKeeper) GetPacketCommitment(ctx types0.Context, portID, channelID string, sequence uint64) []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPacketCommitment", ctx, portID, channelID, sequence)
+	ret0, _ := ret[0].([]byte)
+	return ret0
+}
+
+// GetPacketCommitment indicates an expected call of GetPacketCommitment.
+func (mr *MockChannelKeeperMockRecorder) GetPacketCommitment(ctx, portID, channelID, sequence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPacketCommitment", reflect.TypeOf((*MockChannelKeeper)(nil).GetPacketCommitment), ctx, portID, channelID, sequence)
+}
+
+// GetPacketReceipt mocks base method.
+func (m *MockChannelKeeper) GetPacketReceipt(ctx types0.Context, portID, channelID string, sequence uint64) (string, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPacketReceipt", ctx, portID, channelID, sequence)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetPacketReceipt indicates an expected call of GetPacketReceipt.
+func (mr *MockChannelKeeperMockRecorder) GetPacketReceipt(ctx, portID, channelID, sequence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPacketReceipt", reflect.TypeOf((*MockChannelKeeper)(nil).GetPacketReceipt), ctx, portID, channelID, sequence)
+}
@@ -0,0 +1,151 @@
+package ante
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+var _ sdk.AnteDecorator = MintOnFeeDecorator{}
+
+// MintOnFeeDecorator converts the bond denom fee of the txs that declare the
+// ExtensionOptionMintOnFee extension option into photon.
+type MintOnFeeDecorator struct {
+	k PhotonKeeper
+}
+
+func NewMintOnFeeDecorator(k PhotonKeeper) MintOnFeeDecorator {
+	return MintOnFeeDecorator{k: k}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+// If the tx declares the ExtensionOptionMintOnFee extension option, the bond
+// denom fee is burned from the fee payer, the equivalent amount of photon is
+// minted into the fee payer, and the next decorators see the minted photon as
+// the tx fee. Since ante handler state changes are discarded on failure, the
+// burn and mint are reverted if the tx is rejected later in the ante chain.
+// It must be placed before the decorators that validate and deduct the fee.
+func (mfd MintOnFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !HasMintOnFeeExtensionOption(tx) {
+		return next(ctx, tx, simulate)
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	feeCoins := feeTx.GetFee()
+	if feeCoins.IsZero() {
+		// Skip if no fees
+		return next(ctx, tx, simulate)
+	}
+	if len(feeCoins) > 1 {
+		return ctx, types.ErrTooManyFeeCoins
+	}
+	if granter := feeTx.FeeGranter(); granter != nil && !sdk.AccAddress(granter).Equals(sdk.AccAddress(feeTx.FeePayer())) {
+		return ctx, errorsmod.Wrap(types.ErrMintOnFee, "cannot be used with a fee granter")
+	}
+
+	minted, err := mfd.k.MintPhotonForFee(ctx, feeTx.FeePayer(), feeCoins[0])
+	if err != nil {
+		return ctx, err
+	}
+	return next(ctx, mintOnFeeTx{FeeTx: feeTx, fee: sdk.NewCoins(minted)}, simulate)
+}
+
+// mintOnFeeTx overrides the fee of a FeeTx with the photon minted by
+// MintOnFeeDecorator. It forwards the optional interfaces of the original tx
+// that the next decorators assert, so that they see the same tx apart from
+// its fee.
+type mintOnFeeTx struct {
+	sdk.FeeTx
+	fee sdk.Coins
+}
+
+var (
+	_ authsigning.SigVerifiableTx = mintOnFeeTx{}
+	_ ante.HasExtensionOptionsTx  = mintOnFeeTx{}
+	_ sdk.TxWithMemo              = mintOnFeeTx{}
+)
+
+func (tx mintOnFeeTx) GetFee() sdk.Coins {
+	return tx.fee
+}
+
+func (tx mintOnFeeTx) GetSigners() ([][]byte, error) {
+	sigTx, ok := tx.FeeTx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a SigVerifiableTx")
+	}
+	return sigTx.GetSigners()
+}
+
+func (tx mintOnFeeTx) GetPubKeys() ([]cryptotypes.PubKey, error) {
+	sigTx, ok := tx.FeeTx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a SigVerifiableTx")
+	}
+	return sigTx.GetPubKeys()
+}
+
+func (tx mintOnFeeTx) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	sigTx, ok := tx.FeeTx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a SigVerifiableTx")
+	}
+	return sigTx.GetSignaturesV2()
+}
+
+func (tx mintOnFeeTx) GetExtensionOptions() []*codectypes.Any {
+	if extTx, ok := tx.FeeTx.(ante.HasExtensionOptionsTx); ok {
+		return extTx.GetExtensionOptions()
+	}
+	return nil
+}
+
+func (tx mintOnFeeTx) GetNonCriticalExtensionOptions() []*codectypes.Any {
+	if extTx, ok := tx.FeeTx.(ante.HasExtensionOptionsTx); ok {
+		return extTx.GetNonCriticalExtensionOptions()
+	}
+	return nil
+}
+
+func (tx mintOnFeeTx) GetMemo() string {
+	if memoTx, ok := tx.FeeTx.(sdk.TxWithMemo); ok {
+		return memoTx.GetMemo()
+	}
+	return ""
+}
+
+// HasMintOnFeeExtensionOption returns true if tx declares the
+// ExtensionOptionMintOnFee extension option.
+func HasMintOnFeeExtensionOption(tx sdk.Tx) bool {
+	extTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return false
+	}
+	for _, opt := range extTx.GetExtensionOptions() {
+		if opt.GetTypeUrl() == mintOnFeeTypeURL {
+			return true
+		}
+	}
+	return false
+}
+
+var mintOnFeeTypeURL = "/" + proto.MessageName(&types.ExtensionOptionMintOnFee{})
+
+// ExtensionOptionChecker accepts the ExtensionOptionMintOnFee extension
+// option, and rejects any other extension option. It is intended to be used
+// as the ante.ExtensionOptionChecker of the ante.ExtensionOptionsDecorator.
+func ExtensionOptionChecker(opt *codectypes.Any) bool {
+	return opt.GetTypeUrl() == mintOnFeeTypeURL
+}
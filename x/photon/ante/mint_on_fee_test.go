@@ -0,0 +1,170 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	appparams "github.com/atomone-hub/atomone/app/params"
+	"github.com/atomone-hub/atomone/x/photon/ante"
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestMintOnFeeDecorator(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	var (
+		payer       = sdk.AccAddress("test1")
+		granter     = sdk.AccAddress("test2")
+		atoneFee    = sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 10))
+		photonFee   = sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 92))
+		mintOnFee   = &types.ExtensionOptionMintOnFee{}
+		msg         = &types.MsgMintPhoton{ToAddress: payer.String()}
+		newTxWithOp = func(fee sdk.Coins, granter sdk.AccAddress, opts ...*codectypes.Any) func() sdk.Tx {
+			return func() sdk.Tx {
+				txBuilder := encCfg.TxConfig.NewTxBuilder()
+				require.NoError(t, txBuilder.SetMsgs(msg))
+				txBuilder.SetFeeAmount(fee)
+				txBuilder.SetFeeGranter(granter)
+				txBuilder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(opts...)
+				return txBuilder.GetTx()
+			}
+		}
+	)
+	mintOnFeeAny, err := codectypes.NewAnyWithValue(mintOnFee)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		tx            func() sdk.Tx
+		setup         func(mocks)
+		expectedFee   sdk.Coins
+		expectedError string
+	}{
+		{
+			name:        "ok: no extension option",
+			tx:          newTxWithOp(atoneFee, nil),
+			expectedFee: atoneFee,
+		},
+		{
+			name:        "ok: no fee",
+			tx:          newTxWithOp(nil, nil, mintOnFeeAny),
+			expectedFee: nil,
+		},
+		{
+			name:          "fail: multiple fee coins",
+			tx:            newTxWithOp(atoneFee.Add(sdk.NewInt64Coin("xxx", 1)), nil, mintOnFeeAny),
+			expectedError: "too many fee coins, only accepts fees in one denom",
+		},
+		{
+			name:          "fail: fee granter",
+			tx:            newTxWithOp(atoneFee, granter, mintOnFeeAny),
+			expectedError: "cannot be used with a fee granter: photon mint-on-fee failed",
+		},
+		{
+			name: "fail: mint error",
+			tx:   newTxWithOp(atoneFee, nil, mintOnFeeAny),
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().MintPhotonForFee(m.ctx, payer, atoneFee[0]).
+					Return(sdk.Coin{}, errors.New("oops"))
+			},
+			expectedError: "oops",
+		},
+		{
+			name: "ok: fee converted",
+			tx:   newTxWithOp(atoneFee, nil, mintOnFeeAny),
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().MintPhotonForFee(m.ctx, payer, atoneFee[0]).
+					Return(photonFee[0], nil)
+			},
+			expectedFee: photonFee,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				m       = setupMocks(t)
+				nextFee sdk.Coins
+				next    = func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+					nextFee = tx.(sdk.FeeTx).GetFee()
+					return ctx, nil
+				}
+			)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			mfd := ante.NewMintOnFeeDecorator(m.PhotonKeeper)
+			_, err := mfd.AnteHandle(m.ctx, tt.tx(), false, next)
+
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedFee.String(), nextFee.String())
+		})
+	}
+}
+
+func TestMintOnFeeDecoratorWithSignerRule(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	var (
+		payer     = sdk.AccAddress("test1")
+		atoneFee  = sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 10))
+		photonFee = sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 92))
+		m         = setupMocks(t)
+		params    = types.DefaultParams()
+	)
+	// the signer-restricted rule is checked instead of the default exceptions
+	params.TxFeeExceptions = nil
+	params.TxFeeExceptionRules = []types.TxFeeExceptionRule{
+		{MsgTypeUrl: sdk.MsgTypeURL(&types.MsgMintPhoton{}), Signers: []string{payer.String()}},
+	}
+	mintOnFeeAny, err := codectypes.NewAnyWithValue(&types.ExtensionOptionMintOnFee{})
+	require.NoError(t, err)
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(&types.MsgMintPhoton{ToAddress: payer.String()}))
+	txBuilder.SetFeeAmount(atoneFee)
+	txBuilder.SetMemo("memo")
+	txBuilder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(mintOnFeeAny)
+	m.PhotonKeeper.EXPECT().MintPhotonForFee(m.ctx, payer, atoneFee[0]).Return(photonFee[0], nil)
+	m.PhotonKeeper.EXPECT().GetParams(m.ctx).Return(params)
+
+	var nextTx sdk.Tx
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewMintOnFeeDecorator(m.PhotonKeeper),
+		ante.NewValidateFeeDecorator(m.PhotonKeeper),
+		anteDecoratorFunc(func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+			nextTx = tx
+			return next(ctx, tx, simulate)
+		}),
+	)
+	_, err = anteHandler(m.ctx, txBuilder.GetTx(), false)
+
+	require.NoError(t, err)
+	require.Equal(t, photonFee.String(), nextTx.(sdk.FeeTx).GetFee().String())
+	signers, err := nextTx.(authsigning.SigVerifiableTx).GetSigners()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{payer}, signers)
+	require.Equal(t, "memo", nextTx.(sdk.TxWithMemo).GetMemo())
+	require.True(t, ante.HasMintOnFeeExtensionOption(nextTx))
+}
+
+func TestExtensionOptionChecker(t *testing.T) {
+	mintOnFeeAny, err := codectypes.NewAnyWithValue(&types.ExtensionOptionMintOnFee{})
+	require.NoError(t, err)
+	otherAny, err := codectypes.NewAnyWithValue(&types.MsgMintPhoton{})
+	require.NoError(t, err)
+
+	require.True(t, ante.ExtensionOptionChecker(mintOnFeeAny))
+	require.False(t, ante.ExtensionOptionChecker(otherAny))
+}
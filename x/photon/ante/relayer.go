@@ -0,0 +1,119 @@
+package ante
+
+import (
+	"slices"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+var _ sdk.AnteDecorator = RelayerZeroFeeDecorator{}
+
+// RelayerZeroFeeDecorator wraps the fee decorator, which checks and deducts
+// the tx fee, and skips it for the zero-fee relayer txs when the relayer fee
+// policy is RelayerFeePolicyZeroFeeIfNotRedundant.
+type RelayerZeroFeeDecorator struct {
+	k             PhotonKeeper
+	channelKeeper ChannelKeeper
+	feeDecorator  sdk.AnteDecorator
+}
+
+func NewRelayerZeroFeeDecorator(k PhotonKeeper, channelKeeper ChannelKeeper, feeDecorator sdk.AnteDecorator) RelayerZeroFeeDecorator {
+	return RelayerZeroFeeDecorator{k: k, channelKeeper: channelKeeper, feeDecorator: feeDecorator}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+// A zero-fee relayer tx skips the fee decorator only if it carries at least
+// one non-redundant packet message, and if its gas limit fits in the
+// MaxZeroFeeRelayerGasPerBlock limit of the current block. Otherwise it is
+// rejected, e.g. when it only contains MsgUpdateClient messages. Unlike the
+// IBC RedundantRelayDecorator, which only runs in CheckTx, these checks also
+// run in DeliverTx, so that the zero-fee txs can't be used to fill the blocks
+// for free.
+func (rzd RelayerZeroFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	if !feeTx.GetFee().IsZero() || !IsRelayerTx(tx) {
+		return rzd.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+	params := rzd.k.GetParams(ctx)
+	if params.RelayerFeePolicy != types.RelayerFeePolicyZeroFeeIfNotRedundant {
+		return rzd.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+	if !hasNonRedundantPacketMsg(ctx, rzd.channelKeeper, tx) {
+		return ctx, errorsmod.Wrap(types.ErrRelayerZeroFee, "no non-redundant packet message")
+	}
+	if err := rzd.k.TrackZeroFeeRelayerGas(ctx, params, feeTx.GetGas()); err != nil {
+		return ctx, err
+	}
+	// Skip the fee decorator for zero-fee relayer txs
+	return next(ctx, tx, simulate)
+}
+
+// hasNonRedundantPacketMsg returns true if tx contains at least one packet
+// message that has not been relayed yet: a MsgRecvPacket of a packet that has
+// not been received, or a MsgAcknowledgement or a MsgTimeout of a packet whose
+// commitment has not been deleted.
+func hasNonRedundantPacketMsg(ctx sdk.Context, k ChannelKeeper, tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case *channeltypes.MsgRecvPacket:
+			if !packetReceived(ctx, k, msg.Packet) {
+				return true
+			}
+		case *channeltypes.MsgAcknowledgement:
+			if packetCommitted(ctx, k, msg.Packet) {
+				return true
+			}
+		case *channeltypes.MsgTimeout:
+			if packetCommitted(ctx, k, msg.Packet) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// packetReceived returns true if packet has already been received, or if its
+// destination channel doesn't exist.
+func packetReceived(ctx sdk.Context, k ChannelKeeper, packet channeltypes.Packet) bool {
+	channel, found := k.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		return true
+	}
+	if channel.Ordering == channeltypes.ORDERED {
+		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.DestinationPort, packet.DestinationChannel)
+		return !found || packet.Sequence < nextSequenceRecv
+	}
+	_, found = k.GetPacketReceipt(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	return found
+}
+
+// packetCommitted returns true if the commitment of the sent packet still
+// exists, i.e. the packet has been neither acknowledged nor timed out.
+func packetCommitted(ctx sdk.Context, k ChannelKeeper, packet channeltypes.Packet) bool {
+	return len(k.GetPacketCommitment(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)) > 0
+}
+
+// IsRelayerTx returns true if tx only contains IBC relayer messages, as listed
+// in [types.RelayerMsgTypeURLs].
+func IsRelayerTx(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if !slices.Contains(types.RelayerMsgTypeURLs, sdk.MsgTypeURL(msg)) {
+			return false
+		}
+	}
+	return true
+}
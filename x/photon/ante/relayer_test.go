@@ -0,0 +1,213 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	"github.com/atomone-hub/atomone/x/photon/ante"
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestRelayerMsgTypeURLs(t *testing.T) {
+	require.Equal(t, []string{
+		sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{}),
+		sdk.MsgTypeURL(&channeltypes.MsgAcknowledgement{}),
+		sdk.MsgTypeURL(&channeltypes.MsgTimeout{}),
+		sdk.MsgTypeURL(&clienttypes.MsgUpdateClient{}),
+	}, types.RelayerMsgTypeURLs)
+}
+
+func TestRelayerZeroFeeDecorator(t *testing.T) {
+	txConfig := authtx.NewTxConfig(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		[]signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT},
+	)
+	newTx := func(fee sdk.Coins, msgs ...sdk.Msg) func() sdk.Tx {
+		return func() sdk.Tx {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(msgs...))
+			txBuilder.SetFeeAmount(fee)
+			txBuilder.SetGasLimit(100_000)
+			return txBuilder.GetTx()
+		}
+	}
+	paramsWithPolicy := func(policy types.RelayerFeePolicy) types.Params {
+		params := types.DefaultParams()
+		params.RelayerFeePolicy = policy
+		return params
+	}
+	zeroFeeParams := paramsWithPolicy(types.RelayerFeePolicyZeroFeeIfNotRedundant)
+	packet := channeltypes.Packet{
+		Sequence:           3,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-1",
+	}
+	expectChannel := func(m mocks, ordering channeltypes.Order) {
+		m.ChannelKeeper.EXPECT().GetChannel(m.ctx, packet.DestinationPort, packet.DestinationChannel).
+			Return(channeltypes.Channel{Ordering: ordering}, true)
+	}
+
+	tests := []struct {
+		name                   string
+		tx                     func() sdk.Tx
+		setup                  func(mocks)
+		expectFeeDecoratorCall bool
+		expectedError          string
+	}{
+		{
+			name: "zero-fee relayer tx with zero-fee policy: fee decorator skipped",
+			tx:   newTx(nil, &clienttypes.MsgUpdateClient{}, &channeltypes.MsgRecvPacket{Packet: packet}),
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).Return(zeroFeeParams)
+				expectChannel(m, channeltypes.UNORDERED)
+				m.ChannelKeeper.EXPECT().GetPacketReceipt(m.ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence).
+					Return("", false)
+				m.PhotonKeeper.EXPECT().TrackZeroFeeRelayerGas(m.ctx, zeroFeeParams, uint64(100_000)).Return(nil)
+			},
+			expectFeeDecoratorCall: false,
+		},
+		{
+			name: "zero-fee relayer tx with a non-redundant packet on an ordered channel",
+			tx:   newTx(nil, &channeltypes.MsgRecvPacket{Packet: packet}),
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).Return(zeroFeeParams)
+				expectChannel(m, channeltypes.ORDERED)
+				m.ChannelKeeper.EXPECT().GetNextSequenceRecv(m.ctx, packet.DestinationPort, packet.DestinationChannel).
+					Return(packet.Sequence, true)
+				m.PhotonKeeper.EXPECT().TrackZeroFeeRelayerGas(m.ctx, zeroFeeParams, uint64(100_000)).Return(nil)
+			},
+			expectFeeDecoratorCall: false,
+		},
+		{
+			name: "zero-fee relayer tx with a redundant packet followed by a non-redundant acknowledgement",
+			tx:   newTx(nil, &channeltypes.MsgRecvPacket{Packet: packet}, &channeltypes.MsgAcknowledgement{Packet: packet}),
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).Return(zeroFeeParams)
+				expectChannel(m, channeltypes.UNORDERED)
+				m.ChannelKeeper.EXPECT().GetPacketReceipt(m.ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence).
+					Return("", true)
+				m.ChannelKeeper.EXPECT().GetPacketCommitment(m.ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence).
+					Return([]byte("commitment"))
+				m.PhotonKeeper.EXPECT().TrackZeroFeeRelayerGas(m.ctx, zeroFeeParams, uint64(100_000)).Return(nil)
+			},
+			expectFeeDecoratorCall: false,
+		},
+		{
+			name: "zero-fee relayer tx with only MsgUpdateClient: rejected",
+			tx:   newTx(nil, &clienttypes.MsgUpdateClient{}),
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).Return(zeroFeeParams)
+			},
+			expectedError: "no non-redundant packet message: zero-fee relayer tx not allowed",
+		},
+		{
+			name: "zero-fee relayer tx with redundant packets: rejected",
+			tx: newTx(nil, &clienttypes.MsgUpdateClient{}, &channeltypes.MsgRecvPacket{Packet: packet},
+				&channeltypes.MsgTimeout{Packet: packet}),
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).Return(zeroFeeParams)
+				expectChannel(m, channeltypes.ORDERED)
+				m.ChannelKeeper.EXPECT().GetNextSequenceRecv(m.ctx, packet.DestinationPort, packet.DestinationChannel).
+					Return(packet.Sequence+1, true)
+				m.ChannelKeeper.EXPECT().GetPacketCommitment(m.ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence).
+					Return(nil)
+			},
+			expectedError: "no non-redundant packet message: zero-fee relayer tx not allowed",
+		},
+		{
+			name: "zero-fee relayer tx on an unknown channel: rejected",
+			tx:   newTx(nil, &channeltypes.MsgRecvPacket{Packet: packet}),
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).Return(zeroFeeParams)
+				m.ChannelKeeper.EXPECT().GetChannel(m.ctx, packet.DestinationPort, packet.DestinationChannel).
+					Return(channeltypes.Channel{}, false)
+			},
+			expectedError: "no non-redundant packet message: zero-fee relayer tx not allowed",
+		},
+		{
+			name: "zero-fee relayer tx exceeding the block gas limit: rejected",
+			tx:   newTx(nil, &channeltypes.MsgTimeout{Packet: packet}),
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).Return(zeroFeeParams)
+				m.ChannelKeeper.EXPECT().GetPacketCommitment(m.ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence).
+					Return([]byte("commitment"))
+				m.PhotonKeeper.EXPECT().TrackZeroFeeRelayerGas(m.ctx, zeroFeeParams, uint64(100_000)).
+					Return(errorsmod.Wrapf(types.ErrRelayerZeroFee, "block gas limit of %d exceeded", 10))
+			},
+			expectedError: "block gas limit of 10 exceeded: zero-fee relayer tx not allowed",
+		},
+		{
+			name: "zero-fee relayer tx with photon only policy",
+			tx:   newTx(nil, &channeltypes.MsgAcknowledgement{}),
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).
+					Return(paramsWithPolicy(types.RelayerFeePolicyPhotonOnly))
+			},
+			expectFeeDecoratorCall: true,
+		},
+		{
+			name:                   "relayer tx with fee",
+			tx:                     newTx(sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 1)), &channeltypes.MsgTimeout{}),
+			expectFeeDecoratorCall: true,
+		},
+		{
+			name:                   "zero-fee non relayer tx",
+			tx:                     newTx(nil, &channeltypes.MsgRecvPacket{}, &types.MsgMintPhoton{}),
+			expectFeeDecoratorCall: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				m                  = setupMocks(t)
+				feeDecoratorCalled bool
+				nextInvoked        bool
+				next               = func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+					nextInvoked = true
+					return ctx, nil
+				}
+				feeDecorator = sdk.AnteDecorator(anteDecoratorFunc(
+					func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+						feeDecoratorCalled = true
+						return next(ctx, tx, simulate)
+					},
+				))
+			)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			rzd := ante.NewRelayerZeroFeeDecorator(m.PhotonKeeper, m.ChannelKeeper, feeDecorator)
+			_, err := rzd.AnteHandle(m.ctx, tt.tx(), false, next)
+
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				require.False(t, nextInvoked, "next is invoked")
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, nextInvoked, "next is not invoked")
+			require.Equal(t, tt.expectFeeDecoratorCall, feeDecoratorCalled)
+		})
+	}
+}
+
+type anteDecoratorFunc func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error)
+
+func (f anteDecoratorFunc) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return f(ctx, tx, simulate, next)
+}
@@ -2,12 +2,16 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 
+	appparams "github.com/atomone-hub/atomone/app/params"
 	"github.com/atomone-hub/atomone/x/photon/types"
 )
 
@@ -25,6 +29,14 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(
 		GetQueryParamsCmd(),
 		GetQueryConversionRateCmd(),
+		GetQueryMintQuoteCmd(),
+		GetQueryMintTotalsCmd(),
+		GetQueryMintStatsCmd(),
+		GetQueryConversionRateHistoryCmd(),
+		GetQueryInvariantsCmd(),
+		GetQueryRelayerFeePolicyCmd(),
+		GetQueryBuybackSchedulesCmd(),
+		GetQueryBuybackScheduleCmd(),
 	)
 	return cmd
 }
@@ -72,3 +84,223 @@ func GetQueryConversionRateCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetQueryMintQuoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-quote [amount]",
+		Short: "shows the outcome of minting photons for the given amount",
+		Long: `Shows the outcome of minting photons for the given [amount].
+If [amount] is in the bond denomination, shows the amount of photons minted by
+burning it. If [amount] is in uphoton, shows the minimum amount of bond
+denomination to burn to mint at least that amount.`,
+		Example: fmt.Sprintf(`%s query photon mint-quote 1000000uatone
+%s query photon mint-quote 1000000uphoton`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			req := &types.QueryMintQuoteRequest{}
+			switch amount.Denom {
+			case types.Denom:
+				req.MintAmount = amount.Amount.String()
+			case appparams.BondDenom:
+				req.BurnAmount = amount.Amount.String()
+			default:
+				return fmt.Errorf("invalid denom %s: expected %s or %s", amount.Denom, appparams.BondDenom, types.Denom)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MintQuote(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryMintTotalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-totals [address]",
+		Short: "shows the amounts of photons minted in the current block and epoch",
+		Long: `Shows the amounts of photons minted in the current block and epoch, which
+are subject to the mint limits. If [address] is provided, also shows the amount
+of photons minted by [address] in the current epoch.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryMintTotalsRequest{}
+			if len(args) > 0 {
+				req.Address = args[0]
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MintTotals(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryMintStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-stats",
+		Short: "shows the cumulative amounts of burned atone and minted photons",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MintStats(cmd.Context(), &types.QueryMintStatsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryConversionRateHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversion-rate-history",
+		Short: "shows the historical snapshots of the conversion rate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ConversionRateHistory(cmd.Context(), &types.QueryConversionRateHistoryRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "conversion-rate-history")
+	return cmd
+}
+
+func GetQueryInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants",
+		Short: "runs the photon module invariants and shows their results",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Invariants(cmd.Context(), &types.QueryInvariantsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryRelayerFeePolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer-fee-policy",
+		Short: "shows the fee policy applied to IBC relayer transactions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RelayerFeePolicy(cmd.Context(), &types.QueryRelayerFeePolicyRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryBuybackSchedulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buyback-schedules",
+		Short: "shows the active community pool buyback schedules",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BuybackSchedules(cmd.Context(), &types.QueryBuybackSchedulesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "buyback-schedules")
+	return cmd
+}
+
+func GetQueryBuybackScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buyback-schedule [id]",
+		Short: "shows a community pool buyback schedule",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid buyback schedule id %s: %w", args[0], err)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BuybackSchedule(cmd.Context(), &types.QueryBuybackScheduleRequest{Id: id})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
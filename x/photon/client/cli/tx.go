@@ -2,9 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -14,6 +17,12 @@ import (
 	"github.com/atomone-hub/atomone/x/photon/types"
 )
 
+const (
+	FlagMinPhotonOut = "min-photon-out"
+	FlagExpiryHeight = "expiry-height"
+	FlagExpiryTime   = "expiry-time"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -33,7 +42,12 @@ func GetTxMintPhotonCmd() *cobra.Command {
 		Short: "Broadcast MintPhoton message which burns [amount] and mint photons.",
 		Long: `Mint photons by burning the specified [amount].
 The amount to burn must be specified in the bond denomination.
-Note, the '--from' flag is ignored as it is implied from [to_key_or_address].`,
+Note, the '--from' flag is ignored as it is implied from [to_key_or_address].
+
+Use --min-photon-out to make the transaction fail if the conversion rate at
+execution time yields less than the given amount of uphoton, and
+--expiry-height or --expiry-time (RFC3339) to make it fail if it is executed
+after the given block height or block time.`,
 		Example: fmt.Sprintf(`%s tx photon mint atom1... 1000000uatone`, version.AppName),
 		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -53,12 +67,41 @@ Note, the '--from' flag is ignored as it is implied from [to_key_or_address].`,
 				clientCtx.GetFromAddress(),
 				toBurn,
 			)
+			minPhotonOut, err := cmd.Flags().GetString(FlagMinPhotonOut)
+			if err != nil {
+				return err
+			}
+			if minPhotonOut != "" {
+				amount, ok := math.NewIntFromString(minPhotonOut)
+				if !ok {
+					return fmt.Errorf("invalid %s: %s", FlagMinPhotonOut, minPhotonOut)
+				}
+				msg.MinPhotonOut = amount
+			}
+			msg.ExpiryHeight, err = cmd.Flags().GetInt64(FlagExpiryHeight)
+			if err != nil {
+				return err
+			}
+			expiryTime, err := cmd.Flags().GetString(FlagExpiryTime)
+			if err != nil {
+				return err
+			}
+			if expiryTime != "" {
+				t, err := time.Parse(time.RFC3339, expiryTime)
+				if err != nil {
+					return fmt.Errorf("invalid %s: %w", FlagExpiryTime, err)
+				}
+				msg.ExpiryTime = &t
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(FlagMinPhotonOut, "", "Minimum amount of uphoton to mint, otherwise the transaction fails")
+	cmd.Flags().Int64(FlagExpiryHeight, 0, "Block height after which the transaction fails")
+	cmd.Flags().String(FlagExpiryTime, "", "Block time (RFC3339) after which the transaction fails")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -14,11 +14,36 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(fmt.Sprintf("%s module params has not been set", types.ModuleName))
 	}
+	if genState.MintStats != nil {
+		k.SetMintStats(ctx, *genState.MintStats)
+	} else {
+		k.SetMintStats(ctx, k.NewMintStats(ctx))
+	}
+	for _, snapshot := range genState.ConversionRateSnapshots {
+		k.SetConversionRateSnapshot(ctx, snapshot)
+	}
+	for _, schedule := range genState.BuybackSchedules {
+		k.SetBuybackSchedule(ctx, schedule)
+	}
+	if genState.NextBuybackScheduleId > 0 {
+		k.SetNextBuybackScheduleID(ctx, genState.NextBuybackScheduleId)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	mintStats := k.GetMintStats(ctx)
+	genesis.MintStats = &mintStats
+	k.IterateConversionRateSnapshots(ctx, func(snapshot types.ConversionRateSnapshot) bool {
+		genesis.ConversionRateSnapshots = append(genesis.ConversionRateSnapshots, snapshot)
+		return false
+	})
+	k.IterateBuybackSchedules(ctx, func(schedule types.BuybackSchedule) bool {
+		genesis.BuybackSchedules = append(genesis.BuybackSchedules, schedule)
+		return false
+	})
+	genesis.NextBuybackScheduleId = k.GetNextBuybackScheduleID(ctx)
 	return genesis
 }
@@ -2,9 +2,14 @@ package photon_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/atomone-hub/atomone/x/photon"
 	"github.com/atomone-hub/atomone/x/photon/testutil"
 	"github.com/atomone-hub/atomone/x/photon/types"
@@ -13,6 +18,32 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		MintStats: &types.MintStats{
+			TotalBurned: math.NewInt(1_000),
+			TotalMinted: math.NewInt(9_999),
+			MintCount:   2,
+		},
+		ConversionRateSnapshots: []types.ConversionRateSnapshot{
+			{
+				Height:         600,
+				Time:           time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				ConversionRate: "9.999000000000000000",
+			},
+		},
+		BuybackSchedules: []types.BuybackSchedule{
+			{
+				Id:                 2,
+				EpochIdentifier:    "week",
+				EpochInterval:      4,
+				Fraction:           math.LegacyNewDecWithPrec(1, 1),
+				Amount:             math.ZeroInt(),
+				StartEpoch:         3,
+				LastExecutionEpoch: 7,
+				TotalBurned:        math.NewInt(100),
+				TotalMinted:        math.NewInt(999),
+			},
+		},
+		NextBuybackScheduleId: 3,
 	}
 	k, _, ctx := testutil.SetupPhotonKeeper(t)
 
@@ -20,5 +51,31 @@ func TestGenesis(t *testing.T) {
 	got := photon.ExportGenesis(ctx, *k)
 
 	require.NotNil(t, got)
-	require.Equal(t, genesisState, *got)
+	require.Equal(t, genesisState.Params, got.Params)
+	require.Equal(t, genesisState.MintStats.String(), got.MintStats.String())
+	require.Equal(t, genesisState.ConversionRateSnapshots, got.ConversionRateSnapshots)
+	require.Len(t, got.BuybackSchedules, 1)
+	require.Equal(t, genesisState.BuybackSchedules[0].String(), got.BuybackSchedules[0].String())
+	require.Equal(t, genesisState.NextBuybackScheduleId, got.NextBuybackScheduleId)
+}
+
+func TestGenesisMintStatsMissing(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+	}
+	k, m, ctx := testutil.SetupPhotonKeeper(t)
+	ctx = ctx.WithBlockHeight(42)
+	m.BankKeeper.EXPECT().GetSupply(ctx, types.Denom).Return(sdk.NewInt64Coin(types.Denom, 1_000))
+
+	photon.InitGenesis(ctx, *k, genesisState)
+	got := photon.ExportGenesis(ctx, *k)
+
+	require.NotNil(t, got)
+	require.Equal(t, "0", got.MintStats.TotalBurned.String())
+	require.Equal(t, "1000", got.MintStats.TotalMinted.String())
+	require.Zero(t, got.MintStats.MintCount)
+	require.EqualValues(t, 42, got.MintStats.SinceHeight)
+	require.Empty(t, got.ConversionRateSnapshots)
+	require.Empty(t, got.BuybackSchedules)
+	require.EqualValues(t, 1, got.NextBuybackScheduleId)
 }
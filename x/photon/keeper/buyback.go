@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// GetNextBuybackScheduleID returns the id of the next created buyback
+// schedule.
+func (k Keeper) GetNextBuybackScheduleID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextBuybackScheduleIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextBuybackScheduleID sets the id of the next created buyback schedule.
+func (k Keeper) SetNextBuybackScheduleID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextBuybackScheduleIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetBuybackSchedule sets a buyback schedule.
+func (k Keeper) SetBuybackSchedule(ctx sdk.Context, schedule types.BuybackSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BuybackScheduleKey(schedule.Id), k.cdc.MustMarshal(&schedule))
+}
+
+// GetBuybackSchedule returns the buyback schedule with id, if any.
+func (k Keeper) GetBuybackSchedule(ctx sdk.Context, id uint64) (types.BuybackSchedule, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BuybackScheduleKey(id))
+	if bz == nil {
+		return types.BuybackSchedule{}, false
+	}
+	var schedule types.BuybackSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+// DeleteBuybackSchedule removes the buyback schedule with id.
+func (k Keeper) DeleteBuybackSchedule(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.BuybackScheduleKey(id))
+}
+
+// IterateBuybackSchedules iterates over the buyback schedules in ascending id
+// order, and calls cb for each of them until cb returns true.
+func (k Keeper) IterateBuybackSchedules(ctx sdk.Context, cb func(types.BuybackSchedule) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BuybackScheduleKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.BuybackSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		if cb(schedule) {
+			return
+		}
+	}
+}
+
+// isBuybackScheduleDue returns true if schedule must be executed at the end of
+// the epochNumber epoch of epochIdentifier.
+func isBuybackScheduleDue(schedule types.BuybackSchedule, epochIdentifier string, epochNumber int64) bool {
+	if schedule.EpochIdentifier != epochIdentifier || epochNumber <= schedule.StartEpoch {
+		return false
+	}
+	return uint64(epochNumber-schedule.StartEpoch)%schedule.EpochInterval == 0
+}
+
+// ExecuteDueBuybackSchedules executes the buyback schedules that are due at
+// the end of the epochNumber epoch of epochIdentifier. A failed execution
+// doesn't affect the state and is only logged, so the schedule is retried at
+// its next execution.
+func (k Keeper) ExecuteDueBuybackSchedules(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	var dueSchedules []types.BuybackSchedule
+	k.IterateBuybackSchedules(ctx, func(schedule types.BuybackSchedule) bool {
+		if isBuybackScheduleDue(schedule, epochIdentifier, epochNumber) {
+			dueSchedules = append(dueSchedules, schedule)
+		}
+		return false
+	})
+	for _, schedule := range dueSchedules {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.ExecuteBuybackSchedule(cacheCtx, schedule, epochNumber); err != nil {
+			k.Logger(ctx).Error("failed to execute buyback schedule", "id", schedule.Id, "err", err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// ExecuteBuybackSchedule converts the community pool bond denom funds into
+// photon as defined by schedule, and records the execution at epochNumber.
+// The conversion follows the same burn/mint sequence and conversion rate as
+// MsgMintPhoton, but isn't subject to the mint limits, which are meant for the
+// user mints. It does nothing if there is no bond denom to convert.
+// NOTE the v3 upgrade handler converted the community pool funds with its own
+// burn/mint helpers, which are kept as is rather than refactored onto
+// burnAndMintFunds: the v3 upgrade has already been applied, and its handler
+// must keep producing the exact same state transitions (mint before burn,
+// rounding, events).
+func (k Keeper) ExecuteBuybackSchedule(ctx sdk.Context, schedule types.BuybackSchedule, epochNumber int64) error {
+	params := k.GetParams(ctx)
+	if params.MintDisabled {
+		return types.ErrMintDisabled
+	}
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get bond denom")
+	}
+	feePool, err := k.distrKeeper.GetFeePool(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get fee pool")
+	}
+	var (
+		available = feePool.CommunityPool.AmountOf(bondDenom).TruncateInt()
+		toBurn    math.Int
+	)
+	if !schedule.Fraction.IsNil() && schedule.Fraction.IsPositive() {
+		toBurn = available.ToLegacyDec().Mul(schedule.Fraction).TruncateInt()
+	} else {
+		toBurn = math.MinInt(schedule.Amount, available)
+	}
+	if !toBurn.IsPositive() {
+		k.Logger(ctx).Info("no community pool funds to convert", "buyback_schedule", schedule.Id)
+		return nil
+	}
+	var (
+		bondDenomSupply = k.bankKeeper.GetSupply(ctx, bondDenom).Amount.ToLegacyDec()
+		uphotonSupply   = k.bankKeeper.GetSupply(ctx, types.Denom).Amount.ToLegacyDec()
+		conversionRate  = k.PhotonConversionRate(ctx, bondDenomSupply, uphotonSupply)
+		bondDenomToBurn = sdk.NewCoin(bondDenom, toBurn)
+		uphotonToMint   = mintedUphoton(toBurn, conversionRate)
+	)
+	if uphotonToMint.IsZero() {
+		return types.ErrZeroMintPhotons
+	}
+
+	minted, err := k.burnAndMintFunds(ctx, bondDenomToBurn, uphotonToMint,
+		func(coins sdk.Coins) error { return k.withdrawFromCommunityPool(ctx, coins) },
+		func(coins sdk.Coins) error { return k.depositToCommunityPool(ctx, coins) },
+	)
+	if err != nil {
+		return err
+	}
+
+	schedule.LastExecutionEpoch = epochNumber
+	schedule.TotalBurned = schedule.TotalBurned.Add(toBurn)
+	schedule.TotalMinted = schedule.TotalMinted.Add(minted.Amount)
+	k.SetBuybackSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBuyback,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyBurned, bondDenomToBurn.String()),
+			sdk.NewAttribute(types.AttributeKeyMinted, minted.String()),
+		),
+	)
+	return nil
+}
+
+// withdrawFromCommunityPool moves coins from the community pool to the photon
+// module account.
+// NOTE the community pool isn't a module account, however its coins are held
+// in the distribution module account. Thus the community pool must be reduced
+// separately from the bank transfer.
+func (k Keeper) withdrawFromCommunityPool(ctx sdk.Context, coins sdk.Coins) error {
+	feePool, err := k.distrKeeper.GetFeePool(ctx)
+	if err != nil {
+		return err
+	}
+	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(coins...))
+	if negative {
+		return distrtypes.ErrBadDistribution
+	}
+	feePool.CommunityPool = newPool
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.distrKeeper.SetFeePool(ctx, feePool)
+}
+
+// depositToCommunityPool moves coins from the photon module account to the
+// community pool.
+func (k Keeper) depositToCommunityPool(ctx sdk.Context, coins sdk.Coins) error {
+	feePool, err := k.distrKeeper.GetFeePool(ctx)
+	if err != nil {
+		return err
+	}
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(coins...)...)
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distrtypes.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.distrKeeper.SetFeePool(ctx, feePool)
+}
@@ -0,0 +1,250 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	appparams "github.com/atomone-hub/atomone/app/params"
+	"github.com/atomone-hub/atomone/x/photon/testutil"
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// mockFeePool makes the distribution keeper mock store the fee pool, starting
+// with communityPool.
+func mockFeePool(m testutil.Mocks, communityPool sdk.DecCoins) *distrtypes.FeePool {
+	feePool := &distrtypes.FeePool{CommunityPool: communityPool}
+	m.DistrKeeper.EXPECT().GetFeePool(gomock.Any()).DoAndReturn(
+		func(context.Context) (distrtypes.FeePool, error) { return *feePool, nil },
+	).AnyTimes()
+	m.DistrKeeper.EXPECT().SetFeePool(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fp distrtypes.FeePool) error { *feePool = fp; return nil },
+	).AnyTimes()
+	return feePool
+}
+
+// expectBuyback sets the mocks expectations of a buyback that burns burned
+// bond denom and mints minted uphoton.
+func expectBuyback(m testutil.Mocks, burned, minted int64) {
+	var (
+		atoneSupply int64 = 107_775_332 * 1_000_000 // From genesis
+		coinsToBurn       = sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, burned))
+		coinsToMint       = sdk.NewCoins(sdk.NewInt64Coin(types.Denom, minted))
+	)
+	m.BankKeeper.EXPECT().GetSupply(gomock.Any(), appparams.BondDenom).
+		Return(sdk.NewInt64Coin(appparams.BondDenom, atoneSupply))
+	m.BankKeeper.EXPECT().GetSupply(gomock.Any(), types.Denom).Return(sdk.NewInt64Coin(types.Denom, 0))
+	m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), distrtypes.ModuleName, types.ModuleName, coinsToBurn)
+	m.BankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, coinsToBurn)
+	m.BankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, coinsToMint)
+	m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, distrtypes.ModuleName, coinsToMint)
+}
+
+func TestExecuteBuybackSchedule(t *testing.T) {
+	var (
+		communityPool = sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(appparams.BondDenom, sdkmath.LegacyMustNewDecFromStr("1000.5")),
+			sdk.NewInt64DecCoin("xxx", 42),
+		)
+		newSchedule = func(fraction sdkmath.LegacyDec, amount sdkmath.Int) types.BuybackSchedule {
+			return types.BuybackSchedule{
+				Id:              1,
+				EpochIdentifier: "week",
+				EpochInterval:   2,
+				Fraction:        fraction,
+				Amount:          amount,
+				StartEpoch:      1,
+				TotalBurned:     sdkmath.NewInt(10),
+				TotalMinted:     sdkmath.NewInt(92),
+			}
+		}
+	)
+	tests := []struct {
+		name                  string
+		params                types.Params
+		schedule              types.BuybackSchedule
+		communityPool         sdk.DecCoins
+		setup                 func(testutil.Mocks)
+		expectedErr           string
+		expectedBurned        int64
+		expectedMinted        int64
+		expectedCommunityPool sdk.DecCoins
+	}{
+		{
+			name:                  "fail: mint disabled",
+			params:                types.Params{MintDisabled: true},
+			schedule:              newSchedule(sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.ZeroInt()),
+			communityPool:         communityPool,
+			expectedErr:           "photon mint disabled",
+			expectedCommunityPool: communityPool,
+		},
+		{
+			name:          "ok: mint limits don't apply",
+			params:        types.Params{MaxMintPerBlock: 1, MaxMintPerEpoch: 1, MaxMintPerAddressPerEpoch: 1},
+			schedule:      newSchedule(sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.ZeroInt()),
+			communityPool: communityPool,
+			setup: func(m testutil.Mocks) {
+				expectBuyback(m, 500, 4639)
+			},
+			expectedBurned: 500,
+			expectedMinted: 4639,
+			expectedCommunityPool: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec(appparams.BondDenom, sdkmath.LegacyMustNewDecFromStr("500.5")),
+				sdk.NewInt64DecCoin(types.Denom, 4639),
+				sdk.NewInt64DecCoin("xxx", 42),
+			),
+		},
+		{
+			name:                  "ok: no bond denom in community pool",
+			schedule:              newSchedule(sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.ZeroInt()),
+			communityPool:         sdk.NewDecCoins(sdk.NewInt64DecCoin("xxx", 42)),
+			expectedCommunityPool: sdk.NewDecCoins(sdk.NewInt64DecCoin("xxx", 42)),
+		},
+		{
+			name:          "ok: fraction",
+			schedule:      newSchedule(sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.ZeroInt()),
+			communityPool: communityPool,
+			setup: func(m testutil.Mocks) {
+				expectBuyback(m, 500, 4639)
+			},
+			expectedBurned: 500,
+			expectedMinted: 4639,
+			expectedCommunityPool: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec(appparams.BondDenom, sdkmath.LegacyMustNewDecFromStr("500.5")),
+				sdk.NewInt64DecCoin(types.Denom, 4639),
+				sdk.NewInt64DecCoin("xxx", 42),
+			),
+		},
+		{
+			name:          "ok: fixed amount",
+			schedule:      newSchedule(sdkmath.LegacyZeroDec(), sdkmath.NewInt(300)),
+			communityPool: communityPool,
+			setup: func(m testutil.Mocks) {
+				expectBuyback(m, 300, 2783)
+			},
+			expectedBurned: 300,
+			expectedMinted: 2783,
+			expectedCommunityPool: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec(appparams.BondDenom, sdkmath.LegacyMustNewDecFromStr("700.5")),
+				sdk.NewInt64DecCoin(types.Denom, 2783),
+				sdk.NewInt64DecCoin("xxx", 42),
+			),
+		},
+		{
+			name:          "ok: fixed amount higher than community pool",
+			schedule:      newSchedule(sdkmath.LegacyZeroDec(), sdkmath.NewInt(5_000)),
+			communityPool: communityPool,
+			setup: func(m testutil.Mocks) {
+				expectBuyback(m, 1000, 9278)
+			},
+			expectedBurned: 1000,
+			expectedMinted: 9278,
+			expectedCommunityPool: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec(appparams.BondDenom, sdkmath.LegacyMustNewDecFromStr("0.5")),
+				sdk.NewInt64DecCoin(types.Denom, 9278),
+				sdk.NewInt64DecCoin("xxx", 42),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := testutil.SetupPhotonKeeper(t)
+			require.NoError(t, k.SetParams(ctx, tt.params))
+			k.SetBuybackSchedule(ctx, tt.schedule)
+			m.StakingKeeper.EXPECT().BondDenom(ctx).Return(appparams.BondDenom, nil).AnyTimes()
+			feePool := mockFeePool(m, tt.communityPool)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			err := k.ExecuteBuybackSchedule(ctx, tt.schedule, 3)
+
+			require.Equal(t, tt.expectedCommunityPool.String(), feePool.CommunityPool.String())
+			schedule, found := k.GetBuybackSchedule(ctx, tt.schedule.Id)
+			require.True(t, found)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.expectedMinted == 0 {
+				require.Equal(t, tt.schedule.String(), schedule.String())
+				return
+			}
+			require.EqualValues(t, 3, schedule.LastExecutionEpoch)
+			require.Equal(t, tt.schedule.TotalBurned.AddRaw(tt.expectedBurned).String(), schedule.TotalBurned.String())
+			require.Equal(t, tt.schedule.TotalMinted.AddRaw(tt.expectedMinted).String(), schedule.TotalMinted.String())
+			stats := k.GetMintStats(ctx)
+			require.EqualValues(t, tt.expectedBurned, stats.TotalBurned.Int64())
+			require.EqualValues(t, tt.expectedMinted, stats.TotalMinted.Int64())
+			// the buybacks don't consume the mint limits
+			require.Equal(t, types.MintTotals{}, k.GetMintTotals(ctx))
+			require.Equal(t, types.AddressMintTotal{}, k.GetAddressMintTotal(ctx, authtypes.NewModuleAddress(distrtypes.ModuleName)))
+		})
+	}
+}
+
+func TestExecuteDueBuybackSchedules(t *testing.T) {
+	k, m, ctx := testutil.SetupPhotonKeeper(t)
+	require.NoError(t, k.SetParams(ctx, types.Params{}))
+	m.StakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(appparams.BondDenom, nil).AnyTimes()
+	feePool := mockFeePool(m, sdk.NewDecCoins(sdk.NewInt64DecCoin(appparams.BondDenom, 1_000)))
+	schedules := []types.BuybackSchedule{
+		{
+			// due every 2 weeks
+			Id: 1, EpochIdentifier: "week", EpochInterval: 2, StartEpoch: 1,
+			Fraction: sdkmath.LegacyZeroDec(), Amount: sdkmath.NewInt(300),
+			TotalBurned: sdkmath.ZeroInt(), TotalMinted: sdkmath.ZeroInt(),
+		},
+		{
+			// due every 3 weeks
+			Id: 2, EpochIdentifier: "week", EpochInterval: 3, StartEpoch: 1,
+			Fraction: sdkmath.LegacyZeroDec(), Amount: sdkmath.NewInt(300),
+			TotalBurned: sdkmath.ZeroInt(), TotalMinted: sdkmath.ZeroInt(),
+		},
+		{
+			// paced by another epoch
+			Id: 3, EpochIdentifier: "day", EpochInterval: 1, StartEpoch: 1,
+			Fraction: sdkmath.LegacyZeroDec(), Amount: sdkmath.NewInt(300),
+			TotalBurned: sdkmath.ZeroInt(), TotalMinted: sdkmath.ZeroInt(),
+		},
+	}
+	for _, schedule := range schedules {
+		k.SetBuybackSchedule(ctx, schedule)
+	}
+	// Only the first schedule is due at the end of the third week.
+	expectBuyback(m, 300, 2783)
+
+	k.ExecuteDueBuybackSchedules(ctx, "week", 3)
+
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewInt64DecCoin(appparams.BondDenom, 700),
+		sdk.NewInt64DecCoin(types.Denom, 2783),
+	).String(), feePool.CommunityPool.String())
+	schedule, _ := k.GetBuybackSchedule(ctx, 1)
+	require.EqualValues(t, 3, schedule.LastExecutionEpoch)
+	for _, id := range []uint64{2, 3} {
+		schedule, _ := k.GetBuybackSchedule(ctx, id)
+		require.Zero(t, schedule.LastExecutionEpoch)
+	}
+
+	// A failed execution doesn't change the state.
+	require.NoError(t, k.SetParams(ctx, types.Params{MintDisabled: true}))
+
+	k.ExecuteDueBuybackSchedules(ctx, "week", 4)
+
+	schedule, _ = k.GetBuybackSchedule(ctx, 2)
+	require.Zero(t, schedule.LastExecutionEpoch)
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewInt64DecCoin(appparams.BondDenom, 700),
+		sdk.NewInt64DecCoin(types.Denom, 2783),
+	).String(), feePool.CommunityPool.String())
+}
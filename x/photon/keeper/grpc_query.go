@@ -6,7 +6,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/atomone-hub/atomone/x/photon/types"
 )
@@ -35,3 +39,193 @@ func (k Keeper) ConversionRate(goCtx context.Context, req *types.QueryConversion
 
 	return &types.QueryConversionRateResponse{ConversionRate: cr.String()}, nil
 }
+
+// MintQuote returns the outcome of a photon mint, either for a given amount of
+// staking denom to burn, or for a given amount of photon to mint. The amounts
+// are computed with the same truncation as MintPhoton.
+func (k Keeper) MintQuote(goCtx context.Context, req *types.QueryMintQuoteRequest) (*types.QueryMintQuoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if (req.BurnAmount == "") == (req.MintAmount == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of burn_amount and mint_amount must be set")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get bond denom")
+	}
+	var (
+		stakingDenomSupply = k.bankKeeper.GetSupply(ctx, bondDenom).Amount
+		uphotonSupply      = k.bankKeeper.GetSupply(ctx, types.Denom).Amount
+		cr                 = k.PhotonConversionRate(ctx, stakingDenomSupply.ToLegacyDec(), uphotonSupply.ToLegacyDec())
+		remainingMintable  = math.MaxInt(math.NewInt(types.MaxSupply).Sub(uphotonSupply), math.ZeroInt())
+		burned, minted     math.Int
+	)
+	if req.BurnAmount != "" {
+		amount, ok := math.NewIntFromString(req.BurnAmount)
+		if !ok || !amount.IsPositive() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid burn_amount %q", req.BurnAmount)
+		}
+		// No more than the staking denom supply can be burned, which also keeps
+		// the decimal computation of the minted amount within bounds.
+		if amount.GT(stakingDenomSupply) {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"burn_amount %s exceeds the %s supply %s", amount, bondDenom, stakingDenomSupply)
+		}
+		burned = amount
+		minted = mintedUphoton(burned, cr)
+	} else {
+		amount, ok := math.NewIntFromString(req.MintAmount)
+		if !ok || !amount.IsPositive() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid mint_amount %q", req.MintAmount)
+		}
+		if !cr.IsPositive() || amount.GT(remainingMintable) {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"mint_amount %s exceeds remaining mintable supply %s", amount, remainingMintable)
+		}
+		burned = minBondDenomToBurn(amount, cr)
+		minted = mintedUphoton(burned, cr)
+	}
+
+	return &types.QueryMintQuoteResponse{
+		Burned:            sdk.NewCoin(bondDenom, burned),
+		Minted:            sdk.NewCoin(types.Denom, minted),
+		ConversionRate:    cr.String(),
+		RemainingMintable: sdk.NewCoin(types.Denom, remainingMintable),
+	}, nil
+}
+
+// MintTotals returns the amounts of photon minted in the current block and
+// epoch, and optionally by an address in the current epoch.
+func (k Keeper) MintTotals(goCtx context.Context, req *types.QueryMintTotalsRequest) (*types.QueryMintTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	var addr sdk.AccAddress
+	if req.Address != "" {
+		var err error
+		addr, err = sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	totals, addrTotal, err := k.CurrentMintTotals(ctx, k.GetParams(ctx), addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintTotalsResponse{
+		Totals:             totals,
+		AddressEpochMinted: addrTotal.Minted,
+	}, nil
+}
+
+// MintStats returns the cumulative amounts of staking denom burned and photon
+// minted.
+func (k Keeper) MintStats(goCtx context.Context, req *types.QueryMintStatsRequest) (*types.QueryMintStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryMintStatsResponse{MintStats: k.GetMintStats(ctx)}, nil
+}
+
+// ConversionRateHistory returns the stored conversion rate snapshots.
+func (k Keeper) ConversionRateHistory(goCtx context.Context, req *types.QueryConversionRateHistoryRequest) (*types.QueryConversionRateHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConversionRateSnapshotKeyPrefix)
+
+	var snapshots []types.ConversionRateSnapshot
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var snapshot types.ConversionRateSnapshot
+		if err := k.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConversionRateHistoryResponse{
+		Snapshots:  snapshots,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Invariants runs the photon module invariants and returns their results.
+func (k Keeper) Invariants(goCtx context.Context, req *types.QueryInvariantsRequest) (*types.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := &types.QueryInvariantsResponse{Invariants: k.CheckInvariants(ctx)}
+	for _, res := range resp.Invariants {
+		resp.Broken = resp.Broken || res.Broken
+	}
+	return resp, nil
+}
+
+// RelayerFeePolicy returns the fee policy applied to IBC relayer txs.
+func (k Keeper) RelayerFeePolicy(goCtx context.Context, req *types.QueryRelayerFeePolicyRequest) (*types.QueryRelayerFeePolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	return &types.QueryRelayerFeePolicyResponse{
+		Policy:                       params.RelayerFeePolicy,
+		MsgTypeUrls:                  types.RelayerMsgTypeURLs,
+		MaxZeroFeeRelayerGasPerBlock: params.MaxZeroFeeRelayerGasPerBlock,
+	}, nil
+}
+
+// BuybackSchedules returns the active buyback schedules.
+func (k Keeper) BuybackSchedules(goCtx context.Context, req *types.QueryBuybackSchedulesRequest) (*types.QueryBuybackSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BuybackScheduleKeyPrefix)
+
+	var schedules []types.BuybackSchedule
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var schedule types.BuybackSchedule
+		if err := k.cdc.Unmarshal(value, &schedule); err != nil {
+			return err
+		}
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBuybackSchedulesResponse{
+		Schedules:  schedules,
+		Pagination: pageRes,
+	}, nil
+}
+
+// BuybackSchedule returns a buyback schedule by id.
+func (k Keeper) BuybackSchedule(goCtx context.Context, req *types.QueryBuybackScheduleRequest) (*types.QueryBuybackScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	schedule, found := k.GetBuybackSchedule(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "buyback schedule %d not found", req.Id)
+	}
+	return &types.QueryBuybackScheduleResponse{Schedule: schedule}, nil
+}
@@ -2,8 +2,13 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
+
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 
 	appparams "github.com/atomone-hub/atomone/app/params"
 	"github.com/atomone-hub/atomone/x/photon/testutil"
@@ -62,3 +67,291 @@ func TestConversionRateQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestMintQuoteQuery(t *testing.T) {
+	var (
+		uatoneSupply  int64 = 100_000_000_000_000 // 100,000,000atone
+		uphotonSupply int64 = 100_000_000_000     // 100,000photon
+	)
+	tests := []struct {
+		name             string
+		req              *types.QueryMintQuoteRequest
+		uphotonSupply    int64
+		expectedErr      string
+		expectedResponse *types.QueryMintQuoteResponse
+	}{
+		{
+			name:        "fail: no amount",
+			req:         &types.QueryMintQuoteRequest{},
+			expectedErr: "rpc error: code = InvalidArgument desc = exactly one of burn_amount and mint_amount must be set",
+		},
+		{
+			name:        "fail: both amounts",
+			req:         &types.QueryMintQuoteRequest{BurnAmount: "1", MintAmount: "1"},
+			expectedErr: "rpc error: code = InvalidArgument desc = exactly one of burn_amount and mint_amount must be set",
+		},
+		{
+			name:          "fail: invalid burn amount",
+			req:           &types.QueryMintQuoteRequest{BurnAmount: "-1"},
+			uphotonSupply: uphotonSupply,
+			expectedErr:   "rpc error: code = InvalidArgument desc = invalid burn_amount \"-1\"",
+		},
+		{
+			name:          "fail: burn amount exceeds staking denom supply",
+			req:           &types.QueryMintQuoteRequest{BurnAmount: "100000000000001"},
+			uphotonSupply: uphotonSupply,
+			expectedErr:   "rpc error: code = InvalidArgument desc = burn_amount 100000000000001 exceeds the uatone supply 100000000000000",
+		},
+		{
+			name:          "fail: burn amount close to the max int",
+			req:           &types.QueryMintQuoteRequest{BurnAmount: "115792089237316195423570985008687907853269984665640564039457584007913129639935"},
+			uphotonSupply: uphotonSupply,
+			expectedErr:   "rpc error: code = InvalidArgument desc = burn_amount 115792089237316195423570985008687907853269984665640564039457584007913129639935 exceeds the uatone supply 100000000000000",
+		},
+		{
+			name:          "fail: mint amount exceeds remaining mintable supply",
+			req:           &types.QueryMintQuoteRequest{MintAmount: "999900000000001"},
+			uphotonSupply: uphotonSupply,
+			expectedErr:   "rpc error: code = FailedPrecondition desc = mint_amount 999900000000001 exceeds remaining mintable supply 999900000000000",
+		},
+		{
+			name:          "ok: burn amount",
+			req:           &types.QueryMintQuoteRequest{BurnAmount: "1000000"},
+			uphotonSupply: uphotonSupply,
+			expectedResponse: &types.QueryMintQuoteResponse{
+				Burned:            sdk.NewInt64Coin(appparams.BondDenom, 1_000_000),
+				Minted:            sdk.NewInt64Coin(types.Denom, 9_999_000),
+				ConversionRate:    "9.999000000000000000",
+				RemainingMintable: sdk.NewInt64Coin(types.Denom, 999_900_000_000_000),
+			},
+		},
+		{
+			name:          "ok: mint amount",
+			req:           &types.QueryMintQuoteRequest{MintAmount: "9999000"},
+			uphotonSupply: uphotonSupply,
+			expectedResponse: &types.QueryMintQuoteResponse{
+				Burned:            sdk.NewInt64Coin(appparams.BondDenom, 1_000_000),
+				Minted:            sdk.NewInt64Coin(types.Denom, 9_999_000),
+				ConversionRate:    "9.999000000000000000",
+				RemainingMintable: sdk.NewInt64Coin(types.Denom, 999_900_000_000_000),
+			},
+		},
+		{
+			name:          "ok: mint amount rounded up",
+			req:           &types.QueryMintQuoteRequest{MintAmount: "10"},
+			uphotonSupply: uphotonSupply,
+			expectedResponse: &types.QueryMintQuoteResponse{
+				Burned:            sdk.NewInt64Coin(appparams.BondDenom, 2),
+				Minted:            sdk.NewInt64Coin(types.Denom, 19),
+				ConversionRate:    "9.999000000000000000",
+				RemainingMintable: sdk.NewInt64Coin(types.Denom, 999_900_000_000_000),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := testutil.SetupPhotonKeeper(t)
+			if tt.uphotonSupply != 0 {
+				m.StakingKeeper.EXPECT().BondDenom(ctx).Return(appparams.BondDenom, nil)
+				m.BankKeeper.EXPECT().GetSupply(ctx, appparams.BondDenom).
+					Return(sdk.NewInt64Coin(appparams.BondDenom, uatoneSupply))
+				m.BankKeeper.EXPECT().GetSupply(ctx, types.Denom).
+					Return(sdk.NewInt64Coin(types.Denom, tt.uphotonSupply))
+			}
+
+			resp, err := k.MintQuote(ctx, tt.req)
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedResponse, resp)
+		})
+	}
+}
+
+func TestMintTotalsQuery(t *testing.T) {
+	addr := sdk.AccAddress("test1")
+	tests := []struct {
+		name             string
+		req              *types.QueryMintTotalsRequest
+		expectedErr      string
+		expectedResponse *types.QueryMintTotalsResponse
+	}{
+		{
+			name:        "fail: invalid address",
+			req:         &types.QueryMintTotalsRequest{Address: "xxx"},
+			expectedErr: "rpc error: code = InvalidArgument desc = invalid address: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name: "ok: without address",
+			req:  &types.QueryMintTotalsRequest{},
+			expectedResponse: &types.QueryMintTotalsResponse{
+				Totals: types.MintTotals{
+					BlockHeight: 10,
+					BlockMinted: 5,
+					EpochNumber: 3,
+					EpochMinted: 15,
+				},
+			},
+		},
+		{
+			name: "ok: with address",
+			req:  &types.QueryMintTotalsRequest{Address: addr.String()},
+			expectedResponse: &types.QueryMintTotalsResponse{
+				Totals: types.MintTotals{
+					BlockHeight: 10,
+					BlockMinted: 5,
+					EpochNumber: 3,
+					EpochMinted: 15,
+				},
+				AddressEpochMinted: 7,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := testutil.SetupPhotonKeeper(t)
+			ctx = ctx.WithBlockHeight(10)
+			params := types.DefaultParams()
+			require.NoError(t, k.SetParams(ctx, params))
+			k.SetMintTotals(ctx, types.MintTotals{
+				BlockHeight: 10,
+				BlockMinted: 5,
+				EpochNumber: 3,
+				EpochMinted: 15,
+			})
+			k.SetAddressMintTotal(ctx, addr, types.AddressMintTotal{EpochNumber: 3, Minted: 7})
+			m.EpochsKeeper.EXPECT().GetEpochInfo(ctx, params.MintLimitEpochIdentifier).
+				Return(epochstypes.EpochInfo{CurrentEpoch: 3}, nil).AnyTimes()
+
+			resp, err := k.MintTotals(ctx, tt.req)
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedResponse, resp)
+		})
+	}
+}
+
+func TestMintStatsQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupPhotonKeeper(t)
+	stats := types.MintStats{
+		TotalBurned: math.NewInt(1_000),
+		TotalMinted: math.NewInt(9_999),
+		MintCount:   2,
+	}
+	k.SetMintStats(ctx, stats)
+
+	resp, err := k.MintStats(ctx, &types.QueryMintStatsRequest{})
+
+	require.NoError(t, err)
+	require.Equal(t, stats.String(), resp.MintStats.String())
+}
+
+func TestConversionRateHistoryQuery(t *testing.T) {
+	blockTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := []types.ConversionRateSnapshot{
+		{Height: 5, Time: blockTime, ConversionRate: "3.000000000000000000"},
+		{Height: 10, Time: blockTime.Add(time.Minute), ConversionRate: "2.000000000000000000"},
+		{Height: 15, Time: blockTime.Add(2 * time.Minute), ConversionRate: "1.000000000000000000"},
+	}
+	tests := []struct {
+		name              string
+		req               *types.QueryConversionRateHistoryRequest
+		expectedSnapshots []types.ConversionRateSnapshot
+		expectedNextKey   bool
+	}{
+		{
+			name:              "ok: all snapshots",
+			req:               &types.QueryConversionRateHistoryRequest{},
+			expectedSnapshots: snapshots,
+		},
+		{
+			name: "ok: paginated",
+			req: &types.QueryConversionRateHistoryRequest{
+				Pagination: &query.PageRequest{Limit: 2},
+			},
+			expectedSnapshots: snapshots[:2],
+			expectedNextKey:   true,
+		},
+		{
+			name: "ok: reverse paginated",
+			req: &types.QueryConversionRateHistoryRequest{
+				Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+			},
+			expectedSnapshots: snapshots[2:],
+			expectedNextKey:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, _, ctx := testutil.SetupPhotonKeeper(t)
+			for _, snapshot := range snapshots {
+				k.SetConversionRateSnapshot(ctx, snapshot)
+			}
+
+			resp, err := k.ConversionRateHistory(ctx, tt.req)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedSnapshots, resp.Snapshots)
+			require.Equal(t, tt.expectedNextKey, resp.Pagination.NextKey != nil)
+		})
+	}
+}
+
+func TestRelayerFeePolicyQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupPhotonKeeper(t)
+	params := types.DefaultParams()
+	params.RelayerFeePolicy = types.RelayerFeePolicyZeroFeeIfNotRedundant
+	require.NoError(t, k.SetParams(ctx, params))
+
+	resp, err := k.RelayerFeePolicy(ctx, &types.QueryRelayerFeePolicyRequest{})
+
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryRelayerFeePolicyResponse{
+		Policy:                       types.RelayerFeePolicyZeroFeeIfNotRedundant,
+		MsgTypeUrls:                  types.RelayerMsgTypeURLs,
+		MaxZeroFeeRelayerGasPerBlock: params.MaxZeroFeeRelayerGasPerBlock,
+	}, resp)
+}
+
+func TestBuybackSchedulesQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupPhotonKeeper(t)
+	schedules := []types.BuybackSchedule{
+		{
+			Id: 1, EpochIdentifier: "week", EpochInterval: 1,
+			Fraction: math.LegacyNewDecWithPrec(1, 1), Amount: math.ZeroInt(),
+			TotalBurned: math.ZeroInt(), TotalMinted: math.ZeroInt(),
+		},
+		{
+			Id: 3, EpochIdentifier: "day", EpochInterval: 7,
+			Fraction: math.LegacyZeroDec(), Amount: math.NewInt(1_000),
+			TotalBurned: math.NewInt(1_000), TotalMinted: math.NewInt(9_278),
+		},
+	}
+	for _, schedule := range schedules {
+		k.SetBuybackSchedule(ctx, schedule)
+	}
+
+	resp, err := k.BuybackSchedules(ctx, &types.QueryBuybackSchedulesRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Schedules, 2)
+	for i, schedule := range schedules {
+		require.Equal(t, schedule.String(), resp.Schedules[i].String())
+	}
+
+	respOne, err := k.BuybackSchedule(ctx, &types.QueryBuybackScheduleRequest{Id: 3})
+
+	require.NoError(t, err)
+	require.Equal(t, schedules[1].String(), respOne.Schedule.String())
+
+	_, err = k.BuybackSchedule(ctx, &types.QueryBuybackScheduleRequest{Id: 2})
+
+	require.EqualError(t, err, "rpc error: code = NotFound desc = buyback schedule 2 not found")
+}
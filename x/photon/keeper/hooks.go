@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// EpochHooks is a wrapper struct for the photon keeper that implements the
+// epochs hooks.
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the epochs hooks of the photon keeper.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// BeforeEpochStart prunes the per-address mint totals of the previous epoch
+// when the epoch used by the mint limits starts.
+func (h EpochHooks) BeforeEpochStart(goCtx context.Context, epochIdentifier string, _ int64) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if epochIdentifier != h.k.GetParams(ctx).MintLimitEpochIdentifier {
+		return nil
+	}
+	h.k.DeleteAddressMintTotals(ctx)
+	return nil
+}
+
+// AfterEpochEnd executes the buyback schedules that are due at the end of the
+// epoch.
+func (h EpochHooks) AfterEpochEnd(goCtx context.Context, epochIdentifier string, epochNumber int64) error {
+	h.k.ExecuteDueBuybackSchedules(sdk.UnwrapSDKContext(goCtx), epochIdentifier, epochNumber)
+	return nil
+}
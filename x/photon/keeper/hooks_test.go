@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/testutil"
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestEpochHooksBeforeEpochStart(t *testing.T) {
+	var (
+		addr1 = sdk.AccAddress("test1")
+		addr2 = sdk.AccAddress("test2")
+	)
+	tests := []struct {
+		name            string
+		epochIdentifier string
+		expectPruned    bool
+	}{
+		{
+			name:            "other epoch: address totals kept",
+			epochIdentifier: "week",
+			expectPruned:    false,
+		},
+		{
+			name:            "mint limit epoch: address totals pruned",
+			epochIdentifier: "day",
+			expectPruned:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, _, ctx := testutil.SetupPhotonKeeper(t)
+			require.NoError(t, k.SetParams(ctx, types.Params{MintLimitEpochIdentifier: "day"}))
+			k.SetAddressMintTotal(ctx, addr1, types.AddressMintTotal{EpochNumber: 1, Minted: 1})
+			k.SetAddressMintTotal(ctx, addr2, types.AddressMintTotal{EpochNumber: 1, Minted: 2})
+
+			err := k.EpochHooks().BeforeEpochStart(ctx, tt.epochIdentifier, 2)
+
+			require.NoError(t, err)
+			if tt.expectPruned {
+				require.Equal(t, types.AddressMintTotal{}, k.GetAddressMintTotal(ctx, addr1))
+				require.Equal(t, types.AddressMintTotal{}, k.GetAddressMintTotal(ctx, addr2))
+			} else {
+				require.Equal(t, types.AddressMintTotal{EpochNumber: 1, Minted: 1}, k.GetAddressMintTotal(ctx, addr1))
+				require.Equal(t, types.AddressMintTotal{EpochNumber: 1, Minted: 2}, k.GetAddressMintTotal(ctx, addr2))
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+const (
+	maxSupplyInvariantRoute            = "max-supply"
+	moduleAccountBalanceInvariantRoute = "module-account-balance"
+)
+
+// RegisterInvariants registers all photon invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for _, route := range k.invariantRoutes() {
+		ir.RegisterRoute(types.ModuleName, route.name, route.invariant)
+	}
+}
+
+// AllInvariants runs all invariants of the photon module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, route := range k.invariantRoutes() {
+			if res, stop := route.invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+type invariantRoute struct {
+	name      string
+	invariant sdk.Invariant
+}
+
+func (k Keeper) invariantRoutes() []invariantRoute {
+	return []invariantRoute{
+		{maxSupplyInvariantRoute, MaxSupplyInvariant(k)},
+		{moduleAccountBalanceInvariantRoute, ModuleAccountBalanceInvariant(k)},
+	}
+}
+
+// MaxSupplyInvariant checks that the uphoton supply doesn't exceed MaxSupply.
+func MaxSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		supply := k.bankKeeper.GetSupply(ctx, types.Denom)
+		broken := supply.Amount.GT(math.NewInt(types.MaxSupply))
+
+		return sdk.FormatInvariant(types.ModuleName, maxSupplyInvariantRoute, fmt.Sprintf(
+			"\tuphoton supply: %s\n\tmax supply: %d\n", supply.Amount, types.MaxSupply,
+		)), broken
+	}
+}
+
+// ModuleAccountBalanceInvariant checks that the photon module account holds
+// no coins: the coins it receives during a mint are always burned or sent
+// back in the same mint.
+func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		balances := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		broken := !balances.IsZero()
+
+		return sdk.FormatInvariant(types.ModuleName, moduleAccountBalanceInvariantRoute, fmt.Sprintf(
+			"\tmodule account balance: %s\n", balances,
+		)), broken
+	}
+}
+
+// CheckInvariants runs all photon invariants and returns their results.
+func (k Keeper) CheckInvariants(ctx sdk.Context) []types.InvariantResult {
+	routes := k.invariantRoutes()
+	results := make([]types.InvariantResult, 0, len(routes))
+	for _, route := range routes {
+		msg, broken := route.invariant(ctx)
+		results = append(results, types.InvariantResult{
+			Route:   route.name,
+			Broken:  broken,
+			Message: msg,
+		})
+	}
+	return results
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	appparams "github.com/atomone-hub/atomone/app/params"
+	"github.com/atomone-hub/atomone/x/photon/keeper"
+	"github.com/atomone-hub/atomone/x/photon/testutil"
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestInvariants(t *testing.T) {
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	tests := []struct {
+		name                  string
+		uphotonSupply         int64
+		moduleBalance         sdk.Coins
+		expectMaxSupplyBroken bool
+		expectBalanceBroken   bool
+	}{
+		{
+			name:          "ok",
+			uphotonSupply: types.MaxSupply,
+		},
+		{
+			name:                  "max supply exceeded",
+			uphotonSupply:         types.MaxSupply + 1,
+			expectMaxSupplyBroken: true,
+		},
+		{
+			name:                "module account holds bond denom",
+			uphotonSupply:       1,
+			moduleBalance:       sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 1)),
+			expectBalanceBroken: true,
+		},
+		{
+			name:                "module account holds photon",
+			uphotonSupply:       1,
+			moduleBalance:       sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 1)),
+			expectBalanceBroken: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := testutil.SetupPhotonKeeper(t)
+			m.BankKeeper.EXPECT().GetSupply(ctx, types.Denom).
+				Return(sdk.NewInt64Coin(types.Denom, tt.uphotonSupply)).AnyTimes()
+			m.BankKeeper.EXPECT().GetAllBalances(ctx, moduleAddr).
+				Return(tt.moduleBalance).AnyTimes()
+
+			_, maxSupplyBroken := keeper.MaxSupplyInvariant(*k)(ctx)
+			_, balanceBroken := keeper.ModuleAccountBalanceInvariant(*k)(ctx)
+			_, allBroken := keeper.AllInvariants(*k)(ctx)
+			resp, err := k.Invariants(ctx, &types.QueryInvariantsRequest{})
+
+			require.Equal(t, tt.expectMaxSupplyBroken, maxSupplyBroken)
+			require.Equal(t, tt.expectBalanceBroken, balanceBroken)
+			require.Equal(t, tt.expectMaxSupplyBroken || tt.expectBalanceBroken, allBroken)
+			require.NoError(t, err)
+			require.Equal(t, allBroken, resp.Broken)
+			require.Len(t, resp.Invariants, 2)
+			require.Equal(t, "max-supply", resp.Invariants[0].Route)
+			require.Equal(t, tt.expectMaxSupplyBroken, resp.Invariants[0].Broken)
+			require.Equal(t, "module-account-balance", resp.Invariants[1].Route)
+			require.Equal(t, tt.expectBalanceBroken, resp.Invariants[1].Broken)
+		})
+	}
+}
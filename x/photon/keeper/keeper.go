@@ -22,6 +22,8 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	accountKeeper types.AccountKeeper
 	stakingKeeper types.StakingKeeper
+	epochsKeeper  types.EpochsKeeper
+	distrKeeper   types.DistributionKeeper
 }
 
 func NewKeeper(
@@ -31,6 +33,8 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
 	stakingKeeper types.StakingKeeper,
+	epochsKeeper types.EpochsKeeper,
+	distrKeeper types.DistributionKeeper,
 ) *Keeper {
 	return &Keeper{
 		cdc:           cdc,
@@ -39,6 +43,8 @@ func NewKeeper(
 		bankKeeper:    bankKeeper,
 		accountKeeper: accountKeeper,
 		stakingKeeper: stakingKeeper,
+		epochsKeeper:  epochsKeeper,
+		distrKeeper:   distrKeeper,
 	}
 }
 
@@ -63,3 +69,93 @@ func (k Keeper) PhotonConversionRate(_ context.Context, bondDenomSupply, uphoton
 	}
 	return remainMintableUphotons.Quo(bondDenomSupply)
 }
+
+// mintedUphoton returns the amount of uphoton minted when burning
+// bondDenomAmount at the given conversion rate. The result is truncated.
+func mintedUphoton(bondDenomAmount math.Int, conversionRate math.LegacyDec) math.Int {
+	return bondDenomAmount.ToLegacyDec().Mul(conversionRate).TruncateInt()
+}
+
+// minBondDenomToBurn returns the minimum amount of bond denom to burn so that
+// mintedUphoton returns at least uphotonAmount at the given conversion rate.
+// conversionRate must be positive.
+func minBondDenomToBurn(uphotonAmount math.Int, conversionRate math.LegacyDec) math.Int {
+	burn := uphotonAmount.ToLegacyDec().Quo(conversionRate).Ceil().TruncateInt()
+	// Decimal operations are rounded to 18 decimals, so the estimate may be off
+	// by a few units: adjust it until it is the exact minimum.
+	for mintedUphoton(burn, conversionRate).LT(uphotonAmount) {
+		burn = burn.AddRaw(1)
+	}
+	for burn.IsPositive() && mintedUphoton(burn.SubRaw(1), conversionRate).GTE(uphotonAmount) {
+		burn = burn.SubRaw(1)
+	}
+	return burn
+}
+
+// burnAndMint burns bondDenomToBurn from the to address, and mints
+// uphotonToMint into it. It enforces the mint limits defined in params and
+// records the mint in the mint statistics.
+func (k Keeper) burnAndMint(ctx sdk.Context, params types.Params, to sdk.AccAddress,
+	bondDenomToBurn sdk.Coin, uphotonToMint math.Int,
+) (sdk.Coin, error) {
+	// Ensure the mint limits are not exceeded
+	if err := k.trackMint(ctx, params, to, uphotonToMint.Uint64()); err != nil {
+		return sdk.Coin{}, err
+	}
+	return k.burnAndMintFunds(ctx, bondDenomToBurn, uphotonToMint,
+		func(coins sdk.Coins) error {
+			return k.bankKeeper.SendCoinsFromAccountToModule(ctx, to, types.ModuleName, coins)
+		},
+		func(coins sdk.Coins) error {
+			return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins)
+		},
+	)
+}
+
+// burnAndMintFunds burns bondDenomToBurn and mints uphotonToMint, with the
+// funds moved to and from the module account by the collect and deliver
+// functions, and records the mint in the mint statistics.
+// It doesn't enforce the mint limits, which only apply to the user mints (see
+// burnAndMint), so that the module-driven mints like the buybacks are neither
+// blocked by nor consume the limits.
+func (k Keeper) burnAndMintFunds(ctx sdk.Context, bondDenomToBurn sdk.Coin, uphotonToMint math.Int,
+	collect, deliver func(sdk.Coins) error,
+) (sdk.Coin, error) {
+	// Burn/Mint phase:
+	// 1) move ATONEs from minter funds to this module address
+	// 2) burn ATONEs from this module address
+	// 3) mint PHOTONs into this module address
+	// 4) move PHOTONs from this module address to minter funds
+	var (
+		coinsToBurn = sdk.NewCoins(bondDenomToBurn)
+		coinsToMint = sdk.NewCoins(sdk.NewCoin(types.Denom, uphotonToMint))
+	)
+	// 1) Send atone to photon module for burn
+	if err := collect(coinsToBurn); err != nil {
+		return sdk.Coin{}, err
+	}
+	// 2) Burn atone
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coinsToBurn); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// 3) Mint photons
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coinsToMint); err != nil {
+		return sdk.Coin{}, err
+	}
+	// 4) Send minted photon to minter
+	if err := deliver(coinsToMint); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.recordMint(ctx, bondDenomToBurn.Amount, uphotonToMint)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMintPhoton,
+			sdk.NewAttribute(types.AttributeKeyBurned, coinsToBurn.String()),
+			sdk.NewAttribute(types.AttributeKeyMinted, coinsToMint.String()),
+		),
+	})
+	return coinsToMint[0], nil
+}
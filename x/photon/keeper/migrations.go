@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It seeds the mint statistics from
+// the uphoton supply at the migration height, enables the conversion rate snapshots with their
+// default parameters, and sets the default zero-fee relayer gas limit.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.SetMintStats(ctx, m.keeper.NewMintStats(ctx))
+
+	params := m.keeper.GetParams(ctx)
+	defaultParams := types.DefaultParams()
+	params.ConversionRateSnapshotInterval = defaultParams.ConversionRateSnapshotInterval
+	params.ConversionRateSnapshotRetention = defaultParams.ConversionRateSnapshotRetention
+	params.MaxZeroFeeRelayerGasPerBlock = defaultParams.MaxZeroFeeRelayerGasPerBlock
+	return m.keeper.SetParams(ctx, params)
+}
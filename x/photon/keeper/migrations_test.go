@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/keeper"
+	"github.com/atomone-hub/atomone/x/photon/testutil"
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, m, ctx := testutil.SetupPhotonKeeper(t)
+	ctx = ctx.WithBlockHeight(42)
+	require.NoError(t, k.SetParams(ctx, types.Params{MintDisabled: true}))
+	m.BankKeeper.EXPECT().GetSupply(ctx, types.Denom).Return(sdk.NewInt64Coin(types.Denom, 1_000))
+
+	err := keeper.NewMigrator(*k).Migrate1to2(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, types.Params{
+		MintDisabled:                    true,
+		ConversionRateSnapshotInterval:  types.DefaultParams().ConversionRateSnapshotInterval,
+		ConversionRateSnapshotRetention: types.DefaultParams().ConversionRateSnapshotRetention,
+		MaxZeroFeeRelayerGasPerBlock:    types.DefaultParams().MaxZeroFeeRelayerGasPerBlock,
+	}, k.GetParams(ctx))
+	stats := k.GetMintStats(ctx)
+	require.Equal(t, "0", stats.TotalBurned.String())
+	require.Equal(t, "1000", stats.TotalMinted.String())
+	require.Zero(t, stats.MintCount)
+	require.EqualValues(t, 42, stats.SinceHeight)
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// GetMintTotals returns the last stored mint totals. The returned totals may
+// refer to a past block or epoch, use CurrentMintTotals to get the totals of
+// the current block and epoch.
+func (k Keeper) GetMintTotals(ctx sdk.Context) (totals types.MintTotals) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MintTotalsKey)
+	if bz == nil {
+		return totals
+	}
+	k.cdc.MustUnmarshal(bz, &totals)
+	return totals
+}
+
+// SetMintTotals sets the mint totals.
+func (k Keeper) SetMintTotals(ctx sdk.Context, totals types.MintTotals) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MintTotalsKey, k.cdc.MustMarshal(&totals))
+}
+
+// GetAddressMintTotal returns the last stored mint total of addr.
+func (k Keeper) GetAddressMintTotal(ctx sdk.Context, addr sdk.AccAddress) (total types.AddressMintTotal) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AddressMintTotalKey(addr))
+	if bz == nil {
+		return total
+	}
+	k.cdc.MustUnmarshal(bz, &total)
+	return total
+}
+
+// SetAddressMintTotal sets the mint total of addr.
+func (k Keeper) SetAddressMintTotal(ctx sdk.Context, addr sdk.AccAddress, total types.AddressMintTotal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AddressMintTotalKey(addr), k.cdc.MustMarshal(&total))
+}
+
+// DeleteAddressMintTotals removes the mint totals of all addresses.
+func (k Keeper) DeleteAddressMintTotals(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.AddressMintTotalKeyPrefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// currentEpochNumber returns the current epoch number of the epoch used by the
+// per-epoch mint limits, or 0 if no such epoch is configured.
+func (k Keeper) currentEpochNumber(ctx sdk.Context, params types.Params) (int64, error) {
+	if params.MintLimitEpochIdentifier == "" {
+		return 0, nil
+	}
+	epochInfo, err := k.epochsKeeper.GetEpochInfo(ctx, params.MintLimitEpochIdentifier)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get epoch info %s", params.MintLimitEpochIdentifier)
+	}
+	return epochInfo.CurrentEpoch, nil
+}
+
+// CurrentMintTotals returns the mint totals of the current block and epoch,
+// and the mint total of addr in the current epoch if addr is not empty.
+func (k Keeper) CurrentMintTotals(ctx sdk.Context, params types.Params, addr sdk.AccAddress) (
+	types.MintTotals, types.AddressMintTotal, error,
+) {
+	epochNumber, err := k.currentEpochNumber(ctx, params)
+	if err != nil {
+		return types.MintTotals{}, types.AddressMintTotal{}, err
+	}
+	totals := k.GetMintTotals(ctx)
+	if totals.BlockHeight != ctx.BlockHeight() {
+		totals.BlockHeight = ctx.BlockHeight()
+		totals.BlockMinted = 0
+	}
+	if totals.EpochNumber != epochNumber {
+		totals.EpochNumber = epochNumber
+		totals.EpochMinted = 0
+	}
+	addrTotal := types.AddressMintTotal{EpochNumber: epochNumber}
+	if !addr.Empty() {
+		if t := k.GetAddressMintTotal(ctx, addr); t.EpochNumber == epochNumber {
+			addrTotal = t
+		}
+	}
+	return totals, addrTotal, nil
+}
+
+// trackMint adds amount to the mint totals of the current block and epoch,
+// and to the mint total of addr. It returns an error if any of the mint
+// limits defined in params is exceeded.
+func (k Keeper) trackMint(ctx sdk.Context, params types.Params, addr sdk.AccAddress, amount uint64) error {
+	totals, addrTotal, err := k.CurrentMintTotals(ctx, params, addr)
+	if err != nil {
+		return err
+	}
+	totals.BlockMinted += amount
+	totals.EpochMinted += amount
+	addrTotal.Minted += amount
+
+	if params.MaxMintPerBlock > 0 && totals.BlockMinted > params.MaxMintPerBlock {
+		return errors.Wrapf(types.ErrMintLimitReached, "block limit of %d%s exceeded",
+			params.MaxMintPerBlock, types.Denom)
+	}
+	if params.MaxMintPerEpoch > 0 && totals.EpochMinted > params.MaxMintPerEpoch {
+		return errors.Wrapf(types.ErrMintLimitReached, "epoch limit of %d%s exceeded",
+			params.MaxMintPerEpoch, types.Denom)
+	}
+	if params.MaxMintPerAddressPerEpoch > 0 && addrTotal.Minted > params.MaxMintPerAddressPerEpoch {
+		return errors.Wrapf(types.ErrMintLimitReached, "address epoch limit of %d%s exceeded",
+			params.MaxMintPerAddressPerEpoch, types.Denom)
+	}
+
+	k.SetMintTotals(ctx, totals)
+	// Address totals are only tracked per epoch, and are pruned at the start of
+	// each epoch by the epochs hooks.
+	if params.MintLimitEpochIdentifier != "" {
+		k.SetAddressMintTotal(ctx, addr, addrTotal)
+	}
+	return nil
+}
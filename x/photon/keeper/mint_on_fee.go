@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// MintPhotonForFee burns fee, which must be in bond denom, from payer and
+// mints the equivalent amount of photon into payer, so that it can be deducted
+// as tx fee. It returns the minted photon.
+// The mint is subject to the same rules as MsgMintPhoton, and is additionally
+// capped by the MaxMintOnFee param.
+func (k Keeper) MintPhotonForFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coin) (sdk.Coin, error) {
+	params := k.GetParams(ctx)
+	if params.MaxMintOnFee == 0 {
+		return sdk.Coin{}, errors.Wrap(types.ErrMintOnFee, "mint-on-fee is disabled")
+	}
+	if params.MintDisabled {
+		return sdk.Coin{}, types.ErrMintDisabled
+	}
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, errors.Wrap(err, "failed to get bond denom")
+	}
+	if fee.Denom != bondDenom {
+		return sdk.Coin{}, types.ErrBurnInvalidDenom
+	}
+	var (
+		bondDenomSupply = k.bankKeeper.GetSupply(ctx, bondDenom).Amount.ToLegacyDec()
+		uphotonSupply   = k.bankKeeper.GetSupply(ctx, types.Denom).Amount.ToLegacyDec()
+		conversionRate  = k.PhotonConversionRate(ctx, bondDenomSupply, uphotonSupply)
+		uphotonToMint   = mintedUphoton(fee.Amount, conversionRate)
+	)
+	if uphotonToMint.IsZero() {
+		return sdk.Coin{}, types.ErrZeroMintPhotons
+	}
+	if !uphotonToMint.IsUint64() || uphotonToMint.Uint64() > params.MaxMintOnFee {
+		return sdk.Coin{}, errors.Wrapf(types.ErrMintOnFee, "minted %s%s exceeds mint-on-fee limit of %d%s",
+			uphotonToMint, types.Denom, params.MaxMintOnFee, types.Denom)
+	}
+	return k.burnAndMint(ctx, params, payer, fee, uphotonToMint)
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/atomone-hub/atomone/app/params"
+	"github.com/atomone-hub/atomone/x/photon/testutil"
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestMintPhotonForFee(t *testing.T) {
+	var (
+		payer             = sdk.AccAddress("test1")
+		atoneSupply int64 = 107_775_332 * 1_000_000 // From genesis
+	)
+	tests := []struct {
+		name           string
+		params         types.Params
+		fee            sdk.Coin
+		setup          func(sdk.Context, testutil.Mocks)
+		expectedErr    string
+		expectedMinted sdk.Coin
+	}{
+		{
+			name:        "fail: mint-on-fee disabled",
+			params:      types.Params{},
+			fee:         sdk.NewInt64Coin(appparams.BondDenom, 10),
+			expectedErr: "mint-on-fee is disabled: photon mint-on-fee failed",
+		},
+		{
+			name:        "fail: mint disabled",
+			params:      types.Params{MaxMintOnFee: 1_000, MintDisabled: true},
+			fee:         sdk.NewInt64Coin(appparams.BondDenom, 10),
+			expectedErr: "photon mint disabled",
+		},
+		{
+			name:   "fail: fee denom not bond denom",
+			params: types.Params{MaxMintOnFee: 1_000},
+			fee:    sdk.NewInt64Coin("xxx", 10),
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.StakingKeeper.EXPECT().BondDenom(ctx).Return(appparams.BondDenom, nil)
+			},
+			expectedErr: "invalid burned amount denom: expected bond denom",
+		},
+		{
+			name:   "fail: mint-on-fee limit exceeded",
+			params: types.Params{MaxMintOnFee: 90},
+			fee:    sdk.NewInt64Coin(appparams.BondDenom, 10),
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.StakingKeeper.EXPECT().BondDenom(ctx).Return(appparams.BondDenom, nil)
+				m.BankKeeper.EXPECT().GetSupply(ctx, appparams.BondDenom).
+					Return(sdk.NewInt64Coin(appparams.BondDenom, atoneSupply))
+				m.BankKeeper.EXPECT().GetSupply(ctx, types.Denom).Return(sdk.NewInt64Coin(types.Denom, 0))
+			},
+			expectedErr: "minted 92uphoton exceeds mint-on-fee limit of 90uphoton: photon mint-on-fee failed",
+		},
+		{
+			name:   "ok",
+			params: types.Params{MaxMintOnFee: 1_000},
+			fee:    sdk.NewInt64Coin(appparams.BondDenom, 10),
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.StakingKeeper.EXPECT().BondDenom(ctx).Return(appparams.BondDenom, nil)
+				m.BankKeeper.EXPECT().GetSupply(ctx, appparams.BondDenom).
+					Return(sdk.NewInt64Coin(appparams.BondDenom, atoneSupply))
+				m.BankKeeper.EXPECT().GetSupply(ctx, types.Denom).Return(sdk.NewInt64Coin(types.Denom, 0))
+				m.BankKeeper.EXPECT().SendCoinsFromAccountToModule(
+					ctx, payer, types.ModuleName,
+					sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 10)),
+				)
+				m.BankKeeper.EXPECT().BurnCoins(ctx, types.ModuleName,
+					sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 10)),
+				)
+				m.BankKeeper.EXPECT().MintCoins(ctx, types.ModuleName,
+					sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 92)),
+				)
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(
+					ctx, types.ModuleName, payer,
+					sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 92)),
+				)
+			},
+			expectedMinted: sdk.NewInt64Coin(types.Denom, 92),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := testutil.SetupPhotonKeeper(t)
+			require.NoError(t, k.SetParams(ctx, tt.params))
+			if tt.setup != nil {
+				tt.setup(ctx, m)
+			}
+
+			minted, err := k.MintPhotonForFee(ctx, payer, tt.fee)
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedMinted, minted)
+			require.Equal(t, uint64(1), k.GetMintStats(ctx).MintCount)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// GetMintStats returns the cumulative mint statistics.
+func (k Keeper) GetMintStats(ctx sdk.Context) types.MintStats {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MintStatsKey)
+	if bz == nil {
+		return types.MintStats{
+			TotalBurned: math.ZeroInt(),
+			TotalMinted: math.ZeroInt(),
+		}
+	}
+	var stats types.MintStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+// SetMintStats sets the cumulative mint statistics.
+func (k Keeper) SetMintStats(ctx sdk.Context, stats types.MintStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MintStatsKey, k.cdc.MustMarshal(&stats))
+}
+
+// NewMintStats returns the mint statistics seeded from the bank supply at the
+// current height: the uphoton minted so far is the uphoton supply. The amount
+// of bond denom burned and the number of mints before it can't be recovered,
+// so they are accumulated from the current height.
+func (k Keeper) NewMintStats(ctx sdk.Context) types.MintStats {
+	return types.MintStats{
+		TotalBurned: math.ZeroInt(),
+		TotalMinted: k.bankKeeper.GetSupply(ctx, types.Denom).Amount,
+		SinceHeight: ctx.BlockHeight(),
+	}
+}
+
+// recordMint adds a mint of minted uphoton for burned bond denom to the
+// cumulative mint statistics.
+func (k Keeper) recordMint(ctx sdk.Context, burned, minted math.Int) {
+	stats := k.GetMintStats(ctx)
+	stats.TotalBurned = stats.TotalBurned.Add(burned)
+	stats.TotalMinted = stats.TotalMinted.Add(minted)
+	stats.MintCount++
+	k.SetMintStats(ctx, stats)
+}
+
+// SetConversionRateSnapshot sets a conversion rate snapshot.
+func (k Keeper) SetConversionRateSnapshot(ctx sdk.Context, snapshot types.ConversionRateSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConversionRateSnapshotKey(snapshot.Height), k.cdc.MustMarshal(&snapshot))
+}
+
+// GetConversionRateSnapshot returns the conversion rate snapshot at height, if
+// any.
+func (k Keeper) GetConversionRateSnapshot(ctx sdk.Context, height int64) (types.ConversionRateSnapshot, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConversionRateSnapshotKey(height))
+	if bz == nil {
+		return types.ConversionRateSnapshot{}, false
+	}
+	var snapshot types.ConversionRateSnapshot
+	k.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot, true
+}
+
+// IterateConversionRateSnapshots iterates over the conversion rate snapshots
+// in ascending height order, and calls cb for each of them until cb returns
+// true.
+func (k Keeper) IterateConversionRateSnapshots(ctx sdk.Context, cb func(types.ConversionRateSnapshot) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConversionRateSnapshotKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.ConversionRateSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if cb(snapshot) {
+			return
+		}
+	}
+}
+
+// pruneConversionRateSnapshots removes the conversion rate snapshots strictly
+// below height.
+func (k Keeper) pruneConversionRateSnapshots(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.ConversionRateSnapshotKey(0),
+		types.ConversionRateSnapshotKey(height),
+	)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// SnapshotConversionRate takes a conversion rate snapshot if the current
+// height is a multiple of ConversionRateSnapshotInterval, and prunes the
+// snapshots older than ConversionRateSnapshotRetention blocks.
+func (k Keeper) SnapshotConversionRate(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	interval := int64(params.ConversionRateSnapshotInterval)
+	if interval == 0 || ctx.BlockHeight()%interval != 0 {
+		return nil
+	}
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	var (
+		bondDenomSupply = k.bankKeeper.GetSupply(ctx, bondDenom).Amount.ToLegacyDec()
+		uphotonSupply   = k.bankKeeper.GetSupply(ctx, types.Denom).Amount.ToLegacyDec()
+		conversionRate  = k.PhotonConversionRate(ctx, bondDenomSupply, uphotonSupply)
+	)
+	k.SetConversionRateSnapshot(ctx, types.ConversionRateSnapshot{
+		Height:         ctx.BlockHeight(),
+		Time:           ctx.BlockTime(),
+		ConversionRate: conversionRate.String(),
+	})
+
+	retention := int64(params.ConversionRateSnapshotRetention)
+	if retention > 0 && ctx.BlockHeight() > retention {
+		k.pruneConversionRateSnapshots(ctx, ctx.BlockHeight()-retention)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/atomone-hub/atomone/app/params"
+	"github.com/atomone-hub/atomone/x/photon/testutil"
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestSnapshotConversionRate(t *testing.T) {
+	blockTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name              string
+		blockHeight       int64
+		params            types.Params
+		setup             func(sdk.Context, testutil.Mocks)
+		expectedSnapshots []types.ConversionRateSnapshot
+	}{
+		{
+			name:        "ok: snapshots disabled",
+			blockHeight: 20,
+			params:      types.Params{},
+			expectedSnapshots: []types.ConversionRateSnapshot{
+				{Height: 5, Time: blockTime, ConversionRate: "1.000000000000000000"},
+				{Height: 10, Time: blockTime, ConversionRate: "2.000000000000000000"},
+			},
+		},
+		{
+			name:        "ok: height not a multiple of interval",
+			blockHeight: 21,
+			params:      types.Params{ConversionRateSnapshotInterval: 5},
+			expectedSnapshots: []types.ConversionRateSnapshot{
+				{Height: 5, Time: blockTime, ConversionRate: "1.000000000000000000"},
+				{Height: 10, Time: blockTime, ConversionRate: "2.000000000000000000"},
+			},
+		},
+		{
+			name:        "ok: snapshot without retention",
+			blockHeight: 20,
+			params:      types.Params{ConversionRateSnapshotInterval: 5},
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.StakingKeeper.EXPECT().BondDenom(ctx).Return(appparams.BondDenom, nil)
+				m.BankKeeper.EXPECT().GetSupply(ctx, appparams.BondDenom).
+					Return(sdk.NewInt64Coin(appparams.BondDenom, 100_000_000_000_000))
+				m.BankKeeper.EXPECT().GetSupply(ctx, types.Denom).
+					Return(sdk.NewInt64Coin(types.Denom, 100_000_000_000))
+			},
+			expectedSnapshots: []types.ConversionRateSnapshot{
+				{Height: 5, Time: blockTime, ConversionRate: "1.000000000000000000"},
+				{Height: 10, Time: blockTime, ConversionRate: "2.000000000000000000"},
+				{Height: 20, Time: blockTime, ConversionRate: "9.999000000000000000"},
+			},
+		},
+		{
+			name:        "ok: snapshot and prune",
+			blockHeight: 20,
+			params: types.Params{
+				ConversionRateSnapshotInterval:  5,
+				ConversionRateSnapshotRetention: 10,
+			},
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.StakingKeeper.EXPECT().BondDenom(ctx).Return(appparams.BondDenom, nil)
+				m.BankKeeper.EXPECT().GetSupply(ctx, appparams.BondDenom).
+					Return(sdk.NewInt64Coin(appparams.BondDenom, 100_000_000_000_000))
+				m.BankKeeper.EXPECT().GetSupply(ctx, types.Denom).
+					Return(sdk.NewInt64Coin(types.Denom, 100_000_000_000))
+			},
+			expectedSnapshots: []types.ConversionRateSnapshot{
+				{Height: 10, Time: blockTime, ConversionRate: "2.000000000000000000"},
+				{Height: 20, Time: blockTime, ConversionRate: "9.999000000000000000"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := testutil.SetupPhotonKeeper(t)
+			ctx = ctx.WithBlockHeight(tt.blockHeight).WithBlockTime(blockTime)
+			require.NoError(t, k.SetParams(ctx, tt.params))
+			k.SetConversionRateSnapshot(ctx, types.ConversionRateSnapshot{
+				Height: 5, Time: blockTime, ConversionRate: "1.000000000000000000",
+			})
+			k.SetConversionRateSnapshot(ctx, types.ConversionRateSnapshot{
+				Height: 10, Time: blockTime, ConversionRate: "2.000000000000000000",
+			})
+			if tt.setup != nil {
+				tt.setup(ctx, m)
+			}
+
+			err := k.SnapshotConversionRate(ctx)
+
+			require.NoError(t, err)
+			var snapshots []types.ConversionRateSnapshot
+			k.IterateConversionRateSnapshots(ctx, func(snapshot types.ConversionRateSnapshot) bool {
+				snapshots = append(snapshots, snapshot)
+				return false
+			})
+			require.Equal(t, tt.expectedSnapshots, snapshots)
+		})
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdkgovtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/atomone-hub/atomone/x/photon/types"
@@ -30,6 +32,15 @@ func (k msgServer) MintPhoton(goCtx context.Context, msg *types.MsgMintPhoton) (
 	if params.MintDisabled {
 		return nil, types.ErrMintDisabled
 	}
+	// Ensure the message has not expired
+	if msg.ExpiryHeight > 0 && ctx.BlockHeight() > msg.ExpiryHeight {
+		return nil, errors.Wrapf(types.ErrMintExpired, "block height %d is after expiry height %d",
+			ctx.BlockHeight(), msg.ExpiryHeight)
+	}
+	if msg.ExpiryTime != nil && ctx.BlockTime().After(*msg.ExpiryTime) {
+		return nil, errors.Wrapf(types.ErrMintExpired, "block time %s is after expiry time %s",
+			ctx.BlockTime(), msg.ExpiryTime)
+	}
 
 	// Ensure burned amount denom is bond denom
 	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
@@ -46,55 +57,31 @@ func (k msgServer) MintPhoton(goCtx context.Context, msg *types.MsgMintPhoton) (
 		uphotonSupply   = k.bankKeeper.GetSupply(ctx, types.Denom).Amount.ToLegacyDec()
 		conversionRate  = k.PhotonConversionRate(ctx, bondDenomSupply, uphotonSupply)
 		bondDenomToBurn = msg.Amount
-		uphotonToMint   = bondDenomToBurn.Amount.ToLegacyDec().Mul(conversionRate).TruncateInt()
+		uphotonToMint   = mintedUphoton(bondDenomToBurn.Amount, conversionRate)
 	)
 	// If no photon to mint, do not burn bondDenomToBurn, returns an error
 	// this could happen due to rounding
 	if uphotonToMint.IsZero() {
 		return nil, types.ErrZeroMintPhotons
 	}
+	// Ensure the minted amount honors the requested minimum, which protects the
+	// signer against conversion rate changes between quote and execution.
+	if !msg.MinPhotonOut.IsNil() && uphotonToMint.LT(msg.MinPhotonOut) {
+		return nil, errors.Wrapf(types.ErrMintBelowMinimum, "minted %s%s, expected at least %s%s",
+			uphotonToMint, types.Denom, msg.MinPhotonOut, types.Denom)
+	}
 
-	// Burn/Mint phase:
-	// 1) move ATONEs from msg signer address to this module address
-	// 2) burn ATONEs from this module address
-	// 3) mint PHOTONs into this module address
-	// 4) move PHOTONs from this module address to msg signer address
-	var (
-		coinsToBurn = sdk.NewCoins(bondDenomToBurn)
-		coinsToMint = sdk.NewCoins(sdk.NewCoin(types.Denom, uphotonToMint))
-	)
-	// 1) Send atone to photon module for burn
 	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
 	if err != nil {
 		return nil, err
 	}
-	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, to, types.ModuleName, coinsToBurn); err != nil {
-		return nil, err
-	}
-	// 2) Burn atone
-	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coinsToBurn); err != nil {
-		return nil, err
-	}
-
-	// 3) Mint photons
-	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coinsToMint); err != nil {
-		return nil, err
-	}
-	// 4) Send minted photon to account
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coinsToMint); err != nil {
+	minted, err := k.burnAndMint(ctx, params, to, bondDenomToBurn, uphotonToMint)
+	if err != nil {
 		return nil, err
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeMintPhoton,
-			sdk.NewAttribute(types.AttributeKeyBurned, coinsToBurn.String()),
-			sdk.NewAttribute(types.AttributeKeyMinted, coinsToMint.String()),
-		),
-	})
-
 	return &types.MsgMintPhotonResponse{
-		Minted:         coinsToMint[0],
+		Minted:         minted,
 		ConversionRate: conversionRate.String(),
 	}, nil
 }
@@ -106,9 +93,72 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Params.MintLimitEpochIdentifier != "" {
+		// Ensure the epoch used by the mint limits exists, otherwise all mints
+		// would fail.
+		if _, err := k.epochsKeeper.GetEpochInfo(ctx, msg.Params.MintLimitEpochIdentifier); err != nil {
+			return nil, errors.Wrapf(err, "invalid mint limit epoch identifier %s", msg.Params.MintLimitEpochIdentifier)
+		}
+	}
+	for _, feeDenom := range msg.Params.AcceptedFeeDenoms {
+		if feeDenom.Conversion != types.FeeDenomConversionRate {
+			continue
+		}
+		// The photon conversion rate only prices the bond denom.
+		bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if feeDenom.Denom != bondDenom {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest,
+				"accepted fee denom %s cannot use the conversion rate, only %s can", feeDenom.Denom, bondDenom)
+		}
+	}
 	if err := k.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// CreateBuybackSchedule implements the MsgServer.CreateBuybackSchedule method.
+func (k msgServer) CreateBuybackSchedule(goCtx context.Context, msg *types.MsgCreateBuybackSchedule) (*types.MsgCreateBuybackScheduleResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(sdkgovtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	epochInfo, err := k.epochsKeeper.GetEpochInfo(ctx, msg.EpochIdentifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid buyback epoch identifier %s", msg.EpochIdentifier)
+	}
+	id := k.GetNextBuybackScheduleID(ctx)
+	k.SetBuybackSchedule(ctx, types.BuybackSchedule{
+		Id:              id,
+		EpochIdentifier: msg.EpochIdentifier,
+		EpochInterval:   msg.EpochInterval,
+		Fraction:        msg.Fraction,
+		Amount:          msg.Amount,
+		StartEpoch:      epochInfo.CurrentEpoch,
+		TotalBurned:     math.ZeroInt(),
+		TotalMinted:     math.ZeroInt(),
+	})
+	k.SetNextBuybackScheduleID(ctx, id+1)
+
+	return &types.MsgCreateBuybackScheduleResponse{Id: id}, nil
+}
+
+// CancelBuybackSchedule implements the MsgServer.CancelBuybackSchedule method.
+func (k msgServer) CancelBuybackSchedule(goCtx context.Context, msg *types.MsgCancelBuybackSchedule) (*types.MsgCancelBuybackScheduleResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(sdkgovtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetBuybackSchedule(ctx, msg.Id); !found {
+		return nil, errors.Wrapf(types.ErrBuybackScheduleNotFound, "id %d", msg.Id)
+	}
+	k.DeleteBuybackSchedule(ctx, msg.Id)
+
+	return &types.MsgCancelBuybackScheduleResponse{}, nil
+}
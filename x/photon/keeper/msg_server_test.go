@@ -1,12 +1,18 @@
 package keeper_test
 
 import (
+	"errors"
 	"math"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 
 	appparams "github.com/atomone-hub/atomone/app/params"
 	"github.com/atomone-hub/atomone/x/photon/testutil"
@@ -17,10 +23,14 @@ func TestMsgServerMintPhoton(t *testing.T) {
 	var (
 		toAddress         = sdk.AccAddress("test1")
 		atoneSupply int64 = 107_775_332 * 1_000_000 // From genesis
+		blockTime         = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		expiredTime       = blockTime.Add(-time.Second)
+		futureTime        = blockTime.Add(time.Hour)
 	)
 	tests := []struct {
 		name             string
 		params           types.Params
+		blockHeight      int64
 		msg              *types.MsgMintPhoton
 		setup            func(sdk.Context, testutil.Mocks)
 		expectedErr      string
@@ -32,6 +42,27 @@ func TestMsgServerMintPhoton(t *testing.T) {
 			msg:         &types.MsgMintPhoton{},
 			expectedErr: "photon mint disabled",
 		},
+		{
+			name:        "fail: expiry height reached",
+			params:      types.Params{MintDisabled: false},
+			blockHeight: 11,
+			msg: &types.MsgMintPhoton{
+				ToAddress:    toAddress.String(),
+				Amount:       sdk.NewInt64Coin(appparams.BondDenom, 1),
+				ExpiryHeight: 10,
+			},
+			expectedErr: "block height 11 is after expiry height 10: photon mint message expired",
+		},
+		{
+			name:   "fail: expiry time reached",
+			params: types.Params{MintDisabled: false},
+			msg: &types.MsgMintPhoton{
+				ToAddress:  toAddress.String(),
+				Amount:     sdk.NewInt64Coin(appparams.BondDenom, 1),
+				ExpiryTime: &expiredTime,
+			},
+			expectedErr: "block time 2026-01-01 00:00:00 +0000 UTC is after expiry time 2025-12-31 23:59:59 +0000 UTC: photon mint message expired",
+		},
 		{
 			name:   "fail: empty Amount field",
 			params: types.Params{MintDisabled: false},
@@ -114,10 +145,63 @@ func TestMsgServerMintPhoton(t *testing.T) {
 				ConversionRate: "9.278561071841560182",
 			},
 		},
+		{
+			name:   "fail: minted below min_photon_out",
+			params: types.Params{MintDisabled: false},
+			msg: &types.MsgMintPhoton{
+				ToAddress:    toAddress.String(),
+				Amount:       sdk.NewInt64Coin(appparams.BondDenom, 1),
+				MinPhotonOut: sdkmath.NewInt(10),
+			},
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.StakingKeeper.EXPECT().BondDenom(ctx).Return(appparams.BondDenom, nil)
+				m.BankKeeper.EXPECT().GetSupply(ctx, appparams.BondDenom).
+					Return(sdk.NewInt64Coin(appparams.BondDenom, atoneSupply))
+				m.BankKeeper.EXPECT().GetSupply(ctx, types.Denom).Return(sdk.NewInt64Coin(types.Denom, 0))
+			},
+			expectedErr: "minted 9uphoton, expected at least 10uphoton: minted photons below requested minimum",
+		},
+		{
+			name:        "ok: min_photon_out and expiry honored",
+			params:      types.Params{MintDisabled: false},
+			blockHeight: 10,
+			msg: &types.MsgMintPhoton{
+				ToAddress:    toAddress.String(),
+				Amount:       sdk.NewInt64Coin(appparams.BondDenom, 1),
+				MinPhotonOut: sdkmath.NewInt(9),
+				ExpiryHeight: 10,
+				ExpiryTime:   &futureTime,
+			},
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.StakingKeeper.EXPECT().BondDenom(ctx).Return(appparams.BondDenom, nil)
+				m.BankKeeper.EXPECT().GetSupply(ctx, appparams.BondDenom).
+					Return(sdk.NewInt64Coin(appparams.BondDenom, atoneSupply))
+				m.BankKeeper.EXPECT().GetSupply(ctx, types.Denom).Return(sdk.NewInt64Coin(types.Denom, 0))
+				m.BankKeeper.EXPECT().SendCoinsFromAccountToModule(
+					ctx, toAddress, types.ModuleName,
+					sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 1)),
+				)
+				m.BankKeeper.EXPECT().BurnCoins(ctx, types.ModuleName,
+					sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 1)),
+				)
+				m.BankKeeper.EXPECT().MintCoins(ctx, types.ModuleName,
+					sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 9)),
+				)
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(
+					ctx, types.ModuleName, toAddress,
+					sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 9)),
+				)
+			},
+			expectedResponse: &types.MsgMintPhotonResponse{
+				Minted:         sdk.NewInt64Coin(types.Denom, 9),
+				ConversionRate: "9.278561071841560182",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ms, k, mocks, ctx := testutil.SetupMsgServer(t)
+			ctx = ctx.WithBlockHeight(tt.blockHeight).WithBlockTime(blockTime)
 			require.NoError(t, k.SetParams(ctx, tt.params))
 			if tt.setup != nil {
 				tt.setup(ctx, mocks)
@@ -135,10 +219,144 @@ func TestMsgServerMintPhoton(t *testing.T) {
 	}
 }
 
+func TestMsgServerMintPhotonLimits(t *testing.T) {
+	var (
+		toAddress         = sdk.AccAddress("test1")
+		atoneSupply int64 = 107_775_332 * 1_000_000 // From genesis
+		blockHeight int64 = 10
+		epochNumber int64 = 3
+	)
+	tests := []struct {
+		name              string
+		params            types.Params
+		totals            types.MintTotals
+		addrTotal         types.AddressMintTotal
+		expectedErr       string
+		expectedTotals    types.MintTotals
+		expectedAddrTotal types.AddressMintTotal
+	}{
+		{
+			name:   "fail: block limit exceeded",
+			params: types.Params{MaxMintPerBlock: 10},
+			totals: types.MintTotals{
+				BlockHeight: blockHeight,
+				BlockMinted: 5,
+			},
+			expectedErr: "block limit of 10uphoton exceeded: photon mint limit reached",
+		},
+		{
+			name:   "ok: block limit not exceeded in a new block",
+			params: types.Params{MaxMintPerBlock: 10},
+			totals: types.MintTotals{
+				BlockHeight: blockHeight - 1,
+				BlockMinted: 5,
+			},
+			expectedTotals: types.MintTotals{
+				BlockHeight: blockHeight,
+				BlockMinted: 9,
+				EpochMinted: 9,
+			},
+		},
+		{
+			name:   "fail: epoch limit exceeded",
+			params: types.Params{MaxMintPerEpoch: 10, MintLimitEpochIdentifier: "day"},
+			totals: types.MintTotals{
+				EpochNumber: epochNumber,
+				EpochMinted: 5,
+			},
+			expectedErr: "epoch limit of 10uphoton exceeded: photon mint limit reached",
+		},
+		{
+			name:   "ok: epoch limit not exceeded in a new epoch",
+			params: types.Params{MaxMintPerEpoch: 10, MintLimitEpochIdentifier: "day"},
+			totals: types.MintTotals{
+				EpochNumber: epochNumber - 1,
+				EpochMinted: 5,
+			},
+			expectedTotals: types.MintTotals{
+				BlockHeight: blockHeight,
+				BlockMinted: 9,
+				EpochNumber: epochNumber,
+				EpochMinted: 9,
+			},
+			expectedAddrTotal: types.AddressMintTotal{
+				EpochNumber: epochNumber,
+				Minted:      9,
+			},
+		},
+		{
+			name:   "fail: address epoch limit exceeded",
+			params: types.Params{MaxMintPerAddressPerEpoch: 10, MintLimitEpochIdentifier: "day"},
+			addrTotal: types.AddressMintTotal{
+				EpochNumber: epochNumber,
+				Minted:      5,
+			},
+			expectedErr: "address epoch limit of 10uphoton exceeded: photon mint limit reached",
+		},
+		{
+			name:   "ok: address epoch limit not exceeded in a new epoch",
+			params: types.Params{MaxMintPerAddressPerEpoch: 10, MintLimitEpochIdentifier: "day"},
+			addrTotal: types.AddressMintTotal{
+				EpochNumber: epochNumber - 1,
+				Minted:      5,
+			},
+			expectedTotals: types.MintTotals{
+				BlockHeight: blockHeight,
+				BlockMinted: 9,
+				EpochNumber: epochNumber,
+				EpochMinted: 9,
+			},
+			expectedAddrTotal: types.AddressMintTotal{
+				EpochNumber: epochNumber,
+				Minted:      9,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms, k, m, ctx := testutil.SetupMsgServer(t)
+			ctx = ctx.WithBlockHeight(blockHeight)
+			require.NoError(t, k.SetParams(ctx, tt.params))
+			k.SetMintTotals(ctx, tt.totals)
+			k.SetAddressMintTotal(ctx, toAddress, tt.addrTotal)
+			m.StakingKeeper.EXPECT().BondDenom(ctx).Return(appparams.BondDenom, nil)
+			m.BankKeeper.EXPECT().GetSupply(ctx, appparams.BondDenom).
+				Return(sdk.NewInt64Coin(appparams.BondDenom, atoneSupply))
+			m.BankKeeper.EXPECT().GetSupply(ctx, types.Denom).Return(sdk.NewInt64Coin(types.Denom, 0))
+			if tt.params.MintLimitEpochIdentifier != "" {
+				m.EpochsKeeper.EXPECT().GetEpochInfo(ctx, tt.params.MintLimitEpochIdentifier).
+					Return(epochstypes.EpochInfo{CurrentEpoch: epochNumber}, nil)
+			}
+			if tt.expectedErr == "" {
+				m.BankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, toAddress, types.ModuleName, gomock.Any())
+				m.BankKeeper.EXPECT().BurnCoins(ctx, types.ModuleName, gomock.Any())
+				m.BankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, gomock.Any())
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAddress, gomock.Any())
+			}
+
+			_, err := ms.MintPhoton(ctx, &types.MsgMintPhoton{
+				ToAddress: toAddress.String(),
+				Amount:    sdk.NewInt64Coin(appparams.BondDenom, 1),
+			})
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedTotals, k.GetMintTotals(ctx))
+			if tt.params.MintLimitEpochIdentifier != "" {
+				require.Equal(t, tt.expectedAddrTotal, k.GetAddressMintTotal(ctx, toAddress))
+			}
+		})
+	}
+}
+
 func TestMsgServerUpdateParams(t *testing.T) {
 	tests := []struct {
 		name        string
 		msg         *types.MsgUpdateParams
+		setup       func(sdk.Context, testutil.Mocks)
 		expectedErr string
 	}{
 		{
@@ -157,6 +375,17 @@ func TestMsgServerUpdateParams(t *testing.T) {
 			},
 			expectedErr: "invalid authority; expected cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn, got xxx: expected gov account as only signer for proposal message",
 		},
+		{
+			name: "unknown mint limit epoch identifier",
+			msg: &types.MsgUpdateParams{
+				Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+				Params:    types.Params{MintLimitEpochIdentifier: "xxx"},
+			},
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.EpochsKeeper.EXPECT().GetEpochInfo(ctx, "xxx").Return(epochstypes.EpochInfo{}, errors.New("not found"))
+			},
+			expectedErr: "invalid mint limit epoch identifier xxx: not found",
+		},
 		{
 			name: "ok",
 			msg: &types.MsgUpdateParams{
@@ -164,12 +393,60 @@ func TestMsgServerUpdateParams(t *testing.T) {
 				Params:    types.Params{MintDisabled: true},
 			},
 		},
+		{
+			name: "conversion rate for non bond denom",
+			msg: &types.MsgUpdateParams{
+				Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+				Params: types.Params{
+					AcceptedFeeDenoms: []types.AcceptedFeeDenom{
+						{Denom: "xxx", Conversion: types.FeeDenomConversionRate},
+					},
+				},
+			},
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.StakingKeeper.EXPECT().BondDenom(ctx).Return(appparams.BondDenom, nil)
+			},
+			expectedErr: "accepted fee denom xxx cannot use the conversion rate, only uatone can: invalid request",
+		},
+		{
+			name: "ok: with accepted fee denoms",
+			msg: &types.MsgUpdateParams{
+				Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+				Params: types.Params{
+					AcceptedFeeDenoms: []types.AcceptedFeeDenom{
+						{Denom: "ibc/photon", Conversion: types.FeeDenomConversionPegged},
+						{Denom: appparams.BondDenom, Conversion: types.FeeDenomConversionRate},
+						{Denom: "xxx", Conversion: types.FeeDenomConversionFixedRate, FixedRate: "0.5"},
+					},
+				},
+			},
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.StakingKeeper.EXPECT().BondDenom(ctx).Return(appparams.BondDenom, nil)
+			},
+		},
+		{
+			name: "ok: with mint limits",
+			msg: &types.MsgUpdateParams{
+				Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+				Params: types.Params{
+					MaxMintPerBlock:          1_000_000,
+					MaxMintPerEpoch:          10_000_000,
+					MintLimitEpochIdentifier: "day",
+				},
+			},
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.EpochsKeeper.EXPECT().GetEpochInfo(ctx, "day").Return(epochstypes.EpochInfo{Identifier: "day"}, nil)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ms, k, _, ctx := testutil.SetupMsgServer(t)
+			ms, k, m, ctx := testutil.SetupMsgServer(t)
 			params := types.DefaultParams()
 			require.NoError(t, k.SetParams(ctx, params))
+			if tt.setup != nil {
+				tt.setup(ctx, m)
+			}
 
 			_, err := ms.UpdateParams(ctx, tt.msg)
 
@@ -183,3 +460,126 @@ func TestMsgServerUpdateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgServerCreateBuybackSchedule(t *testing.T) {
+	authority := "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+	tests := []struct {
+		name             string
+		msg              *types.MsgCreateBuybackSchedule
+		setup            func(sdk.Context, testutil.Mocks)
+		expectedErr      string
+		expectedSchedule types.BuybackSchedule
+	}{
+		{
+			name: "invalid authority field",
+			msg: &types.MsgCreateBuybackSchedule{
+				Authority:       "xxx",
+				EpochIdentifier: "week",
+				EpochInterval:   1,
+				Amount:          sdkmath.NewInt(1),
+			},
+			expectedErr: "invalid authority; expected cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn, got xxx: expected gov account as only signer for proposal message",
+		},
+		{
+			name: "unknown epoch identifier",
+			msg: &types.MsgCreateBuybackSchedule{
+				Authority:       authority,
+				EpochIdentifier: "xxx",
+				EpochInterval:   1,
+				Amount:          sdkmath.NewInt(1),
+			},
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.EpochsKeeper.EXPECT().GetEpochInfo(ctx, "xxx").Return(epochstypes.EpochInfo{}, errors.New("not found"))
+			},
+			expectedErr: "invalid buyback epoch identifier xxx: not found",
+		},
+		{
+			name: "ok",
+			msg: &types.MsgCreateBuybackSchedule{
+				Authority:       authority,
+				EpochIdentifier: "week",
+				EpochInterval:   4,
+				Fraction:        sdkmath.LegacyNewDecWithPrec(1, 1),
+			},
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.EpochsKeeper.EXPECT().GetEpochInfo(ctx, "week").Return(epochstypes.EpochInfo{CurrentEpoch: 5}, nil)
+			},
+			expectedSchedule: types.BuybackSchedule{
+				Id:              1,
+				EpochIdentifier: "week",
+				EpochInterval:   4,
+				Fraction:        sdkmath.LegacyNewDecWithPrec(1, 1),
+				Amount:          sdkmath.ZeroInt(),
+				StartEpoch:      5,
+				TotalBurned:     sdkmath.ZeroInt(),
+				TotalMinted:     sdkmath.ZeroInt(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms, k, m, ctx := testutil.SetupMsgServer(t)
+			if tt.setup != nil {
+				tt.setup(ctx, m)
+			}
+
+			resp, err := ms.CreateBuybackSchedule(ctx, tt.msg)
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				require.EqualValues(t, 1, k.GetNextBuybackScheduleID(ctx))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedSchedule.Id, resp.Id)
+			schedule, found := k.GetBuybackSchedule(ctx, resp.Id)
+			require.True(t, found)
+			require.Equal(t, tt.expectedSchedule.String(), schedule.String())
+			require.Equal(t, resp.Id+1, k.GetNextBuybackScheduleID(ctx))
+		})
+	}
+}
+
+func TestMsgServerCancelBuybackSchedule(t *testing.T) {
+	authority := "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+	tests := []struct {
+		name        string
+		msg         *types.MsgCancelBuybackSchedule
+		expectedErr string
+	}{
+		{
+			name:        "invalid authority field",
+			msg:         &types.MsgCancelBuybackSchedule{Authority: "xxx", Id: 1},
+			expectedErr: "invalid authority; expected cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn, got xxx: expected gov account as only signer for proposal message",
+		},
+		{
+			name:        "unknown schedule",
+			msg:         &types.MsgCancelBuybackSchedule{Authority: authority, Id: 2},
+			expectedErr: "id 2: buyback schedule not found",
+		},
+		{
+			name: "ok",
+			msg:  &types.MsgCancelBuybackSchedule{Authority: authority, Id: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms, k, _, ctx := testutil.SetupMsgServer(t)
+			k.SetBuybackSchedule(ctx, types.BuybackSchedule{
+				Id: 1, EpochIdentifier: "week", EpochInterval: 1, Amount: sdkmath.NewInt(1),
+				TotalBurned: sdkmath.ZeroInt(), TotalMinted: sdkmath.ZeroInt(),
+			})
+
+			_, err := ms.CancelBuybackSchedule(ctx, tt.msg)
+
+			_, found := k.GetBuybackSchedule(ctx, 1)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				require.True(t, found)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, found)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// GetZeroFeeRelayerTotals returns the last stored zero-fee relayer totals,
+// which may refer to a past block.
+func (k Keeper) GetZeroFeeRelayerTotals(ctx sdk.Context) (totals types.ZeroFeeRelayerTotals) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ZeroFeeRelayerTotalsKey)
+	if bz == nil {
+		return totals
+	}
+	k.cdc.MustUnmarshal(bz, &totals)
+	return totals
+}
+
+// SetZeroFeeRelayerTotals sets the zero-fee relayer totals.
+func (k Keeper) SetZeroFeeRelayerTotals(ctx sdk.Context, totals types.ZeroFeeRelayerTotals) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ZeroFeeRelayerTotalsKey, k.cdc.MustMarshal(&totals))
+}
+
+// TrackZeroFeeRelayerGas adds gas, the gas limit of a zero-fee relayer tx, to
+// the zero-fee relayer totals of the current block. It returns an error if the
+// MaxZeroFeeRelayerGasPerBlock limit defined in params is exceeded.
+func (k Keeper) TrackZeroFeeRelayerGas(ctx sdk.Context, params types.Params, gas uint64) error {
+	if params.MaxZeroFeeRelayerGasPerBlock == 0 {
+		return errors.Wrap(types.ErrRelayerZeroFee, "zero-fee relayer txs are disabled")
+	}
+	totals := k.GetZeroFeeRelayerTotals(ctx)
+	if totals.BlockHeight != ctx.BlockHeight() {
+		totals.BlockHeight = ctx.BlockHeight()
+		totals.BlockGas = 0
+	}
+	maxGas := params.MaxZeroFeeRelayerGasPerBlock
+	if totals.BlockGas > maxGas || gas > maxGas-totals.BlockGas {
+		return errors.Wrapf(types.ErrRelayerZeroFee, "block gas limit of %d exceeded",
+			params.MaxZeroFeeRelayerGasPerBlock)
+	}
+	totals.BlockGas += gas
+	k.SetZeroFeeRelayerTotals(ctx, totals)
+	return nil
+}
@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/atomone-hub/atomone/x/photon/testutil"
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestTrackZeroFeeRelayerGas(t *testing.T) {
+	k, _, ctx := testutil.SetupPhotonKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+	params := types.DefaultParams()
+	params.MaxZeroFeeRelayerGasPerBlock = 1_000
+
+	require.NoError(t, k.TrackZeroFeeRelayerGas(ctx, params, 600))
+	require.NoError(t, k.TrackZeroFeeRelayerGas(ctx, params, 400))
+	err := k.TrackZeroFeeRelayerGas(ctx, params, 1)
+	require.EqualError(t, err, "block gas limit of 1000 exceeded: zero-fee relayer tx not allowed")
+	require.Equal(t, types.ZeroFeeRelayerTotals{BlockHeight: 10, BlockGas: 1_000}, k.GetZeroFeeRelayerTotals(ctx))
+
+	// the totals are reset at the next block
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, k.TrackZeroFeeRelayerGas(ctx, params, 1_000))
+	require.Equal(t, types.ZeroFeeRelayerTotals{BlockHeight: 11, BlockGas: 1_000}, k.GetZeroFeeRelayerTotals(ctx))
+
+	// a lowered limit rejects the txs once exceeded
+	params.MaxZeroFeeRelayerGasPerBlock = 500
+	err = k.TrackZeroFeeRelayerGas(ctx, params, 1)
+	require.EqualError(t, err, "block gas limit of 500 exceeded: zero-fee relayer tx not allowed")
+
+	// a zero limit disables the zero-fee relayer txs
+	params.MaxZeroFeeRelayerGasPerBlock = 0
+	err = k.TrackZeroFeeRelayerGas(ctx.WithBlockHeight(12), params, 1)
+	require.EqualError(t, err, "zero-fee relayer txs are disabled: zero-fee relayer tx not allowed")
+}
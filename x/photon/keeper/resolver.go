@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/atomone-hub/atomone/x/photon/types"
@@ -15,32 +17,64 @@ func (k Keeper) ConvertToDenom(ctx context.Context, coin sdk.DecCoin, denom stri
 		return coin, nil
 	}
 
-	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	rate, err := k.uphotonPerFeeDenom(ctx, denom)
 	if err != nil {
 		return sdk.DecCoin{}, err
 	}
-
-	if denom == bondDenom {
-		// use the conversion rate to convert bond denom to photon
-		bondDenomSupply := k.bankKeeper.GetSupply(ctx, denom).Amount.ToLegacyDec()
-		uphotonSupply := k.bankKeeper.GetSupply(ctx, types.Denom).Amount.ToLegacyDec()
-		conversionRate := k.PhotonConversionRate(ctx, bondDenomSupply, uphotonSupply)
-
-		// convert bond denom to photon
-		amount := coin.Amount.Quo(conversionRate)
-		return sdk.NewDecCoinFromDec(denom, amount), nil
+	if !rate.IsPositive() {
+		return sdk.DecCoin{}, fmt.Errorf("cannot convert to denom %s: conversion rate is %s", denom, rate)
 	}
 
-	return sdk.DecCoin{}, fmt.Errorf("error resolving denom")
+	// convert photon to denom
+	amount := coin.Amount.Quo(rate)
+	return sdk.NewDecCoinFromDec(denom, amount), nil
 }
 
+// ExtraDenoms returns the denoms, other than photon, that can be used to pay
+// fees: the bond denom and the accepted fee denoms.
 func (k Keeper) ExtraDenoms(ctx context.Context) ([]string, error) {
 	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return []string{
-		bondDenom,
-	}, nil
+	denoms := []string{bondDenom}
+	for _, feeDenom := range k.GetParams(sdk.UnwrapSDKContext(ctx)).AcceptedFeeDenoms {
+		if feeDenom.Denom != bondDenom {
+			denoms = append(denoms, feeDenom.Denom)
+		}
+	}
+	return denoms, nil
+}
+
+// uphotonPerFeeDenom returns the amount of uphoton equivalent to one unit of
+// denom. The bond denom is priced using the photon conversion rate, unless it
+// is overridden in the accepted fee denoms.
+func (k Keeper) uphotonPerFeeDenom(ctx context.Context, denom string) (math.LegacyDec, error) {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	feeDenom, ok := k.GetParams(sdk.UnwrapSDKContext(ctx)).AcceptedFeeDenom(denom)
+	if !ok {
+		if denom != bondDenom {
+			return math.LegacyDec{}, fmt.Errorf("error resolving denom")
+		}
+		feeDenom.Conversion = types.FeeDenomConversionRate
+	}
+
+	switch feeDenom.Conversion {
+	case types.FeeDenomConversionPegged:
+		return math.LegacyOneDec(), nil
+	case types.FeeDenomConversionRate:
+		// use the conversion rate to convert bond denom to photon
+		bondDenomSupply := k.bankKeeper.GetSupply(ctx, bondDenom).Amount.ToLegacyDec()
+		uphotonSupply := k.bankKeeper.GetSupply(ctx, types.Denom).Amount.ToLegacyDec()
+		return k.PhotonConversionRate(ctx, bondDenomSupply, uphotonSupply), nil
+	case types.FeeDenomConversionFixedRate:
+		return math.LegacyNewDecFromStr(feeDenom.FixedRate)
+	default:
+		return math.LegacyDec{}, fmt.Errorf("invalid conversion %s for denom %s", feeDenom.Conversion, denom)
+	}
 }
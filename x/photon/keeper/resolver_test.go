@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/atomone-hub/atomone/app/params"
+	"github.com/atomone-hub/atomone/x/photon/testutil"
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestConvertToDenom(t *testing.T) {
+	acceptedFeeDenoms := []types.AcceptedFeeDenom{
+		{Denom: "ibc/photon", Conversion: types.FeeDenomConversionPegged},
+		{Denom: "xxx", Conversion: types.FeeDenomConversionFixedRate, FixedRate: "0.5"},
+	}
+	tests := []struct {
+		name          string
+		coin          sdk.DecCoin
+		denom         string
+		setup         func(sdk.Context, testutil.Mocks)
+		expectedCoin  sdk.DecCoin
+		expectedError string
+	}{
+		{
+			name:         "ok: same denom",
+			coin:         sdk.NewInt64DecCoin(types.Denom, 10),
+			denom:        types.Denom,
+			expectedCoin: sdk.NewInt64DecCoin(types.Denom, 10),
+		},
+		{
+			name:  "ok: bond denom",
+			coin:  sdk.NewInt64DecCoin(types.Denom, 10),
+			denom: appparams.BondDenom,
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.BankKeeper.EXPECT().GetSupply(ctx, appparams.BondDenom).
+					Return(sdk.NewInt64Coin(appparams.BondDenom, 100_000_000_000_000))
+				m.BankKeeper.EXPECT().GetSupply(ctx, types.Denom).
+					Return(sdk.NewInt64Coin(types.Denom, 100_000_000_000))
+			},
+			// 10 / 9.999
+			expectedCoin: sdk.NewDecCoinFromDec(appparams.BondDenom, math.LegacyMustNewDecFromStr("1.000100010001000100")),
+		},
+		{
+			name:          "fail: bond denom with zero conversion rate",
+			coin:          sdk.NewInt64DecCoin(types.Denom, 10),
+			denom:         appparams.BondDenom,
+			expectedError: "cannot convert to denom uatone: conversion rate is 0.000000000000000000",
+			setup: func(ctx sdk.Context, m testutil.Mocks) {
+				m.BankKeeper.EXPECT().GetSupply(ctx, appparams.BondDenom).
+					Return(sdk.NewInt64Coin(appparams.BondDenom, 100_000_000_000_000))
+				m.BankKeeper.EXPECT().GetSupply(ctx, types.Denom).
+					Return(sdk.NewInt64Coin(types.Denom, types.MaxSupply+1))
+			},
+		},
+		{
+			name:         "ok: pegged denom",
+			coin:         sdk.NewInt64DecCoin(types.Denom, 10),
+			denom:        "ibc/photon",
+			expectedCoin: sdk.NewInt64DecCoin("ibc/photon", 10),
+		},
+		{
+			name:         "ok: fixed rate denom",
+			coin:         sdk.NewInt64DecCoin(types.Denom, 10),
+			denom:        "xxx",
+			expectedCoin: sdk.NewInt64DecCoin("xxx", 20),
+		},
+		{
+			name:          "fail: unknown denom",
+			coin:          sdk.NewInt64DecCoin(types.Denom, 10),
+			denom:         "yyy",
+			expectedError: "error resolving denom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := testutil.SetupPhotonKeeper(t)
+			params := types.DefaultParams()
+			params.AcceptedFeeDenoms = acceptedFeeDenoms
+			require.NoError(t, k.SetParams(ctx, params))
+			m.StakingKeeper.EXPECT().BondDenom(ctx).Return(appparams.BondDenom, nil).AnyTimes()
+			if tt.setup != nil {
+				tt.setup(ctx, m)
+			}
+
+			coin, err := k.ConvertToDenom(ctx, tt.coin, tt.denom)
+
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedCoin.String(), coin.String())
+		})
+	}
+}
+
+func TestExtraDenoms(t *testing.T) {
+	k, m, ctx := testutil.SetupPhotonKeeper(t)
+	params := types.DefaultParams()
+	params.AcceptedFeeDenoms = []types.AcceptedFeeDenom{
+		{Denom: "ibc/photon", Conversion: types.FeeDenomConversionPegged},
+		{Denom: appparams.BondDenom, Conversion: types.FeeDenomConversionFixedRate, FixedRate: "10"},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	m.StakingKeeper.EXPECT().BondDenom(ctx).Return(appparams.BondDenom, nil)
+
+	denoms, err := k.ExtraDenoms(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{appparams.BondDenom, "ibc/photon"}, denoms)
+}
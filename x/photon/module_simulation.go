@@ -1,9 +1,16 @@
 package photon
 
 import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	sdksimulation "github.com/cosmos/cosmos-sdk/x/simulation"
 
+	"github.com/atomone-hub/atomone/x/photon/keeper"
 	"github.com/atomone-hub/atomone/x/photon/simulation"
 	"github.com/atomone-hub/atomone/x/photon/types"
 )
@@ -23,7 +30,29 @@ func (AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Weight
 }
 
 // WeightedOperations returns the all the module operations with their respective weights.
+// The photon invariants are asserted after each operation, since the app
+// doesn't run the registered invariants.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
-	return simulation.WeightedOperations(simState.AppParams, simState.Cdc,
+	operations := simulation.WeightedOperations(simState.AppParams, simState.Cdc,
 		am.accountKeeper, am.bankKeeper, am.stakingKeeper, am.keeper)
+	for i, op := range operations {
+		operations[i] = sdksimulation.NewWeightedOperation(op.Weight(), assertInvariants(op.Op(), am.keeper))
+	}
+	return operations
+}
+
+// assertInvariants returns an operation that runs op and then returns an error
+// if one of the photon invariants is broken.
+func assertInvariants(op simtypes.Operation, k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		opMsg, futureOps, err := op(r, app, ctx, accs, chainID)
+		if err != nil {
+			return opMsg, futureOps, err
+		}
+		if msg, broken := keeper.AllInvariants(k)(ctx); broken {
+			return opMsg, futureOps, fmt.Errorf("invariant broken after %s: %s", opMsg.Name, msg)
+		}
+		return opMsg, futureOps, nil
+	}
 }
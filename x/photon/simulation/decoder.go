@@ -1,16 +1,62 @@
 package simulation
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
 )
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
 // Value to the corresponding photon type.
 func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
-		panic(fmt.Sprintf("invalid photon key prefix %X", kvA.Key[:1]))
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.MintTotalsKey):
+			var totalsA, totalsB types.MintTotals
+			cdc.MustUnmarshal(kvA.Value, &totalsA)
+			cdc.MustUnmarshal(kvB.Value, &totalsB)
+			return fmt.Sprintf("%v\n%v", totalsA, totalsB)
+
+		case bytes.Equal(kvA.Key[:1], types.AddressMintTotalKeyPrefix):
+			var totalA, totalB types.AddressMintTotal
+			cdc.MustUnmarshal(kvA.Value, &totalA)
+			cdc.MustUnmarshal(kvB.Value, &totalB)
+			return fmt.Sprintf("%v\n%v", totalA, totalB)
+
+		case bytes.Equal(kvA.Key[:1], types.MintStatsKey):
+			var statsA, statsB types.MintStats
+			cdc.MustUnmarshal(kvA.Value, &statsA)
+			cdc.MustUnmarshal(kvB.Value, &statsB)
+			return fmt.Sprintf("%v\n%v", statsA, statsB)
+
+		case bytes.Equal(kvA.Key[:1], types.ConversionRateSnapshotKeyPrefix):
+			var snapshotA, snapshotB types.ConversionRateSnapshot
+			cdc.MustUnmarshal(kvA.Value, &snapshotA)
+			cdc.MustUnmarshal(kvB.Value, &snapshotB)
+			return fmt.Sprintf("%v\n%v", snapshotA, snapshotB)
+
+		case bytes.Equal(kvA.Key[:1], types.BuybackScheduleKeyPrefix):
+			var scheduleA, scheduleB types.BuybackSchedule
+			cdc.MustUnmarshal(kvA.Value, &scheduleA)
+			cdc.MustUnmarshal(kvB.Value, &scheduleB)
+			return fmt.Sprintf("%v\n%v", scheduleA, scheduleB)
+
+		case bytes.Equal(kvA.Key[:1], types.NextBuybackScheduleIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.ZeroFeeRelayerTotalsKey):
+			var totalsA, totalsB types.ZeroFeeRelayerTotals
+			cdc.MustUnmarshal(kvA.Value, &totalsA)
+			cdc.MustUnmarshal(kvB.Value, &totalsB)
+			return fmt.Sprintf("%v\n%v", totalsA, totalsB)
+
+		default:
+			panic(fmt.Sprintf("invalid photon key prefix %X", kvA.Key[:1]))
+		}
 	}
 }
@@ -24,6 +24,8 @@ type Mocks struct {
 	AccountKeeper *MockAccountKeeper
 	BankKeeper    *MockBankKeeper
 	StakingKeeper *MockStakingKeeper
+	EpochsKeeper  *MockEpochsKeeper
+	DistrKeeper   *MockDistributionKeeper
 }
 
 func SetupMsgServer(t *testing.T) (types.MsgServer, *keeper.Keeper, Mocks, sdk.Context) {
@@ -43,6 +45,8 @@ func SetupPhotonKeeper(t *testing.T) (
 		AccountKeeper: NewMockAccountKeeper(ctrl),
 		BankKeeper:    NewMockBankKeeper(ctrl),
 		StakingKeeper: NewMockStakingKeeper(ctrl),
+		EpochsKeeper:  NewMockEpochsKeeper(ctrl),
+		DistrKeeper:   NewMockDistributionKeeper(ctrl),
 	}
 
 	key := storetypes.NewKVStoreKey(types.StoreKey)
@@ -52,5 +56,5 @@ func SetupPhotonKeeper(t *testing.T) (
 	types.RegisterInterfaces(encCfg.InterfaceRegistry)
 	// banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
-	return keeper.NewKeeper(encCfg.Codec, key, authority, m.BankKeeper, m.AccountKeeper, m.StakingKeeper), m, ctx
+	return keeper.NewKeeper(encCfg.Codec, key, authority, m.BankKeeper, m.AccountKeeper, m.StakingKeeper, m.EpochsKeeper, m.DistrKeeper), m, ctx
 }
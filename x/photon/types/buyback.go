@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Validate validates the buyback schedule.
+func (s BuybackSchedule) Validate() error {
+	if s.Id == 0 {
+		return fmt.Errorf("buyback schedule id must be positive")
+	}
+	if err := ValidateBuybackConversion(s.EpochIdentifier, s.EpochInterval, s.Fraction, s.Amount); err != nil {
+		return err
+	}
+	if s.StartEpoch < 0 || s.LastExecutionEpoch < 0 {
+		return fmt.Errorf("buyback schedule epochs cannot be negative")
+	}
+	if s.TotalBurned.IsNil() || s.TotalBurned.IsNegative() {
+		return fmt.Errorf("invalid buyback schedule total burned: %s", s.TotalBurned)
+	}
+	if s.TotalMinted.IsNil() || s.TotalMinted.IsNegative() {
+		return fmt.Errorf("invalid buyback schedule total minted: %s", s.TotalMinted)
+	}
+	return nil
+}
+
+// ValidateBuybackConversion validates the conversion defined by a buyback
+// schedule: it must be paced by an epoch, and exactly one of fraction and
+// amount must be set.
+func ValidateBuybackConversion(epochIdentifier string, epochInterval uint64, fraction math.LegacyDec, amount math.Int) error {
+	if epochIdentifier == "" {
+		return fmt.Errorf("epoch identifier cannot be empty")
+	}
+	if epochInterval == 0 {
+		return fmt.Errorf("epoch interval must be positive")
+	}
+	var (
+		hasFraction = !fraction.IsNil() && !fraction.IsZero()
+		hasAmount   = !amount.IsNil() && !amount.IsZero()
+	)
+	if hasFraction == hasAmount {
+		return fmt.Errorf("exactly one of fraction and amount must be set")
+	}
+	if hasFraction && (fraction.IsNegative() || fraction.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("fraction must be greater than 0 and lower than or equal to 1, got %s", fraction)
+	}
+	if hasAmount && amount.IsNegative() {
+		return fmt.Errorf("amount must be positive, got %s", amount)
+	}
+	return nil
+}
@@ -6,17 +6,24 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMintPhoton{}, "atomone/photon/v1/MsgMintPhoton")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "atomone/x/photon/v1/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateBuybackSchedule{}, "atomone/x/photon/v1/MsgCreateBuyback")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelBuybackSchedule{}, "atomone/x/photon/v1/MsgCancelBuyback")
 	cdc.RegisterConcrete(&Params{}, "atomone/photon/v1/Params", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgMintPhoton{}, &MsgUpdateParams{},
+		&MsgCreateBuybackSchedule{}, &MsgCancelBuybackSchedule{},
+	)
+	registry.RegisterImplementations((*tx.TxExtensionOptionI)(nil),
+		&ExtensionOptionMintOnFee{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -11,4 +11,11 @@ var (
 	ErrZeroMintPhotons  = errorsmod.Register(ModuleName, 3, "no mintable photon after rounding, try higher burn")
 	ErrTooManyFeeCoins  = errorsmod.Register(ModuleName, 5, "too many fee coins, only accepts fees in one denom")
 	ErrInvalidFeeToken  = errorsmod.Register(ModuleName, 6, "invalid fee token")
+	ErrMintBelowMinimum = errorsmod.Register(ModuleName, 7, "minted photons below requested minimum")
+	ErrMintExpired      = errorsmod.Register(ModuleName, 8, "photon mint message expired")
+	ErrMintLimitReached = errorsmod.Register(ModuleName, 9, "photon mint limit reached")
+	ErrMintOnFee        = errorsmod.Register(ModuleName, 10, "photon mint-on-fee failed")
+
+	ErrBuybackScheduleNotFound = errorsmod.Register(ModuleName, 11, "buyback schedule not found")
+	ErrRelayerZeroFee          = errorsmod.Register(ModuleName, 12, "zero-fee relayer tx not allowed")
 )
@@ -3,7 +3,9 @@ package types
 // Photon  module event types
 const (
 	EventTypeMintPhoton = "mint_photon"
+	EventTypeBuyback    = "photon_buyback"
 
-	AttributeKeyBurned = "burned"
-	AttributeKeyMinted = "minted"
+	AttributeKeyBurned     = "burned"
+	AttributeKeyMinted     = "minted"
+	AttributeKeyScheduleID = "schedule_id"
 )
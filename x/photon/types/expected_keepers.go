@@ -4,6 +4,8 @@ import (
 	context "context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -23,5 +25,19 @@ type BankKeeper interface {
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	GetSupply(ctx context.Context, denom string) sdk.Coin
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// EpochsKeeper defines the expected epochs keeper.
+type EpochsKeeper interface {
+	GetEpochInfo(ctx context.Context, identifier string) (epochstypes.EpochInfo, error)
+}
+
+// DistributionKeeper defines the expected distribution keeper, used to convert
+// the community pool funds.
+type DistributionKeeper interface {
+	GetFeePool(ctx context.Context) (distrtypes.FeePool, error)
+	SetFeePool(ctx context.Context, feePool distrtypes.FeePool) error
 }
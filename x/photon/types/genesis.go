@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
 // NewGenesisState creates a new genesis state for the governance module
 func NewGenesisState(params Params) *GenesisState {
 	return &GenesisState{
@@ -15,5 +21,44 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.ValidateBasic()
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+	if gs.MintStats != nil {
+		if gs.MintStats.TotalBurned.IsNil() || gs.MintStats.TotalBurned.IsNegative() {
+			return fmt.Errorf("invalid mint stats total burned: %s", gs.MintStats.TotalBurned)
+		}
+		if gs.MintStats.TotalMinted.IsNil() || gs.MintStats.TotalMinted.IsNegative() {
+			return fmt.Errorf("invalid mint stats total minted: %s", gs.MintStats.TotalMinted)
+		}
+		if gs.MintStats.SinceHeight < 0 {
+			return fmt.Errorf("invalid mint stats since height: %d", gs.MintStats.SinceHeight)
+		}
+	}
+	var lastHeight int64
+	for _, snapshot := range gs.ConversionRateSnapshots {
+		if snapshot.Height <= lastHeight {
+			return fmt.Errorf("conversion rate snapshots must have positive and strictly increasing heights, got %d after %d",
+				snapshot.Height, lastHeight)
+		}
+		lastHeight = snapshot.Height
+		if _, err := math.LegacyNewDecFromStr(snapshot.ConversionRate); err != nil {
+			return fmt.Errorf("invalid conversion rate snapshot at height %d: %w", snapshot.Height, err)
+		}
+	}
+	seenScheduleIDs := make(map[uint64]bool, len(gs.BuybackSchedules))
+	for _, schedule := range gs.BuybackSchedules {
+		if err := schedule.Validate(); err != nil {
+			return fmt.Errorf("invalid buyback schedule %d: %w", schedule.Id, err)
+		}
+		if seenScheduleIDs[schedule.Id] {
+			return fmt.Errorf("duplicate buyback schedule id %d", schedule.Id)
+		}
+		seenScheduleIDs[schedule.Id] = true
+		if schedule.Id >= gs.NextBuybackScheduleId {
+			return fmt.Errorf("buyback schedule id %d must be lower than next buyback schedule id %d",
+				schedule.Id, gs.NextBuybackScheduleId)
+		}
+	}
+	return nil
 }
@@ -27,6 +27,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the x/photon module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// mint_stats holds the cumulative mint statistics. If not set, they are
+	// initialized from the x/bank uphoton supply.
+	MintStats *MintStats `protobuf:"bytes,2,opt,name=mint_stats,json=mintStats,proto3" json:"mint_stats,omitempty"`
+	// conversion_rate_snapshots holds the conversion rate snapshots.
+	ConversionRateSnapshots []ConversionRateSnapshot `protobuf:"bytes,3,rep,name=conversion_rate_snapshots,json=conversionRateSnapshots,proto3" json:"conversion_rate_snapshots"`
+	// buyback_schedules holds the active buyback schedules.
+	BuybackSchedules []BuybackSchedule `protobuf:"bytes,4,rep,name=buyback_schedules,json=buybackSchedules,proto3" json:"buyback_schedules"`
+	// next_buyback_schedule_id is the id of the next created buyback schedule.
+	NextBuybackScheduleId uint64 `protobuf:"varint,5,opt,name=next_buyback_schedule_id,json=nextBuybackScheduleId,proto3" json:"next_buyback_schedule_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +78,34 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetMintStats() *MintStats {
+	if m != nil {
+		return m.MintStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConversionRateSnapshots() []ConversionRateSnapshot {
+	if m != nil {
+		return m.ConversionRateSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBuybackSchedules() []BuybackSchedule {
+	if m != nil {
+		return m.BuybackSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextBuybackScheduleId() uint64 {
+	if m != nil {
+		return m.NextBuybackScheduleId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "atomone.photon.v1.GenesisState")
 }
@@ -76,21 +113,30 @@ func init() {
 func init() { proto.RegisterFile("atomone/photon/v1/genesis.proto", fileDescriptor_bd52513321c28864) }
 
 var fileDescriptor_bd52513321c28864 = []byte{
-	// 213 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x2c, 0xc9, 0xcf,
-	0xcd, 0xcf, 0x4b, 0xd5, 0x2f, 0xc8, 0xc8, 0x2f, 0xc9, 0xcf, 0xd3, 0x2f, 0x33, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x2a, 0xd0,
-	0x83, 0x28, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x72, 0x98, 0x26, 0x41, 0xb5, 0x40, 0xe4, 0x05, 0x13, 0x73, 0x33, 0xf3, 0xf2,
-	0xf5, 0xc1, 0x24, 0x44, 0x48, 0xc9, 0x87, 0x8b, 0xc7, 0x1d, 0x62, 0x59, 0x70, 0x49, 0x62, 0x49,
-	0xaa, 0x90, 0x0d, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06,
-	0xb7, 0x91, 0xa4, 0x1e, 0x86, 0xe5, 0x7a, 0x01, 0x60, 0x05, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33,
-	0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa, 0xc7, 0xc9, 0xfd, 0xc4, 0x23, 0x39, 0xc6, 0x0b,
-	0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86,
-	0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x74, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73,
-	0xf5, 0xa1, 0x26, 0xea, 0x66, 0x94, 0x26, 0xc1, 0xd8, 0xfa, 0x15, 0x30, 0x37, 0x97, 0x54, 0x16,
-	0xa4, 0x16, 0x27, 0xb1, 0x81, 0x5d, 0x67, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x05, 0xe6, 0x1f,
-	0xfb, 0x1c, 0x01, 0x00, 0x00,
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x3f, 0x6b, 0xf2, 0x50,
+	0x14, 0xc6, 0x93, 0x57, 0x5f, 0xc1, 0xeb, 0x3b, 0xbc, 0x86, 0x96, 0x46, 0x29, 0x51, 0x9c, 0x6c,
+	0xc1, 0x04, 0xed, 0xd0, 0xa1, 0x9d, 0xd2, 0x41, 0x3a, 0x14, 0x4a, 0xa4, 0x4b, 0x97, 0x70, 0x13,
+	0x2f, 0x49, 0xb0, 0xb9, 0x37, 0xe4, 0x9c, 0x88, 0x7e, 0x81, 0xce, 0xfd, 0x18, 0x1d, 0xfb, 0x31,
+	0x1c, 0x1d, 0x3b, 0x95, 0xa2, 0x43, 0xbf, 0x46, 0xc9, 0x1f, 0x0b, 0xd5, 0x2c, 0x97, 0xc3, 0x7d,
+	0x9e, 0xe7, 0xf7, 0x1c, 0x38, 0xa4, 0x43, 0x51, 0x84, 0x82, 0x33, 0x23, 0xf2, 0x05, 0x0a, 0x6e,
+	0xcc, 0x87, 0x86, 0xc7, 0x38, 0x83, 0x00, 0xf4, 0x28, 0x16, 0x28, 0x94, 0x66, 0x61, 0xd0, 0x73,
+	0x83, 0x3e, 0x1f, 0xb6, 0x8f, 0x3c, 0xe1, 0x89, 0x4c, 0x35, 0xd2, 0x29, 0x37, 0xb6, 0xb5, 0x43,
+	0x52, 0x11, 0xc9, 0xf5, 0x26, 0x0d, 0x03, 0x2e, 0x8c, 0xec, 0xcd, 0xbf, 0x7a, 0xcf, 0x15, 0xf2,
+	0x6f, 0x9c, 0xb7, 0x4d, 0x90, 0x22, 0x53, 0xae, 0x49, 0x2d, 0xa2, 0x31, 0x0d, 0x41, 0x95, 0xbb,
+	0x72, 0xbf, 0x31, 0x6a, 0xe9, 0x07, 0xed, 0xfa, 0x7d, 0x66, 0x30, 0xeb, 0xab, 0x8f, 0x8e, 0xf4,
+	0xfa, 0xf5, 0x76, 0x2e, 0x5b, 0x45, 0x46, 0xb9, 0x22, 0x24, 0x0c, 0x38, 0xda, 0x80, 0x14, 0x41,
+	0xfd, 0x93, 0x11, 0x4e, 0x4b, 0x08, 0x77, 0x01, 0xc7, 0xb4, 0x0f, 0xac, 0x7a, 0xb8, 0x1b, 0x95,
+	0x19, 0x69, 0xb9, 0x82, 0xcf, 0x59, 0x0c, 0x81, 0xe0, 0x76, 0x4c, 0x91, 0xd9, 0xc0, 0x69, 0x04,
+	0xbe, 0x40, 0x50, 0x2b, 0xdd, 0x4a, 0xbf, 0x31, 0x3a, 0x2b, 0x61, 0xdd, 0xfc, 0x64, 0x2c, 0x8a,
+	0x6c, 0x52, 0x24, 0xcc, 0x6a, 0xba, 0x9d, 0x75, 0xe2, 0x96, 0xaa, 0xa0, 0x3c, 0x90, 0xa6, 0x93,
+	0x2c, 0x1d, 0xea, 0xce, 0x6c, 0x70, 0x7d, 0x36, 0x4d, 0x9e, 0x18, 0xa8, 0xd5, 0xac, 0xa4, 0x57,
+	0x52, 0x62, 0xe6, 0xde, 0x49, 0x61, 0x2d, 0xe8, 0xff, 0x9d, 0xdf, 0xdf, 0xa0, 0x5c, 0x12, 0x95,
+	0xb3, 0x05, 0xda, 0xfb, 0x6c, 0x3b, 0x98, 0xaa, 0x7f, 0xbb, 0x72, 0xbf, 0x6a, 0x1d, 0xa7, 0xfa,
+	0x1e, 0xee, 0x76, 0x6a, 0x8e, 0x57, 0x1b, 0x4d, 0x5e, 0x6f, 0x34, 0xf9, 0x73, 0xa3, 0xc9, 0x2f,
+	0x5b, 0x4d, 0x5a, 0x6f, 0x35, 0xe9, 0x7d, 0xab, 0x49, 0x8f, 0x03, 0x2f, 0x40, 0x3f, 0x71, 0x74,
+	0x57, 0x84, 0x46, 0xb1, 0xd8, 0xc0, 0x4f, 0x9c, 0xdd, 0x6c, 0x2c, 0x76, 0xe7, 0xc6, 0x65, 0xc4,
+	0xc0, 0xa9, 0x65, 0x87, 0xbd, 0xf8, 0x1e, 0x00, 0xe8, 0x78, 0x2e, 0xb8, 0x57, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +159,51 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextBuybackScheduleId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextBuybackScheduleId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BuybackSchedules) > 0 {
+		for iNdEx := len(m.BuybackSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuybackSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ConversionRateSnapshots) > 0 {
+		for iNdEx := len(m.ConversionRateSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionRateSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MintStats != nil {
+		{
+			size, err := m.MintStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -145,6 +236,25 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.MintStats != nil {
+		l = m.MintStats.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ConversionRateSnapshots) > 0 {
+		for _, e := range m.ConversionRateSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BuybackSchedules) > 0 {
+		for _, e := range m.BuybackSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextBuybackScheduleId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextBuybackScheduleId))
+	}
 	return n
 }
 
@@ -216,6 +326,129 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintStats == nil {
+				m.MintStats = &MintStats{}
+			}
+			if err := m.MintStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRateSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionRateSnapshots = append(m.ConversionRateSnapshots, ConversionRateSnapshot{})
+			if err := m.ConversionRateSnapshots[len(m.ConversionRateSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuybackSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuybackSchedules = append(m.BuybackSchedules, BuybackSchedule{})
+			if err := m.BuybackSchedules[len(m.BuybackSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBuybackScheduleId", wireType)
+			}
+			m.NextBuybackScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextBuybackScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
+
+	"cosmossdk.io/math"
 
 	"github.com/atomone-hub/atomone/x/photon/types"
 	"github.com/stretchr/testify/require"
@@ -23,6 +26,142 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc: "valid mint stats and snapshots",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MintStats: &types.MintStats{
+					TotalBurned: math.NewInt(1),
+					TotalMinted: math.NewInt(2),
+					MintCount:   1,
+					SinceHeight: 10,
+				},
+				ConversionRateSnapshots: []types.ConversionRateSnapshot{
+					{Height: 1, Time: time.Unix(1, 0), ConversionRate: "2.0"},
+					{Height: 2, Time: time.Unix(2, 0), ConversionRate: "1.5"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "negative mint stats",
+			genState: &types.GenesisState{
+				MintStats: &types.MintStats{
+					TotalBurned: math.NewInt(-1),
+					TotalMinted: math.NewInt(2),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "negative mint stats since height",
+			genState: &types.GenesisState{
+				MintStats: &types.MintStats{
+					TotalBurned: math.NewInt(1),
+					TotalMinted: math.NewInt(2),
+					SinceHeight: -1,
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "nil mint stats amount",
+			genState: &types.GenesisState{
+				MintStats: &types.MintStats{TotalBurned: math.NewInt(1)},
+			},
+			valid: false,
+		},
+		{
+			desc: "unordered snapshots",
+			genState: &types.GenesisState{
+				ConversionRateSnapshots: []types.ConversionRateSnapshot{
+					{Height: 2, ConversionRate: "2.0"},
+					{Height: 1, ConversionRate: "1.5"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero snapshot height",
+			genState: &types.GenesisState{
+				ConversionRateSnapshots: []types.ConversionRateSnapshot{
+					{Height: 0, ConversionRate: "2.0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid snapshot conversion rate",
+			genState: &types.GenesisState{
+				ConversionRateSnapshots: []types.ConversionRateSnapshot{
+					{Height: 1, ConversionRate: "xxx"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid buyback schedules",
+			genState: &types.GenesisState{
+				BuybackSchedules: []types.BuybackSchedule{
+					{
+						Id: 1, EpochIdentifier: "day", EpochInterval: 7,
+						Fraction: math.LegacyNewDecWithPrec(5, 1), Amount: math.ZeroInt(),
+						TotalBurned: math.ZeroInt(), TotalMinted: math.ZeroInt(),
+					},
+					{
+						Id: 3, EpochIdentifier: "week", EpochInterval: 1,
+						Fraction: math.LegacyZeroDec(), Amount: math.NewInt(1_000),
+						TotalBurned: math.NewInt(1_000), TotalMinted: math.NewInt(9_000),
+					},
+				},
+				NextBuybackScheduleId: 4,
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate buyback schedule id",
+			genState: &types.GenesisState{
+				BuybackSchedules: []types.BuybackSchedule{
+					{
+						Id: 1, EpochIdentifier: "day", EpochInterval: 7, Amount: math.NewInt(1),
+						TotalBurned: math.ZeroInt(), TotalMinted: math.ZeroInt(),
+					},
+					{
+						Id: 1, EpochIdentifier: "day", EpochInterval: 7, Amount: math.NewInt(1),
+						TotalBurned: math.ZeroInt(), TotalMinted: math.ZeroInt(),
+					},
+				},
+				NextBuybackScheduleId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "buyback schedule id not lower than next id",
+			genState: &types.GenesisState{
+				BuybackSchedules: []types.BuybackSchedule{
+					{
+						Id: 2, EpochIdentifier: "day", EpochInterval: 7, Amount: math.NewInt(1),
+						TotalBurned: math.ZeroInt(), TotalMinted: math.ZeroInt(),
+					},
+				},
+				NextBuybackScheduleId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid buyback schedule",
+			genState: &types.GenesisState{
+				BuybackSchedules: []types.BuybackSchedule{
+					{
+						Id: 1, EpochIdentifier: "day", EpochInterval: 7,
+						Fraction: math.LegacyNewDecWithPrec(5, 1), Amount: math.NewInt(1),
+						TotalBurned: math.ZeroInt(), TotalMinted: math.ZeroInt(),
+					},
+				},
+				NextBuybackScheduleId: 2,
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "photon"
@@ -11,4 +16,30 @@ const (
 	RouterKey = ModuleName
 )
 
-var ParamsKey = []byte{0x00}
+var (
+	ParamsKey                 = []byte{0x00}
+	MintTotalsKey             = []byte{0x01}
+	AddressMintTotalKeyPrefix = []byte{0x02}
+	MintStatsKey              = []byte{0x03}
+
+	ConversionRateSnapshotKeyPrefix = []byte{0x04}
+	BuybackScheduleKeyPrefix        = []byte{0x05}
+	NextBuybackScheduleIDKey        = []byte{0x06}
+	ZeroFeeRelayerTotalsKey         = []byte{0x07}
+)
+
+// AddressMintTotalKey returns the key of the AddressMintTotal of addr.
+func AddressMintTotalKey(addr sdk.AccAddress) []byte {
+	return append(AddressMintTotalKeyPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// ConversionRateSnapshotKey returns the key of the ConversionRateSnapshot at
+// height.
+func ConversionRateSnapshotKey(height int64) []byte {
+	return append(ConversionRateSnapshotKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// BuybackScheduleKey returns the key of the BuybackSchedule with id.
+func BuybackScheduleKey(id uint64) []byte {
+	return append(BuybackScheduleKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
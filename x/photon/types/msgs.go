@@ -10,7 +10,10 @@ import (
 	"github.com/atomone-hub/atomone/x/gov/types"
 )
 
-var _, _ sdk.Msg = &MsgMintPhoton{}, &MsgUpdateParams{}
+var (
+	_, _ sdk.Msg = &MsgMintPhoton{}, &MsgUpdateParams{}
+	_, _ sdk.Msg = &MsgCreateBuybackSchedule{}, &MsgCancelBuybackSchedule{}
+)
 
 func NewMsgMintPhoton(toAddr sdk.AccAddress, amount sdk.Coin) *MsgMintPhoton {
 	return &MsgMintPhoton{
@@ -33,6 +36,12 @@ func (msg *MsgMintPhoton) ValidateBasic() error {
 	if msg.Amount.Denom != params.BondDenom {
 		return errorsmod.Wrapf(ErrBurnInvalidDenom, "invalid denom %s", msg.Amount.Denom)
 	}
+	if !msg.MinPhotonOut.IsNil() && msg.MinPhotonOut.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "min photon out cannot be negative")
+	}
+	if msg.ExpiryHeight < 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry height cannot be negative")
+	}
 	return nil
 }
 
@@ -50,3 +59,25 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 
 	return msg.Params.ValidateBasic()
 }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateBuybackSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if err := ValidateBuybackConversion(msg.EpochIdentifier, msg.EpochInterval, msg.Fraction, msg.Amount); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelBuybackSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if msg.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("buyback schedule id must be positive")
+	}
+	return nil
+}
@@ -47,6 +47,24 @@ func TestMsgMintPhoton_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidCoins,
 		},
+		{
+			name: "fail: negative min photon out",
+			msg: MsgMintPhoton{
+				ToAddress:    sdk.AccAddress("test1").String(),
+				Amount:       sdk.NewInt64Coin(appparams.BondDenom, 1),
+				MinPhotonOut: math.NewInt(-1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "fail: negative expiry height",
+			msg: MsgMintPhoton{
+				ToAddress:    sdk.AccAddress("test1").String(),
+				Amount:       sdk.NewInt64Coin(appparams.BondDenom, 1),
+				ExpiryHeight: -1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
 		{
 			name: "ok",
 			msg: MsgMintPhoton{
@@ -66,3 +84,109 @@ func TestMsgMintPhoton_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgCreateBuybackSchedule_ValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("gov").String()
+	tests := []struct {
+		name string
+		msg  MsgCreateBuybackSchedule
+		err  error
+	}{
+		{
+			name: "fail: invalid authority",
+			msg: MsgCreateBuybackSchedule{
+				Authority:       "invalid_address",
+				EpochIdentifier: "day",
+				EpochInterval:   1,
+				Amount:          math.NewInt(1),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "fail: empty epoch identifier",
+			msg: MsgCreateBuybackSchedule{
+				Authority:     authority,
+				EpochInterval: 1,
+				Amount:        math.NewInt(1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "fail: zero epoch interval",
+			msg: MsgCreateBuybackSchedule{
+				Authority:       authority,
+				EpochIdentifier: "day",
+				Amount:          math.NewInt(1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "fail: neither fraction nor amount",
+			msg: MsgCreateBuybackSchedule{
+				Authority:       authority,
+				EpochIdentifier: "day",
+				EpochInterval:   1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "fail: both fraction and amount",
+			msg: MsgCreateBuybackSchedule{
+				Authority:       authority,
+				EpochIdentifier: "day",
+				EpochInterval:   1,
+				Fraction:        math.LegacyNewDecWithPrec(1, 1),
+				Amount:          math.NewInt(1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "fail: fraction greater than 1",
+			msg: MsgCreateBuybackSchedule{
+				Authority:       authority,
+				EpochIdentifier: "day",
+				EpochInterval:   1,
+				Fraction:        math.LegacyNewDecWithPrec(11, 1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "fail: negative amount",
+			msg: MsgCreateBuybackSchedule{
+				Authority:       authority,
+				EpochIdentifier: "day",
+				EpochInterval:   1,
+				Amount:          math.NewInt(-1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "ok: fraction",
+			msg: MsgCreateBuybackSchedule{
+				Authority:       authority,
+				EpochIdentifier: "day",
+				EpochInterval:   7,
+				Fraction:        math.LegacyOneDec(),
+			},
+		},
+		{
+			name: "ok: amount",
+			msg: MsgCreateBuybackSchedule{
+				Authority:       authority,
+				EpochIdentifier: "week",
+				EpochInterval:   1,
+				Amount:          math.NewInt(1_000_000),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package types
 
 import (
+	"fmt"
 	"slices"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -15,16 +19,37 @@ func NewParams(mintDisabled bool, txFeeExceptions []string) Params {
 }
 
 const (
-	defaultMintDisabled = false
+	defaultMintDisabled                    = false
+	defaultMintLimitEpochIdentifier        = "day"
+	defaultConversionRateSnapshotInterval  = 600       // ~1 hour with 6s blocks
+	defaultConversionRateSnapshotRetention = 432_000   // ~30 days with 6s blocks
+	defaultMaxMintOnFee                    = 1_000_000 // 1photon
+	defaultMaxZeroFeeRelayerGasPerBlock    = 10_000_000
 )
 
 // NOTE(tb): Not possible to use `sdk.MsgTypeURL(types.MsgMintPhoton{})`
 // instead of plain text because at this step the msg is not registered yet.
 var defaultTxFeeExceptions = []string{"/atomone.photon.v1.MsgMintPhoton"}
 
+// RelayerMsgTypeURLs holds the type URLs of the IBC relayer messages. The txs
+// that only contain these messages are subject to the RelayerFeePolicy param.
+var RelayerMsgTypeURLs = []string{
+	"/ibc.core.channel.v1.MsgRecvPacket",
+	"/ibc.core.channel.v1.MsgAcknowledgement",
+	"/ibc.core.channel.v1.MsgTimeout",
+	"/ibc.core.client.v1.MsgUpdateClient",
+}
+
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(defaultMintDisabled, defaultTxFeeExceptions)
+	params := NewParams(defaultMintDisabled, defaultTxFeeExceptions)
+	params.MintLimitEpochIdentifier = defaultMintLimitEpochIdentifier
+	params.ConversionRateSnapshotInterval = defaultConversionRateSnapshotInterval
+	params.ConversionRateSnapshotRetention = defaultConversionRateSnapshotRetention
+	params.MaxMintOnFee = defaultMaxMintOnFee
+	params.RelayerFeePolicy = RelayerFeePolicyPhotonOnly
+	params.MaxZeroFeeRelayerGasPerBlock = defaultMaxZeroFeeRelayerGasPerBlock
+	return params
 }
 
 // ValidateBasic validates the set of params
@@ -37,5 +62,95 @@ func (p Params) ValidateBasic() error {
 			len(p.TxFeeExceptions),
 		)
 	}
+	if (p.MaxMintPerEpoch > 0 || p.MaxMintPerAddressPerEpoch > 0) && p.MintLimitEpochIdentifier == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap(
+			"mint_limit_epoch_identifier: must be set when a per-epoch mint limit is used",
+		)
+	}
+	if p.ConversionRateSnapshotRetention > 0 && p.ConversionRateSnapshotRetention < p.ConversionRateSnapshotInterval {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"conversion_rate_snapshot_retention: must be greater than or equal to conversion_rate_snapshot_interval (%d < %d)",
+			p.ConversionRateSnapshotRetention, p.ConversionRateSnapshotInterval,
+		)
+	}
+	seenDenoms := make(map[string]bool, len(p.AcceptedFeeDenoms))
+	for _, feeDenom := range p.AcceptedFeeDenoms {
+		if err := feeDenom.Validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("accepted_fee_denoms: %s", err)
+		}
+		if seenDenoms[feeDenom.Denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("accepted_fee_denoms: duplicate denom %s", feeDenom.Denom)
+		}
+		seenDenoms[feeDenom.Denom] = true
+	}
+	if _, ok := RelayerFeePolicy_name[int32(p.RelayerFeePolicy)]; !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("relayer_fee_policy: unknown policy %d", p.RelayerFeePolicy)
+	}
+	for i, rule := range p.TxFeeExceptionRules {
+		if err := rule.Validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("tx_fee_exception_rules[%d]: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// AcceptedFeeDenom returns the AcceptedFeeDenom of denom, if any.
+func (p Params) AcceptedFeeDenom(denom string) (AcceptedFeeDenom, bool) {
+	for _, feeDenom := range p.AcceptedFeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom, true
+		}
+	}
+	return AcceptedFeeDenom{}, false
+}
+
+// Validate validates the accepted fee denom.
+func (a AcceptedFeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return err
+	}
+	if a.Denom == Denom {
+		return fmt.Errorf("%s is always accepted as fee denom", Denom)
+	}
+	switch a.Conversion {
+	case FeeDenomConversionPegged, FeeDenomConversionRate:
+		if a.FixedRate != "" {
+			return fmt.Errorf("fixed_rate must be empty for %s conversion of %s", a.Conversion, a.Denom)
+		}
+	case FeeDenomConversionFixedRate:
+		rate, err := math.LegacyNewDecFromStr(a.FixedRate)
+		if err != nil {
+			return fmt.Errorf("invalid fixed_rate of %s: %w", a.Denom, err)
+		}
+		if !rate.IsPositive() {
+			return fmt.Errorf("fixed_rate of %s must be positive", a.Denom)
+		}
+	default:
+		return fmt.Errorf("invalid conversion %s for %s", a.Conversion, a.Denom)
+	}
+	return nil
+}
+
+// Validate validates the tx fee exception rule.
+func (r TxFeeExceptionRule) Validate() error {
+	if r.MsgTypeUrl == "" {
+		return fmt.Errorf("msg_type_url must be set")
+	}
+	seenSigners := make(map[string]bool, len(r.Signers))
+	for _, signer := range r.Signers {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return fmt.Errorf("invalid signer %s: %w", signer, err)
+		}
+		if seenSigners[signer] {
+			return fmt.Errorf("duplicate signer %s", signer)
+		}
+		seenSigners[signer] = true
+	}
+	if err := r.MaxFee.Validate(); err != nil {
+		return fmt.Errorf("invalid max_fee: %w", err)
+	}
+	if r.MaxFee.AmountOf(Denom).IsPositive() {
+		return fmt.Errorf("max_fee caps the non-photon fee, it cannot contain %s", Denom)
+	}
 	return nil
 }
@@ -5,10 +5,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/atomone-hub/atomone/x/photon/types"
 )
 
 func TestParams_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr1").String()
 	tests := []struct {
 		name    string
 		params  types.Params
@@ -56,6 +59,179 @@ func TestParams_ValidateBasic(t *testing.T) {
 			}),
 			wantErr: true,
 		},
+		{
+			name: "mint limits",
+			params: types.Params{
+				MaxMintPerBlock:           1_000_000,
+				MaxMintPerEpoch:           10_000_000,
+				MaxMintPerAddressPerEpoch: 1_000_000,
+				MintLimitEpochIdentifier:  "day",
+			},
+		},
+		{
+			name:    "epoch mint limit without epoch identifier",
+			params:  types.Params{MaxMintPerEpoch: 10_000_000},
+			wantErr: true,
+		},
+		{
+			name:    "address epoch mint limit without epoch identifier",
+			params:  types.Params{MaxMintPerAddressPerEpoch: 1_000_000},
+			wantErr: true,
+		},
+		{
+			name: "accepted fee denoms",
+			params: types.Params{
+				AcceptedFeeDenoms: []types.AcceptedFeeDenom{
+					{Denom: "ibc/photon", Conversion: types.FeeDenomConversionPegged},
+					{Denom: "uatone", Conversion: types.FeeDenomConversionRate},
+					{Denom: "xxx", Conversion: types.FeeDenomConversionFixedRate, FixedRate: "0.5"},
+				},
+			},
+		},
+		{
+			name: "accepted fee denom photon",
+			params: types.Params{
+				AcceptedFeeDenoms: []types.AcceptedFeeDenom{
+					{Denom: types.Denom, Conversion: types.FeeDenomConversionPegged},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepted fee denom invalid denom",
+			params: types.Params{
+				AcceptedFeeDenoms: []types.AcceptedFeeDenom{
+					{Denom: "1x", Conversion: types.FeeDenomConversionPegged},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepted fee denom duplicate",
+			params: types.Params{
+				AcceptedFeeDenoms: []types.AcceptedFeeDenom{
+					{Denom: "xxx", Conversion: types.FeeDenomConversionPegged},
+					{Denom: "xxx", Conversion: types.FeeDenomConversionFixedRate, FixedRate: "2"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepted fee denom unspecified conversion",
+			params: types.Params{
+				AcceptedFeeDenoms: []types.AcceptedFeeDenom{{Denom: "xxx"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepted fee denom fixed rate without rate",
+			params: types.Params{
+				AcceptedFeeDenoms: []types.AcceptedFeeDenom{
+					{Denom: "xxx", Conversion: types.FeeDenomConversionFixedRate},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepted fee denom zero fixed rate",
+			params: types.Params{
+				AcceptedFeeDenoms: []types.AcceptedFeeDenom{
+					{Denom: "xxx", Conversion: types.FeeDenomConversionFixedRate, FixedRate: "0"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepted fee denom pegged with fixed rate",
+			params: types.Params{
+				AcceptedFeeDenoms: []types.AcceptedFeeDenom{
+					{Denom: "xxx", Conversion: types.FeeDenomConversionPegged, FixedRate: "2"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tx fee exception rules",
+			params: types.Params{
+				TxFeeExceptionRules: []types.TxFeeExceptionRule{
+					{
+						MsgTypeUrl:   "/atomone.photon.v1.MsgMintPhoton",
+						Signers:      []string{addr},
+						MatchWrapped: true,
+						MaxFee:       sdk.NewCoins(sdk.NewInt64Coin("uatone", 1_000)),
+					},
+					{MsgTypeUrl: "*"},
+				},
+			},
+		},
+		{
+			name: "tx fee exception rule without msg type url",
+			params: types.Params{
+				TxFeeExceptionRules: []types.TxFeeExceptionRule{{MatchWrapped: true}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tx fee exception rule with invalid signer",
+			params: types.Params{
+				TxFeeExceptionRules: []types.TxFeeExceptionRule{
+					{MsgTypeUrl: "*", Signers: []string{"xxx"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tx fee exception rule with duplicate signer",
+			params: types.Params{
+				TxFeeExceptionRules: []types.TxFeeExceptionRule{
+					{
+						MsgTypeUrl: "*",
+						Signers: []string{
+							addr,
+							addr,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tx fee exception rule with photon max fee",
+			params: types.Params{
+				TxFeeExceptionRules: []types.TxFeeExceptionRule{
+					{MsgTypeUrl: "*", MaxFee: sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 1))},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "conversion rate snapshots",
+			params: types.Params{
+				ConversionRateSnapshotInterval:  600,
+				ConversionRateSnapshotRetention: 432_000,
+			},
+		},
+		{
+			name:   "conversion rate snapshots without retention",
+			params: types.Params{ConversionRateSnapshotInterval: 600},
+		},
+		{
+			name: "conversion rate snapshot retention lower than interval",
+			params: types.Params{
+				ConversionRateSnapshotInterval:  600,
+				ConversionRateSnapshotRetention: 599,
+			},
+			wantErr: true,
+		},
+		{
+			name:   "relayer fee policy zero fee if not redundant",
+			params: types.Params{RelayerFeePolicy: types.RelayerFeePolicyZeroFeeIfNotRedundant},
+		},
+		{
+			name:    "unknown relayer fee policy",
+			params:  types.Params{RelayerFeePolicy: types.RelayerFeePolicy(99)},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -4,17 +4,26 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -22,6 +31,88 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RelayerFeePolicy enumerates the fee policies of the txs that only contain
+// IBC relayer messages.
+type RelayerFeePolicy int32
+
+const (
+	// RELAYER_FEE_POLICY_UNSPECIFIED defines an unspecified policy, which
+	// behaves like RELAYER_FEE_POLICY_PHOTON_ONLY.
+	RelayerFeePolicyUnspecified RelayerFeePolicy = 0
+	// RELAYER_FEE_POLICY_PHOTON_ONLY requires relayer txs to pay fees in photon,
+	// like any other tx.
+	RelayerFeePolicyPhotonOnly RelayerFeePolicy = 1
+	// RELAYER_FEE_POLICY_ZERO_FEE_IF_NOT_REDUNDANT allows relayer txs that carry
+	// at least one non-redundant packet message to pay no fee, within the
+	// max_zero_fee_relayer_gas_per_block limit. Non-zero fees must be paid in
+	// photon.
+	RelayerFeePolicyZeroFeeIfNotRedundant RelayerFeePolicy = 2
+	// RELAYER_FEE_POLICY_ANY_DENOM allows relayer txs to pay fees in any denom.
+	RelayerFeePolicyAnyDenom RelayerFeePolicy = 3
+)
+
+var RelayerFeePolicy_name = map[int32]string{
+	0: "RELAYER_FEE_POLICY_UNSPECIFIED",
+	1: "RELAYER_FEE_POLICY_PHOTON_ONLY",
+	2: "RELAYER_FEE_POLICY_ZERO_FEE_IF_NOT_REDUNDANT",
+	3: "RELAYER_FEE_POLICY_ANY_DENOM",
+}
+
+var RelayerFeePolicy_value = map[string]int32{
+	"RELAYER_FEE_POLICY_UNSPECIFIED":               0,
+	"RELAYER_FEE_POLICY_PHOTON_ONLY":               1,
+	"RELAYER_FEE_POLICY_ZERO_FEE_IF_NOT_REDUNDANT": 2,
+	"RELAYER_FEE_POLICY_ANY_DENOM":                 3,
+}
+
+func (x RelayerFeePolicy) String() string {
+	return proto.EnumName(RelayerFeePolicy_name, int32(x))
+}
+
+func (RelayerFeePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_37449d2fb4799465, []int{0}
+}
+
+// FeeDenomConversion enumerates the ways an accepted fee denom can be priced
+// in photon.
+type FeeDenomConversion int32
+
+const (
+	// FEE_DENOM_CONVERSION_UNSPECIFIED defines an unspecified conversion.
+	FeeDenomConversionUnspecified FeeDenomConversion = 0
+	// FEE_DENOM_CONVERSION_PEGGED prices one unit of the denom as one uphoton,
+	// e.g. for photon that returned over IBC.
+	FeeDenomConversionPegged FeeDenomConversion = 1
+	// FEE_DENOM_CONVERSION_CONVERSION_RATE prices the denom using the on-chain
+	// photon conversion rate. Only allowed for the bond denom.
+	FeeDenomConversionRate FeeDenomConversion = 2
+	// FEE_DENOM_CONVERSION_FIXED_RATE prices the denom using a fixed rate set
+	// by governance.
+	FeeDenomConversionFixedRate FeeDenomConversion = 3
+)
+
+var FeeDenomConversion_name = map[int32]string{
+	0: "FEE_DENOM_CONVERSION_UNSPECIFIED",
+	1: "FEE_DENOM_CONVERSION_PEGGED",
+	2: "FEE_DENOM_CONVERSION_CONVERSION_RATE",
+	3: "FEE_DENOM_CONVERSION_FIXED_RATE",
+}
+
+var FeeDenomConversion_value = map[string]int32{
+	"FEE_DENOM_CONVERSION_UNSPECIFIED":     0,
+	"FEE_DENOM_CONVERSION_PEGGED":          1,
+	"FEE_DENOM_CONVERSION_CONVERSION_RATE": 2,
+	"FEE_DENOM_CONVERSION_FIXED_RATE":      3,
+}
+
+func (x FeeDenomConversion) String() string {
+	return proto.EnumName(FeeDenomConversion_name, int32(x))
+}
+
+func (FeeDenomConversion) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_37449d2fb4799465, []int{1}
+}
+
 // Params defines the parameters for the x/photon module.
 type Params struct {
 	// Allow to mint photon or not
@@ -32,6 +123,47 @@ type Params struct {
 	// When used, "*" must be the sole entry; combining it with specific message
 	// type URLs is contradictory and rejected during parameter validation.
 	TxFeeExceptions []string `protobuf:"bytes,2,rep,name=tx_fee_exceptions,json=txFeeExceptions,proto3" json:"tx_fee_exceptions,omitempty"`
+	// max_mint_per_block is the maximum amount of uphoton that can be minted in
+	// a single block. Zero means no limit.
+	MaxMintPerBlock uint64 `protobuf:"varint,3,opt,name=max_mint_per_block,json=maxMintPerBlock,proto3" json:"max_mint_per_block,omitempty"`
+	// max_mint_per_epoch is the maximum amount of uphoton that can be minted
+	// during a single epoch of mint_limit_epoch_identifier. Zero means no limit.
+	MaxMintPerEpoch uint64 `protobuf:"varint,4,opt,name=max_mint_per_epoch,json=maxMintPerEpoch,proto3" json:"max_mint_per_epoch,omitempty"`
+	// max_mint_per_address_per_epoch is the maximum amount of uphoton that a
+	// single address can mint during a single epoch of
+	// mint_limit_epoch_identifier. Zero means no limit.
+	MaxMintPerAddressPerEpoch uint64 `protobuf:"varint,5,opt,name=max_mint_per_address_per_epoch,json=maxMintPerAddressPerEpoch,proto3" json:"max_mint_per_address_per_epoch,omitempty"`
+	// mint_limit_epoch_identifier is the x/epochs identifier of the epoch used
+	// by the per-epoch mint limits.
+	MintLimitEpochIdentifier string `protobuf:"bytes,6,opt,name=mint_limit_epoch_identifier,json=mintLimitEpochIdentifier,proto3" json:"mint_limit_epoch_identifier,omitempty"`
+	// conversion_rate_snapshot_interval is the number of blocks between two
+	// conversion rate snapshots. Zero disables the snapshots.
+	ConversionRateSnapshotInterval uint64 `protobuf:"varint,7,opt,name=conversion_rate_snapshot_interval,json=conversionRateSnapshotInterval,proto3" json:"conversion_rate_snapshot_interval,omitempty"`
+	// conversion_rate_snapshot_retention is the number of blocks during which a
+	// conversion rate snapshot is kept before being pruned. Zero means the
+	// snapshots are never pruned.
+	ConversionRateSnapshotRetention uint64 `protobuf:"varint,8,opt,name=conversion_rate_snapshot_retention,json=conversionRateSnapshotRetention,proto3" json:"conversion_rate_snapshot_retention,omitempty"`
+	// accepted_fee_denoms holds the denoms, other than photon, that are accepted
+	// as tx fee, along with the way they are priced in photon.
+	AcceptedFeeDenoms []AcceptedFeeDenom `protobuf:"bytes,9,rep,name=accepted_fee_denoms,json=acceptedFeeDenoms,proto3" json:"accepted_fee_denoms"`
+	// tx_fee_exception_rules holds structured rules that allow some messages to
+	// use different tx fee coins than photon. Unlike tx_fee_exceptions, a rule
+	// can also restrict the tx signers, match messages wrapped in an authz
+	// MsgExec or a gov MsgSubmitProposal, and cap the non-photon fee amount.
+	TxFeeExceptionRules []TxFeeExceptionRule `protobuf:"bytes,10,rep,name=tx_fee_exception_rules,json=txFeeExceptionRules,proto3" json:"tx_fee_exception_rules"`
+	// max_mint_on_fee is the maximum amount of uphoton that can be minted to pay
+	// the fee of a single tx using the ExtensionOptionMintOnFee tx extension
+	// option. Zero disables mint-on-fee.
+	MaxMintOnFee uint64 `protobuf:"varint,11,opt,name=max_mint_on_fee,json=maxMintOnFee,proto3" json:"max_mint_on_fee,omitempty"`
+	// relayer_fee_policy defines the fees accepted for the txs that only contain
+	// IBC relayer messages: MsgRecvPacket, MsgAcknowledgement, MsgTimeout and
+	// MsgUpdateClient.
+	RelayerFeePolicy RelayerFeePolicy `protobuf:"varint,12,opt,name=relayer_fee_policy,json=relayerFeePolicy,proto3,enum=atomone.photon.v1.RelayerFeePolicy" json:"relayer_fee_policy,omitempty"`
+	// max_zero_fee_relayer_gas_per_block is the maximum cumulative gas limit of
+	// the zero-fee relayer txs included in a single block, when relayer_fee_policy
+	// is RELAYER_FEE_POLICY_ZERO_FEE_IF_NOT_REDUNDANT. Zero disables the zero-fee
+	// relayer txs.
+	MaxZeroFeeRelayerGasPerBlock uint64 `protobuf:"varint,13,opt,name=max_zero_fee_relayer_gas_per_block,json=maxZeroFeeRelayerGasPerBlock,proto3" json:"max_zero_fee_relayer_gas_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -81,139 +213,1965 @@ func (m *Params) GetTxFeeExceptions() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "atomone.photon.v1.Params")
+func (m *Params) GetMaxMintPerBlock() uint64 {
+	if m != nil {
+		return m.MaxMintPerBlock
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("atomone/photon/v1/photon.proto", fileDescriptor_37449d2fb4799465) }
+func (m *Params) GetMaxMintPerEpoch() uint64 {
+	if m != nil {
+		return m.MaxMintPerEpoch
+	}
+	return 0
+}
 
-var fileDescriptor_37449d2fb4799465 = []byte{
-	// 199 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x2c, 0xc9, 0xcf,
-	0xcd, 0xcf, 0x4b, 0xd5, 0x2f, 0xc8, 0xc8, 0x2f, 0xc9, 0xcf, 0xd3, 0x2f, 0x33, 0x84, 0xb2, 0xf4,
-	0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0xa1, 0xf2, 0x7a, 0x50, 0xd1, 0x32, 0x43, 0xa5, 0x48,
-	0x2e, 0xb6, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x65, 0x2e, 0xde, 0xdc, 0xcc, 0xbc, 0x92,
-	0xf8, 0x94, 0xcc, 0xe2, 0xc4, 0xa4, 0x9c, 0xd4, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x8e, 0x20,
-	0x1e, 0x90, 0xa0, 0x0b, 0x54, 0x4c, 0x48, 0x8b, 0x4b, 0xb0, 0xa4, 0x22, 0x3e, 0x2d, 0x35, 0x35,
-	0x3e, 0xb5, 0x22, 0x39, 0xb5, 0xa0, 0x24, 0x33, 0x3f, 0xaf, 0x58, 0x82, 0x49, 0x81, 0x59, 0x83,
-	0x33, 0x88, 0xbf, 0xa4, 0xc2, 0x2d, 0x35, 0xd5, 0x15, 0x2e, 0xec, 0xe4, 0x7e, 0xe2, 0x91, 0x1c,
-	0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9,
-	0xf9, 0xb9, 0xfa, 0x50, 0x27, 0xe9, 0x66, 0x94, 0x26, 0xc1, 0xd8, 0xfa, 0x15, 0x30, 0x0f, 0x94,
-	0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x5d, 0x6f, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x73,
-	0xd5, 0xd1, 0xd3, 0xdf, 0x00, 0x00, 0x00,
+func (m *Params) GetMaxMintPerAddressPerEpoch() uint64 {
+	if m != nil {
+		return m.MaxMintPerAddressPerEpoch
+	}
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetMintLimitEpochIdentifier() string {
+	if m != nil {
+		return m.MintLimitEpochIdentifier
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetConversionRateSnapshotInterval() uint64 {
+	if m != nil {
+		return m.ConversionRateSnapshotInterval
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxFeeExceptions) > 0 {
-		for iNdEx := len(m.TxFeeExceptions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TxFeeExceptions[iNdEx])
-			copy(dAtA[i:], m.TxFeeExceptions[iNdEx])
-			i = encodeVarintPhoton(dAtA, i, uint64(len(m.TxFeeExceptions[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *Params) GetConversionRateSnapshotRetention() uint64 {
+	if m != nil {
+		return m.ConversionRateSnapshotRetention
 	}
-	if m.MintDisabled {
-		i--
-		if m.MintDisabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *Params) GetAcceptedFeeDenoms() []AcceptedFeeDenom {
+	if m != nil {
+		return m.AcceptedFeeDenoms
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintPhoton(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPhoton(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Params) GetTxFeeExceptionRules() []TxFeeExceptionRule {
+	if m != nil {
+		return m.TxFeeExceptionRules
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Params) GetMaxMintOnFee() uint64 {
+	if m != nil {
+		return m.MaxMintOnFee
 	}
-	var l int
-	_ = l
-	if m.MintDisabled {
-		n += 2
+	return 0
+}
+
+func (m *Params) GetRelayerFeePolicy() RelayerFeePolicy {
+	if m != nil {
+		return m.RelayerFeePolicy
 	}
-	if len(m.TxFeeExceptions) > 0 {
-		for _, s := range m.TxFeeExceptions {
-			l = len(s)
-			n += 1 + l + sovPhoton(uint64(l))
+	return RelayerFeePolicyUnspecified
+}
+
+func (m *Params) GetMaxZeroFeeRelayerGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxZeroFeeRelayerGasPerBlock
+	}
+	return 0
+}
+
+// TxFeeExceptionRule defines a rule that allows the messages it matches to use
+// different tx fee coins than photon.
+type TxFeeExceptionRule struct {
+	// msg_type_url is the type URL of the matched messages. A wildcard "*"
+	// matches all message types.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// signers restricts the rule to the txs whose signers are all in this list.
+	// If empty, the rule applies to any signer.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// match_wrapped allows the rule to also match messages wrapped in an authz
+	// MsgExec or a gov MsgSubmitProposal.
+	MatchWrapped bool `protobuf:"varint,3,opt,name=match_wrapped,json=matchWrapped,proto3" json:"match_wrapped,omitempty"`
+	// max_fee caps the non-photon tx fee amount allowed by the rule. If empty,
+	// the fee amount is not capped.
+	MaxFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=max_fee,json=maxFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_fee"`
+}
+
+func (m *TxFeeExceptionRule) Reset()         { *m = TxFeeExceptionRule{} }
+func (m *TxFeeExceptionRule) String() string { return proto.CompactTextString(m) }
+func (*TxFeeExceptionRule) ProtoMessage()    {}
+func (*TxFeeExceptionRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37449d2fb4799465, []int{1}
+}
+func (m *TxFeeExceptionRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxFeeExceptionRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxFeeExceptionRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *TxFeeExceptionRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFeeExceptionRule.Merge(m, src)
+}
+func (m *TxFeeExceptionRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxFeeExceptionRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFeeExceptionRule.DiscardUnknown(m)
 }
 
-func sovPhoton(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_TxFeeExceptionRule proto.InternalMessageInfo
+
+func (m *TxFeeExceptionRule) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
 }
-func sozPhoton(x uint64) (n int) {
-	return sovPhoton(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *TxFeeExceptionRule) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPhoton
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *TxFeeExceptionRule) GetMatchWrapped() bool {
+	if m != nil {
+		return m.MatchWrapped
+	}
+	return false
+}
+
+func (m *TxFeeExceptionRule) GetMaxFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxFee
+	}
+	return nil
+}
+
+// AcceptedFeeDenom defines a denom accepted as tx fee, and how it is priced in
+// photon.
+type AcceptedFeeDenom struct {
+	// denom is the accepted fee denom, e.g. an ibc/... denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// conversion defines how the amount of denom is priced in uphoton.
+	Conversion FeeDenomConversion `protobuf:"varint,2,opt,name=conversion,proto3,enum=atomone.photon.v1.FeeDenomConversion" json:"conversion,omitempty"`
+	// fixed_rate is the amount of uphoton equivalent to one unit of denom. Must
+	// be set if and only if conversion is FEE_DENOM_CONVERSION_FIXED_RATE.
+	FixedRate string `protobuf:"bytes,3,opt,name=fixed_rate,json=fixedRate,proto3" json:"fixed_rate,omitempty"`
+}
+
+func (m *AcceptedFeeDenom) Reset()         { *m = AcceptedFeeDenom{} }
+func (m *AcceptedFeeDenom) String() string { return proto.CompactTextString(m) }
+func (*AcceptedFeeDenom) ProtoMessage()    {}
+func (*AcceptedFeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37449d2fb4799465, []int{2}
+}
+func (m *AcceptedFeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcceptedFeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptedFeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		return b[:n], nil
+	}
+}
+func (m *AcceptedFeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptedFeeDenom.Merge(m, src)
+}
+func (m *AcceptedFeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcceptedFeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptedFeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptedFeeDenom proto.InternalMessageInfo
+
+func (m *AcceptedFeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AcceptedFeeDenom) GetConversion() FeeDenomConversion {
+	if m != nil {
+		return m.Conversion
+	}
+	return FeeDenomConversionUnspecified
+}
+
+func (m *AcceptedFeeDenom) GetFixedRate() string {
+	if m != nil {
+		return m.FixedRate
+	}
+	return ""
+}
+
+// MintTotals holds the amounts of uphoton minted in the current block and in
+// the current epoch, which are used to enforce the mint limits.
+type MintTotals struct {
+	// block_height is the height of the block of block_minted.
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_minted is the amount of uphoton minted at block_height.
+	BlockMinted uint64 `protobuf:"varint,2,opt,name=block_minted,json=blockMinted,proto3" json:"block_minted,omitempty"`
+	// epoch_number is the number of the epoch of epoch_minted.
+	EpochNumber int64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// epoch_minted is the amount of uphoton minted during epoch_number.
+	EpochMinted uint64 `protobuf:"varint,4,opt,name=epoch_minted,json=epochMinted,proto3" json:"epoch_minted,omitempty"`
+}
+
+func (m *MintTotals) Reset()         { *m = MintTotals{} }
+func (m *MintTotals) String() string { return proto.CompactTextString(m) }
+func (*MintTotals) ProtoMessage()    {}
+func (*MintTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37449d2fb4799465, []int{3}
+}
+func (m *MintTotals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintTotals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		return b[:n], nil
+	}
+}
+func (m *MintTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintTotals.Merge(m, src)
+}
+func (m *MintTotals) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintTotals proto.InternalMessageInfo
+
+func (m *MintTotals) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *MintTotals) GetBlockMinted() uint64 {
+	if m != nil {
+		return m.BlockMinted
+	}
+	return 0
+}
+
+func (m *MintTotals) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *MintTotals) GetEpochMinted() uint64 {
+	if m != nil {
+		return m.EpochMinted
+	}
+	return 0
+}
+
+// ZeroFeeRelayerTotals holds the cumulative gas limit of the zero-fee relayer
+// txs of the current block, which is used to enforce the
+// max_zero_fee_relayer_gas_per_block limit.
+type ZeroFeeRelayerTotals struct {
+	// block_height is the height of the block of block_gas.
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_gas is the cumulative gas limit of the zero-fee relayer txs at
+	// block_height.
+	BlockGas uint64 `protobuf:"varint,2,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+}
+
+func (m *ZeroFeeRelayerTotals) Reset()         { *m = ZeroFeeRelayerTotals{} }
+func (m *ZeroFeeRelayerTotals) String() string { return proto.CompactTextString(m) }
+func (*ZeroFeeRelayerTotals) ProtoMessage()    {}
+func (*ZeroFeeRelayerTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37449d2fb4799465, []int{4}
+}
+func (m *ZeroFeeRelayerTotals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZeroFeeRelayerTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZeroFeeRelayerTotals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDisabled", wireType)
-			}
+		return b[:n], nil
+	}
+}
+func (m *ZeroFeeRelayerTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZeroFeeRelayerTotals.Merge(m, src)
+}
+func (m *ZeroFeeRelayerTotals) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZeroFeeRelayerTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZeroFeeRelayerTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZeroFeeRelayerTotals proto.InternalMessageInfo
+
+func (m *ZeroFeeRelayerTotals) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ZeroFeeRelayerTotals) GetBlockGas() uint64 {
+	if m != nil {
+		return m.BlockGas
+	}
+	return 0
+}
+
+// AddressMintTotal holds the amount of uphoton minted by an address in the
+// current epoch, which is used to enforce the per-address mint limit.
+type AddressMintTotal struct {
+	// epoch_number is the number of the epoch of minted.
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// minted is the amount of uphoton minted by the address during epoch_number.
+	Minted uint64 `protobuf:"varint,2,opt,name=minted,proto3" json:"minted,omitempty"`
+}
+
+func (m *AddressMintTotal) Reset()         { *m = AddressMintTotal{} }
+func (m *AddressMintTotal) String() string { return proto.CompactTextString(m) }
+func (*AddressMintTotal) ProtoMessage()    {}
+func (*AddressMintTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37449d2fb4799465, []int{5}
+}
+func (m *AddressMintTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressMintTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressMintTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressMintTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressMintTotal.Merge(m, src)
+}
+func (m *AddressMintTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressMintTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressMintTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressMintTotal proto.InternalMessageInfo
+
+func (m *AddressMintTotal) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *AddressMintTotal) GetMinted() uint64 {
+	if m != nil {
+		return m.Minted
+	}
+	return 0
+}
+
+// MintStats holds the cumulative amounts of bond denom burned and uphoton
+// minted through MsgMintPhoton.
+type MintStats struct {
+	// total_burned is the cumulative amount of bond denom burned.
+	TotalBurned cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
+	// total_minted is the cumulative amount of uphoton minted.
+	TotalMinted cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_minted,json=totalMinted,proto3,customtype=cosmossdk.io/math.Int" json:"total_minted"`
+	// mint_count is the number of successful MsgMintPhoton.
+	MintCount uint64 `protobuf:"varint,3,opt,name=mint_count,json=mintCount,proto3" json:"mint_count,omitempty"`
+	// since_height is the height from which total_burned and mint_count are
+	// accumulated. When the statistics were introduced, total_minted was seeded
+	// from the uphoton supply, but the mints before it are not accounted in
+	// total_burned and mint_count.
+	SinceHeight int64 `protobuf:"varint,4,opt,name=since_height,json=sinceHeight,proto3" json:"since_height,omitempty"`
+}
+
+func (m *MintStats) Reset()         { *m = MintStats{} }
+func (m *MintStats) String() string { return proto.CompactTextString(m) }
+func (*MintStats) ProtoMessage()    {}
+func (*MintStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37449d2fb4799465, []int{6}
+}
+func (m *MintStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintStats.Merge(m, src)
+}
+func (m *MintStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintStats proto.InternalMessageInfo
+
+func (m *MintStats) GetMintCount() uint64 {
+	if m != nil {
+		return m.MintCount
+	}
+	return 0
+}
+
+func (m *MintStats) GetSinceHeight() int64 {
+	if m != nil {
+		return m.SinceHeight
+	}
+	return 0
+}
+
+// ConversionRateSnapshot holds the conversion rate at a given height.
+type ConversionRateSnapshot struct {
+	// height is the block height of the snapshot.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the snapshot.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// conversion_rate represents the factor used to convert atone to photon.
+	ConversionRate string `protobuf:"bytes,3,opt,name=conversion_rate,json=conversionRate,proto3" json:"conversion_rate,omitempty"`
+}
+
+func (m *ConversionRateSnapshot) Reset()         { *m = ConversionRateSnapshot{} }
+func (m *ConversionRateSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ConversionRateSnapshot) ProtoMessage()    {}
+func (*ConversionRateSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37449d2fb4799465, []int{7}
+}
+func (m *ConversionRateSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionRateSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionRateSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionRateSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionRateSnapshot.Merge(m, src)
+}
+func (m *ConversionRateSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionRateSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionRateSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionRateSnapshot proto.InternalMessageInfo
+
+func (m *ConversionRateSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConversionRateSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ConversionRateSnapshot) GetConversionRate() string {
+	if m != nil {
+		return m.ConversionRate
+	}
+	return ""
+}
+
+// BuybackSchedule defines a recurring conversion of the community pool bond
+// denom funds into photon, which stays in the community pool. Exactly one of
+// fraction and amount is set.
+type BuybackSchedule struct {
+	// id is the unique identifier of the schedule.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// epoch_identifier is the identifier of the epoch that paces the schedule.
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// epoch_interval is the number of epochs between two conversions.
+	EpochInterval uint64 `protobuf:"varint,3,opt,name=epoch_interval,json=epochInterval,proto3" json:"epoch_interval,omitempty"`
+	// fraction is the fraction of the community pool bond denom funds converted
+	// at each execution, e.g. 0.1 for 10%.
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+	// amount is the fixed amount of community pool bond denom converted at each
+	// execution. If the community pool holds less, all of it is converted.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// start_epoch is the epoch number at which the schedule was created. The
+	// schedule is executed at the end of every epoch_interval epochs after it.
+	StartEpoch int64 `protobuf:"varint,6,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// last_execution_epoch is the epoch number of the last successful execution,
+	// or zero if the schedule has not been executed yet.
+	LastExecutionEpoch int64 `protobuf:"varint,7,opt,name=last_execution_epoch,json=lastExecutionEpoch,proto3" json:"last_execution_epoch,omitempty"`
+	// total_burned is the cumulative amount of bond denom burned by the
+	// schedule.
+	TotalBurned cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
+	// total_minted is the cumulative amount of uphoton minted by the schedule.
+	TotalMinted cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=total_minted,json=totalMinted,proto3,customtype=cosmossdk.io/math.Int" json:"total_minted"`
+}
+
+func (m *BuybackSchedule) Reset()         { *m = BuybackSchedule{} }
+func (m *BuybackSchedule) String() string { return proto.CompactTextString(m) }
+func (*BuybackSchedule) ProtoMessage()    {}
+func (*BuybackSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37449d2fb4799465, []int{8}
+}
+func (m *BuybackSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuybackSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuybackSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuybackSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuybackSchedule.Merge(m, src)
+}
+func (m *BuybackSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuybackSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuybackSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuybackSchedule proto.InternalMessageInfo
+
+func (m *BuybackSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BuybackSchedule) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *BuybackSchedule) GetEpochInterval() uint64 {
+	if m != nil {
+		return m.EpochInterval
+	}
+	return 0
+}
+
+func (m *BuybackSchedule) GetStartEpoch() int64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *BuybackSchedule) GetLastExecutionEpoch() int64 {
+	if m != nil {
+		return m.LastExecutionEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("atomone.photon.v1.RelayerFeePolicy", RelayerFeePolicy_name, RelayerFeePolicy_value)
+	proto.RegisterEnum("atomone.photon.v1.FeeDenomConversion", FeeDenomConversion_name, FeeDenomConversion_value)
+	proto.RegisterType((*Params)(nil), "atomone.photon.v1.Params")
+	proto.RegisterType((*TxFeeExceptionRule)(nil), "atomone.photon.v1.TxFeeExceptionRule")
+	proto.RegisterType((*AcceptedFeeDenom)(nil), "atomone.photon.v1.AcceptedFeeDenom")
+	proto.RegisterType((*MintTotals)(nil), "atomone.photon.v1.MintTotals")
+	proto.RegisterType((*ZeroFeeRelayerTotals)(nil), "atomone.photon.v1.ZeroFeeRelayerTotals")
+	proto.RegisterType((*AddressMintTotal)(nil), "atomone.photon.v1.AddressMintTotal")
+	proto.RegisterType((*MintStats)(nil), "atomone.photon.v1.MintStats")
+	proto.RegisterType((*ConversionRateSnapshot)(nil), "atomone.photon.v1.ConversionRateSnapshot")
+	proto.RegisterType((*BuybackSchedule)(nil), "atomone.photon.v1.BuybackSchedule")
+}
+
+func init() { proto.RegisterFile("atomone/photon/v1/photon.proto", fileDescriptor_37449d2fb4799465) }
+
+var fileDescriptor_37449d2fb4799465 = []byte{
+	// 1495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xbd, 0x6f, 0xdb, 0x46,
+	0x14, 0x37, 0x25, 0xc5, 0xb6, 0xce, 0x8e, 0xad, 0x5c, 0x5c, 0x83, 0x91, 0x13, 0x49, 0x51, 0x6a,
+	0xc0, 0x49, 0x6a, 0x29, 0x4e, 0x87, 0x76, 0x49, 0x01, 0x7d, 0x50, 0x8e, 0x50, 0x5b, 0x52, 0x69,
+	0x39, 0xad, 0xd3, 0x81, 0x3d, 0x91, 0x67, 0x8a, 0x30, 0xc9, 0x13, 0x78, 0x27, 0x57, 0xea, 0x5f,
+	0x50, 0x78, 0xca, 0xd8, 0xc5, 0x5d, 0xb2, 0x75, 0xe8, 0x94, 0xb9, 0x73, 0xa6, 0x22, 0xc8, 0x54,
+	0x74, 0x48, 0x8a, 0x64, 0xee, 0xd8, 0xa5, 0x53, 0x71, 0xc7, 0x93, 0x2d, 0x53, 0x0a, 0xd0, 0xaf,
+	0xc9, 0xe4, 0xbb, 0xdf, 0xfb, 0xf1, 0x7d, 0xdd, 0xfb, 0xc9, 0x20, 0x83, 0x18, 0xf1, 0x88, 0x8f,
+	0x8b, 0xbd, 0x2e, 0x61, 0xc4, 0x2f, 0x1e, 0x6f, 0xc9, 0xa7, 0x42, 0x2f, 0x20, 0x8c, 0xc0, 0x2b,
+	0xf2, 0xbc, 0x20, 0xad, 0xc7, 0x5b, 0xe9, 0x15, 0x9b, 0xd8, 0x44, 0x9c, 0x16, 0xf9, 0x53, 0x08,
+	0x4c, 0x67, 0x6d, 0x42, 0x6c, 0x17, 0x17, 0xc5, 0x5b, 0xa7, 0x7f, 0x58, 0x64, 0x8e, 0x87, 0x29,
+	0x43, 0x5e, 0x4f, 0x02, 0xae, 0x99, 0x84, 0x7a, 0x84, 0x1a, 0xa1, 0x67, 0xf8, 0x22, 0x8f, 0x32,
+	0xe1, 0x5b, 0xb1, 0x83, 0x28, 0x2e, 0x1e, 0x6f, 0x75, 0x30, 0x43, 0x5b, 0x45, 0x93, 0x38, 0x32,
+	0x88, 0xfc, 0xcf, 0xb3, 0x60, 0xb6, 0x85, 0x02, 0xe4, 0x51, 0x78, 0x0b, 0x5c, 0xf6, 0x1c, 0x9f,
+	0x19, 0x96, 0x43, 0x51, 0xc7, 0xc5, 0x96, 0xaa, 0xe4, 0x94, 0x8d, 0x79, 0x7d, 0x91, 0x1b, 0xab,
+	0xd2, 0x06, 0xef, 0x80, 0x2b, 0x6c, 0x60, 0x1c, 0x62, 0x6c, 0xe0, 0x81, 0x89, 0x7b, 0xcc, 0x21,
+	0x3e, 0x55, 0x63, 0xb9, 0xf8, 0x46, 0x52, 0x5f, 0x66, 0x83, 0x1a, 0xc6, 0xda, 0x99, 0x19, 0xde,
+	0x05, 0xd0, 0x43, 0x03, 0x43, 0x90, 0xf6, 0x70, 0x60, 0x74, 0x5c, 0x62, 0x1e, 0xa9, 0xf1, 0x9c,
+	0xb2, 0x91, 0xd0, 0x97, 0x3d, 0x34, 0xd8, 0x75, 0x7c, 0xd6, 0xc2, 0x41, 0x99, 0x9b, 0x27, 0xc0,
+	0xb8, 0x47, 0xcc, 0xae, 0x9a, 0x88, 0x82, 0x35, 0x6e, 0x86, 0x25, 0x90, 0xb9, 0x00, 0x46, 0x96,
+	0x15, 0x60, 0x4a, 0xc7, 0x1c, 0x2f, 0x09, 0xc7, 0x6b, 0xe7, 0x8e, 0xa5, 0x10, 0x72, 0x46, 0xf1,
+	0x00, 0xac, 0x09, 0x77, 0xd7, 0xf1, 0x1c, 0x16, 0x3a, 0x19, 0x8e, 0x85, 0x7d, 0xe6, 0x1c, 0x3a,
+	0x38, 0x50, 0x67, 0x73, 0xca, 0x46, 0x52, 0x57, 0x39, 0x64, 0x87, 0x23, 0x84, 0x53, 0xfd, 0xec,
+	0x1c, 0xd6, 0xc1, 0x4d, 0x93, 0xf8, 0xc7, 0x38, 0xa0, 0x0e, 0xf1, 0x8d, 0x00, 0x31, 0x6c, 0x50,
+	0x1f, 0xf5, 0x68, 0x97, 0x30, 0xc3, 0xf1, 0x19, 0x0e, 0x8e, 0x91, 0xab, 0xce, 0x89, 0x20, 0x32,
+	0xe7, 0x40, 0x1d, 0x31, 0xbc, 0x27, 0x61, 0x75, 0x89, 0x82, 0x9f, 0x82, 0xfc, 0x3b, 0xa9, 0x02,
+	0xcc, 0xf8, 0x27, 0x89, 0xaf, 0xce, 0x0b, 0xae, 0xec, 0x74, 0x2e, 0x7d, 0x04, 0x83, 0x07, 0xe0,
+	0x2a, 0x32, 0x79, 0x07, 0xb0, 0x25, 0xba, 0x64, 0x61, 0x9f, 0x78, 0x54, 0x4d, 0xe6, 0xe2, 0x1b,
+	0x0b, 0xf7, 0x6f, 0x15, 0x26, 0x46, 0xae, 0x50, 0x92, 0xe8, 0x1a, 0xc6, 0x55, 0x8e, 0x2d, 0x27,
+	0x9e, 0xbf, 0xca, 0xce, 0xe8, 0x57, 0x50, 0xc4, 0x4e, 0xe1, 0x57, 0x60, 0x35, 0xda, 0x7a, 0x23,
+	0xe8, 0xbb, 0x98, 0xaa, 0x40, 0xb0, 0xaf, 0x4f, 0x61, 0x6f, 0x5f, 0x18, 0x09, 0xbd, 0xef, 0x62,
+	0xc9, 0x7f, 0x95, 0x4d, 0x9c, 0x50, 0xb8, 0x0e, 0x96, 0xcf, 0xda, 0x4a, 0x7c, 0xfe, 0x29, 0x75,
+	0x41, 0xa4, 0xbd, 0x28, 0xfb, 0xd8, 0xf4, 0x6b, 0x18, 0xc3, 0xcf, 0x00, 0x0c, 0xb0, 0x8b, 0x86,
+	0x38, 0x10, 0xd1, 0xf4, 0x88, 0xeb, 0x98, 0x43, 0x75, 0x31, 0xa7, 0x6c, 0x2c, 0x4d, 0x4d, 0x51,
+	0x0f, 0xc1, 0x35, 0x8c, 0x5b, 0x02, 0xaa, 0xa7, 0x82, 0x88, 0x05, 0x3e, 0x04, 0x79, 0xfe, 0xe5,
+	0x6f, 0x70, 0x40, 0x04, 0xe7, 0x88, 0xdf, 0x46, 0x74, 0x6c, 0x74, 0x2f, 0x8b, 0x60, 0xae, 0x7b,
+	0x68, 0xf0, 0x18, 0x07, 0xa4, 0x86, 0xb1, 0x64, 0xde, 0x46, 0x74, 0x34, 0xc7, 0xf9, 0x3f, 0x14,
+	0x00, 0x27, 0xb3, 0x86, 0x39, 0xb0, 0xe8, 0x51, 0xdb, 0x60, 0xc3, 0x1e, 0x36, 0xfa, 0x81, 0x2b,
+	0xee, 0x56, 0x52, 0x07, 0x1e, 0xb5, 0xdb, 0xc3, 0x1e, 0xde, 0x0f, 0x5c, 0x78, 0x1f, 0xcc, 0x51,
+	0xc7, 0xf6, 0x71, 0x20, 0xef, 0x53, 0x59, 0x7d, 0xf9, 0x6c, 0x73, 0x45, 0x5e, 0x66, 0x39, 0xbd,
+	0x7b, 0x2c, 0x70, 0x7c, 0x5b, 0x1f, 0x01, 0xc5, 0x95, 0x45, 0xcc, 0xec, 0x1a, 0x5f, 0x07, 0xa8,
+	0xd7, 0xc3, 0x96, 0x1a, 0x97, 0x57, 0x96, 0x1b, 0x3f, 0x0f, 0x6d, 0xd0, 0x02, 0x73, 0x3c, 0x37,
+	0x5e, 0xcd, 0x84, 0x68, 0xd4, 0xb5, 0x82, 0x64, 0xe5, 0x4b, 0xa1, 0x20, 0x97, 0x42, 0xa1, 0x42,
+	0x1c, 0xbf, 0x7c, 0x8f, 0x37, 0xe7, 0x87, 0xd7, 0xd9, 0x0d, 0xdb, 0x61, 0xdd, 0x7e, 0xa7, 0x60,
+	0x12, 0x4f, 0xee, 0x13, 0xf9, 0x67, 0x93, 0x5a, 0x47, 0x45, 0x9e, 0x05, 0x15, 0x0e, 0x54, 0x9f,
+	0xf5, 0x10, 0xcf, 0x36, 0xff, 0xbd, 0x02, 0x52, 0xd1, 0x59, 0x82, 0x2b, 0xe0, 0x92, 0x18, 0x40,
+	0x99, 0x6e, 0xf8, 0x02, 0x35, 0x00, 0xce, 0xc7, 0x58, 0x8d, 0x89, 0xbe, 0x4d, 0x1b, 0x9e, 0x11,
+	0x4d, 0xe5, 0x7c, 0xe6, 0xc7, 0x1c, 0xe1, 0x26, 0x00, 0x87, 0xce, 0x00, 0x5b, 0xe2, 0xca, 0x88,
+	0xcc, 0x93, 0xe5, 0xa5, 0x97, 0xcf, 0x36, 0x81, 0xcc, 0xae, 0x8a, 0x4d, 0x3d, 0x29, 0x10, 0xfc,
+	0xaa, 0xe4, 0xbf, 0x53, 0x00, 0xe0, 0x33, 0xd4, 0x26, 0x0c, 0xb9, 0x14, 0xde, 0x04, 0x8b, 0xa2,
+	0xa9, 0x46, 0x17, 0x3b, 0x76, 0x97, 0x89, 0x08, 0xe3, 0xfa, 0x82, 0xb0, 0x3d, 0x14, 0xa6, 0x73,
+	0x08, 0x1f, 0x48, 0x6c, 0x89, 0x48, 0x13, 0x12, 0xb2, 0x2b, 0x4c, 0x1c, 0x12, 0xae, 0x0e, 0xbf,
+	0xef, 0x75, 0x70, 0x20, 0xa2, 0x88, 0xeb, 0x0b, 0xc2, 0xd6, 0x10, 0xa6, 0x73, 0x88, 0x64, 0x09,
+	0x57, 0x5a, 0x08, 0x09, 0x59, 0xf2, 0x8f, 0xc0, 0xca, 0xc5, 0x81, 0xfa, 0xfb, 0x31, 0xae, 0x81,
+	0x64, 0x08, 0xb1, 0x11, 0x95, 0x01, 0xce, 0x0b, 0xc3, 0x36, 0xa2, 0xf9, 0x5d, 0x90, 0x92, 0x83,
+	0x73, 0x96, 0xf8, 0x44, 0xc4, 0xca, 0x64, 0xc4, 0xab, 0x60, 0xf6, 0x42, 0xc6, 0xf2, 0x2d, 0xff,
+	0xbb, 0x02, 0x92, 0x9c, 0x68, 0x8f, 0x21, 0x46, 0x61, 0x03, 0x2c, 0x32, 0xce, 0x68, 0x74, 0xfa,
+	0x81, 0x2f, 0xd5, 0x22, 0x59, 0xbe, 0xcb, 0x07, 0xe8, 0xd7, 0x57, 0xd9, 0xf7, 0xc2, 0x26, 0x50,
+	0xeb, 0xa8, 0xe0, 0x90, 0xa2, 0x87, 0x58, 0xb7, 0x50, 0xf7, 0xd9, 0x58, 0x77, 0xea, 0x3e, 0xd3,
+	0x17, 0x04, 0x41, 0x59, 0xf8, 0x9f, 0xf3, 0x8d, 0x7d, 0xfb, 0x5f, 0xf1, 0xc9, 0xd6, 0xdc, 0x00,
+	0x40, 0x2c, 0x12, 0x93, 0xf4, 0x7d, 0x26, 0x55, 0x27, 0xc9, 0x2d, 0x15, 0x6e, 0xe0, 0x75, 0xa0,
+	0x8e, 0x6f, 0xe2, 0x51, 0x6d, 0x13, 0x61, 0x1d, 0x84, 0x2d, 0xac, 0x6d, 0xfe, 0xa9, 0x02, 0x56,
+	0x2b, 0x53, 0xf7, 0x2d, 0x2f, 0xd1, 0x85, 0x9e, 0xc8, 0x37, 0xf8, 0x31, 0x48, 0x70, 0x71, 0x16,
+	0xc1, 0x2f, 0xdc, 0x4f, 0x17, 0x42, 0xe5, 0x2e, 0x8c, 0x94, 0xbb, 0xd0, 0x1e, 0x29, 0x77, 0x79,
+	0x9e, 0x27, 0xf6, 0xe4, 0x75, 0x56, 0xd1, 0x85, 0x07, 0xfc, 0x08, 0x2c, 0x47, 0x54, 0xe0, 0x1d,
+	0x23, 0xbd, 0x74, 0x51, 0x02, 0xf2, 0x7f, 0xc6, 0xc1, 0x72, 0xb9, 0x3f, 0xec, 0x20, 0xf3, 0x68,
+	0xcf, 0xec, 0x62, 0x8b, 0x6f, 0x9b, 0x25, 0x10, 0x73, 0xc2, 0x8e, 0x24, 0xf4, 0x98, 0x63, 0xc1,
+	0xdb, 0x20, 0x35, 0xa1, 0x70, 0xa2, 0xbe, 0xfa, 0x32, 0x8e, 0x08, 0xdb, 0x3a, 0x58, 0x92, 0xd0,
+	0x91, 0x8a, 0x85, 0xa5, 0xbb, 0x1c, 0x02, 0xa5, 0x11, 0xee, 0x82, 0xf9, 0xc3, 0x00, 0x99, 0x42,
+	0x9a, 0x12, 0x22, 0xce, 0x2d, 0xd9, 0xa9, 0xb5, 0xc9, 0x4e, 0xed, 0x60, 0x1b, 0x99, 0xc3, 0x2a,
+	0x36, 0x23, 0xa9, 0x9c, 0x51, 0xc0, 0x0a, 0x98, 0x45, 0x9e, 0x68, 0xd4, 0xa5, 0x7f, 0xde, 0x76,
+	0xe9, 0x0a, 0xb3, 0x60, 0x81, 0x32, 0x14, 0x48, 0x35, 0x17, 0x12, 0x1e, 0xd7, 0x81, 0x30, 0x85,
+	0x9a, 0x7f, 0x0f, 0xac, 0xb8, 0x88, 0x32, 0x03, 0x0f, 0xb0, 0xd9, 0x17, 0xfa, 0x15, 0x22, 0xe7,
+	0x04, 0x12, 0xf2, 0x33, 0x6d, 0x74, 0x14, 0x7a, 0x44, 0x87, 0x7c, 0xfe, 0x7f, 0x1e, 0xf2, 0xe4,
+	0x7f, 0x1b, 0xf2, 0x3b, 0x3f, 0xc5, 0x40, 0x2a, 0x2a, 0x6f, 0xb0, 0x02, 0x32, 0xba, 0xb6, 0x53,
+	0x3a, 0xd0, 0x74, 0xa3, 0xa6, 0x69, 0x46, 0xab, 0xb9, 0x53, 0xaf, 0x1c, 0x18, 0xfb, 0x8d, 0xbd,
+	0x96, 0x56, 0xa9, 0xd7, 0xea, 0x5a, 0x35, 0x35, 0x93, 0xce, 0x9e, 0x9c, 0xe6, 0xd6, 0xa2, 0x9e,
+	0xfb, 0x3e, 0xed, 0x61, 0x93, 0xcf, 0x81, 0x05, 0xcb, 0x53, 0x49, 0x5a, 0x0f, 0x9b, 0xed, 0x66,
+	0xc3, 0x68, 0x36, 0x76, 0x0e, 0x52, 0x4a, 0x3a, 0x73, 0x72, 0x9a, 0x4b, 0x47, 0x49, 0x5a, 0x62,
+	0x7f, 0x37, 0x7d, 0x77, 0x08, 0xbf, 0x04, 0x1f, 0x4c, 0xe1, 0x78, 0xac, 0xe9, 0x4d, 0xf1, 0x5e,
+	0xaf, 0x19, 0x8d, 0x66, 0xdb, 0xd0, 0xb5, 0xea, 0x7e, 0xa3, 0x5a, 0x6a, 0xb4, 0x53, 0xb1, 0xf4,
+	0xed, 0x93, 0xd3, 0xdc, 0x7a, 0x94, 0x51, 0xee, 0xc6, 0xfa, 0x61, 0x83, 0x30, 0x1d, 0x5b, 0x7d,
+	0xdf, 0x42, 0x3e, 0x83, 0x9f, 0x80, 0xeb, 0x53, 0xc8, 0x4b, 0x8d, 0x03, 0xa3, 0xaa, 0x35, 0x9a,
+	0xbb, 0xa9, 0x78, 0xfa, 0xfa, 0xc9, 0x69, 0x4e, 0x8d, 0x92, 0x95, 0xfc, 0xa1, 0x10, 0x95, 0x74,
+	0xe2, 0xdb, 0xa7, 0x99, 0x99, 0x3b, 0x3f, 0xc6, 0x00, 0x9c, 0xd4, 0x19, 0xb8, 0x0d, 0x72, 0x9c,
+	0x54, 0x30, 0x19, 0x95, 0x66, 0xe3, 0x91, 0xa6, 0xef, 0xd5, 0x9b, 0x8d, 0x48, 0x11, 0x6f, 0x9e,
+	0x9c, 0xe6, 0x6e, 0x4c, 0x7a, 0x8f, 0x97, 0xf1, 0x01, 0x58, 0x9b, 0x4a, 0xd4, 0xd2, 0xb6, 0xb7,
+	0xb5, 0x6a, 0x4a, 0x09, 0x83, 0x9c, 0xe4, 0x68, 0x61, 0xdb, 0xc6, 0x16, 0xac, 0x82, 0xf7, 0xa7,
+	0xba, 0x8f, 0x3d, 0xea, 0xa5, 0xb6, 0x96, 0x8a, 0xa5, 0xd3, 0x27, 0xa7, 0xb9, 0xd5, 0x49, 0x1e,
+	0xbe, 0x22, 0x60, 0x15, 0x64, 0xa7, 0xb2, 0xd4, 0xea, 0x5f, 0x68, 0xd5, 0x90, 0x20, 0x1e, 0x4e,
+	0xc4, 0x24, 0x41, 0x6d, 0x24, 0xa0, 0x61, 0xc1, 0xca, 0xdb, 0xcf, 0xdf, 0x64, 0x94, 0x17, 0x6f,
+	0x32, 0xca, 0x6f, 0x6f, 0x32, 0xca, 0x93, 0xb7, 0x99, 0x99, 0x17, 0x6f, 0x33, 0x33, 0xbf, 0xbc,
+	0xcd, 0xcc, 0x3c, 0xde, 0x1c, 0xfb, 0xcd, 0x20, 0xc5, 0x7c, 0xb3, 0xdb, 0xef, 0x8c, 0x9e, 0x8b,
+	0x83, 0xd1, 0x3f, 0x42, 0xe2, 0xe7, 0x43, 0x67, 0x56, 0x2c, 0xc5, 0x0f, 0xff, 0x1a, 0x00, 0x85,
+	0xd5, 0x1f, 0x46, 0x27, 0x0d, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxZeroFeeRelayerGasPerBlock != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.MaxZeroFeeRelayerGasPerBlock))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.RelayerFeePolicy != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.RelayerFeePolicy))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxMintOnFee != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.MaxMintOnFee))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TxFeeExceptionRules) > 0 {
+		for iNdEx := len(m.TxFeeExceptionRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxFeeExceptionRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPhoton(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for iNdEx := len(m.AcceptedFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedFeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPhoton(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.ConversionRateSnapshotRetention != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.ConversionRateSnapshotRetention))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ConversionRateSnapshotInterval != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.ConversionRateSnapshotInterval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MintLimitEpochIdentifier) > 0 {
+		i -= len(m.MintLimitEpochIdentifier)
+		copy(dAtA[i:], m.MintLimitEpochIdentifier)
+		i = encodeVarintPhoton(dAtA, i, uint64(len(m.MintLimitEpochIdentifier)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxMintPerAddressPerEpoch != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.MaxMintPerAddressPerEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxMintPerEpoch != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.MaxMintPerEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxMintPerBlock != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.MaxMintPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxFeeExceptions) > 0 {
+		for iNdEx := len(m.TxFeeExceptions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxFeeExceptions[iNdEx])
+			copy(dAtA[i:], m.TxFeeExceptions[iNdEx])
+			i = encodeVarintPhoton(dAtA, i, uint64(len(m.TxFeeExceptions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MintDisabled {
+		i--
+		if m.MintDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxFeeExceptionRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxFeeExceptionRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxFeeExceptionRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxFee) > 0 {
+		for iNdEx := len(m.MaxFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPhoton(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MatchWrapped {
+		i--
+		if m.MatchWrapped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintPhoton(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintPhoton(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptedFeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptedFeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptedFeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FixedRate) > 0 {
+		i -= len(m.FixedRate)
+		copy(dAtA[i:], m.FixedRate)
+		i = encodeVarintPhoton(dAtA, i, uint64(len(m.FixedRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Conversion != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.Conversion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPhoton(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintTotals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintTotals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochMinted != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.EpochMinted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockMinted != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.BlockMinted))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZeroFeeRelayerTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZeroFeeRelayerTotals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZeroFeeRelayerTotals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockGas != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.BlockGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressMintTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressMintTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressMintTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Minted != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.Minted))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SinceHeight != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.SinceHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MintCount != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.MintCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPhoton(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPhoton(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionRateSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionRateSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionRateSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConversionRate) > 0 {
+		i -= len(m.ConversionRate)
+		copy(dAtA[i:], m.ConversionRate)
+		i = encodeVarintPhoton(dAtA, i, uint64(len(m.ConversionRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPhoton(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuybackSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuybackSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuybackSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPhoton(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPhoton(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.LastExecutionEpoch != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.LastExecutionEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPhoton(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPhoton(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.EpochInterval != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.EpochInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintPhoton(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPhoton(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPhoton(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPhoton(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MintDisabled {
+		n += 2
+	}
+	if len(m.TxFeeExceptions) > 0 {
+		for _, s := range m.TxFeeExceptions {
+			l = len(s)
+			n += 1 + l + sovPhoton(uint64(l))
+		}
+	}
+	if m.MaxMintPerBlock != 0 {
+		n += 1 + sovPhoton(uint64(m.MaxMintPerBlock))
+	}
+	if m.MaxMintPerEpoch != 0 {
+		n += 1 + sovPhoton(uint64(m.MaxMintPerEpoch))
+	}
+	if m.MaxMintPerAddressPerEpoch != 0 {
+		n += 1 + sovPhoton(uint64(m.MaxMintPerAddressPerEpoch))
+	}
+	l = len(m.MintLimitEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovPhoton(uint64(l))
+	}
+	if m.ConversionRateSnapshotInterval != 0 {
+		n += 1 + sovPhoton(uint64(m.ConversionRateSnapshotInterval))
+	}
+	if m.ConversionRateSnapshotRetention != 0 {
+		n += 1 + sovPhoton(uint64(m.ConversionRateSnapshotRetention))
+	}
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for _, e := range m.AcceptedFeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovPhoton(uint64(l))
+		}
+	}
+	if len(m.TxFeeExceptionRules) > 0 {
+		for _, e := range m.TxFeeExceptionRules {
+			l = e.Size()
+			n += 1 + l + sovPhoton(uint64(l))
+		}
+	}
+	if m.MaxMintOnFee != 0 {
+		n += 1 + sovPhoton(uint64(m.MaxMintOnFee))
+	}
+	if m.RelayerFeePolicy != 0 {
+		n += 1 + sovPhoton(uint64(m.RelayerFeePolicy))
+	}
+	if m.MaxZeroFeeRelayerGasPerBlock != 0 {
+		n += 1 + sovPhoton(uint64(m.MaxZeroFeeRelayerGasPerBlock))
+	}
+	return n
+}
+
+func (m *TxFeeExceptionRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovPhoton(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovPhoton(uint64(l))
+		}
+	}
+	if m.MatchWrapped {
+		n += 2
+	}
+	if len(m.MaxFee) > 0 {
+		for _, e := range m.MaxFee {
+			l = e.Size()
+			n += 1 + l + sovPhoton(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AcceptedFeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPhoton(uint64(l))
+	}
+	if m.Conversion != 0 {
+		n += 1 + sovPhoton(uint64(m.Conversion))
+	}
+	l = len(m.FixedRate)
+	if l > 0 {
+		n += 1 + l + sovPhoton(uint64(l))
+	}
+	return n
+}
+
+func (m *MintTotals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovPhoton(uint64(m.BlockHeight))
+	}
+	if m.BlockMinted != 0 {
+		n += 1 + sovPhoton(uint64(m.BlockMinted))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovPhoton(uint64(m.EpochNumber))
+	}
+	if m.EpochMinted != 0 {
+		n += 1 + sovPhoton(uint64(m.EpochMinted))
+	}
+	return n
+}
+
+func (m *ZeroFeeRelayerTotals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovPhoton(uint64(m.BlockHeight))
+	}
+	if m.BlockGas != 0 {
+		n += 1 + sovPhoton(uint64(m.BlockGas))
+	}
+	return n
+}
+
+func (m *AddressMintTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovPhoton(uint64(m.EpochNumber))
+	}
+	if m.Minted != 0 {
+		n += 1 + sovPhoton(uint64(m.Minted))
+	}
+	return n
+}
+
+func (m *MintStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovPhoton(uint64(l))
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovPhoton(uint64(l))
+	if m.MintCount != 0 {
+		n += 1 + sovPhoton(uint64(m.MintCount))
+	}
+	if m.SinceHeight != 0 {
+		n += 1 + sovPhoton(uint64(m.SinceHeight))
+	}
+	return n
+}
+
+func (m *ConversionRateSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovPhoton(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovPhoton(uint64(l))
+	l = len(m.ConversionRate)
+	if l > 0 {
+		n += 1 + l + sovPhoton(uint64(l))
+	}
+	return n
+}
+
+func (m *BuybackSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPhoton(uint64(m.Id))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovPhoton(uint64(l))
+	}
+	if m.EpochInterval != 0 {
+		n += 1 + sovPhoton(uint64(m.EpochInterval))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovPhoton(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovPhoton(uint64(l))
+	if m.StartEpoch != 0 {
+		n += 1 + sovPhoton(uint64(m.StartEpoch))
+	}
+	if m.LastExecutionEpoch != 0 {
+		n += 1 + sovPhoton(uint64(m.LastExecutionEpoch))
+	}
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovPhoton(uint64(l))
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovPhoton(uint64(l))
+	return n
+}
+
+func sovPhoton(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPhoton(x uint64) (n int) {
+	return sovPhoton(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPhoton
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MintDisabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFeeExceptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxFeeExceptions = append(m.TxFeeExceptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintPerBlock", wireType)
+			}
+			m.MaxMintPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMintPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintPerEpoch", wireType)
+			}
+			m.MaxMintPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMintPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintPerAddressPerEpoch", wireType)
+			}
+			m.MaxMintPerAddressPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMintPerAddressPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintLimitEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintLimitEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRateSnapshotInterval", wireType)
+			}
+			m.ConversionRateSnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConversionRateSnapshotInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRateSnapshotRetention", wireType)
+			}
+			m.ConversionRateSnapshotRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConversionRateSnapshotRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedFeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedFeeDenoms = append(m.AcceptedFeeDenoms, AcceptedFeeDenom{})
+			if err := m.AcceptedFeeDenoms[len(m.AcceptedFeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFeeExceptionRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxFeeExceptionRules = append(m.TxFeeExceptionRules, TxFeeExceptionRule{})
+			if err := m.TxFeeExceptionRules[len(m.TxFeeExceptionRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintOnFee", wireType)
+			}
+			m.MaxMintOnFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMintOnFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFeePolicy", wireType)
+			}
+			m.RelayerFeePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayerFeePolicy |= RelayerFeePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxZeroFeeRelayerGasPerBlock", wireType)
+			}
+			m.MaxZeroFeeRelayerGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxZeroFeeRelayerGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPhoton(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxFeeExceptionRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPhoton
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxFeeExceptionRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxFeeExceptionRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchWrapped", wireType)
+			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -224,15 +2182,843 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MatchWrapped = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFee = append(m.MaxFee, types.Coin{})
+			if err := m.MaxFee[len(m.MaxFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPhoton(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcceptedFeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPhoton
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcceptedFeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcceptedFeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conversion", wireType)
+			}
+			m.Conversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Conversion |= FeeDenomConversion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixedRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPhoton(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPhoton
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMinted", wireType)
+			}
+			m.BlockMinted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMinted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMinted", wireType)
+			}
+			m.EpochMinted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochMinted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPhoton(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZeroFeeRelayerTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPhoton
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZeroFeeRelayerTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZeroFeeRelayerTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGas", wireType)
+			}
+			m.BlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPhoton(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressMintTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPhoton
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressMintTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressMintTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			m.Minted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPhoton(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPhoton
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintCount", wireType)
+			}
+			m.MintCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceHeight", wireType)
+			}
+			m.SinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPhoton(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionRateSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPhoton
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionRateSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionRateSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPhoton(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuybackSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPhoton
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuybackSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuybackSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MintDisabled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxFeeExceptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -260,7 +3046,200 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxFeeExceptions = append(m.TxFeeExceptions, string(dAtA[iNdEx:postIndex]))
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInterval", wireType)
+			}
+			m.EpochInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExecutionEpoch", wireType)
+			}
+			m.LastExecutionEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastExecutionEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPhoton
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPhoton
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -196,333 +198,4017 @@ func (m *QueryConversionRateResponse) GetConversionRate() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "atomone.photon.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "atomone.photon.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryConversionRateRequest)(nil), "atomone.photon.v1.QueryConversionRateRequest")
-	proto.RegisterType((*QueryConversionRateResponse)(nil), "atomone.photon.v1.QueryConversionRateResponse")
+// QueryMintQuoteRequest is request type for the Query/MintQuote RPC method.
+// Exactly one of burn_amount and mint_amount must be set.
+type QueryMintQuoteRequest struct {
+	// burn_amount is the amount of bond denom to burn. When set, the response
+	// holds the amount of uphoton that MsgMintPhoton would mint.
+	BurnAmount string `protobuf:"bytes,1,opt,name=burn_amount,json=burnAmount,proto3" json:"burn_amount,omitempty"`
+	// mint_amount is the amount of uphoton to mint. When set, the response holds
+	// the minimum amount of bond denom to burn to mint at least this amount.
+	MintAmount string `protobuf:"bytes,2,opt,name=mint_amount,json=mintAmount,proto3" json:"mint_amount,omitempty"`
 }
 
-func init() { proto.RegisterFile("atomone/photon/v1/query.proto", fileDescriptor_4cb3d9462fe75129) }
-
-var fileDescriptor_4cb3d9462fe75129 = []byte{
-	// 379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x4b, 0xfb, 0x40,
-	0x18, 0xc6, 0x93, 0xf2, 0xff, 0x17, 0x3c, 0xa1, 0xe2, 0xd9, 0xc1, 0xa6, 0x35, 0x6a, 0x50, 0x11,
-	0x21, 0x09, 0xad, 0x83, 0x7b, 0x15, 0xdc, 0x44, 0x33, 0x38, 0xb8, 0x94, 0x6b, 0x38, 0xd2, 0x80,
-	0xb9, 0x37, 0xcd, 0x5d, 0x8a, 0x75, 0xf4, 0x13, 0x08, 0x8e, 0x4e, 0x7e, 0x07, 0x3f, 0x44, 0xc7,
-	0xa2, 0x8b, 0x93, 0x48, 0xeb, 0x07, 0x91, 0xde, 0xa5, 0x85, 0xda, 0x88, 0x6e, 0xc9, 0x3d, 0x4f,
-	0x7e, 0xcf, 0xf3, 0xbe, 0x39, 0xb4, 0x41, 0x04, 0x44, 0xc0, 0xa8, 0x1b, 0x77, 0x40, 0x00, 0x73,
-	0x7b, 0x75, 0xb7, 0x9b, 0xd2, 0xa4, 0xef, 0xc4, 0x09, 0x08, 0xc0, 0xab, 0x99, 0xec, 0x28, 0xd9,
-	0xe9, 0xd5, 0x8d, 0x72, 0x00, 0x01, 0x48, 0xd5, 0x9d, 0x3c, 0x29, 0xa3, 0x51, 0x0b, 0x00, 0x82,
-	0x6b, 0xea, 0x92, 0x38, 0x74, 0x09, 0x63, 0x20, 0x88, 0x08, 0x81, 0xf1, 0x4c, 0x35, 0x17, 0x53,
-	0x32, 0xa0, 0xd2, 0x2b, 0x3e, 0xf0, 0x08, 0x78, 0x4b, 0x61, 0xd5, 0x8b, 0x92, 0xac, 0x32, 0xc2,
-	0x17, 0x93, 0x42, 0xe7, 0x24, 0x21, 0x11, 0xf7, 0x68, 0x37, 0xa5, 0x5c, 0x58, 0x67, 0x68, 0x6d,
-	0xee, 0x94, 0xc7, 0xc0, 0x38, 0xc5, 0x47, 0xa8, 0x18, 0xcb, 0x93, 0x75, 0x7d, 0x4b, 0xdf, 0x5f,
-	0x6e, 0x54, 0x9c, 0x85, 0xfe, 0x8e, 0xfa, 0xa4, 0xf9, 0x6f, 0xf0, 0xbe, 0xa9, 0x79, 0x99, 0xdd,
-	0xaa, 0x21, 0x43, 0xf2, 0x8e, 0x81, 0xf5, 0x68, 0xc2, 0x43, 0x60, 0x1e, 0x11, 0x74, 0x9a, 0x76,
-	0x89, 0xaa, 0xb9, 0xea, 0x2c, 0x75, 0xc5, 0x9f, 0x29, 0xad, 0x84, 0x08, 0x2a, 0xe3, 0x97, 0x9a,
-	0xa5, 0x97, 0x67, 0x1b, 0x65, 0xd3, 0x9c, 0x50, 0xdf, 0x2b, 0xf9, 0x73, 0x80, 0xc6, 0x53, 0x01,
-	0xfd, 0x97, 0x60, 0x7c, 0x8b, 0x8a, 0xaa, 0x17, 0xde, 0xcd, 0xa9, 0xbc, 0xb8, 0x00, 0x63, 0xef,
-	0x37, 0x9b, 0xea, 0x66, 0x6d, 0xdf, 0xbd, 0x7e, 0x3e, 0x14, 0xaa, 0xb8, 0xe2, 0xe6, 0xfc, 0x02,
-	0x95, 0xf8, 0xa8, 0xa3, 0xd2, 0xfc, 0x64, 0xd8, 0xfe, 0x89, 0x9e, 0xbb, 0x1f, 0xc3, 0xf9, 0xab,
-	0x3d, 0x2b, 0x75, 0x20, 0x4b, 0xed, 0x60, 0x2b, 0xa7, 0xd4, 0xb7, 0x4d, 0x36, 0x4f, 0x07, 0x23,
-	0x53, 0x1f, 0x8e, 0x4c, 0xfd, 0x63, 0x64, 0xea, 0xf7, 0x63, 0x53, 0x1b, 0x8e, 0x4d, 0xed, 0x6d,
-	0x6c, 0x6a, 0x57, 0x76, 0x10, 0x8a, 0x4e, 0xda, 0x76, 0x7c, 0x88, 0xa6, 0x1c, 0xbb, 0x93, 0xb6,
-	0x67, 0xcc, 0x9b, 0x29, 0x55, 0xf4, 0x63, 0xca, 0xdb, 0x45, 0x79, 0x9f, 0x0e, 0xbf, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xaa, 0xab, 0xf6, 0x67, 0xf2, 0x02, 0x00, 0x00,
+func (m *QueryMintQuoteRequest) Reset()         { *m = QueryMintQuoteRequest{} }
+func (m *QueryMintQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintQuoteRequest) ProtoMessage()    {}
+func (*QueryMintQuoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{4}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ConversionRate queries the photon's conversion rate
-	ConversionRate(ctx context.Context, in *QueryConversionRateRequest, opts ...grpc.CallOption) (*QueryConversionRateResponse, error)
+func (m *QueryMintQuoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryMintQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintQuoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryMintQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintQuoteRequest.Merge(m, src)
+}
+func (m *QueryMintQuoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintQuoteRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/atomone.photon.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMintQuoteRequest proto.InternalMessageInfo
+
+func (m *QueryMintQuoteRequest) GetBurnAmount() string {
+	if m != nil {
+		return m.BurnAmount
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ConversionRate(ctx context.Context, in *QueryConversionRateRequest, opts ...grpc.CallOption) (*QueryConversionRateResponse, error) {
-	out := new(QueryConversionRateResponse)
-	err := c.cc.Invoke(ctx, "/atomone.photon.v1.Query/ConversionRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMintQuoteRequest) GetMintAmount() string {
+	if m != nil {
+		return m.MintAmount
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ConversionRate queries the photon's conversion rate
-	ConversionRate(context.Context, *QueryConversionRateRequest) (*QueryConversionRateResponse, error)
+// QueryMintQuoteResponse is response type for the Query/MintQuote RPC method.
+type QueryMintQuoteResponse struct {
+	// burned is the amount of bond denom to burn.
+	Burned types.Coin `protobuf:"bytes,1,opt,name=burned,proto3" json:"burned"`
+	// minted is the amount of uphoton minted when burning the burned amount.
+	Minted types.Coin `protobuf:"bytes,2,opt,name=minted,proto3" json:"minted"`
+	// conversion_rate represents the factor used to convert atone to photon.
+	ConversionRate string `protobuf:"bytes,3,opt,name=conversion_rate,json=conversionRate,proto3" json:"conversion_rate,omitempty"`
+	// remaining_mintable is the amount of uphoton that can still be minted
+	// before the max supply is reached.
+	RemainingMintable types.Coin `protobuf:"bytes,4,opt,name=remaining_mintable,json=remainingMintable,proto3" json:"remaining_mintable"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryMintQuoteResponse) Reset()         { *m = QueryMintQuoteResponse{} }
+func (m *QueryMintQuoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintQuoteResponse) ProtoMessage()    {}
+func (*QueryMintQuoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{5}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryMintQuoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ConversionRate(ctx context.Context, req *QueryConversionRateRequest) (*QueryConversionRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConversionRate not implemented")
+func (m *QueryMintQuoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintQuoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryMintQuoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintQuoteResponse.Merge(m, src)
+}
+func (m *QueryMintQuoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintQuoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintQuoteResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomone.photon.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryMintQuoteResponse proto.InternalMessageInfo
+
+func (m *QueryMintQuoteResponse) GetBurned() types.Coin {
+	if m != nil {
+		return m.Burned
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_ConversionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConversionRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ConversionRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomone.photon.v1.Query/ConversionRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ConversionRate(ctx, req.(*QueryConversionRateRequest))
+func (m *QueryMintQuoteResponse) GetMinted() types.Coin {
+	if m != nil {
+		return m.Minted
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "atomone.photon.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ConversionRate",
-			Handler:    _Query_ConversionRate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "atomone/photon/v1/query.proto",
+func (m *QueryMintQuoteResponse) GetConversionRate() string {
+	if m != nil {
+		return m.ConversionRate
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintQuoteResponse) GetRemainingMintable() types.Coin {
+	if m != nil {
+		return m.RemainingMintable
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMintTotalsRequest is request type for the Query/MintTotals RPC method.
+type QueryMintTotalsRequest struct {
+	// address is an optional address to query the amount minted by in the
+	// current epoch.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryMintTotalsRequest) Reset()         { *m = QueryMintTotalsRequest{} }
+func (m *QueryMintTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintTotalsRequest) ProtoMessage()    {}
+func (*QueryMintTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{6}
+}
+func (m *QueryMintTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintTotalsRequest.Merge(m, src)
+}
+func (m *QueryMintTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintTotalsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMintTotalsRequest proto.InternalMessageInfo
+
+func (m *QueryMintTotalsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMintTotalsResponse is response type for the Query/MintTotals RPC method.
+type QueryMintTotalsResponse struct {
+	// totals holds the amounts of uphoton minted in the current block and epoch.
+	Totals MintTotals `protobuf:"bytes,1,opt,name=totals,proto3" json:"totals"`
+	// address_epoch_minted is the amount of uphoton minted by the requested
+	// address in the current epoch.
+	AddressEpochMinted uint64 `protobuf:"varint,2,opt,name=address_epoch_minted,json=addressEpochMinted,proto3" json:"address_epoch_minted,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMintTotalsResponse) Reset()         { *m = QueryMintTotalsResponse{} }
+func (m *QueryMintTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintTotalsResponse) ProtoMessage()    {}
+func (*QueryMintTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{7}
+}
+func (m *QueryMintTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryConversionRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMintTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintTotalsResponse.Merge(m, src)
 }
-
-func (m *QueryConversionRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintTotalsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConversionRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryMintTotalsResponse proto.InternalMessageInfo
+
+func (m *QueryMintTotalsResponse) GetTotals() MintTotals {
+	if m != nil {
+		return m.Totals
+	}
+	return MintTotals{}
 }
 
-func (m *QueryConversionRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintTotalsResponse) GetAddressEpochMinted() uint64 {
+	if m != nil {
+		return m.AddressEpochMinted
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryConversionRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMintStatsRequest is request type for the Query/MintStats RPC method.
+type QueryMintStatsRequest struct {
 }
 
-func (m *QueryConversionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConversionRate) > 0 {
-		i -= len(m.ConversionRate)
-		copy(dAtA[i:], m.ConversionRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConversionRate)))
+func (m *QueryMintStatsRequest) Reset()         { *m = QueryMintStatsRequest{} }
+func (m *QueryMintStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintStatsRequest) ProtoMessage()    {}
+func (*QueryMintStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{8}
+}
+func (m *QueryMintStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintStatsRequest.Merge(m, src)
+}
+func (m *QueryMintStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintStatsRequest proto.InternalMessageInfo
+
+// QueryMintStatsResponse is response type for the Query/MintStats RPC method.
+type QueryMintStatsResponse struct {
+	// mint_stats holds the cumulative mint statistics.
+	MintStats MintStats `protobuf:"bytes,1,opt,name=mint_stats,json=mintStats,proto3" json:"mint_stats"`
+}
+
+func (m *QueryMintStatsResponse) Reset()         { *m = QueryMintStatsResponse{} }
+func (m *QueryMintStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintStatsResponse) ProtoMessage()    {}
+func (*QueryMintStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{9}
+}
+func (m *QueryMintStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintStatsResponse.Merge(m, src)
+}
+func (m *QueryMintStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintStatsResponse proto.InternalMessageInfo
+
+func (m *QueryMintStatsResponse) GetMintStats() MintStats {
+	if m != nil {
+		return m.MintStats
+	}
+	return MintStats{}
+}
+
+// QueryConversionRateHistoryRequest is request type for the
+// Query/ConversionRateHistory RPC method.
+type QueryConversionRateHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConversionRateHistoryRequest) Reset()         { *m = QueryConversionRateHistoryRequest{} }
+func (m *QueryConversionRateHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionRateHistoryRequest) ProtoMessage()    {}
+func (*QueryConversionRateHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{10}
+}
+func (m *QueryConversionRateHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionRateHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionRateHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionRateHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionRateHistoryRequest.Merge(m, src)
+}
+func (m *QueryConversionRateHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionRateHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionRateHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionRateHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryConversionRateHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConversionRateHistoryResponse is response type for the
+// Query/ConversionRateHistory RPC method.
+type QueryConversionRateHistoryResponse struct {
+	// snapshots holds the conversion rate snapshots, ordered by height.
+	Snapshots []ConversionRateSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConversionRateHistoryResponse) Reset()         { *m = QueryConversionRateHistoryResponse{} }
+func (m *QueryConversionRateHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionRateHistoryResponse) ProtoMessage()    {}
+func (*QueryConversionRateHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{11}
+}
+func (m *QueryConversionRateHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionRateHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionRateHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionRateHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionRateHistoryResponse.Merge(m, src)
+}
+func (m *QueryConversionRateHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionRateHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionRateHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionRateHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryConversionRateHistoryResponse) GetSnapshots() []ConversionRateSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *QueryConversionRateHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInvariantsRequest is request type for the Query/Invariants RPC method.
+type QueryInvariantsRequest struct {
+}
+
+func (m *QueryInvariantsRequest) Reset()         { *m = QueryInvariantsRequest{} }
+func (m *QueryInvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsRequest) ProtoMessage()    {}
+func (*QueryInvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{12}
+}
+func (m *QueryInvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsRequest.Merge(m, src)
+}
+func (m *QueryInvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsRequest proto.InternalMessageInfo
+
+// QueryInvariantsResponse is response type for the Query/Invariants RPC
+// method.
+type QueryInvariantsResponse struct {
+	// invariants holds the result of each photon module invariant.
+	Invariants []InvariantResult `protobuf:"bytes,1,rep,name=invariants,proto3" json:"invariants"`
+	// broken is true if at least one invariant is broken.
+	Broken bool `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+}
+
+func (m *QueryInvariantsResponse) Reset()         { *m = QueryInvariantsResponse{} }
+func (m *QueryInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsResponse) ProtoMessage()    {}
+func (*QueryInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{13}
+}
+func (m *QueryInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsResponse.Merge(m, src)
+}
+func (m *QueryInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryInvariantsResponse) GetInvariants() []InvariantResult {
+	if m != nil {
+		return m.Invariants
+	}
+	return nil
+}
+
+func (m *QueryInvariantsResponse) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+// InvariantResult holds the result of an invariant.
+type InvariantResult struct {
+	// route is the route of the invariant, as registered in the invariant
+	// registry.
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// broken is true if the invariant is broken.
+	Broken bool `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	// message describes the checked values.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{14}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// QueryRelayerFeePolicyRequest is request type for the Query/RelayerFeePolicy
+// RPC method.
+type QueryRelayerFeePolicyRequest struct {
+}
+
+func (m *QueryRelayerFeePolicyRequest) Reset()         { *m = QueryRelayerFeePolicyRequest{} }
+func (m *QueryRelayerFeePolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerFeePolicyRequest) ProtoMessage()    {}
+func (*QueryRelayerFeePolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{15}
+}
+func (m *QueryRelayerFeePolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerFeePolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerFeePolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerFeePolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerFeePolicyRequest.Merge(m, src)
+}
+func (m *QueryRelayerFeePolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerFeePolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerFeePolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerFeePolicyRequest proto.InternalMessageInfo
+
+// QueryRelayerFeePolicyResponse is response type for the
+// Query/RelayerFeePolicy RPC method.
+type QueryRelayerFeePolicyResponse struct {
+	// policy is the active relayer fee policy.
+	Policy RelayerFeePolicy `protobuf:"varint,1,opt,name=policy,proto3,enum=atomone.photon.v1.RelayerFeePolicy" json:"policy,omitempty"`
+	// msg_type_urls holds the type URLs of the relayer messages. The policy
+	// applies to the txs that only contain these messages.
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// max_zero_fee_relayer_gas_per_block is the maximum cumulative gas limit of
+	// the zero-fee relayer txs included in a single block.
+	MaxZeroFeeRelayerGasPerBlock uint64 `protobuf:"varint,3,opt,name=max_zero_fee_relayer_gas_per_block,json=maxZeroFeeRelayerGasPerBlock,proto3" json:"max_zero_fee_relayer_gas_per_block,omitempty"`
+}
+
+func (m *QueryRelayerFeePolicyResponse) Reset()         { *m = QueryRelayerFeePolicyResponse{} }
+func (m *QueryRelayerFeePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerFeePolicyResponse) ProtoMessage()    {}
+func (*QueryRelayerFeePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{16}
+}
+func (m *QueryRelayerFeePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerFeePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerFeePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerFeePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerFeePolicyResponse.Merge(m, src)
+}
+func (m *QueryRelayerFeePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerFeePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerFeePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerFeePolicyResponse proto.InternalMessageInfo
+
+func (m *QueryRelayerFeePolicyResponse) GetPolicy() RelayerFeePolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return RelayerFeePolicyUnspecified
+}
+
+func (m *QueryRelayerFeePolicyResponse) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *QueryRelayerFeePolicyResponse) GetMaxZeroFeeRelayerGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxZeroFeeRelayerGasPerBlock
+	}
+	return 0
+}
+
+// QueryBuybackSchedulesRequest is request type for the Query/BuybackSchedules
+// RPC method.
+type QueryBuybackSchedulesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBuybackSchedulesRequest) Reset()         { *m = QueryBuybackSchedulesRequest{} }
+func (m *QueryBuybackSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBuybackSchedulesRequest) ProtoMessage()    {}
+func (*QueryBuybackSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{17}
+}
+func (m *QueryBuybackSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuybackSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuybackSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBuybackSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuybackSchedulesRequest.Merge(m, src)
+}
+func (m *QueryBuybackSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuybackSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuybackSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBuybackSchedulesRequest proto.InternalMessageInfo
+
+func (m *QueryBuybackSchedulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBuybackSchedulesResponse is response type for the
+// Query/BuybackSchedules RPC method.
+type QueryBuybackSchedulesResponse struct {
+	// schedules holds the active buyback schedules, ordered by id.
+	Schedules []BuybackSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBuybackSchedulesResponse) Reset()         { *m = QueryBuybackSchedulesResponse{} }
+func (m *QueryBuybackSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBuybackSchedulesResponse) ProtoMessage()    {}
+func (*QueryBuybackSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{18}
+}
+func (m *QueryBuybackSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuybackSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuybackSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBuybackSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuybackSchedulesResponse.Merge(m, src)
+}
+func (m *QueryBuybackSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuybackSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuybackSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBuybackSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryBuybackSchedulesResponse) GetSchedules() []BuybackSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *QueryBuybackSchedulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBuybackScheduleRequest is request type for the Query/BuybackSchedule
+// RPC method.
+type QueryBuybackScheduleRequest struct {
+	// id is the identifier of the schedule.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryBuybackScheduleRequest) Reset()         { *m = QueryBuybackScheduleRequest{} }
+func (m *QueryBuybackScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBuybackScheduleRequest) ProtoMessage()    {}
+func (*QueryBuybackScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{19}
+}
+func (m *QueryBuybackScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuybackScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuybackScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBuybackScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuybackScheduleRequest.Merge(m, src)
+}
+func (m *QueryBuybackScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuybackScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuybackScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBuybackScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryBuybackScheduleRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryBuybackScheduleResponse is response type for the Query/BuybackSchedule
+// RPC method.
+type QueryBuybackScheduleResponse struct {
+	Schedule BuybackSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *QueryBuybackScheduleResponse) Reset()         { *m = QueryBuybackScheduleResponse{} }
+func (m *QueryBuybackScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBuybackScheduleResponse) ProtoMessage()    {}
+func (*QueryBuybackScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb3d9462fe75129, []int{20}
+}
+func (m *QueryBuybackScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuybackScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuybackScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBuybackScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuybackScheduleResponse.Merge(m, src)
+}
+func (m *QueryBuybackScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuybackScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuybackScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBuybackScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryBuybackScheduleResponse) GetSchedule() BuybackSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return BuybackSchedule{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "atomone.photon.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "atomone.photon.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryConversionRateRequest)(nil), "atomone.photon.v1.QueryConversionRateRequest")
+	proto.RegisterType((*QueryConversionRateResponse)(nil), "atomone.photon.v1.QueryConversionRateResponse")
+	proto.RegisterType((*QueryMintQuoteRequest)(nil), "atomone.photon.v1.QueryMintQuoteRequest")
+	proto.RegisterType((*QueryMintQuoteResponse)(nil), "atomone.photon.v1.QueryMintQuoteResponse")
+	proto.RegisterType((*QueryMintTotalsRequest)(nil), "atomone.photon.v1.QueryMintTotalsRequest")
+	proto.RegisterType((*QueryMintTotalsResponse)(nil), "atomone.photon.v1.QueryMintTotalsResponse")
+	proto.RegisterType((*QueryMintStatsRequest)(nil), "atomone.photon.v1.QueryMintStatsRequest")
+	proto.RegisterType((*QueryMintStatsResponse)(nil), "atomone.photon.v1.QueryMintStatsResponse")
+	proto.RegisterType((*QueryConversionRateHistoryRequest)(nil), "atomone.photon.v1.QueryConversionRateHistoryRequest")
+	proto.RegisterType((*QueryConversionRateHistoryResponse)(nil), "atomone.photon.v1.QueryConversionRateHistoryResponse")
+	proto.RegisterType((*QueryInvariantsRequest)(nil), "atomone.photon.v1.QueryInvariantsRequest")
+	proto.RegisterType((*QueryInvariantsResponse)(nil), "atomone.photon.v1.QueryInvariantsResponse")
+	proto.RegisterType((*InvariantResult)(nil), "atomone.photon.v1.InvariantResult")
+	proto.RegisterType((*QueryRelayerFeePolicyRequest)(nil), "atomone.photon.v1.QueryRelayerFeePolicyRequest")
+	proto.RegisterType((*QueryRelayerFeePolicyResponse)(nil), "atomone.photon.v1.QueryRelayerFeePolicyResponse")
+	proto.RegisterType((*QueryBuybackSchedulesRequest)(nil), "atomone.photon.v1.QueryBuybackSchedulesRequest")
+	proto.RegisterType((*QueryBuybackSchedulesResponse)(nil), "atomone.photon.v1.QueryBuybackSchedulesResponse")
+	proto.RegisterType((*QueryBuybackScheduleRequest)(nil), "atomone.photon.v1.QueryBuybackScheduleRequest")
+	proto.RegisterType((*QueryBuybackScheduleResponse)(nil), "atomone.photon.v1.QueryBuybackScheduleResponse")
+}
+
+func init() { proto.RegisterFile("atomone/photon/v1/query.proto", fileDescriptor_4cb3d9462fe75129) }
+
+var fileDescriptor_4cb3d9462fe75129 = []byte{
+	// 1256 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0xb7, 0x69, 0xda, 0x7d, 0xf9, 0x7e, 0xd3, 0x76, 0x48, 0xdb, 0xad, 0x9b, 0x6c, 0x53,
+	0xd3, 0xa4, 0x49, 0xe8, 0xae, 0x93, 0x05, 0xc4, 0x81, 0x53, 0xd2, 0x92, 0xa4, 0x12, 0xa9, 0x52,
+	0xa7, 0x20, 0x51, 0x0e, 0xd6, 0xec, 0xee, 0xd4, 0x6b, 0x65, 0xed, 0x71, 0x3c, 0x76, 0x94, 0x6d,
+	0x85, 0x84, 0xb8, 0x80, 0xe0, 0x82, 0xd4, 0x23, 0x47, 0xe0, 0xce, 0xa1, 0x07, 0x0e, 0xfc, 0x01,
+	0x15, 0xa7, 0xaa, 0x5c, 0x38, 0x21, 0x94, 0xf0, 0x87, 0x20, 0xcf, 0x0f, 0xef, 0x2f, 0x6f, 0x62,
+	0xa4, 0xde, 0xe2, 0x79, 0xef, 0xf3, 0xde, 0xe7, 0x7d, 0x66, 0x66, 0x3f, 0x13, 0x98, 0xc5, 0x11,
+	0xf5, 0xa8, 0x4f, 0xcc, 0xa0, 0x45, 0x23, 0xea, 0x9b, 0x07, 0xab, 0xe6, 0x7e, 0x4c, 0xc2, 0x4e,
+	0x35, 0x08, 0x69, 0x44, 0xd1, 0x25, 0x19, 0xae, 0x8a, 0x70, 0xf5, 0x60, 0x55, 0x9f, 0x76, 0xa8,
+	0x43, 0x79, 0xd4, 0x4c, 0xfe, 0x12, 0x89, 0xfa, 0x8c, 0x43, 0xa9, 0xd3, 0x26, 0x26, 0x0e, 0x5c,
+	0x13, 0xfb, 0x3e, 0x8d, 0x70, 0xe4, 0x52, 0x9f, 0xc9, 0x68, 0x79, 0xb8, 0x8b, 0x2c, 0x28, 0xe2,
+	0xd7, 0x1a, 0x94, 0x79, 0x94, 0xd9, 0xa2, 0xac, 0xf8, 0x50, 0x50, 0xf1, 0x65, 0xd6, 0x31, 0x23,
+	0xe6, 0xc1, 0x6a, 0x9d, 0x44, 0x78, 0xd5, 0x6c, 0x50, 0x57, 0x41, 0x97, 0x7b, 0xe3, 0x9c, 0x7a,
+	0x9a, 0x15, 0x60, 0xc7, 0xf5, 0x39, 0x0f, 0x91, 0x6b, 0x4c, 0x03, 0x7a, 0x98, 0x64, 0xec, 0xe0,
+	0x10, 0x7b, 0xcc, 0x22, 0xfb, 0x31, 0x61, 0x91, 0xf1, 0x00, 0xde, 0xea, 0x5b, 0x65, 0x01, 0xf5,
+	0x19, 0x41, 0x1f, 0xc0, 0x44, 0xc0, 0x57, 0x4a, 0xda, 0x9c, 0xb6, 0x38, 0x59, 0xbb, 0x56, 0x1d,
+	0xd2, 0xa2, 0x2a, 0x20, 0xeb, 0xe3, 0x2f, 0xff, 0xba, 0x31, 0x66, 0xc9, 0x74, 0x63, 0x06, 0x74,
+	0x5e, 0xef, 0x2e, 0xf5, 0x0f, 0x48, 0xc8, 0x5c, 0xea, 0x5b, 0x38, 0x22, 0xaa, 0xdb, 0xa7, 0x70,
+	0x3d, 0x33, 0x9a, 0x76, 0xbd, 0xd0, 0x48, 0x23, 0x76, 0x88, 0x23, 0xc2, 0xdb, 0x17, 0xd7, 0xa7,
+	0x5e, 0xbf, 0xa8, 0x80, 0x54, 0xe6, 0x1e, 0x69, 0x58, 0x53, 0x8d, 0xbe, 0x02, 0x46, 0x07, 0x2e,
+	0xf3, 0xba, 0xdb, 0xae, 0x1f, 0x3d, 0x8c, 0x69, 0xda, 0x10, 0x99, 0x30, 0x59, 0x8f, 0x43, 0xdf,
+	0xc6, 0x1e, 0x8d, 0xfd, 0x28, 0xa3, 0xda, 0x7d, 0x3f, 0xb2, 0x20, 0x49, 0x59, 0xe3, 0x19, 0x09,
+	0xc0, 0x73, 0xfd, 0x48, 0x01, 0x0a, 0xd9, 0x80, 0x24, 0x45, 0x00, 0x8c, 0xe7, 0x05, 0xb8, 0x32,
+	0xd8, 0xbb, 0x2b, 0x62, 0x52, 0x99, 0x34, 0x53, 0x11, 0x65, 0x8d, 0x64, 0xbb, 0xaa, 0x72, 0xa3,
+	0xaa, 0x77, 0xa9, 0xeb, 0x2b, 0x11, 0x45, 0x7a, 0x02, 0x4c, 0x3a, 0x90, 0x66, 0xa9, 0x90, 0x13,
+	0x28, 0xd2, 0xb3, 0x04, 0x3c, 0x93, 0x47, 0x40, 0xf4, 0x00, 0x50, 0x48, 0x3c, 0xec, 0xfa, 0xae,
+	0xef, 0xd8, 0x49, 0x31, 0x5c, 0x6f, 0x93, 0xd2, 0x78, 0xbe, 0xee, 0x97, 0x52, 0xe8, 0xb6, 0x44,
+	0x1a, 0x1f, 0xf7, 0x88, 0xf2, 0x88, 0x46, 0xb8, 0xad, 0x0e, 0x1c, 0xaa, 0xc1, 0x39, 0xdc, 0x6c,
+	0x86, 0x84, 0x31, 0xb9, 0x1b, 0xa5, 0xd7, 0x2f, 0x2a, 0xd3, 0xb2, 0xc3, 0x9a, 0x88, 0xec, 0x46,
+	0xa1, 0xeb, 0x3b, 0x96, 0x4a, 0x34, 0xbe, 0xd1, 0xe0, 0xea, 0x50, 0x39, 0x29, 0xf2, 0x87, 0x30,
+	0x11, 0xf1, 0x15, 0x29, 0xf2, 0x6c, 0xc6, 0x49, 0xed, 0xc2, 0x94, 0x5e, 0x02, 0x82, 0x56, 0x60,
+	0x5a, 0xf6, 0xb0, 0x49, 0x40, 0x1b, 0x2d, 0xbb, 0x47, 0xf6, 0x71, 0x0b, 0xc9, 0xd8, 0x47, 0x49,
+	0x68, 0x9b, 0x47, 0x8c, 0xab, 0x3d, 0x27, 0x6d, 0x37, 0xc2, 0x51, 0x7a, 0x91, 0x3e, 0x87, 0x2b,
+	0x83, 0x01, 0xc9, 0x70, 0x0d, 0xf8, 0x79, 0xb1, 0x59, 0xb2, 0x2a, 0x59, 0xce, 0x8c, 0x60, 0xc9,
+	0x91, 0x92, 0x64, 0xd1, 0x53, 0x0b, 0xc6, 0x1e, 0xdc, 0xcc, 0xb8, 0x37, 0x5b, 0x2e, 0x8b, 0x68,
+	0xd8, 0x51, 0xca, 0x6e, 0x00, 0x74, 0x2f, 0xbd, 0xec, 0xb3, 0xd0, 0xb7, 0x77, 0xe2, 0xc7, 0x4d,
+	0xed, 0xe0, 0x0e, 0x76, 0xd4, 0x3d, 0xb1, 0x7a, 0x90, 0xc6, 0x6f, 0x1a, 0x18, 0x27, 0x75, 0x93,
+	0x63, 0x6d, 0x43, 0x91, 0xf9, 0x38, 0x60, 0x2d, 0xca, 0xa7, 0x3a, 0xb3, 0x38, 0x59, 0x5b, 0xca,
+	0x98, 0xaa, 0xbf, 0xc8, 0xae, 0x44, 0xa8, 0x11, 0xd3, 0x0a, 0x68, 0xb3, 0x8f, 0xbd, 0x38, 0xf7,
+	0xb7, 0x4f, 0x65, 0x2f, 0xb8, 0xf4, 0xd1, 0x2f, 0xc9, 0x8d, 0xb8, 0xef, 0x1f, 0xe0, 0xd0, 0xc5,
+	0x7e, 0x77, 0x8b, 0x9e, 0xc1, 0xd5, 0xa1, 0x88, 0x1c, 0x66, 0x0b, 0xc0, 0x4d, 0x57, 0xe5, 0x34,
+	0x46, 0xc6, 0x34, 0x29, 0xd4, 0x22, 0x2c, 0x6e, 0xab, 0x31, 0x7a, 0xb0, 0xe8, 0x0a, 0x4c, 0xd4,
+	0x43, 0xba, 0x47, 0xc4, 0x0c, 0xe7, 0x2d, 0xf9, 0x65, 0x7c, 0x06, 0x17, 0x06, 0xc0, 0x68, 0x1a,
+	0xce, 0x86, 0x34, 0x56, 0x3f, 0x72, 0x96, 0xf8, 0x18, 0x55, 0x00, 0x95, 0xe0, 0x9c, 0x47, 0x18,
+	0xc3, 0x8e, 0xbc, 0xd3, 0x96, 0xfa, 0x34, 0xca, 0x30, 0xc3, 0xe7, 0xb2, 0x48, 0x1b, 0x77, 0x48,
+	0xb8, 0x41, 0xc8, 0x0e, 0x6d, 0xbb, 0x0d, 0x75, 0x30, 0x8c, 0xdf, 0x35, 0x98, 0x1d, 0x91, 0xd0,
+	0xbd, 0x44, 0x01, 0x5f, 0xe1, 0x54, 0xa6, 0x6a, 0x6f, 0x67, 0x8c, 0x3e, 0x04, 0x96, 0x10, 0x64,
+	0xc0, 0xff, 0x3d, 0xe6, 0xd8, 0x51, 0x27, 0x20, 0x76, 0x1c, 0xb6, 0x59, 0xa9, 0x30, 0x77, 0x66,
+	0xb1, 0x68, 0x4d, 0x7a, 0xcc, 0x79, 0xd4, 0x09, 0xc8, 0x27, 0x61, 0x9b, 0xa1, 0x2d, 0x30, 0x3c,
+	0x7c, 0x68, 0x3f, 0x25, 0x21, 0xb5, 0x9f, 0x10, 0x62, 0x87, 0xa2, 0x98, 0xed, 0x60, 0x66, 0x07,
+	0x24, 0xb4, 0xeb, 0x6d, 0xda, 0xd8, 0xe3, 0x73, 0x8d, 0x5b, 0x33, 0x1e, 0x3e, 0x7c, 0x4c, 0x42,
+	0xba, 0x41, 0x88, 0xec, 0xb9, 0x89, 0xd9, 0x0e, 0x09, 0xd7, 0x93, 0x1c, 0xe3, 0x89, 0x1c, 0x76,
+	0x3d, 0xee, 0xd4, 0x71, 0x63, 0x6f, 0xb7, 0xd1, 0x22, 0xcd, 0xb8, 0x4d, 0xd8, 0x9b, 0xbe, 0x05,
+	0xbf, 0x28, 0xd1, 0x86, 0x1b, 0x49, 0xd1, 0x36, 0xa0, 0xc8, 0xd4, 0xe2, 0x09, 0x47, 0x66, 0x00,
+	0x9f, 0x9e, 0x7c, 0x05, 0x7d, 0x73, 0x27, 0xbf, 0x22, 0xdd, 0x75, 0xa0, 0xa3, 0x52, 0x66, 0x0a,
+	0x0a, 0xae, 0xb0, 0xa2, 0x71, 0xab, 0xe0, 0x36, 0x8d, 0x66, 0xb6, 0x92, 0xe9, 0x7c, 0xf7, 0xe0,
+	0xbc, 0x22, 0x29, 0x75, 0xcc, 0x3f, 0x5e, 0x8a, 0xac, 0x7d, 0xf9, 0x3f, 0x38, 0xcb, 0xdb, 0xa0,
+	0xa7, 0x30, 0x21, 0x9e, 0x0c, 0x68, 0x3e, 0xa3, 0xce, 0xf0, 0xdb, 0x44, 0x5f, 0x38, 0x2d, 0x4d,
+	0x10, 0x35, 0x6e, 0x7e, 0xf5, 0xc7, 0x3f, 0xcf, 0x0b, 0xd7, 0xd1, 0x35, 0x33, 0xe3, 0xa5, 0x25,
+	0x3a, 0xfe, 0xa0, 0xc1, 0x54, 0xff, 0x2f, 0x11, 0xaa, 0x8c, 0xaa, 0x9e, 0xf9, 0x74, 0xd1, 0xab,
+	0x79, 0xd3, 0x25, 0xa9, 0x65, 0x4e, 0xea, 0x16, 0x32, 0x32, 0x48, 0x0d, 0x78, 0x34, 0xfa, 0x5a,
+	0x83, 0x62, 0xfa, 0x7c, 0x40, 0x8b, 0xa3, 0x3a, 0x0d, 0xbe, 0x6e, 0xf4, 0xa5, 0x1c, 0x99, 0x92,
+	0xce, 0x3c, 0xa7, 0x73, 0x03, 0xcd, 0x66, 0xd0, 0xe1, 0xee, 0xb4, 0xcf, 0x7b, 0x7f, 0xa7, 0x01,
+	0x74, 0xdd, 0x12, 0x9d, 0xd8, 0xa0, 0xcf, 0xd7, 0xf5, 0xe5, 0x3c, 0xa9, 0x92, 0xcc, 0x02, 0x27,
+	0x33, 0x87, 0xca, 0xa3, 0xc8, 0x48, 0x7b, 0x56, 0xba, 0x70, 0x13, 0x3c, 0x59, 0x97, 0x5e, 0x2f,
+	0xd6, 0x97, 0x72, 0x64, 0xe6, 0xd5, 0x85, 0xbb, 0x36, 0xfa, 0x55, 0x83, 0xcb, 0x99, 0x76, 0x88,
+	0xde, 0xcb, 0x77, 0x2e, 0xfa, 0xbd, 0x5a, 0x7f, 0xff, 0x3f, 0xa2, 0x24, 0xdb, 0x1a, 0x67, 0x7b,
+	0x07, 0x2d, 0x9f, 0x7e, 0xa8, 0xec, 0x96, 0x24, 0xf8, 0xad, 0x06, 0xd0, 0x75, 0xbc, 0xd1, 0x5b,
+	0x3a, 0xe4, 0x97, 0xfa, 0x72, 0x9e, 0xd4, 0x1c, 0x3a, 0xf6, 0xb8, 0xe3, 0x4f, 0x1a, 0x5c, 0x1c,
+	0x34, 0x12, 0x64, 0x8e, 0xea, 0x33, 0xc2, 0xd0, 0xf4, 0x95, 0xfc, 0x00, 0x49, 0xaf, 0xc2, 0xe9,
+	0xdd, 0x46, 0xf3, 0x19, 0xf4, 0x94, 0x17, 0x25, 0xbe, 0x24, 0x2d, 0xed, 0x47, 0x0d, 0x2e, 0x0e,
+	0xfe, 0xee, 0x8f, 0xa6, 0x39, 0xc2, 0x8a, 0xf4, 0x95, 0xfc, 0x00, 0x49, 0xf3, 0x0e, 0xa7, 0xb9,
+	0x80, 0x6e, 0x65, 0xd0, 0xac, 0x0b, 0x90, 0xdd, 0x35, 0x8e, 0x9f, 0x35, 0xb8, 0x30, 0x50, 0x0a,
+	0x55, 0x73, 0xf6, 0x54, 0x1c, 0xcd, 0xdc, 0xf9, 0x92, 0xe2, 0x2a, 0xa7, 0xf8, 0x0e, 0x5a, 0xca,
+	0x43, 0xd1, 0x7c, 0xe6, 0x36, 0xbf, 0x58, 0xdf, 0x7c, 0x79, 0x54, 0xd6, 0x5e, 0x1d, 0x95, 0xb5,
+	0xbf, 0x8f, 0xca, 0xda, 0xf7, 0xc7, 0xe5, 0xb1, 0x57, 0xc7, 0xe5, 0xb1, 0x3f, 0x8f, 0xcb, 0x63,
+	0x8f, 0x2b, 0x8e, 0x1b, 0xb5, 0xe2, 0x7a, 0xb5, 0x41, 0x3d, 0x55, 0xae, 0xd2, 0x8a, 0xeb, 0x69,
+	0xe9, 0x43, 0x55, 0x3c, 0x79, 0x5c, 0xb0, 0xfa, 0x04, 0xff, 0x4f, 0xf6, 0xdd, 0x7f, 0x07, 0x00,
+	0x44, 0x7f, 0xe4, 0xcd, 0xb8, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ConversionRate queries the photon's conversion rate
+	ConversionRate(ctx context.Context, in *QueryConversionRateRequest, opts ...grpc.CallOption) (*QueryConversionRateResponse, error)
+	// MintQuote queries the outcome of a photon mint, either the uphoton minted
+	// for a given bond denom amount, or the minimum bond denom amount to burn to
+	// mint a given uphoton amount.
+	MintQuote(ctx context.Context, in *QueryMintQuoteRequest, opts ...grpc.CallOption) (*QueryMintQuoteResponse, error)
+	// MintTotals queries the amounts of photon minted in the current block and
+	// epoch, which are subject to the mint limits.
+	MintTotals(ctx context.Context, in *QueryMintTotalsRequest, opts ...grpc.CallOption) (*QueryMintTotalsResponse, error)
+	// MintStats queries the cumulative amounts of atone burned and photon
+	// minted, and the number of mints.
+	MintStats(ctx context.Context, in *QueryMintStatsRequest, opts ...grpc.CallOption) (*QueryMintStatsResponse, error)
+	// ConversionRateHistory queries the conversion rate snapshots.
+	ConversionRateHistory(ctx context.Context, in *QueryConversionRateHistoryRequest, opts ...grpc.CallOption) (*QueryConversionRateHistoryResponse, error)
+	// Invariants runs the photon module invariants and returns their results.
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
+	// RelayerFeePolicy queries the fee policy applied to IBC relayer txs.
+	RelayerFeePolicy(ctx context.Context, in *QueryRelayerFeePolicyRequest, opts ...grpc.CallOption) (*QueryRelayerFeePolicyResponse, error)
+	// BuybackSchedules queries the active community pool buyback schedules.
+	BuybackSchedules(ctx context.Context, in *QueryBuybackSchedulesRequest, opts ...grpc.CallOption) (*QueryBuybackSchedulesResponse, error)
+	// BuybackSchedule queries a community pool buyback schedule by id.
+	BuybackSchedule(ctx context.Context, in *QueryBuybackScheduleRequest, opts ...grpc.CallOption) (*QueryBuybackScheduleResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/atomone.photon.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConversionRate(ctx context.Context, in *QueryConversionRateRequest, opts ...grpc.CallOption) (*QueryConversionRateResponse, error) {
+	out := new(QueryConversionRateResponse)
+	err := c.cc.Invoke(ctx, "/atomone.photon.v1.Query/ConversionRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintQuote(ctx context.Context, in *QueryMintQuoteRequest, opts ...grpc.CallOption) (*QueryMintQuoteResponse, error) {
+	out := new(QueryMintQuoteResponse)
+	err := c.cc.Invoke(ctx, "/atomone.photon.v1.Query/MintQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintTotals(ctx context.Context, in *QueryMintTotalsRequest, opts ...grpc.CallOption) (*QueryMintTotalsResponse, error) {
+	out := new(QueryMintTotalsResponse)
+	err := c.cc.Invoke(ctx, "/atomone.photon.v1.Query/MintTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintStats(ctx context.Context, in *QueryMintStatsRequest, opts ...grpc.CallOption) (*QueryMintStatsResponse, error) {
+	out := new(QueryMintStatsResponse)
+	err := c.cc.Invoke(ctx, "/atomone.photon.v1.Query/MintStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConversionRateHistory(ctx context.Context, in *QueryConversionRateHistoryRequest, opts ...grpc.CallOption) (*QueryConversionRateHistoryResponse, error) {
+	out := new(QueryConversionRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/atomone.photon.v1.Query/ConversionRateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/atomone.photon.v1.Query/Invariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RelayerFeePolicy(ctx context.Context, in *QueryRelayerFeePolicyRequest, opts ...grpc.CallOption) (*QueryRelayerFeePolicyResponse, error) {
+	out := new(QueryRelayerFeePolicyResponse)
+	err := c.cc.Invoke(ctx, "/atomone.photon.v1.Query/RelayerFeePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BuybackSchedules(ctx context.Context, in *QueryBuybackSchedulesRequest, opts ...grpc.CallOption) (*QueryBuybackSchedulesResponse, error) {
+	out := new(QueryBuybackSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/atomone.photon.v1.Query/BuybackSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BuybackSchedule(ctx context.Context, in *QueryBuybackScheduleRequest, opts ...grpc.CallOption) (*QueryBuybackScheduleResponse, error) {
+	out := new(QueryBuybackScheduleResponse)
+	err := c.cc.Invoke(ctx, "/atomone.photon.v1.Query/BuybackSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ConversionRate queries the photon's conversion rate
+	ConversionRate(context.Context, *QueryConversionRateRequest) (*QueryConversionRateResponse, error)
+	// MintQuote queries the outcome of a photon mint, either the uphoton minted
+	// for a given bond denom amount, or the minimum bond denom amount to burn to
+	// mint a given uphoton amount.
+	MintQuote(context.Context, *QueryMintQuoteRequest) (*QueryMintQuoteResponse, error)
+	// MintTotals queries the amounts of photon minted in the current block and
+	// epoch, which are subject to the mint limits.
+	MintTotals(context.Context, *QueryMintTotalsRequest) (*QueryMintTotalsResponse, error)
+	// MintStats queries the cumulative amounts of atone burned and photon
+	// minted, and the number of mints.
+	MintStats(context.Context, *QueryMintStatsRequest) (*QueryMintStatsResponse, error)
+	// ConversionRateHistory queries the conversion rate snapshots.
+	ConversionRateHistory(context.Context, *QueryConversionRateHistoryRequest) (*QueryConversionRateHistoryResponse, error)
+	// Invariants runs the photon module invariants and returns their results.
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
+	// RelayerFeePolicy queries the fee policy applied to IBC relayer txs.
+	RelayerFeePolicy(context.Context, *QueryRelayerFeePolicyRequest) (*QueryRelayerFeePolicyResponse, error)
+	// BuybackSchedules queries the active community pool buyback schedules.
+	BuybackSchedules(context.Context, *QueryBuybackSchedulesRequest) (*QueryBuybackSchedulesResponse, error)
+	// BuybackSchedule queries a community pool buyback schedule by id.
+	BuybackSchedule(context.Context, *QueryBuybackScheduleRequest) (*QueryBuybackScheduleResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ConversionRate(ctx context.Context, req *QueryConversionRateRequest) (*QueryConversionRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversionRate not implemented")
+}
+func (*UnimplementedQueryServer) MintQuote(ctx context.Context, req *QueryMintQuoteRequest) (*QueryMintQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintQuote not implemented")
+}
+func (*UnimplementedQueryServer) MintTotals(ctx context.Context, req *QueryMintTotalsRequest) (*QueryMintTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintTotals not implemented")
+}
+func (*UnimplementedQueryServer) MintStats(ctx context.Context, req *QueryMintStatsRequest) (*QueryMintStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintStats not implemented")
+}
+func (*UnimplementedQueryServer) ConversionRateHistory(ctx context.Context, req *QueryConversionRateHistoryRequest) (*QueryConversionRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversionRateHistory not implemented")
+}
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
+func (*UnimplementedQueryServer) RelayerFeePolicy(ctx context.Context, req *QueryRelayerFeePolicyRequest) (*QueryRelayerFeePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerFeePolicy not implemented")
+}
+func (*UnimplementedQueryServer) BuybackSchedules(ctx context.Context, req *QueryBuybackSchedulesRequest) (*QueryBuybackSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuybackSchedules not implemented")
+}
+func (*UnimplementedQueryServer) BuybackSchedule(ctx context.Context, req *QueryBuybackScheduleRequest) (*QueryBuybackScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuybackSchedule not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.photon.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConversionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConversionRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConversionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.photon.v1.Query/ConversionRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConversionRate(ctx, req.(*QueryConversionRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.photon.v1.Query/MintQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintQuote(ctx, req.(*QueryMintQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.photon.v1.Query/MintTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintTotals(ctx, req.(*QueryMintTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.photon.v1.Query/MintStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintStats(ctx, req.(*QueryMintStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConversionRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConversionRateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConversionRateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.photon.v1.Query/ConversionRateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConversionRateHistory(ctx, req.(*QueryConversionRateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.photon.v1.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelayerFeePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerFeePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayerFeePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.photon.v1.Query/RelayerFeePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayerFeePolicy(ctx, req.(*QueryRelayerFeePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BuybackSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuybackSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuybackSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.photon.v1.Query/BuybackSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuybackSchedules(ctx, req.(*QueryBuybackSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BuybackSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuybackScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuybackSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.photon.v1.Query/BuybackSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuybackSchedule(ctx, req.(*QueryBuybackScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomone.photon.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ConversionRate",
+			Handler:    _Query_ConversionRate_Handler,
+		},
+		{
+			MethodName: "MintQuote",
+			Handler:    _Query_MintQuote_Handler,
+		},
+		{
+			MethodName: "MintTotals",
+			Handler:    _Query_MintTotals_Handler,
+		},
+		{
+			MethodName: "MintStats",
+			Handler:    _Query_MintStats_Handler,
+		},
+		{
+			MethodName: "ConversionRateHistory",
+			Handler:    _Query_ConversionRateHistory_Handler,
+		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
+		{
+			MethodName: "RelayerFeePolicy",
+			Handler:    _Query_RelayerFeePolicy_Handler,
+		},
+		{
+			MethodName: "BuybackSchedules",
+			Handler:    _Query_BuybackSchedules_Handler,
+		},
+		{
+			MethodName: "BuybackSchedule",
+			Handler:    _Query_BuybackSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "atomone/photon/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConversionRate) > 0 {
+		i -= len(m.ConversionRate)
+		copy(dAtA[i:], m.ConversionRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConversionRate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintQuoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintQuoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintQuoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MintAmount) > 0 {
+		i -= len(m.MintAmount)
+		copy(dAtA[i:], m.MintAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MintAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BurnAmount) > 0 {
+		i -= len(m.BurnAmount)
+		copy(dAtA[i:], m.BurnAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BurnAmount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintQuoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintQuoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintQuoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RemainingMintable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ConversionRate) > 0 {
+		i -= len(m.ConversionRate)
+		copy(dAtA[i:], m.ConversionRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConversionRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Minted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Burned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddressEpochMinted != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AddressEpochMinted))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Totals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionRateHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionRateHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionRateHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionRateHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionRateHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionRateHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Invariants) > 0 {
+		for iNdEx := len(m.Invariants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invariants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerFeePolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerFeePolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerFeePolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerFeePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerFeePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerFeePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxZeroFeeRelayerGasPerBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxZeroFeeRelayerGasPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Policy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBuybackSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuybackSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuybackSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBuybackSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuybackSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuybackSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBuybackScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuybackScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuybackScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBuybackScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuybackScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuybackScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConversionRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConversionRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConversionRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintQuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BurnAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MintAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintQuoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Burned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ConversionRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.RemainingMintable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Totals.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AddressEpochMinted != 0 {
+		n += 1 + sovQuery(uint64(m.AddressEpochMinted))
+	}
+	return n
+}
+
+func (m *QueryMintStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMintStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConversionRateHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConversionRateHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for _, e := range m.Invariants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Broken {
+		n += 2
+	}
+	return n
+}
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerFeePolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRelayerFeePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		n += 1 + sovQuery(uint64(m.Policy))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxZeroFeeRelayerGasPerBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MaxZeroFeeRelayerGasPerBlock))
+	}
+	return n
+}
+
+func (m *QueryBuybackSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBuybackSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBuybackScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryBuybackScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConversionRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConversionRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintQuoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintQuoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintQuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintQuoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintQuoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintQuoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingMintable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingMintable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintTotalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintTotalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintTotalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintTotalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Totals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressEpochMinted", wireType)
+			}
+			m.AddressEpochMinted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressEpochMinted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConversionRateHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionRateHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionRateHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConversionRateHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionRateHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionRateHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, ConversionRateSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invariants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invariants = append(m.Invariants, InvariantResult{})
+			if err := m.Invariants[len(m.Invariants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRelayerFeePolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerFeePolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerFeePolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryConversionRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRelayerFeePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerFeePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerFeePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= RelayerFeePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxZeroFeeRelayerGasPerBlock", wireType)
+			}
+			m.MaxZeroFeeRelayerGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxZeroFeeRelayerGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryConversionRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConversionRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBuybackSchedulesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -545,12 +4231,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBuybackSchedulesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBuybackSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -572,7 +4294,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBuybackSchedulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -595,15 +4317,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBuybackSchedulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBuybackSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -630,7 +4352,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schedules = append(m.Schedules, BuybackSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -655,7 +4414,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConversionRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBuybackScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -678,12 +4437,31 @@ func (m *QueryConversionRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConversionRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBuybackScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConversionRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBuybackScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -705,7 +4483,7 @@ func (m *QueryConversionRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConversionRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBuybackScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -728,17 +4506,17 @@ func (m *QueryConversionRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConversionRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBuybackScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConversionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBuybackScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -748,23 +4526,24 @@ func (m *QueryConversionRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConversionRate = string(dAtA[iNdEx:postIndex])
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -13,18 +14,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +41,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgMintPhoton struct {
 	ToAddress string     `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
 	Amount    types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// min_photon_out is the minimum amount of uphoton that the message must
+	// mint. If the conversion rate at execution time yields less, the message
+	// fails. Unset or zero means no minimum.
+	MinPhotonOut cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=min_photon_out,json=minPhotonOut,proto3,customtype=cosmossdk.io/math.Int" json:"min_photon_out"`
+	// expiry_height is the last block height at which the message can be
+	// executed. Zero means no height limit.
+	ExpiryHeight int64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// expiry_time is the latest block time at which the message can be
+	// executed. Unset means no time limit.
+	ExpiryTime *time.Time `protobuf:"bytes,5,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
 }
 
 func (m *MsgMintPhoton) Reset()         { *m = MsgMintPhoton{} }
@@ -222,50 +237,330 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgCreateBuybackSchedule is the Msg/CreateBuybackSchedule request type.
+// Exactly one of fraction and amount must be set.
+type MsgCreateBuybackSchedule struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// epoch_identifier is the identifier of the epoch that paces the schedule.
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// epoch_interval is the number of epochs between two conversions.
+	EpochInterval uint64 `protobuf:"varint,3,opt,name=epoch_interval,json=epochInterval,proto3" json:"epoch_interval,omitempty"`
+	// fraction is the fraction of the community pool bond denom funds converted
+	// at each execution, e.g. 0.1 for 10%.
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+	// amount is the fixed amount of community pool bond denom converted at each
+	// execution.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MsgCreateBuybackSchedule) Reset()         { *m = MsgCreateBuybackSchedule{} }
+func (m *MsgCreateBuybackSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBuybackSchedule) ProtoMessage()    {}
+func (*MsgCreateBuybackSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e60927c7c01862c, []int{4}
+}
+func (m *MsgCreateBuybackSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateBuybackSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateBuybackSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateBuybackSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBuybackSchedule.Merge(m, src)
+}
+func (m *MsgCreateBuybackSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateBuybackSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBuybackSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateBuybackSchedule proto.InternalMessageInfo
+
+func (m *MsgCreateBuybackSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCreateBuybackSchedule) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *MsgCreateBuybackSchedule) GetEpochInterval() uint64 {
+	if m != nil {
+		return m.EpochInterval
+	}
+	return 0
+}
+
+// MsgCreateBuybackScheduleResponse defines the response structure for
+// executing a MsgCreateBuybackSchedule message.
+type MsgCreateBuybackScheduleResponse struct {
+	// id is the identifier of the created schedule.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreateBuybackScheduleResponse) Reset()         { *m = MsgCreateBuybackScheduleResponse{} }
+func (m *MsgCreateBuybackScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBuybackScheduleResponse) ProtoMessage()    {}
+func (*MsgCreateBuybackScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e60927c7c01862c, []int{5}
+}
+func (m *MsgCreateBuybackScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateBuybackScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateBuybackScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateBuybackScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBuybackScheduleResponse.Merge(m, src)
+}
+func (m *MsgCreateBuybackScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateBuybackScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBuybackScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateBuybackScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgCreateBuybackScheduleResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelBuybackSchedule is the Msg/CancelBuybackSchedule request type.
+type MsgCancelBuybackSchedule struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the identifier of the schedule to cancel.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelBuybackSchedule) Reset()         { *m = MsgCancelBuybackSchedule{} }
+func (m *MsgCancelBuybackSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBuybackSchedule) ProtoMessage()    {}
+func (*MsgCancelBuybackSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e60927c7c01862c, []int{6}
+}
+func (m *MsgCancelBuybackSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelBuybackSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelBuybackSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelBuybackSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBuybackSchedule.Merge(m, src)
+}
+func (m *MsgCancelBuybackSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelBuybackSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBuybackSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelBuybackSchedule proto.InternalMessageInfo
+
+func (m *MsgCancelBuybackSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelBuybackSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelBuybackScheduleResponse defines the response structure for
+// executing a MsgCancelBuybackSchedule message.
+type MsgCancelBuybackScheduleResponse struct {
+}
+
+func (m *MsgCancelBuybackScheduleResponse) Reset()         { *m = MsgCancelBuybackScheduleResponse{} }
+func (m *MsgCancelBuybackScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBuybackScheduleResponse) ProtoMessage()    {}
+func (*MsgCancelBuybackScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e60927c7c01862c, []int{7}
+}
+func (m *MsgCancelBuybackScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelBuybackScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelBuybackScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelBuybackScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBuybackScheduleResponse.Merge(m, src)
+}
+func (m *MsgCancelBuybackScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelBuybackScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBuybackScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelBuybackScheduleResponse proto.InternalMessageInfo
+
+// ExtensionOptionMintOnFee is a tx extension option that opts the tx into
+// mint-on-fee: the bond denom tx fee is burned, and the equivalent amount of
+// photon is minted and deducted as fee instead. This allows accounts that hold
+// only bond denom to pay photon fees without a prior MsgMintPhoton.
+type ExtensionOptionMintOnFee struct {
+}
+
+func (m *ExtensionOptionMintOnFee) Reset()         { *m = ExtensionOptionMintOnFee{} }
+func (m *ExtensionOptionMintOnFee) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionMintOnFee) ProtoMessage()    {}
+func (*ExtensionOptionMintOnFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e60927c7c01862c, []int{8}
+}
+func (m *ExtensionOptionMintOnFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionMintOnFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionMintOnFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionMintOnFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionMintOnFee.Merge(m, src)
+}
+func (m *ExtensionOptionMintOnFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionMintOnFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionMintOnFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionMintOnFee proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgMintPhoton)(nil), "atomone.photon.v1.MsgMintPhoton")
 	proto.RegisterType((*MsgMintPhotonResponse)(nil), "atomone.photon.v1.MsgMintPhotonResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "atomone.photon.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "atomone.photon.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateBuybackSchedule)(nil), "atomone.photon.v1.MsgCreateBuybackSchedule")
+	proto.RegisterType((*MsgCreateBuybackScheduleResponse)(nil), "atomone.photon.v1.MsgCreateBuybackScheduleResponse")
+	proto.RegisterType((*MsgCancelBuybackSchedule)(nil), "atomone.photon.v1.MsgCancelBuybackSchedule")
+	proto.RegisterType((*MsgCancelBuybackScheduleResponse)(nil), "atomone.photon.v1.MsgCancelBuybackScheduleResponse")
+	proto.RegisterType((*ExtensionOptionMintOnFee)(nil), "atomone.photon.v1.ExtensionOptionMintOnFee")
 }
 
 func init() { proto.RegisterFile("atomone/photon/v1/tx.proto", fileDescriptor_7e60927c7c01862c) }
 
 var fileDescriptor_7e60927c7c01862c = []byte{
-	// 522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6b, 0xdb, 0x40,
-	0x14, 0xd6, 0xb5, 0x34, 0xa0, 0x6b, 0x9b, 0x10, 0x91, 0x12, 0x5b, 0x83, 0x6c, 0xd4, 0xc5, 0x18,
-	0xac, 0xc3, 0x29, 0x24, 0x90, 0x76, 0xa9, 0x5b, 0xe8, 0x64, 0x08, 0x2a, 0x85, 0xd2, 0xa1, 0xe6,
-	0x24, 0x1f, 0xb2, 0x06, 0xdd, 0x13, 0xba, 0xb3, 0x49, 0xb6, 0xd2, 0xa9, 0x74, 0x28, 0xfd, 0x09,
-	0x19, 0x3b, 0x7a, 0xc8, 0xde, 0xa1, 0x50, 0x32, 0x86, 0x4c, 0x9d, 0x4a, 0xb1, 0x07, 0xf7, 0x67,
-	0x14, 0xe9, 0xce, 0x8e, 0x55, 0x1b, 0x9c, 0x45, 0x48, 0xef, 0x7b, 0xdf, 0x7b, 0xdf, 0xf7, 0xdd,
-	0x09, 0xdb, 0x54, 0x42, 0x02, 0x9c, 0x91, 0x74, 0x00, 0x12, 0x38, 0x19, 0xb5, 0x89, 0x3c, 0xf5,
-	0xd2, 0x0c, 0x24, 0x58, 0xbb, 0x1a, 0xf3, 0x14, 0xe6, 0x8d, 0xda, 0xf6, 0x5e, 0x04, 0x11, 0x14,
-	0x28, 0xc9, 0xdf, 0x54, 0xa3, 0xed, 0x84, 0x20, 0x12, 0x10, 0x24, 0xa0, 0x82, 0x91, 0x51, 0x3b,
-	0x60, 0x92, 0xb6, 0x49, 0x08, 0x31, 0xd7, 0x78, 0x55, 0xe1, 0x3d, 0x45, 0x54, 0x1f, 0x1a, 0xda,
-	0xd7, 0xd4, 0x44, 0x44, 0xf9, 0xee, 0x44, 0x44, 0x1a, 0xd8, 0xa5, 0x49, 0xcc, 0x81, 0x14, 0xcf,
-	0xf9, 0x9a, 0x55, 0xad, 0x5a, 0x59, 0x81, 0xbb, 0x3f, 0x10, 0x7e, 0xd8, 0x15, 0x51, 0x37, 0xe6,
-	0xf2, 0xa4, 0xa8, 0x5b, 0x47, 0x18, 0x4b, 0xe8, 0xd1, 0x7e, 0x3f, 0x63, 0x42, 0x54, 0x50, 0x1d,
-	0x35, 0xcc, 0x4e, 0xe5, 0xfa, 0xa2, 0xb5, 0xa7, 0x35, 0x3c, 0x57, 0xc8, 0x6b, 0x99, 0xc5, 0x3c,
-	0xf2, 0x4d, 0x09, 0xba, 0x60, 0x3d, 0xc3, 0x5b, 0x34, 0x81, 0x21, 0x97, 0x95, 0x3b, 0x75, 0xd4,
-	0xb8, 0x7f, 0x50, 0xf5, 0x34, 0x23, 0xb7, 0xe8, 0x69, 0x8b, 0xde, 0x0b, 0x88, 0x79, 0xc7, 0xbc,
-	0xfc, 0x5d, 0x33, 0xbe, 0xcd, 0xc6, 0x4d, 0xe4, 0x6b, 0xce, 0xf1, 0xd3, 0x4f, 0xe7, 0x35, 0xe3,
-	0xef, 0x79, 0xcd, 0xf8, 0x38, 0x1b, 0x37, 0x97, 0x14, 0x7c, 0x9e, 0x8d, 0x9b, 0xb5, 0x55, 0x13,
-	0x25, 0xcd, 0xee, 0x17, 0x84, 0x1f, 0x95, 0x2a, 0x3e, 0x13, 0x29, 0x70, 0xc1, 0x72, 0x51, 0x49,
-	0xcc, 0x25, 0xeb, 0x17, 0x4e, 0x6e, 0x2d, 0x4a, 0x71, 0xac, 0x23, 0xbc, 0x13, 0x02, 0x1f, 0xb1,
-	0x4c, 0xc4, 0xc0, 0x7b, 0x19, 0x95, 0xac, 0xf0, 0x66, 0x76, 0xb6, 0xaf, 0x2f, 0x5a, 0x58, 0x4f,
-	0x7a, 0xc9, 0x42, 0x7f, 0xfb, 0xa6, 0xcd, 0xa7, 0x92, 0xb9, 0xdf, 0x11, 0xde, 0xe9, 0x8a, 0xe8,
-	0x4d, 0xda, 0xa7, 0x92, 0x9d, 0xd0, 0x8c, 0x26, 0xc2, 0x3a, 0xc4, 0x26, 0x1d, 0xca, 0x01, 0x64,
-	0xb1, 0x3c, 0xdb, 0x9c, 0xeb, 0xa2, 0x35, 0xb7, 0x90, 0x16, 0x13, 0x16, 0xb9, 0xae, 0xdc, 0x31,
-	0x4f, 0xad, 0x28, 0x59, 0x50, 0x9c, 0xe3, 0xc3, 0x3c, 0xcf, 0x9b, 0x69, 0x79, 0x9c, 0x8f, 0xe7,
-	0x71, 0x9e, 0x96, 0x03, 0x5d, 0x56, 0xeb, 0x56, 0xf1, 0xfe, 0x7f, 0xa5, 0x79, 0xa6, 0x07, 0x3f,
-	0x11, 0xbe, 0xdb, 0x15, 0x91, 0xf5, 0x16, 0xe3, 0xa5, 0x7b, 0x53, 0x5f, 0x23, 0xab, 0x74, 0x26,
-	0x76, 0x63, 0x53, 0xc7, 0xe2, 0xd4, 0xde, 0xe3, 0x07, 0xa5, 0xe8, 0xdc, 0xf5, 0xcc, 0xe5, 0x1e,
-	0xbb, 0xb9, 0xb9, 0x67, 0x3e, 0xdf, 0xbe, 0xf7, 0x21, 0xcf, 0xa8, 0xf3, 0xea, 0x72, 0xe2, 0xa0,
-	0xab, 0x89, 0x83, 0xfe, 0x4c, 0x1c, 0xf4, 0x75, 0xea, 0x18, 0x57, 0x53, 0xc7, 0xf8, 0x35, 0x75,
-	0x8c, 0x77, 0xad, 0x28, 0x96, 0x83, 0x61, 0xe0, 0x85, 0x90, 0x10, 0x3d, 0xb6, 0x35, 0x18, 0x06,
-	0x64, 0x25, 0x39, 0x79, 0x96, 0x32, 0x11, 0x6c, 0x15, 0x3f, 0xd3, 0x93, 0x7f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x73, 0x17, 0x27, 0x31, 0x1a, 0x04, 0x00, 0x00,
+	// 875 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6b, 0xe3, 0x46,
+	0x14, 0xb6, 0x6c, 0x67, 0xa9, 0x67, 0x13, 0xa7, 0x2b, 0x36, 0xac, 0xa2, 0x82, 0x6d, 0xb4, 0x2d,
+	0xb8, 0x5e, 0x2c, 0xe1, 0x04, 0x36, 0x90, 0xf6, 0xb2, 0xce, 0xf6, 0x47, 0xa0, 0x26, 0x5b, 0x6d,
+	0x0b, 0xa5, 0x87, 0x9a, 0xb1, 0x34, 0x91, 0x86, 0xb5, 0x66, 0x84, 0x66, 0x64, 0xec, 0x5b, 0xe9,
+	0xa9, 0xf4, 0x50, 0xf6, 0xde, 0xcb, 0x1e, 0x7b, 0xcc, 0x61, 0x8f, 0x85, 0x5e, 0xf7, 0x18, 0x72,
+	0x2a, 0x3d, 0xa4, 0x25, 0x39, 0xa4, 0xd7, 0xfe, 0x03, 0xa5, 0x48, 0x33, 0x56, 0x2c, 0xdb, 0x21,
+	0xb8, 0xf4, 0x12, 0xa2, 0xf7, 0xbe, 0xf7, 0xeb, 0xfb, 0xde, 0x1b, 0x03, 0x1d, 0x72, 0x1a, 0x50,
+	0x82, 0xac, 0xd0, 0xa7, 0x9c, 0x12, 0x6b, 0xd4, 0xb1, 0xf8, 0xd8, 0x0c, 0x23, 0xca, 0xa9, 0x7a,
+	0x4f, 0xfa, 0x4c, 0xe1, 0x33, 0x47, 0x1d, 0xfd, 0xbe, 0x47, 0x3d, 0x9a, 0x7a, 0xad, 0xe4, 0x3f,
+	0x01, 0xd4, 0xeb, 0x1e, 0xa5, 0xde, 0x10, 0x59, 0xe9, 0xd7, 0x20, 0x3e, 0xb6, 0x38, 0x0e, 0x10,
+	0xe3, 0x30, 0x08, 0x25, 0xa0, 0xe6, 0x50, 0x16, 0x50, 0x66, 0x0d, 0x20, 0x43, 0xd6, 0xa8, 0x33,
+	0x40, 0x1c, 0x76, 0x2c, 0x87, 0x62, 0x22, 0xfd, 0xdb, 0xc2, 0xdf, 0x17, 0x99, 0xc5, 0x87, 0x74,
+	0x3d, 0x90, 0xa1, 0x01, 0xf3, 0x92, 0xe6, 0x02, 0xe6, 0x49, 0xc7, 0x3d, 0x18, 0x60, 0x42, 0xad,
+	0xf4, 0xef, 0xb4, 0xcc, 0xe2, 0x30, 0xb2, 0xf5, 0xd4, 0x6f, 0xfc, 0x53, 0x04, 0x1b, 0x3d, 0xe6,
+	0xf5, 0x30, 0xe1, 0xcf, 0x52, 0xbb, 0xba, 0x07, 0x00, 0xa7, 0x7d, 0xe8, 0xba, 0x11, 0x62, 0x4c,
+	0x53, 0x1a, 0x4a, 0xb3, 0xd2, 0xd5, 0xce, 0x5e, 0xb7, 0xef, 0xcb, 0x1e, 0x9e, 0x08, 0xcf, 0x73,
+	0x1e, 0x61, 0xe2, 0xd9, 0x15, 0x4e, 0xa5, 0x41, 0xfd, 0x10, 0xdc, 0x81, 0x01, 0x8d, 0x09, 0xd7,
+	0x8a, 0x0d, 0xa5, 0x79, 0x77, 0x67, 0xdb, 0x94, 0x11, 0xc9, 0x88, 0xa6, 0x1c, 0xd1, 0x3c, 0xa0,
+	0x98, 0x74, 0x2b, 0x6f, 0xce, 0xeb, 0x85, 0x9f, 0xaf, 0x4e, 0x5a, 0x8a, 0x2d, 0x63, 0xd4, 0xcf,
+	0x41, 0x35, 0xc0, 0xa4, 0x2f, 0x9a, 0xeb, 0xd3, 0x98, 0x6b, 0xa5, 0xb4, 0xf4, 0xa3, 0x04, 0xfa,
+	0xfb, 0x79, 0x7d, 0x4b, 0x24, 0x63, 0xee, 0x0b, 0x13, 0x53, 0x2b, 0x80, 0xdc, 0x37, 0x0f, 0x09,
+	0x3f, 0x7b, 0xdd, 0x06, 0xb2, 0xca, 0x21, 0xe1, 0xf6, 0x7a, 0x80, 0x89, 0x18, 0xe3, 0x28, 0xe6,
+	0xea, 0x43, 0xb0, 0x81, 0xc6, 0x21, 0x8e, 0x26, 0x7d, 0x1f, 0x61, 0xcf, 0xe7, 0x5a, 0xb9, 0xa1,
+	0x34, 0x4b, 0xf6, 0xba, 0x30, 0x7e, 0x9a, 0xda, 0xd4, 0x27, 0xe0, 0xae, 0x04, 0x25, 0x0a, 0x69,
+	0x6b, 0x69, 0xeb, 0xba, 0x29, 0xe4, 0x33, 0xa7, 0xf2, 0x99, 0x5f, 0x4c, 0xe5, 0xeb, 0x96, 0x5f,
+	0xfe, 0x51, 0x57, 0x6c, 0x20, 0x82, 0x12, 0xf3, 0xfe, 0x07, 0xdf, 0xbf, 0xaa, 0x17, 0xfe, 0x7a,
+	0x55, 0x2f, 0x7c, 0x77, 0x75, 0xd2, 0x9a, 0x21, 0xef, 0x87, 0xab, 0x93, 0x56, 0x7d, 0x91, 0xff,
+	0x1c, 0xdd, 0xc6, 0x8f, 0x0a, 0xd8, 0xca, 0x59, 0x6c, 0xc4, 0x42, 0x4a, 0x18, 0x4a, 0xf8, 0x0c,
+	0x30, 0xe1, 0xc8, 0xd5, 0x94, 0x55, 0xf8, 0x14, 0x31, 0xea, 0x1e, 0xd8, 0x74, 0x28, 0x19, 0xa1,
+	0x88, 0x61, 0x4a, 0xfa, 0x11, 0xe4, 0x28, 0x95, 0xa5, 0xd2, 0xad, 0xce, 0x70, 0xf6, 0x14, 0x39,
+	0x76, 0xf5, 0x1a, 0x66, 0x43, 0x8e, 0x8c, 0x5f, 0x15, 0xb0, 0xd9, 0x63, 0xde, 0x97, 0xa1, 0x0b,
+	0x39, 0x7a, 0x06, 0x23, 0x18, 0x30, 0xf5, 0x31, 0xa8, 0xc0, 0x98, 0xfb, 0x34, 0xc2, 0x7c, 0x72,
+	0xfb, 0x4a, 0x64, 0xd0, 0x64, 0x84, 0x30, 0xcd, 0x90, 0xad, 0xc4, 0xc2, 0xfd, 0x98, 0xa2, 0x44,
+	0x6e, 0x04, 0x11, 0xb3, 0xff, 0x38, 0xe1, 0xf3, 0x3a, 0x5b, 0x42, 0xe7, 0xc3, 0x29, 0x9d, 0xe3,
+	0x3c, 0xa1, 0xb3, 0xdd, 0x1a, 0xdb, 0xe0, 0xc1, 0x9c, 0x69, 0xca, 0xa9, 0xf1, 0x77, 0x11, 0x68,
+	0x3d, 0xe6, 0x1d, 0x44, 0x08, 0x72, 0xd4, 0x8d, 0x27, 0x03, 0xe8, 0xbc, 0x78, 0xee, 0xf8, 0xc8,
+	0x8d, 0x87, 0xe8, 0x3f, 0x4f, 0xf9, 0x3e, 0x78, 0x1b, 0x85, 0xd4, 0xf1, 0xfb, 0xd8, 0x45, 0x84,
+	0xe3, 0x63, 0x8c, 0x22, 0xc1, 0xb5, 0xbd, 0x99, 0xda, 0x0f, 0x33, 0xb3, 0xfa, 0x1e, 0xa8, 0x4a,
+	0x28, 0xe1, 0x28, 0x1a, 0xc1, 0x61, 0xba, 0xe5, 0x65, 0x7b, 0x43, 0x00, 0xa5, 0x51, 0xed, 0x81,
+	0xb7, 0x8e, 0x23, 0xe8, 0x70, 0x4c, 0x49, 0xba, 0xb4, 0x95, 0x6e, 0x47, 0x9e, 0xc1, 0x3b, 0x8b,
+	0x67, 0xf0, 0x19, 0xf2, 0xa0, 0x33, 0x79, 0x8a, 0x9c, 0x39, 0x61, 0xb3, 0x14, 0xea, 0x41, 0x76,
+	0x99, 0x6b, 0xab, 0xdf, 0x94, 0x0c, 0xdd, 0xdf, 0x5b, 0x54, 0xe3, 0xdd, 0x1b, 0xd4, 0xc8, 0xd1,
+	0x6b, 0xec, 0x80, 0xc6, 0x4d, 0x94, 0x67, 0xbb, 0x5e, 0x05, 0x45, 0x2c, 0xf6, 0xbc, 0x6c, 0x17,
+	0xb1, 0x6b, 0xfc, 0xa4, 0x08, 0x9d, 0x20, 0x71, 0xd0, 0xf0, 0xff, 0xd2, 0x49, 0x14, 0x29, 0x4e,
+	0x8b, 0xac, 0x34, 0xd1, 0x6c, 0x23, 0x86, 0x01, 0x1a, 0xf3, 0xb6, 0xf9, 0x89, 0x0c, 0x1d, 0x68,
+	0x1f, 0x8d, 0x39, 0x22, 0xc9, 0x5d, 0x1d, 0x85, 0x89, 0x0c, 0xc9, 0x89, 0x1f, 0x91, 0x8f, 0x11,
+	0xda, 0xf9, 0xa5, 0x04, 0x4a, 0x3d, 0xe6, 0xa9, 0x5f, 0x01, 0x30, 0xf3, 0xf0, 0x36, 0x96, 0x1c,
+	0x47, 0xee, 0x65, 0xd0, 0x9b, 0xb7, 0x21, 0x32, 0x3e, 0xbf, 0x01, 0xeb, 0xb9, 0x03, 0x36, 0x96,
+	0x47, 0xce, 0x62, 0xf4, 0xd6, 0xed, 0x98, 0x2c, 0xff, 0x04, 0x6c, 0x2d, 0xbf, 0xa1, 0x47, 0xcb,
+	0x93, 0x2c, 0x05, 0xeb, 0xbb, 0x2b, 0x80, 0x73, 0xa5, 0x97, 0xae, 0xc5, 0x4d, 0xa5, 0x97, 0x81,
+	0xf5, 0xdd, 0x15, 0xc0, 0xd3, 0xd2, 0xfa, 0xda, 0xb7, 0xc9, 0xfb, 0xd4, 0xfd, 0xe4, 0xcd, 0x45,
+	0x4d, 0x39, 0xbd, 0xa8, 0x29, 0x7f, 0x5e, 0xd4, 0x94, 0x97, 0x97, 0xb5, 0xc2, 0xe9, 0x65, 0xad,
+	0xf0, 0xdb, 0x65, 0xad, 0xf0, 0x75, 0xdb, 0xc3, 0xdc, 0x8f, 0x07, 0xa6, 0x43, 0x03, 0x4b, 0xe6,
+	0x6f, 0xfb, 0xf1, 0xc0, 0x5a, 0xd8, 0x2a, 0x3e, 0x09, 0x11, 0x1b, 0xdc, 0x49, 0x7f, 0x5e, 0x76,
+	0xff, 0x1d, 0x00, 0xdd, 0x3e, 0xe6, 0x05, 0x72, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -285,6 +580,12 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/photon
 	// module parameters. The authority is defined in the keeper.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateBuybackSchedule defines a governance operation for creating a
+	// recurring conversion of the community pool bond denom funds into photon.
+	CreateBuybackSchedule(ctx context.Context, in *MsgCreateBuybackSchedule, opts ...grpc.CallOption) (*MsgCreateBuybackScheduleResponse, error)
+	// CancelBuybackSchedule defines a governance operation for cancelling a
+	// buyback schedule.
+	CancelBuybackSchedule(ctx context.Context, in *MsgCancelBuybackSchedule, opts ...grpc.CallOption) (*MsgCancelBuybackScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -313,6 +614,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) CreateBuybackSchedule(ctx context.Context, in *MsgCreateBuybackSchedule, opts ...grpc.CallOption) (*MsgCreateBuybackScheduleResponse, error) {
+	out := new(MsgCreateBuybackScheduleResponse)
+	err := c.cc.Invoke(ctx, "/atomone.photon.v1.Msg/CreateBuybackSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelBuybackSchedule(ctx context.Context, in *MsgCancelBuybackSchedule, opts ...grpc.CallOption) (*MsgCancelBuybackScheduleResponse, error) {
+	out := new(MsgCancelBuybackScheduleResponse)
+	err := c.cc.Invoke(ctx, "/atomone.photon.v1.Msg/CancelBuybackSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// MintPhoton defines a method to burn atone and mint photons.
@@ -320,6 +639,12 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/photon
 	// module parameters. The authority is defined in the keeper.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateBuybackSchedule defines a governance operation for creating a
+	// recurring conversion of the community pool bond denom funds into photon.
+	CreateBuybackSchedule(context.Context, *MsgCreateBuybackSchedule) (*MsgCreateBuybackScheduleResponse, error)
+	// CancelBuybackSchedule defines a governance operation for cancelling a
+	// buyback schedule.
+	CancelBuybackSchedule(context.Context, *MsgCancelBuybackSchedule) (*MsgCancelBuybackScheduleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -332,6 +657,12 @@ func (*UnimplementedMsgServer) MintPhoton(ctx context.Context, req *MsgMintPhoto
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) CreateBuybackSchedule(ctx context.Context, req *MsgCreateBuybackSchedule) (*MsgCreateBuybackScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBuybackSchedule not implemented")
+}
+func (*UnimplementedMsgServer) CancelBuybackSchedule(ctx context.Context, req *MsgCancelBuybackSchedule) (*MsgCancelBuybackScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBuybackSchedule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -373,6 +704,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateBuybackSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBuybackSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBuybackSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.photon.v1.Msg/CreateBuybackSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBuybackSchedule(ctx, req.(*MsgCreateBuybackSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelBuybackSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelBuybackSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBuybackSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.photon.v1.Msg/CancelBuybackSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBuybackSchedule(ctx, req.(*MsgCancelBuybackSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "atomone.photon.v1.Msg",
@@ -386,6 +753,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CreateBuybackSchedule",
+			Handler:    _Msg_CreateBuybackSchedule_Handler,
+		},
+		{
+			MethodName: "CancelBuybackSchedule",
+			Handler:    _Msg_CancelBuybackSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "atomone/photon/v1/tx.proto",
@@ -411,6 +786,31 @@ func (m *MsgMintPhoton) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinPhotonOut.Size()
+		i -= size
+		if _, err := m.MinPhotonOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -534,78 +934,812 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreateBuybackSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgMintPhoton) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *MsgCreateBuybackSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMintPhotonResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateBuybackSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Minted.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ConversionRate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	var l int
+	i--
+	dAtA[i] = 0x22
+	if m.EpochInterval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBuybackScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBuybackScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBuybackScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelBuybackSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBuybackSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBuybackSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelBuybackScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBuybackScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBuybackScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionMintOnFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionMintOnFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionMintOnFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgMintPhoton) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinPhotonOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintPhotonResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minted.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ConversionRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateBuybackSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochInterval != 0 {
+		n += 1 + sovTx(uint64(m.EpochInterval))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateBuybackScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelBuybackSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelBuybackScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExtensionOptionMintOnFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMintPhoton) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintPhoton: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintPhoton: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPhotonOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPhotonOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintPhotonResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintPhotonResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintPhotonResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgMintPhoton) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateBuybackSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -628,15 +1762,15 @@ func (m *MsgMintPhoton) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintPhoton: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateBuybackSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintPhoton: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateBuybackSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -664,13 +1798,98 @@ func (m *MsgMintPhoton) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInterval", wireType)
+			}
+			m.EpochInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -680,15 +1899,16 @@ func (m *MsgMintPhoton) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
@@ -720,7 +1940,7 @@ func (m *MsgMintPhoton) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintPhotonResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateBuybackScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -743,50 +1963,17 @@ func (m *MsgMintPhotonResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintPhotonResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateBuybackScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintPhotonResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateBuybackScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -796,24 +1983,11 @@ func (m *MsgMintPhotonResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConversionRate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -835,7 +2009,7 @@ func (m *MsgMintPhotonResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelBuybackSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -858,10 +2032,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelBuybackSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelBuybackSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -897,10 +2071,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -910,25 +2084,61 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelBuybackScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelBuybackScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelBuybackScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -950,7 +2160,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ExtensionOptionMintOnFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -973,10 +2183,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtensionOptionMintOnFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtensionOptionMintOnFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: